@@ -12,6 +12,7 @@ type Access interface {
 	HasToken() bool
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	IsOwner(string) bool
 	IsAdmin() bool
 	IsSystem() bool
 	TeamNames() []string
@@ -58,6 +59,22 @@ func (a *access) IsAuthorized(team string) bool {
 	return false
 }
 
+// IsOwner reports whether the user is an owner of the team, regardless of
+// the action being performed. Admins own every team.
+func (a *access) IsOwner(team string) bool {
+	if a.IsAdmin() {
+		return true
+	}
+
+	for _, role := range a.TeamRoles()[team] {
+		if role == "owner" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *access) hasPermission(role string) bool {
 	switch requiredRoles[a.action] {
 	case "owner":
@@ -144,6 +161,11 @@ func (a *access) UserName() string {
 var requiredRoles = map[string]string{
 	atc.SaveConfig:                    "member",
 	atc.GetConfig:                     "viewer",
+	atc.ListPipelineConfigVersions:    "viewer",
+	atc.GetPipelineConfigVersion:      "viewer",
+	atc.RollbackPipelineConfig:        "member",
+	atc.ExportPipeline:                "viewer",
+	atc.ImportPipeline:                "member",
 	atc.GetCC:                         "viewer",
 	atc.GetBuild:                      "viewer",
 	atc.GetBuildPlan:                  "viewer",
@@ -174,7 +196,12 @@ var requiredRoles = map[string]string{
 	atc.SetPinCommentOnResource:       "pipeline-operator",
 	atc.CheckResource:                 "pipeline-operator",
 	atc.CheckResourceWebHook:          "pipeline-operator",
+	atc.PipelineWebHook:               "pipeline-operator",
+	atc.TeamWebHook:                   "pipeline-operator",
 	atc.CheckResourceType:             "pipeline-operator",
+	atc.GetCheck:                      "viewer",
+	atc.CheckEvents:                   "viewer",
+	atc.ListResourceChecks:            "viewer",
 	atc.ListResourceVersions:          "viewer",
 	atc.GetResourceVersion:            "viewer",
 	atc.EnableResourceVersion:         "pipeline-operator",
@@ -190,6 +217,7 @@ var requiredRoles = map[string]string{
 	atc.OrderPipelines:                "member",
 	atc.PausePipeline:                 "pipeline-operator",
 	atc.UnpausePipeline:               "pipeline-operator",
+	atc.ArchivePipeline:               "member",
 	atc.ExposePipeline:                "member",
 	atc.HidePipeline:                  "member",
 	atc.RenamePipeline:                "member",
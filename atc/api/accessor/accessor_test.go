@@ -276,6 +276,53 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Is Owner", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, atc.CreateJobBuild)
+		})
+
+		Context("when request has team name claim set for some-team as owner", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"owner"}}}
+			})
+			It("returns true", func() {
+				Expect(access.IsOwner("some-team")).To(BeTrue())
+			})
+		})
+
+		Context("when request has team name claim set for some-team as member", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"member"}}}
+			})
+			It("returns false", func() {
+				Expect(access.IsOwner("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when request has team name claim set to other-team:owner", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"other-team": {"owner"}}}
+			})
+			It("returns false", func() {
+				Expect(access.IsOwner("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when request has admin claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"is_admin": true}
+			})
+			It("returns true", func() {
+				Expect(access.IsOwner("some-team")).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("Get CSRF Token", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -462,6 +509,31 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.GetConfig, atc.GetConfig, "pipeline-operator", true),
 		Entry("viewer :: "+atc.GetConfig, atc.GetConfig, "viewer", true),
 
+		Entry("owner :: "+atc.ListPipelineConfigVersions, atc.ListPipelineConfigVersions, "owner", true),
+		Entry("member :: "+atc.ListPipelineConfigVersions, atc.ListPipelineConfigVersions, "member", true),
+		Entry("pipeline-operator :: "+atc.ListPipelineConfigVersions, atc.ListPipelineConfigVersions, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListPipelineConfigVersions, atc.ListPipelineConfigVersions, "viewer", true),
+
+		Entry("owner :: "+atc.GetPipelineConfigVersion, atc.GetPipelineConfigVersion, "owner", true),
+		Entry("member :: "+atc.GetPipelineConfigVersion, atc.GetPipelineConfigVersion, "member", true),
+		Entry("pipeline-operator :: "+atc.GetPipelineConfigVersion, atc.GetPipelineConfigVersion, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetPipelineConfigVersion, atc.GetPipelineConfigVersion, "viewer", true),
+
+		Entry("owner :: "+atc.RollbackPipelineConfig, atc.RollbackPipelineConfig, "owner", true),
+		Entry("member :: "+atc.RollbackPipelineConfig, atc.RollbackPipelineConfig, "member", true),
+		Entry("pipeline-operator :: "+atc.RollbackPipelineConfig, atc.RollbackPipelineConfig, "pipeline-operator", false),
+		Entry("viewer :: "+atc.RollbackPipelineConfig, atc.RollbackPipelineConfig, "viewer", false),
+
+		Entry("owner :: "+atc.ExportPipeline, atc.ExportPipeline, "owner", true),
+		Entry("member :: "+atc.ExportPipeline, atc.ExportPipeline, "member", true),
+		Entry("pipeline-operator :: "+atc.ExportPipeline, atc.ExportPipeline, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ExportPipeline, atc.ExportPipeline, "viewer", true),
+
+		Entry("owner :: "+atc.ImportPipeline, atc.ImportPipeline, "owner", true),
+		Entry("member :: "+atc.ImportPipeline, atc.ImportPipeline, "member", true),
+		Entry("pipeline-operator :: "+atc.ImportPipeline, atc.ImportPipeline, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ImportPipeline, atc.ImportPipeline, "viewer", false),
+
 		Entry("owner :: "+atc.GetCC, atc.GetCC, "owner", true),
 		Entry("member :: "+atc.GetCC, atc.GetCC, "member", true),
 		Entry("pipeline-operator :: "+atc.GetCC, atc.GetCC, "pipeline-operator", true),
@@ -602,11 +674,36 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "pipeline-operator", true),
 		Entry("viewer :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "viewer", false),
 
+		Entry("owner :: "+atc.PipelineWebHook, atc.PipelineWebHook, "owner", true),
+		Entry("member :: "+atc.PipelineWebHook, atc.PipelineWebHook, "member", true),
+		Entry("pipeline-operator :: "+atc.PipelineWebHook, atc.PipelineWebHook, "pipeline-operator", true),
+		Entry("viewer :: "+atc.PipelineWebHook, atc.PipelineWebHook, "viewer", false),
+
+		Entry("owner :: "+atc.TeamWebHook, atc.TeamWebHook, "owner", true),
+		Entry("member :: "+atc.TeamWebHook, atc.TeamWebHook, "member", true),
+		Entry("pipeline-operator :: "+atc.TeamWebHook, atc.TeamWebHook, "pipeline-operator", true),
+		Entry("viewer :: "+atc.TeamWebHook, atc.TeamWebHook, "viewer", false),
+
 		Entry("owner :: "+atc.CheckResourceType, atc.CheckResourceType, "owner", true),
 		Entry("member :: "+atc.CheckResourceType, atc.CheckResourceType, "member", true),
 		Entry("pipeline-operator :: "+atc.CheckResourceType, atc.CheckResourceType, "pipeline-operator", true),
 		Entry("viewer :: "+atc.CheckResourceType, atc.CheckResourceType, "viewer", false),
 
+		Entry("owner :: "+atc.GetCheck, atc.GetCheck, "owner", true),
+		Entry("member :: "+atc.GetCheck, atc.GetCheck, "member", true),
+		Entry("pipeline-operator :: "+atc.GetCheck, atc.GetCheck, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetCheck, atc.GetCheck, "viewer", true),
+
+		Entry("owner :: "+atc.CheckEvents, atc.CheckEvents, "owner", true),
+		Entry("member :: "+atc.CheckEvents, atc.CheckEvents, "member", true),
+		Entry("pipeline-operator :: "+atc.CheckEvents, atc.CheckEvents, "pipeline-operator", true),
+		Entry("viewer :: "+atc.CheckEvents, atc.CheckEvents, "viewer", true),
+
+		Entry("owner :: "+atc.ListResourceChecks, atc.ListResourceChecks, "owner", true),
+		Entry("member :: "+atc.ListResourceChecks, atc.ListResourceChecks, "member", true),
+		Entry("pipeline-operator :: "+atc.ListResourceChecks, atc.ListResourceChecks, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListResourceChecks, atc.ListResourceChecks, "viewer", true),
+
 		Entry("owner :: "+atc.ListResourceVersions, atc.ListResourceVersions, "owner", true),
 		Entry("member :: "+atc.ListResourceVersions, atc.ListResourceVersions, "member", true),
 		Entry("pipeline-operator :: "+atc.ListResourceVersions, atc.ListResourceVersions, "pipeline-operator", true),
@@ -677,6 +774,11 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.UnpausePipeline, atc.UnpausePipeline, "pipeline-operator", true),
 		Entry("viewer :: "+atc.UnpausePipeline, atc.UnpausePipeline, "viewer", false),
 
+		Entry("owner :: "+atc.ArchivePipeline, atc.ArchivePipeline, "owner", true),
+		Entry("member :: "+atc.ArchivePipeline, atc.ArchivePipeline, "member", true),
+		Entry("pipeline-operator :: "+atc.ArchivePipeline, atc.ArchivePipeline, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ArchivePipeline, atc.ArchivePipeline, "viewer", false),
+
 		Entry("owner :: "+atc.ExposePipeline, atc.ExposePipeline, "owner", true),
 		Entry("member :: "+atc.ExposePipeline, atc.ExposePipeline, "member", true),
 		Entry("pipeline-operator :: "+atc.ExposePipeline, atc.ExposePipeline, "pipeline-operator", false),
@@ -59,6 +59,17 @@ type FakeAccess struct {
 	isAuthorizedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsOwnerStub        func(string) bool
+	isOwnerMutex       sync.RWMutex
+	isOwnerArgsForCall []struct {
+		arg1 string
+	}
+	isOwnerReturns struct {
+		result1 bool
+	}
+	isOwnerReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsSystemStub        func() bool
 	isSystemMutex       sync.RWMutex
 	isSystemArgsForCall []struct {
@@ -361,6 +372,66 @@ func (fake *FakeAccess) IsAuthorizedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsOwner(arg1 string) bool {
+	fake.isOwnerMutex.Lock()
+	ret, specificReturn := fake.isOwnerReturnsOnCall[len(fake.isOwnerArgsForCall)]
+	fake.isOwnerArgsForCall = append(fake.isOwnerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("IsOwner", []interface{}{arg1})
+	fake.isOwnerMutex.Unlock()
+	if fake.IsOwnerStub != nil {
+		return fake.IsOwnerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isOwnerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsOwnerCallCount() int {
+	fake.isOwnerMutex.RLock()
+	defer fake.isOwnerMutex.RUnlock()
+	return len(fake.isOwnerArgsForCall)
+}
+
+func (fake *FakeAccess) IsOwnerCalls(stub func(string) bool) {
+	fake.isOwnerMutex.Lock()
+	defer fake.isOwnerMutex.Unlock()
+	fake.IsOwnerStub = stub
+}
+
+func (fake *FakeAccess) IsOwnerArgsForCall(i int) string {
+	fake.isOwnerMutex.RLock()
+	defer fake.isOwnerMutex.RUnlock()
+	argsForCall := fake.isOwnerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccess) IsOwnerReturns(result1 bool) {
+	fake.isOwnerMutex.Lock()
+	defer fake.isOwnerMutex.Unlock()
+	fake.IsOwnerStub = nil
+	fake.isOwnerReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsOwnerReturnsOnCall(i int, result1 bool) {
+	fake.isOwnerMutex.Lock()
+	defer fake.isOwnerMutex.Unlock()
+	fake.IsOwnerStub = nil
+	if fake.isOwnerReturnsOnCall == nil {
+		fake.isOwnerReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isOwnerReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsSystem() bool {
 	fake.isSystemMutex.Lock()
 	ret, specificReturn := fake.isSystemReturnsOnCall[len(fake.isSystemArgsForCall)]
@@ -530,6 +601,8 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.isAuthenticatedMutex.RUnlock()
 	fake.isAuthorizedMutex.RLock()
 	defer fake.isAuthorizedMutex.RUnlock()
+	fake.isOwnerMutex.RLock()
+	defer fake.isOwnerMutex.RUnlock()
 	fake.isSystemMutex.RLock()
 	defer fake.isSystemMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
@@ -15,7 +15,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
-	"github.com/concourse/concourse/atc/api/resourceserver/resourceserverfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
@@ -42,7 +41,7 @@ var (
 	dbPipelineFactory       *dbfakes.FakePipelineFactory
 	dbJobFactory            *dbfakes.FakeJobFactory
 	dbResourceFactory       *dbfakes.FakeResourceFactory
-	dbResourceConfigFactory *dbfakes.FakeResourceConfigFactory
+	dbCheckFactory          *dbfakes.FakeCheckFactory
 	fakePipeline            *dbfakes.FakePipeline
 	fakeAccess              *accessorfakes.FakeAccess
 	fakeAccessor            *accessorfakes.FakeAccessFactory
@@ -52,8 +51,8 @@ var (
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbUserFactory           *dbfakes.FakeUserFactory
 	dbTeam                  *dbfakes.FakeTeam
-	fakeScannerFactory      *resourceserverfakes.FakeScannerFactory
 	fakeSecretManager       *credsfakes.FakeSecrets
+	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	credsManagers           creds.Managers
 	interceptTimeoutFactory *containerserverfakes.FakeInterceptTimeoutFactory
 	interceptTimeout        *containerserverfakes.FakeInterceptTimeout
@@ -93,7 +92,7 @@ var _ = BeforeEach(func() {
 	dbPipelineFactory = new(dbfakes.FakePipelineFactory)
 	dbJobFactory = new(dbfakes.FakeJobFactory)
 	dbResourceFactory = new(dbfakes.FakeResourceFactory)
-	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
+	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
 
@@ -118,13 +117,12 @@ var _ = BeforeEach(func() {
 
 	fakeWorkerClient = new(workerfakes.FakeClient)
 
-	fakeScannerFactory = new(resourceserverfakes.FakeScannerFactory)
-
 	fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
 	fakeContainerRepository = new(dbfakes.FakeContainerRepository)
 	fakeDestroyer = new(gcfakes.FakeDestroyer)
 
 	fakeSecretManager = new(credsfakes.FakeSecrets)
+	fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 	credsManagers = make(creds.Managers)
 	var err error
 
@@ -173,15 +171,13 @@ var _ = BeforeEach(func() {
 		fakeContainerRepository,
 		fakeDestroyer,
 		dbBuildFactory,
-		dbResourceConfigFactory,
+		dbCheckFactory,
 		dbUserFactory,
 
 		constructedEventHandler.Construct,
 
 		fakeWorkerClient,
 
-		fakeScannerFactory,
-
 		sink,
 
 		isTLSEnabled,
@@ -190,6 +186,7 @@ var _ = BeforeEach(func() {
 		"1.2.3",
 		"4.5.6",
 		fakeSecretManager,
+		fakeVarSourcePool,
 		credsManagers,
 		interceptTimeoutFactory,
 	)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -45,6 +46,12 @@ func (h checkPipelineAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	teamName := r.FormValue(":team_name")
 	pipelineName := r.FormValue(":pipeline_name")
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	team, found, err := h.teamFactory.FindTeam(teamName)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -55,7 +62,11 @@ func (h checkPipelineAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
-	pipeline, found, err := team.Pipeline(pipelineName)
+
+	pipeline, found, err := team.Pipeline(atc.PipelineRef{
+		Name:         pipelineName,
+		InstanceVars: instanceVars,
+	})
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -585,6 +585,20 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						Context("when the build's pipeline is instanced", func() {
+							BeforeEach(func() {
+								build.PipelineInstanceVarsReturns(atc.InstanceVars{"branch": "feature"})
+							})
+
+							It("returns the pipeline's instance vars", func() {
+								var presented atc.Build
+								err := json.NewDecoder(response.Body).Decode(&presented)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(presented.PipelineInstanceVars).To(Equal(atc.InstanceVars{"branch": "feature"}))
+							})
+						})
 					})
 				})
 			})
@@ -1422,6 +1436,43 @@ var _ = Describe("Builds API", func() {
 						"plan": {"some":"plan"}
 					}`))
 					})
+
+					Context("when the build has attempts", func() {
+						BeforeEach(func() {
+							build.AttemptsReturns(map[atc.PlanID][]atc.BuildAttempt{
+								"some-retry-plan": {
+									{Attempt: 1, Status: atc.StatusErrored, Error: "nope", StartTime: 10, EndTime: 20},
+									{Attempt: 2, Status: atc.StatusSucceeded, StartTime: 30, EndTime: 40},
+								},
+							}, nil)
+						})
+
+						It("returns the outcome of each attempt", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`{
+							"schema": "some-schema",
+							"plan": {"some":"plan"},
+							"attempts": {
+								"some-retry-plan": [
+									{"attempt": 1, "status": "errored", "error": "nope", "start_time": 10, "end_time": 20},
+									{"attempt": 2, "status": "succeeded", "start_time": 30, "end_time": 40}
+								]
+							}
+						}`))
+						})
+					})
+
+					Context("when getting the attempts fails", func() {
+						BeforeEach(func() {
+							build.AttemptsReturns(nil, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
 				})
 
 				Context("when the build has no plan", func() {
@@ -16,10 +16,19 @@ func (s *Server) GetBuildPlan(build db.Build) http.Handler {
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
+
+		attempts, err := build.Attempts()
+		if err != nil {
+			hLog.Error("failed-to-get-build-attempts", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		w.Header().Set("Content-Type", "application/json")
-		err := json.NewEncoder(w).Encode(atc.PublicBuildPlan{
-			Schema: build.Schema(),
-			Plan:   build.PublicPlan(),
+		err = json.NewEncoder(w).Encode(atc.PublicBuildPlan{
+			Schema:   build.Schema(),
+			Plan:     build.PublicPlan(),
+			Attempts: attempts,
 		})
 		if err != nil {
 			hLog.Error("failed-to-encode-public-build-plan", err)
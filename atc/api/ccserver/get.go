@@ -57,6 +57,10 @@ func (s *Server) GetCC(w http.ResponseWriter, r *http.Request) {
 	var projects []Project
 
 	for _, pipeline := range pipelines {
+		if pipeline.Archived() {
+			continue
+		}
+
 		dashboards, err := pipeline.Dashboard()
 
 		if err != nil {
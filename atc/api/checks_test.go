@@ -0,0 +1,273 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/vito/go-sse/sse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checks API", func() {
+	var (
+		response   *http.Response
+		fakeaccess *accessorfakes.FakeAccess
+		checkID    string
+	)
+
+	BeforeEach(func() {
+		fakeaccess = new(accessorfakes.FakeAccess)
+		checkID = "10"
+	})
+
+	Describe("GET /api/v1/checks/:check_id", func() {
+		JustBeforeEach(func() {
+			fakeAccessor.CreateReturns(fakeaccess)
+
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/checks/" + checkID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the check exists", func() {
+				BeforeEach(func() {
+					fakeCheck := new(dbfakes.FakeCheck)
+					fakeCheck.IDReturns(10)
+					fakeCheck.TeamNameReturns("some-team")
+					fakeCheck.StatusReturns(atc.CheckStatusErrored)
+					fakeCheck.CreatedByReturns(atc.CheckCreatedByManual)
+					fakeCheck.CreateTimeReturns(time.Unix(100, 0))
+					fakeCheck.StartTimeReturns(time.Unix(101, 0))
+					fakeCheck.EndTimeReturns(time.Unix(102, 0))
+					fakeCheck.PlanReturns(atc.CheckPlan{Resource: "some-resource", From: atc.Version{"ref": "v1"}})
+					fakeCheck.CheckErrorReturns(errors.New("nope"))
+
+					dbCheckFactory.CheckReturns(fakeCheck, true, nil)
+				})
+
+				Context("when authorized for the check's team", func() {
+					BeforeEach(func() {
+						fakeaccess.IsAuthorizedReturns(true)
+					})
+
+					It("looks up the check", func() {
+						Expect(dbCheckFactory.CheckArgsForCall(0)).To(Equal(10))
+						Expect(fakeaccess.IsAuthorizedArgsForCall(0)).To(Equal("some-team"))
+					})
+
+					It("returns 200 with the check", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"id": 10,
+							"status": "errored",
+							"created_by": "manual",
+							"create_time": 100,
+							"start_time": 101,
+							"end_time": 102,
+							"plan": {"resource": "some-resource", "from": {"ref": "v1"}},
+							"check_error": "nope"
+						}`))
+					})
+				})
+
+				Context("when not authorized for the check's team", func() {
+					BeforeEach(func() {
+						fakeaccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+				})
+			})
+
+			Context("when the check does not exist", func() {
+				BeforeEach(func() {
+					dbCheckFactory.CheckReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when looking up the check fails", func() {
+				BeforeEach(func() {
+					dbCheckFactory.CheckReturns(nil, false, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the check id is malformed", func() {
+				BeforeEach(func() {
+					checkID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/checks/:check_id/events", func() {
+		var (
+			request         *http.Request
+			fakeCheck       *dbfakes.FakeCheck
+			fakeEventSource *dbfakes.FakeEventSource
+		)
+
+		BeforeEach(func() {
+			fakeCheck = new(dbfakes.FakeCheck)
+			fakeCheck.IDReturns(10)
+			fakeCheck.TeamNameReturns("some-team")
+			dbCheckFactory.CheckReturns(fakeCheck, true, nil)
+
+			returnedEvents := []event.Envelope{
+				checkEvent(`{"payload":"some-stderr"}`),
+			}
+
+			fakeEventSource = new(dbfakes.FakeEventSource)
+			fakeCheck.EventsStub = func(from uint) (db.EventSource, error) {
+				fakeEventSource.NextStub = func() (event.Envelope, error) {
+					if from >= uint(len(returnedEvents)) {
+						return event.Envelope{}, db.ErrEndOfCheckEventStream
+					}
+
+					from++
+
+					return returnedEvents[from-1], nil
+				}
+
+				return fakeEventSource, nil
+			}
+
+			var err error
+			request, err = http.NewRequest("GET", server.URL+"/api/v1/checks/"+checkID+"/events", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			fakeAccessor.CreateReturns(fakeaccess)
+
+			var err error
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when authorized for the check's team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+				})
+
+				AfterEach(func() {
+					Eventually(fakeEventSource.CloseCallCount).Should(Equal(1))
+				})
+
+				It("returns 200 with an event stream", func() {
+					_ = response.Body.Close()
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+				})
+
+				It("emits the events of the check from the start, followed by an end event", func() {
+					defer response.Body.Close()
+					reader := sse.NewReadCloser(response.Body)
+
+					Expect(reader.Next()).To(Equal(sse.Event{
+						ID:   "0",
+						Name: "event",
+						Data: []byte(`{"data":{"payload":"some-stderr"},"event":"log","version":"5.1"}`),
+					}))
+
+					Expect(reader.Next()).To(Equal(sse.Event{
+						ID:   "1",
+						Name: "end",
+						Data: []byte{},
+					}))
+
+					Expect(fakeCheck.EventsArgsForCall(0)).To(BeZero())
+				})
+
+				Context("when the Last-Event-ID header is given", func() {
+					BeforeEach(func() {
+						request.Header.Set("Last-Event-ID", "0")
+					})
+
+					It("picks up after it", func() {
+						_ = response.Body.Close()
+						Expect(fakeCheck.EventsArgsForCall(0)).To(Equal(uint(1)))
+					})
+				})
+			})
+
+			Context("when not authorized for the check's team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeCheck.EventsCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+})
+
+func checkEvent(payload string) event.Envelope {
+	msg := json.RawMessage(payload)
+	return event.Envelope{
+		Data:    &msg,
+		Event:   "log",
+		Version: "5.1",
+	}
+}
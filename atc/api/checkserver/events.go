@@ -0,0 +1,93 @@
+package checkserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/vito/go-sse/sse"
+)
+
+// CheckEvents streams the log of the check as server-sent events, in the same
+// way as the events of a build, ending the stream once the check finishes.
+func (s *Server) CheckEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("check-events")
+
+	check, ok := s.authorizedCheck(logger, w, r)
+	if !ok {
+		return
+	}
+
+	clientNotifier := w.(http.CloseNotifier)
+	flusher := w.(http.Flusher)
+
+	var eventID uint = 0
+	if r.Header.Get("Last-Event-ID") != "" {
+		startString := r.Header.Get("Last-Event-ID")
+		_, err := fmt.Sscanf(startString, "%d", &eventID)
+		if err != nil {
+			logger.Info("failed-to-parse-last-event-id", lager.Data{"last-event-id": startString})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		eventID++
+	}
+
+	events, err := check.Events(eventID)
+	if err != nil {
+		logger.Error("failed-to-get-check-events", err, lager.Data{"check-id": check.ID(), "start": eventID})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	defer db.Close(events)
+
+	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Add("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err != db.ErrEndOfCheckEventStream {
+				logger.Error("failed-to-get-next-check-event", err)
+				return
+			}
+
+			err = sse.Event{ID: fmt.Sprintf("%d", eventID), Name: "end"}.Write(w)
+			if err != nil {
+				logger.Info("failed-to-write-end", lager.Data{"error": err.Error()})
+				return
+			}
+
+			flusher.Flush()
+
+			<-clientNotifier.CloseNotify()
+			return
+		}
+
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			logger.Error("failed-to-marshal-check-event", err)
+			return
+		}
+
+		err = sse.Event{
+			ID:   fmt.Sprintf("%d", eventID),
+			Name: "event",
+			Data: payload,
+		}.Write(w)
+		if err != nil {
+			logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+			return
+		}
+
+		flusher.Flush()
+
+		eventID++
+	}
+}
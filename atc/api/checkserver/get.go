@@ -0,0 +1,62 @@
+package checkserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) GetCheck(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-check")
+
+	check, ok := s.authorizedCheck(logger, w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err := json.NewEncoder(w).Encode(present.Check(check))
+	if err != nil {
+		logger.Error("failed-to-encode-check", err)
+	}
+}
+
+// authorizedCheck looks up the check of the request, responding with the
+// appropriate error status if it can't be found or belongs to a team the user
+// isn't authorized for.
+func (s *Server) authorizedCheck(logger lager.Logger, w http.ResponseWriter, r *http.Request) (db.Check, bool) {
+	checkID, err := strconv.Atoi(rata.Param(r, "check_id"))
+	if err != nil {
+		logger.Info("malformed-check-id", lager.Data{"check-id": rata.Param(r, "check_id")})
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+
+	check, found, err := s.checkFactory.Check(checkID)
+	if err != nil {
+		logger.Error("failed-to-get-check", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsAuthorized(check.TeamName()) {
+		w.WriteHeader(http.StatusForbidden)
+		return nil, false
+	}
+
+	return check, true
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/vars"
 	"github.com/ghodss/yaml"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/tedsuo/rata"
@@ -91,6 +92,33 @@ var _ = Describe("Config API", func() {
 					},
 				},
 			},
+
+			Vars: map[string]interface{}{
+				"some-var": "some-value",
+			},
+
+			Display: &atc.DisplayConfig{
+				BackgroundImage: "https://example.com/image.png",
+			},
+
+			FreezeWindows: atc.FreezeWindowConfigs{
+				{
+					Name:     "weekend",
+					Start:    "0 18 * * 5",
+					Duration: "62h",
+					Jobs:     []string{"some-job"},
+				},
+			},
+
+			VarSources: atc.VarSourceConfigs{
+				{
+					Name: "some-var-source",
+					Type: "vault",
+					Config: map[string]interface{}{
+						"url": "https://vault.example.com",
+					},
+				},
+			},
 		}
 	})
 
@@ -141,6 +169,33 @@ var _ = Describe("Config API", func() {
 								Resources: []string{"some-resource"},
 							},
 						})
+						fakePipeline.VarsReturns(map[string]interface{}{
+							"some-var": "some-value",
+						})
+						fakePipeline.DisplayReturns(&atc.DisplayConfig{
+							BackgroundImage: "https://example.com/image.png",
+						})
+						fakePipeline.FreezeWindowsReturns(atc.FreezeWindowConfigs{
+							{
+								Name:     "weekend",
+								Start:    "0 18 * * 5",
+								Duration: "62h",
+								Jobs:     []string{"some-job"},
+							},
+						})
+						fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+							{
+								Name: "some-var-source",
+								Type: "vault",
+								Config: map[string]interface{}{
+									"url":          "https://vault.example.com",
+									"client_token": "some-token",
+									"auth_param": map[string]interface{}{
+										"role_id": "((role-id))",
+									},
+								},
+							},
+						})
 						fakeTeam.PipelineReturns(fakePipeline, true, nil)
 					})
 
@@ -217,11 +272,25 @@ var _ = Describe("Config API", func() {
 									Expect(response.Header.Get(atc.ConfigVersionHeader)).To(Equal("1"))
 								})
 
-								It("returns the config", func() {
+								It("returns the config with the var sources' credentials redacted", func() {
 									var actualConfigResponse atc.ConfigResponse
 									err := json.NewDecoder(response.Body).Decode(&actualConfigResponse)
 									Expect(err).NotTo(HaveOccurred())
 
+									pipelineConfig.VarSources = atc.VarSourceConfigs{
+										{
+											Name: "some-var-source",
+											Type: "vault",
+											Config: map[string]interface{}{
+												"url":          "https://vault.example.com",
+												"client_token": "((redacted))",
+												"auth_param": map[string]interface{}{
+													"role_id": "((role-id))",
+												},
+											},
+										},
+									}
+
 									Expect(actualConfigResponse).To(Equal(atc.ConfigResponse{
 										Config: pipelineConfig,
 									}))
@@ -426,13 +495,153 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
 						})
 
+						Context("when the user is known", func() {
+							BeforeEach(func() {
+								fakeaccess.UserNameReturns("some-user")
+							})
+
+							It("saves it as created by them", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+
+								_, _, _, _, createdBy := dbTeam.SavePipelineArgsForCall(0)
+								Expect(createdBy).To(Equal("some-user"))
+							})
+						})
+
+						Context("when the dry_run param is set", func() {
+							var dryRunPipeline *dbfakes.FakePipeline
+
+							BeforeEach(func() {
+								query := request.URL.Query()
+								query.Add(atc.SaveConfigDryRun, "")
+								request.URL.RawQuery = query.Encode()
+
+								dryRunPipeline = new(dbfakes.FakePipeline)
+								dbTeam.PipelineReturns(dryRunPipeline, true, nil)
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("does not save it", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							})
+
+							It("looks up the pipeline", func() {
+								Expect(dbTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+							})
+
+							Context("when the pipeline does not exist yet", func() {
+								BeforeEach(func() {
+									dbTeam.PipelineReturns(nil, false, nil)
+								})
+
+								It("reports no impact", func() {
+									Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"dry_run":{}}`))
+								})
+							})
+
+							Context("when the pipeline exists", func() {
+								var (
+									fakeResource *dbfakes.FakeResource
+									fakeJob      *dbfakes.FakeJob
+								)
+
+								BeforeEach(func() {
+									pipelineConfig.Jobs[0].OldName = "old-job"
+									pipelineConfig.Jobs[0].Plan[0].Trigger = true
+									payload, err := json.Marshal(pipelineConfig)
+									Expect(err).NotTo(HaveOccurred())
+									request.Body = gbytes.BufferWithBytes(payload)
+
+									fakeResource = new(dbfakes.FakeResource)
+									fakeResource.NameReturns("some-resource")
+									fakeResource.TypeReturns("some-type")
+									fakeResource.SourceReturns(atc.Source{"source-config": "some-value"})
+									fakeResource.VersionsReturns([]atc.ResourceVersion{{ID: 1}}, db.Pagination{}, true, nil)
+									dryRunPipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+									fakeJob = new(dbfakes.FakeJob)
+									fakeJob.NameReturns("old-job")
+									fakeJob.ConfigReturns(atc.JobConfig{Name: "old-job"})
+									dryRunPipeline.JobsReturns(db.Jobs{fakeJob}, nil)
+								})
+
+								It("reports the renamed jobs and the builds that would be triggered", func() {
+									Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+										"dry_run": {
+											"renamed_jobs": [{"from": "old-job", "to": "some-job"}],
+											"triggered_jobs": ["some-job"]
+										}
+									}`))
+								})
+
+								Context("when the resource's source changes", func() {
+									BeforeEach(func() {
+										fakeResource.SourceReturns(atc.Source{"source-config": "some-other-value"})
+									})
+
+									It("reports the new resource config scope and does not expect a build", func() {
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"dry_run": {
+												"new_resource_scopes": ["some-resource"],
+												"renamed_jobs": [{"from": "old-job", "to": "some-job"}]
+											}
+										}`))
+									})
+								})
+
+								Context("when the pipeline is paused", func() {
+									BeforeEach(func() {
+										dryRunPipeline.PausedReturns(true)
+									})
+
+									It("does not expect a build", func() {
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"dry_run": {
+												"renamed_jobs": [{"from": "old-job", "to": "some-job"}]
+											}
+										}`))
+									})
+								})
+
+								Context("when the job already triggered on the resource", func() {
+									BeforeEach(func() {
+										fakeJob.ConfigReturns(atc.JobConfig{
+											Name: "old-job",
+											Plan: atc.PlanSequence{{Get: "some-resource", Trigger: true}},
+										})
+									})
+
+									It("does not expect a build", func() {
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"dry_run": {
+												"renamed_jobs": [{"from": "old-job", "to": "some-job"}]
+											}
+										}`))
+									})
+								})
+							})
+
+							Context("when looking up the pipeline fails", func() {
+								BeforeEach(func() {
+									dbTeam.PipelineReturns(nil, false, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
+
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
 								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
@@ -458,6 +667,40 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when instance vars are given", func() {
+							BeforeEach(func() {
+								query := request.URL.Query()
+								query.Add("instance_vars", `{"branch":"release-1.2"}`)
+								request.URL.RawQuery = query.Encode()
+							})
+
+							It("saves the instance identified by them", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+
+								pipelineRef, _, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{
+									Name:         "a-pipeline",
+									InstanceVars: atc.InstanceVars{"branch": "release-1.2"},
+								}))
+							})
+						})
+
+						Context("when the instance vars are malformed", func() {
+							BeforeEach(func() {
+								query := request.URL.Query()
+								query.Add("instance_vars", "bogus")
+								request.URL.RawQuery = query.Encode()
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("does not save anything", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							})
+						})
+
 						Context("when the config is invalid", func() {
 							BeforeEach(func() {
 								pipelineConfig.Groups[0].Resources = []string{"missing-resource"}
@@ -487,6 +730,77 @@ var _ = Describe("Config API", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
 							})
 						})
+
+						Context("when an input is passed through a job of another pipeline which can't be found", func() {
+							BeforeEach(func() {
+								pipelineConfig.Jobs[0].Plan[0].Passed = []string{"upstream-pipeline/upstream-job"}
+								payload, err := json.Marshal(pipelineConfig)
+								Expect(err).NotTo(HaveOccurred())
+								request.Body = gbytes.BufferWithBytes(payload)
+
+								dbTeam.SavePipelineReturns(nil, false, db.InvalidPassedJobsError{
+									Errors: []string{"jobs.some-job.get.some-input.passed references an unknown job ('upstream-pipeline/upstream-job')"},
+								})
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("returns error JSON", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+								{
+									"errors": [
+										"jobs.some-job.get.some-input.passed references an unknown job ('upstream-pipeline/upstream-job')"
+									]
+								}`))
+							})
+
+							Context("when the dry_run param is set", func() {
+								BeforeEach(func() {
+									query := request.URL.Query()
+									query.Add(atc.SaveConfigDryRun, "")
+									request.URL.RawQuery = query.Encode()
+
+									dbTeam.ValidatePassedJobsReturns([]string{
+										"jobs.some-job.get.some-input.passed references an unknown pipeline ('upstream-pipeline')",
+									}, nil)
+								})
+
+								It("validates the config's passed jobs", func() {
+									Expect(dbTeam.ValidatePassedJobsCallCount()).To(Equal(1))
+									Expect(dbTeam.ValidatePassedJobsArgsForCall(0).Jobs[0].Plan[0].Passed).To(Equal([]string{"upstream-pipeline/upstream-job"}))
+								})
+
+								It("returns error JSON", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+									Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+									{
+										"errors": [
+											"jobs.some-job.get.some-input.passed references an unknown pipeline ('upstream-pipeline')"
+										]
+									}`))
+								})
+
+								It("does not save it", func() {
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								})
+							})
+
+							Context("when validating the passed jobs fails during a dry run", func() {
+								BeforeEach(func() {
+									query := request.URL.Query()
+									query.Add(atc.SaveConfigDryRun, "")
+									request.URL.RawQuery = query.Encode()
+
+									dbTeam.ValidatePassedJobsReturns(nil, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
 					})
 
 					Context("YAML", func() {
@@ -510,8 +824,8 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -554,8 +868,8 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								pipelineRef, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
 										{
@@ -824,8 +1138,8 @@ jobs:
 									It("passes validation and saves it un-interpolated", func() {
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-										name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-										Expect(name).To(Equal("a-pipeline"))
+										pipelineRef, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+										Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
 										Expect(initiallyPaused).To(BeTrue())
@@ -867,6 +1181,28 @@ jobs:
 								})
 							})
 
+							Context("when the dry_run param is set", func() {
+								BeforeEach(func() {
+									query := request.URL.Query()
+									query.Add(atc.SaveConfigDryRun, "")
+									request.URL.RawQuery = query.Encode()
+
+									fakeSecretManager.GetReturns(nil, nil, false, nil)
+									dbTeam.PipelineReturns(nil, false, nil)
+								})
+
+								It("returns 200", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+								})
+
+								It("reports the credentials that can't be resolved", func() {
+									Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"dry_run":{"unresolved_credentials":["failed to interpolate task config: undefined vars: BAR"]}}`))
+								})
+
+								It("does not save it", func() {
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								})
+							})
 						})
 
 						Context("when it's the first time the pipeline has been created", func() {
@@ -980,8 +1316,8 @@ jobs:
 					It("saves it", func() {
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-						name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-						Expect(name).To(Equal("a-pipeline"))
+						pipelineRef, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+						Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
 								{
@@ -1076,4 +1412,825 @@ jobs:
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:name/config/versions", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.ListPipelineConfigVersions, rata.Params{
+				"team_name":     "a-team",
+				"pipeline_name": "a-pipeline",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the config versions are found", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigVersionsReturns([]db.PipelineConfigVersion{
+						{
+							Version:   2,
+							Config:    pipelineConfig,
+							CreatedBy: "some-user",
+							CreatedAt: time.Unix(200, 0),
+						},
+						{
+							Version:   1,
+							Config:    pipelineConfig,
+							CreatedAt: time.Unix(100, 0),
+						},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns the versions without their configs", func() {
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{"version": 2, "created_by": "some-user", "created_at": 200},
+						{"version": 1, "created_at": 100}
+					]`))
+				})
+			})
+
+			Context("when getting the config versions fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigVersionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:name/config/versions/:config_version", func() {
+		var (
+			response      *http.Response
+			configVersion string
+		)
+
+		BeforeEach(func() {
+			configVersion = "1"
+		})
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.GetPipelineConfigVersion, rata.Params{
+				"team_name":      "a-team",
+				"pipeline_name":  "a-pipeline",
+				"config_version": configVersion,
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the config version is found", func() {
+				BeforeEach(func() {
+					storedConfig := pipelineConfig
+					storedConfig.VarSources = atc.VarSourceConfigs{
+						{
+							Name:   "some-var-source",
+							Type:   "vault",
+							Config: map[string]interface{}{"client_token": "some-token"},
+						},
+					}
+
+					fakePipeline.FindConfigVersionReturns(db.PipelineConfigVersion{
+						Version:   1,
+						Config:    storedConfig,
+						CreatedBy: "some-user",
+						CreatedAt: time.Unix(100, 0),
+					}, true, nil)
+				})
+
+				It("looks up the requested version", func() {
+					Expect(fakePipeline.FindConfigVersionArgsForCall(0)).To(Equal(db.ConfigVersion(1)))
+				})
+
+				It("returns the version with its config, redacting the var sources' credentials", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					var configVersion atc.PipelineConfigVersion
+					err := json.NewDecoder(response.Body).Decode(&configVersion)
+					Expect(err).NotTo(HaveOccurred())
+
+					pipelineConfig.VarSources = atc.VarSourceConfigs{
+						{
+							Name:   "some-var-source",
+							Type:   "vault",
+							Config: map[string]interface{}{"client_token": "((redacted))"},
+						},
+					}
+
+					Expect(configVersion).To(Equal(atc.PipelineConfigVersion{
+						Version:   1,
+						CreatedBy: "some-user",
+						CreatedAt: 100,
+						Config:    &pipelineConfig,
+					}))
+				})
+			})
+
+			Context("when the config version is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.FindConfigVersionReturns(db.PipelineConfigVersion{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the config version is malformed", func() {
+				BeforeEach(func() {
+					configVersion = "bogus"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when finding the config version fails", func() {
+				BeforeEach(func() {
+					fakePipeline.FindConfigVersionReturns(db.PipelineConfigVersion{}, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:name/config/versions/:config_version/rollback", func() {
+		var (
+			response      *http.Response
+			configVersion string
+			storedConfig  atc.Config
+		)
+
+		BeforeEach(func() {
+			configVersion = "1"
+
+			fakePipeline.NameReturns("a-pipeline")
+			fakePipeline.TeamNameReturns("a-team")
+			fakePipeline.ConfigVersionReturns(3)
+		})
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.RollbackPipelineConfig, rata.Params{
+				"team_name":      "a-team",
+				"pipeline_name":  "a-pipeline",
+				"config_version": configVersion,
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.UserNameReturns("some-user")
+			})
+
+			Context("when the config version has var sources with credentials", func() {
+				BeforeEach(func() {
+					storedConfig = pipelineConfig
+					storedConfig.VarSources = atc.VarSourceConfigs{
+						{
+							Name:   "some-var-source",
+							Type:   "vault",
+							Config: map[string]interface{}{"client_token": "some-token"},
+						},
+					}
+
+					fakePipeline.FindConfigVersionReturns(db.PipelineConfigVersion{
+						Version: 1,
+						Config:  storedConfig,
+					}, true, nil)
+				})
+
+				It("looks up the requested version", func() {
+					Expect(fakePipeline.FindConfigVersionArgsForCall(0)).To(Equal(db.ConfigVersion(1)))
+				})
+
+				It("saves the stored config, keeping its credentials", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+					pipelineRef, savedConfig, fromVersion, _, createdBy := dbTeam.SavePipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+					Expect(savedConfig).To(Equal(storedConfig))
+					Expect(savedConfig.VarSources[0].Config).To(Equal(map[string]interface{}{"client_token": "some-token"}))
+					Expect(fromVersion).To(Equal(db.ConfigVersion(3)))
+					Expect(createdBy).To(Equal("some-user"))
+				})
+
+				Context("when saving it fails", func() {
+					BeforeEach(func() {
+						dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the stored config's passed jobs can no longer be found", func() {
+					BeforeEach(func() {
+						dbTeam.SavePipelineReturns(nil, false, db.InvalidPassedJobsError{
+							Errors: []string{"jobs.some-job.get.some-input.passed references an unknown pipeline ('upstream-pipeline')"},
+						})
+					})
+
+					It("returns 400 with the errors", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+						{
+							"errors": [
+								"jobs.some-job.get.some-input.passed references an unknown pipeline ('upstream-pipeline')"
+							]
+						}`))
+					})
+				})
+			})
+
+			Context("when the stored config is no longer valid", func() {
+				BeforeEach(func() {
+					storedConfig = pipelineConfig
+					storedConfig.Jobs = append(atc.JobConfigs{}, pipelineConfig.Jobs...)
+					storedConfig.Jobs[0].Name = ""
+
+					fakePipeline.FindConfigVersionReturns(db.PipelineConfigVersion{
+						Version: 1,
+						Config:  storedConfig,
+					}, true, nil)
+				})
+
+				It("returns 400 without saving it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the config version is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.FindConfigVersionReturns(db.PipelineConfigVersion{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the config version is malformed", func() {
+				BeforeEach(func() {
+					configVersion = "bogus"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:name/export", func() {
+		var (
+			query    string
+			response *http.Response
+
+			fakeJob      *dbfakes.FakeJob
+			fakeResource *dbfakes.FakeResource
+			fakeType     *dbfakes.FakeResourceType
+		)
+
+		BeforeEach(func() {
+			query = ""
+
+			fakePipeline.PausedReturns(true)
+			fakePipeline.PausedByReturns("some-user")
+			fakePipeline.PauseCommentReturns("moving clusters")
+
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.NameReturns("some-job")
+			fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"})
+			fakeJob.PausedReturns(true)
+			fakeJob.PausedByReturns("some-user")
+			fakeJob.PauseCommentReturns("flaky")
+			fakePipeline.JobsReturns(db.Jobs{fakeJob}, nil)
+
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.NameReturns("some-resource")
+			fakeResource.TypeReturns("custom-resource")
+			fakeResource.APIPinnedVersionReturns(atc.Version{"ref": "v1"})
+			fakeResource.PinCommentReturns("known good")
+			fakeResource.VersionsReturnsOnCall(0, []atc.ResourceVersion{
+				{ID: 3, Version: atc.Version{"ref": "v3"}, Enabled: true},
+				{ID: 2, Version: atc.Version{"ref": "v2"}, Enabled: false},
+			}, db.Pagination{Next: &db.Page{Since: 2, Limit: 1000}}, true, nil)
+			fakeResource.VersionsReturnsOnCall(1, []atc.ResourceVersion{
+				{
+					ID:       1,
+					Version:  atc.Version{"ref": "v1"},
+					Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+					Enabled:  true,
+				},
+			}, db.Pagination{}, true, nil)
+			fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+			fakeType = new(dbfakes.FakeResourceType)
+			fakeType.NameReturns("custom-resource")
+			fakeType.TypeReturns("registry-image")
+			fakeType.VersionReturns(atc.Version{"digest": "sha256:abc"})
+			fakePipeline.ResourceTypesReturns(db.ResourceTypes{fakeType}, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.ExportPipeline, rata.Params{
+				"team_name":     "a-team",
+				"pipeline_name": "a-pipeline",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = query
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns the pipeline's config and state, with versions oldest first", func() {
+				var bundle atc.PipelineBundle
+				err := json.NewDecoder(response.Body).Decode(&bundle)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(bundle.Format).To(Equal(atc.PipelineBundleFormat))
+				Expect(bundle.Config.Jobs).To(Equal(atc.JobConfigs{{Name: "some-job"}}))
+				Expect(bundle.Paused).To(BeTrue())
+				Expect(bundle.PausedBy).To(Equal("some-user"))
+				Expect(bundle.PauseComment).To(Equal("moving clusters"))
+
+				Expect(bundle.ResourceTypes).To(Equal([]atc.ResourceTypeBundle{
+					{Name: "custom-resource", Version: atc.Version{"digest": "sha256:abc"}},
+				}))
+
+				Expect(bundle.Resources).To(Equal([]atc.ResourceBundle{
+					{
+						Name: "some-resource",
+						Versions: []atc.BundledVersion{
+							{
+								Version:  atc.Version{"ref": "v1"},
+								Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+								Enabled:  true,
+							},
+							{Version: atc.Version{"ref": "v2"}, Enabled: false},
+							{Version: atc.Version{"ref": "v3"}, Enabled: true},
+						},
+						PinnedVersion: atc.Version{"ref": "v1"},
+						PinComment:    "known good",
+					},
+				}))
+
+				Expect(bundle.Jobs).To(Equal([]atc.JobBundle{
+					{Name: "some-job", Paused: true, PausedBy: "some-user", PauseComment: "flaky"},
+				}))
+			})
+
+			It("pages through every version", func() {
+				Expect(fakeResource.VersionsCallCount()).To(Equal(2))
+
+				page, _ := fakeResource.VersionsArgsForCall(1)
+				Expect(page).To(Equal(db.Page{Since: 2, Limit: 1000}))
+			})
+
+			It("does not include builds", func() {
+				Expect(fakeJob.BuildsCallCount()).To(Equal(0))
+			})
+
+			Context("when builds are asked for", func() {
+				BeforeEach(func() {
+					query = atc.ExportPipelineBuilds + "=true"
+
+					fakeBuild := new(dbfakes.FakeBuild)
+					fakeBuild.IDReturns(42)
+					fakeBuild.NameReturns("7")
+					fakeBuild.JobNameReturns("some-job")
+					fakeBuild.PipelineNameReturns("a-pipeline")
+					fakeBuild.TeamNameReturns("a-team")
+					fakeBuild.StatusReturns(db.BuildStatusSucceeded)
+					fakeBuild.StartTimeReturns(time.Unix(100, 0))
+					fakeBuild.EndTimeReturns(time.Unix(200, 0))
+					fakeJob.BuildsReturns([]db.Build{fakeBuild}, db.Pagination{}, nil)
+				})
+
+				It("includes a summary of each job's builds", func() {
+					var bundle atc.PipelineBundle
+					err := json.NewDecoder(response.Body).Decode(&bundle)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(bundle.Jobs[0].Builds).To(Equal([]atc.Build{
+						{
+							ID:           42,
+							Name:         "7",
+							JobName:      "some-job",
+							PipelineName: "a-pipeline",
+							TeamName:     "a-team",
+							Status:       "succeeded",
+							APIURL:       "/api/v1/builds/42",
+							StartTime:    100,
+							EndTime:      200,
+						},
+					}))
+				})
+			})
+
+			Context("when getting the versions fails", func() {
+				BeforeEach(func() {
+					fakeResource.VersionsReturnsOnCall(0, nil, db.Pagination{}, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:name/import", func() {
+		var (
+			bundle   atc.PipelineBundle
+			response *http.Response
+
+			importedPipeline *dbfakes.FakePipeline
+			fakeJob          *dbfakes.FakeJob
+			fakeResource     *dbfakes.FakeResource
+			fakeType         *dbfakes.FakeResourceType
+			fakeScope        *dbfakes.FakeResourceConfigScope
+			fakeTypeScope    *dbfakes.FakeResourceConfigScope
+		)
+
+		BeforeEach(func() {
+			bundle = atc.PipelineBundle{
+				Format: atc.PipelineBundleFormat,
+				Config: pipelineConfig,
+				ResourceTypes: []atc.ResourceTypeBundle{
+					{Name: "custom-resource", Version: atc.Version{"digest": "sha256:abc"}},
+				},
+				Resources: []atc.ResourceBundle{
+					{
+						Name: "some-resource",
+						Versions: []atc.BundledVersion{
+							{
+								Version:  atc.Version{"ref": "v1"},
+								Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+								Enabled:  true,
+							},
+							{Version: atc.Version{"ref": "v2"}, Enabled: false},
+						},
+						PinnedVersion: atc.Version{"ref": "v1"},
+						PinComment:    "known good",
+					},
+				},
+				Jobs: []atc.JobBundle{
+					{Name: "some-job", Paused: true, PausedBy: "some-user", PauseComment: "flaky"},
+				},
+			}
+
+			dbTeam.PipelineReturns(nil, false, nil)
+
+			importedPipeline = new(dbfakes.FakePipeline)
+			importedPipeline.VariablesReturns(vars.StaticVariables{"some-var": "some-value"})
+			dbTeam.SavePipelineReturns(importedPipeline, true, nil)
+
+			fakeTypeScope = new(dbfakes.FakeResourceConfigScope)
+			fakeType = new(dbfakes.FakeResourceType)
+			fakeType.NameReturns("custom-resource")
+			fakeType.TypeReturns("custom-type")
+			fakeType.SourceReturns(atc.Source{"custom": "source"})
+			fakeType.SetResourceConfigReturns(fakeTypeScope, nil)
+			importedPipeline.ResourceTypeReturns(fakeType, true, nil)
+			importedPipeline.ResourceTypesReturns(db.ResourceTypes{fakeType}, nil)
+
+			fakeScope = new(dbfakes.FakeResourceConfigScope)
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.NameReturns("some-resource")
+			fakeResource.SourceReturns(atc.Source{"source-config": "((some-var))"})
+			fakeResource.SetResourceConfigReturns(fakeScope, nil)
+			fakeScope.ResourceReturns(fakeResource)
+			fakeResource.ResourceConfigVersionIDStub = func(version atc.Version) (int, bool, error) {
+				if version["ref"] == "v1" {
+					return 1, true, nil
+				}
+
+				return 2, true, nil
+			}
+			importedPipeline.ResourceReturns(fakeResource, true, nil)
+
+			fakeJob = new(dbfakes.FakeJob)
+			importedPipeline.JobReturns(fakeJob, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(bundle)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := requestGenerator.CreateRequest(atc.ImportPipeline, rata.Params{
+				"team_name":     "a-team",
+				"pipeline_name": "a-pipeline",
+			}, bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.UserNameReturns("some-importer")
+			})
+
+			It("returns 201", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			})
+
+			It("saves the config initially paused", func() {
+				Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+
+				pipelineRef, savedConfig, _, initiallyPaused, createdBy := dbTeam.SavePipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+				Expect(savedConfig).To(Equal(pipelineConfig))
+				Expect(initiallyPaused).To(BeTrue())
+				Expect(createdBy).To(Equal("some-importer"))
+			})
+
+			It("skips the version of the resource type, as its versions are shared", func() {
+				Expect(fakeTypeScope.SaveVersionsCallCount()).To(Equal(0))
+
+				var importResponse atc.ImportPipelineResponse
+				err := json.NewDecoder(response.Body).Decode(&importResponse)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(importResponse.Skipped).To(Equal([]string{
+					"version of resource type 'custom-resource': its versions are shared with other teams' resources and can only be restored by an admin",
+				}))
+			})
+
+			Context("when the user is an admin", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAdminReturns(true)
+				})
+
+				It("restores the version of the resource type", func() {
+					Expect(fakeType.SetResourceConfigCallCount()).To(Equal(1))
+
+					source, _ := fakeType.SetResourceConfigArgsForCall(0)
+					Expect(source).To(Equal(atc.Source{"custom": "source"}))
+
+					Expect(fakeTypeScope.SaveVersionsCallCount()).To(Equal(1))
+					Expect(fakeTypeScope.SaveVersionsArgsForCall(0)).To(Equal([]atc.Version{{"digest": "sha256:abc"}}))
+				})
+
+				Context("when the resource type's resource config already has versions", func() {
+					BeforeEach(func() {
+						fakeTypeScope.LatestVersionReturns(new(dbfakes.FakeResourceConfigVersion), true, nil)
+					})
+
+					It("skips its version", func() {
+						Expect(fakeTypeScope.SaveVersionsCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			It("saves the resource's versions in order to its evaluated resource config", func() {
+				Expect(fakeResource.SetResourceConfigCallCount()).To(Equal(1))
+
+				source, _ := fakeResource.SetResourceConfigArgsForCall(0)
+				Expect(source).To(Equal(atc.Source{"source-config": "some-value"}))
+
+				Expect(fakeScope.SaveVersionsCallCount()).To(Equal(1))
+				Expect(fakeScope.SaveVersionsArgsForCall(0)).To(Equal([]atc.Version{{"ref": "v1"}, {"ref": "v2"}}))
+			})
+
+			It("restores the versions' metadata", func() {
+				Expect(fakeResource.UpdateMetadataCallCount()).To(Equal(1))
+
+				version, metadata := fakeResource.UpdateMetadataArgsForCall(0)
+				Expect(version).To(Equal(atc.Version{"ref": "v1"}))
+				Expect(metadata).To(Equal(db.ResourceConfigMetadataFields{{Name: "author", Value: "someone"}}))
+			})
+
+			It("disables the disabled versions", func() {
+				Expect(fakeResource.DisableVersionCallCount()).To(Equal(1))
+				Expect(fakeResource.DisableVersionArgsForCall(0)).To(Equal(2))
+			})
+
+			It("restores the pin and its comment", func() {
+				Expect(fakeResource.PinVersionCallCount()).To(Equal(1))
+				Expect(fakeResource.PinVersionArgsForCall(0)).To(Equal(1))
+				Expect(fakeResource.SetPinCommentArgsForCall(0)).To(Equal("known good"))
+			})
+
+			It("pauses the paused jobs", func() {
+				Expect(importedPipeline.JobArgsForCall(0)).To(Equal("some-job"))
+				Expect(fakeJob.PauseCallCount()).To(Equal(1))
+
+				pausedBy, comment := fakeJob.PauseArgsForCall(0)
+				Expect(pausedBy).To(Equal("some-user"))
+				Expect(comment).To(Equal("flaky"))
+			})
+
+			It("unpauses the pipeline once its state is restored", func() {
+				Expect(importedPipeline.UnpauseCallCount()).To(Equal(1))
+				Expect(importedPipeline.PauseCallCount()).To(Equal(0))
+			})
+
+			Context("when the bundled pipeline is paused", func() {
+				BeforeEach(func() {
+					bundle.Paused = true
+					bundle.PausedBy = "some-user"
+					bundle.PauseComment = "moving clusters"
+				})
+
+				It("keeps it paused with its reason", func() {
+					Expect(importedPipeline.UnpauseCallCount()).To(Equal(0))
+					Expect(importedPipeline.PauseCallCount()).To(Equal(1))
+
+					pausedBy, comment := importedPipeline.PauseArgsForCall(0)
+					Expect(pausedBy).To(Equal("some-user"))
+					Expect(comment).To(Equal("moving clusters"))
+				})
+			})
+
+			Context("when the resource's source can't be evaluated", func() {
+				BeforeEach(func() {
+					fakeResource.SourceReturns(atc.Source{"source-config": "((missing))"})
+				})
+
+				It("skips its versions and reports it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(fakeScope.SaveVersionsCallCount()).To(Equal(0))
+
+					var importResponse atc.ImportPipelineResponse
+					err := json.NewDecoder(response.Body).Decode(&importResponse)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(importResponse.Skipped).To(ContainElement(HavePrefix("versions of resource 'some-resource': ")))
+				})
+			})
+
+			Context("when the resource's resource config already has versions", func() {
+				BeforeEach(func() {
+					fakeScope.LatestVersionReturns(new(dbfakes.FakeResourceConfigVersion), true, nil)
+				})
+
+				It("skips its versions and reports it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(fakeScope.SaveVersionsCallCount()).To(Equal(0))
+					Expect(fakeResource.PinVersionCallCount()).To(Equal(0))
+
+					var importResponse atc.ImportPipelineResponse
+					err := json.NewDecoder(response.Body).Decode(&importResponse)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(importResponse.Skipped).To(ContainElement("versions of resource 'some-resource': its resource config already has versions"))
+				})
+			})
+
+			Context("when the resource's resource config is shared", func() {
+				BeforeEach(func() {
+					fakeScope.ResourceReturns(nil)
+				})
+
+				It("skips its versions", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(fakeScope.SaveVersionsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the pipeline already exists", func() {
+				BeforeEach(func() {
+					dbTeam.PipelineReturns(fakePipeline, true, nil)
+				})
+
+				It("returns 409 without saving anything", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the bundle is of another format", func() {
+				BeforeEach(func() {
+					bundle.Format = 2
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"errors": ["unsupported bundle format 2 (expected 1)"]}`))
+				})
+			})
+
+			Context("when the config is invalid", func() {
+				BeforeEach(func() {
+					bundle.Config.Jobs = append(bundle.Config.Jobs, atc.JobConfig{Name: "some-job"})
+				})
+
+				It("returns 400 without saving anything", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when saving the versions fails", func() {
+				BeforeEach(func() {
+					fakeScope.SaveVersionsReturns(errors.New("nope"))
+				})
+
+				It("returns 500 and destroys the half-imported pipeline", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					Expect(importedPipeline.UnpauseCallCount()).To(Equal(0))
+					Expect(importedPipeline.DestroyCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
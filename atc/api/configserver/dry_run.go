@@ -0,0 +1,173 @@
+package configserver
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/hashicorp/go-multierror"
+)
+
+// configImpact determines what saving the config would do to the pipeline,
+// without saving it. A pipeline that doesn't exist yet is created paused, so
+// all that can be reported for it is the credentials that can't be resolved.
+func configImpact(team db.Team, pipelineRef atc.PipelineRef, config atc.Config, credErrs error) (atc.ConfigImpact, error) {
+	impact := atc.ConfigImpact{}
+
+	if credErrs != nil {
+		if multiErr, ok := credErrs.(*multierror.Error); ok {
+			for _, err := range multiErr.Errors {
+				impact.UnresolvedCredentials = append(impact.UnresolvedCredentials, err.Error())
+			}
+		} else {
+			impact.UnresolvedCredentials = append(impact.UnresolvedCredentials, credErrs.Error())
+		}
+	}
+
+	pipeline, found, err := team.Pipeline(pipelineRef)
+	if err != nil {
+		return atc.ConfigImpact{}, err
+	}
+
+	if !found {
+		return impact, nil
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return atc.ConfigImpact{}, err
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return atc.ConfigImpact{}, err
+	}
+
+	jobs, err := pipeline.Jobs()
+	if err != nil {
+		return atc.ConfigImpact{}, err
+	}
+
+	newScopes := map[string]bool{}
+	for _, resource := range config.Resources {
+		existing, found := resources.Lookup(resource.Name)
+		if !found {
+			continue
+		}
+
+		if resourceConfigChanged(existing, resource, resourceTypes.Configs(), config.ResourceTypes) {
+			newScopes[resource.Name] = true
+			impact.NewResourceScopes = append(impact.NewResourceScopes, resource.Name)
+		}
+	}
+
+	existingJobs := jobs.Configs()
+
+	for _, job := range config.Jobs {
+		existingName := job.Name
+		if job.OldName != "" && job.OldName != job.Name {
+			if _, found := existingJobs.Lookup(job.OldName); found {
+				impact.RenamedJobs = append(impact.RenamedJobs, atc.JobRename{From: job.OldName, To: job.Name})
+				existingName = job.OldName
+			}
+		}
+
+		if pipeline.Paused() {
+			continue
+		}
+
+		var existingJob db.Job
+		for _, j := range jobs {
+			if j.Name() == existingName {
+				existingJob = j
+				break
+			}
+		}
+
+		if existingJob != nil && existingJob.Paused() {
+			continue
+		}
+
+		triggers, err := triggersImmediately(job, existingJob, resources, newScopes)
+		if err != nil {
+			return atc.ConfigImpact{}, err
+		}
+
+		if triggers {
+			impact.TriggeredJobs = append(impact.TriggeredJobs, job.Name)
+		}
+	}
+
+	return impact, nil
+}
+
+// A resource gets a new resource config, and so a new scope, when its type or
+// source changes, or when the custom type it uses does.
+func resourceConfigChanged(existing db.Resource, resource atc.ResourceConfig, existingTypes atc.ResourceTypes, types atc.ResourceTypes) bool {
+	if existing.Type() != resource.Type || !sameJSON(existing.Source(), resource.Source) {
+		return true
+	}
+
+	resourceType, found := types.Lookup(resource.Type)
+	if !found {
+		return false
+	}
+
+	existingType, found := existingTypes.Lookup(resource.Type)
+	if !found {
+		return true
+	}
+
+	return existingType.Type != resourceType.Type || !sameJSON(existingType.Source, resourceType.Source)
+}
+
+// A job triggers as soon as the config is saved if it gains a trigger input
+// with no passed constraints on a resource which already has versions.
+func triggersImmediately(job atc.JobConfig, existingJob db.Job, resources db.Resources, newScopes map[string]bool) (bool, error) {
+	existingTriggers := map[string]bool{}
+	if existingJob != nil {
+		for _, input := range existingJob.Config().Inputs() {
+			if input.Trigger {
+				existingTriggers[input.Resource] = true
+			}
+		}
+	}
+
+	for _, input := range job.Inputs() {
+		if !input.Trigger || len(input.Passed) > 0 || existingTriggers[input.Resource] || newScopes[input.Resource] {
+			continue
+		}
+
+		resource, found := resources.Lookup(input.Resource)
+		if !found {
+			continue
+		}
+
+		versions, _, found, err := resource.Versions(db.Page{Limit: 1}, nil)
+		if err != nil {
+			return false, err
+		}
+
+		if found && len(versions) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Configs are compared as JSON since the ones loaded from the database have
+// been through a JSON round trip.
+func sameJSON(a interface{}, b interface{}) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return string(aJSON) == string(bJSON)
+}
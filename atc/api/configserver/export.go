@@ -0,0 +1,156 @@
+package configserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// exportPageLimit is the number of versions or builds fetched at a time when
+// exporting a pipeline.
+const exportPageLimit = 1000
+
+func (s *Server) ExportPipeline(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("export-pipeline")
+
+		_, includeBuilds := r.URL.Query()[atc.ExportPipelineBuilds]
+
+		jobs, err := pipeline.Jobs()
+		if err != nil {
+			logger.Error("failed-to-get-jobs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		resourceTypes, err := pipeline.ResourceTypes()
+		if err != nil {
+			logger.Error("failed-to-get-resource-types", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		bundle := atc.PipelineBundle{
+			Format:       atc.PipelineBundleFormat,
+			Config:       pipelineConfig(pipeline, jobs, resources, resourceTypes),
+			Paused:       pipeline.Paused(),
+			PausedBy:     pipeline.PausedBy(),
+			PauseComment: pipeline.PauseComment(),
+		}
+
+		for _, resourceType := range resourceTypes {
+			bundle.ResourceTypes = append(bundle.ResourceTypes, atc.ResourceTypeBundle{
+				Name:    resourceType.Name(),
+				Version: resourceType.Version(),
+			})
+		}
+
+		for _, resource := range resources {
+			versions, err := allVersions(resource)
+			if err != nil {
+				logger.Error("failed-to-get-resource-versions", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			bundle.Resources = append(bundle.Resources, atc.ResourceBundle{
+				Name:          resource.Name(),
+				Versions:      versions,
+				PinnedVersion: resource.APIPinnedVersion(),
+				PinComment:    resource.PinComment(),
+			})
+		}
+
+		for _, job := range jobs {
+			jobBundle := atc.JobBundle{
+				Name:         job.Name(),
+				Paused:       job.Paused(),
+				PausedBy:     job.PausedBy(),
+				PauseComment: job.PauseComment(),
+			}
+
+			if includeBuilds {
+				jobBundle.Builds, err = allBuilds(job)
+				if err != nil {
+					logger.Error("failed-to-get-job-builds", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+			}
+
+			bundle.Jobs = append(bundle.Jobs, jobBundle)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(bundle)
+		if err != nil {
+			logger.Error("failed-to-encode-bundle", err)
+		}
+	})
+}
+
+// allVersions returns every version of the resource, oldest first so that
+// saving them in order on import gives them the same check order.
+func allVersions(resource db.Resource) ([]atc.BundledVersion, error) {
+	var versions []atc.BundledVersion
+
+	page := &db.Page{Limit: exportPageLimit}
+	for page != nil {
+		resourceVersions, pagination, found, err := resource.Versions(*page, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			break
+		}
+
+		for _, resourceVersion := range resourceVersions {
+			versions = append(versions, atc.BundledVersion{
+				Version:  resourceVersion.Version,
+				Metadata: resourceVersion.Metadata,
+				Enabled:  resourceVersion.Enabled,
+			})
+		}
+
+		page = pagination.Next
+	}
+
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+
+	return versions, nil
+}
+
+func allBuilds(job db.Job) ([]atc.Build, error) {
+	var builds []atc.Build
+
+	page := &db.Page{Limit: exportPageLimit}
+	for page != nil {
+		jobBuilds, pagination, err := job.Builds(*page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, build := range jobBuilds {
+			builds = append(builds, present.Build(build))
+		}
+
+		page = pagination.Next
+	}
+
+	return builds, nil
+}
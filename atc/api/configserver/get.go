@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/tedsuo/rata"
 )
 
@@ -16,6 +17,13 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		logger.Error("malformed-instance-vars", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		logger.Error("failed-to-find-team", err)
@@ -29,7 +37,12 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pipeline, found, err := team.Pipeline(pipelineName)
+	pipelineRef := atc.PipelineRef{
+		Name:         pipelineName,
+		InstanceVars: instanceVars,
+	}
+
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		logger.Error("failed-to-find-pipeline", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -37,7 +50,7 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !found {
-		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineName})
+		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineRef.String()})
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
@@ -63,12 +76,7 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	config := atc.Config{
-		Groups:        pipeline.Groups(),
-		Resources:     resources.Configs(),
-		ResourceTypes: resourceTypes.Configs(),
-		Jobs:          jobs.Configs(),
-	}
+	config := pipelineConfig(pipeline, jobs, resources, resourceTypes)
 
 	w.Header().Set(atc.ConfigVersionHeader, fmt.Sprintf("%d", pipeline.ConfigVersion()))
 	w.Header().Set("Content-Type", "application/json")
@@ -81,3 +89,16 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func pipelineConfig(pipeline db.Pipeline, jobs db.Jobs, resources db.Resources, resourceTypes db.ResourceTypes) atc.Config {
+	return atc.Config{
+		Groups:        pipeline.Groups(),
+		Resources:     resources.Configs(),
+		ResourceTypes: resourceTypes.Configs(),
+		Jobs:          jobs.Configs(),
+		VarSources:    pipeline.VarSources().Redacted(),
+		Vars:          pipeline.Vars(),
+		Display:       pipeline.Display(),
+		FreezeWindows: pipeline.FreezeWindows(),
+	}
+}
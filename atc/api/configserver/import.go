@@ -0,0 +1,413 @@
+package configserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/vars"
+	"github.com/tedsuo/rata"
+)
+
+// ImportPipeline creates a pipeline from a bundle exported from another
+// cluster, then restores the versions of its resources, its pins and its
+// pause states. Only new pipelines can be imported, and versions are only
+// restored into resource configs which have none yet, so that existing history
+// is never mixed with the imported one. Shared resource configs, which other
+// teams' resources may use too, are only restored by admins.
+func (s *Server) ImportPipeline(w http.ResponseWriter, r *http.Request) {
+	session := s.logger.Session("import-pipeline")
+
+	instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		session.Error("malformed-instance-vars", err)
+		s.handleBadRequest(w, err.Error())
+		return
+	}
+
+	var bundle atc.PipelineBundle
+	err = json.NewDecoder(r.Body).Decode(&bundle)
+	if err != nil {
+		session.Info("malformed-bundle", lager.Data{"error": err.Error()})
+		s.handleBadRequest(w, fmt.Sprintf("malformed bundle: %s", err))
+		return
+	}
+
+	if bundle.Format != atc.PipelineBundleFormat {
+		s.handleBadRequest(w, fmt.Sprintf("unsupported bundle format %d (expected %d)", bundle.Format, atc.PipelineBundleFormat))
+		return
+	}
+
+	warnings, errorMessages := bundle.Config.Validate()
+	if len(errorMessages) > 0 {
+		session.Info("ignoring-invalid-config")
+		s.handleBadRequest(w, errorMessages...)
+		return
+	}
+
+	teamName := rata.Param(r, "team_name")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		session.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		session.Debug("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	pipelineRef := atc.PipelineRef{
+		Name:         rata.Param(r, "pipeline_name"),
+		InstanceVars: instanceVars,
+	}
+
+	_, found, err = team.Pipeline(pipelineRef)
+	if err != nil {
+		session.Error("failed-to-find-pipeline", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if found {
+		session.Info("pipeline-already-exists", lager.Data{"pipeline": pipelineRef.String()})
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+
+	// the pipeline is created paused, and only unpaused once its state has
+	// been restored, so that nothing is scheduled against half of its history
+	pipeline, _, err := team.SavePipeline(pipelineRef, bundle.Config, 0, true, acc.UserName())
+	if invalidErr, ok := err.(db.InvalidPassedJobsError); ok {
+		session.Info("ignoring-invalid-config")
+		s.handleBadRequest(w, invalidErr.Errors...)
+		return
+	}
+
+	if err != nil {
+		session.Error("failed-to-save-config", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "failed to save config: %s", err)
+		return
+	}
+
+	skipped, err := s.restoreState(session, pipeline, bundle, acc.IsAdmin())
+	if err != nil {
+		session.Error("failed-to-restore-pipeline-state", err)
+
+		// the import either happens as a whole or not at all, so that it can
+		// simply be retried
+		destroyErr := pipeline.Destroy()
+		if destroyErr != nil {
+			session.Error("failed-to-destroy-pipeline", destroyErr)
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "failed to restore pipeline state: %s", err)
+		return
+	}
+
+	session.Info("imported", lager.Data{"skipped": len(skipped)})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(atc.ImportPipelineResponse{
+		Warnings: warnings,
+		Skipped:  skipped,
+	})
+	if err != nil {
+		session.Error("failed-to-encode-response", err)
+	}
+}
+
+// restoreState restores the bundle's state onto the newly created pipeline.
+// Parts of it which can't be restored, e.g. the versions of a resource whose
+// source uses credentials this cluster can't resolve, are skipped and
+// reported rather than failing the import.
+func (s *Server) restoreState(logger lager.Logger, pipeline db.Pipeline, bundle atc.PipelineBundle, isAdmin bool) ([]string, error) {
+	variables := pipeline.Variables(logger, s.secretManager, s.varSourcePool)
+
+	skipped, err := restoreResourceTypes(pipeline, variables, bundle.ResourceTypes, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResourceTypes, typesErr := creds.NewVersionedResourceTypes(variables, resourceTypes.Deserialize()).Evaluate()
+
+	for _, resourceBundle := range bundle.Resources {
+		if len(resourceBundle.Versions) == 0 {
+			continue
+		}
+
+		if typesErr != nil {
+			skipped = append(skipped, fmt.Sprintf("versions of resource '%s': %s", resourceBundle.Name, typesErr))
+			continue
+		}
+
+		resource, found, err := pipeline.Resource(resourceBundle.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			skipped = append(skipped, fmt.Sprintf("versions of resource '%s': not in the config", resourceBundle.Name))
+			continue
+		}
+
+		reason, err := restoreResource(resource, variables, versionedResourceTypes, resourceBundle, isAdmin)
+		if err != nil {
+			return nil, err
+		}
+
+		if reason != "" {
+			skipped = append(skipped, fmt.Sprintf("versions of resource '%s': %s", resourceBundle.Name, reason))
+		}
+	}
+
+	for _, jobBundle := range bundle.Jobs {
+		if !jobBundle.Paused {
+			continue
+		}
+
+		job, found, err := pipeline.Job(jobBundle.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			skipped = append(skipped, fmt.Sprintf("pause state of job '%s': not in the config", jobBundle.Name))
+			continue
+		}
+
+		err = job.Pause(jobBundle.PausedBy, jobBundle.PauseComment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bundle.Paused {
+		err = pipeline.Pause(bundle.PausedBy, bundle.PauseComment)
+	} else {
+		err = pipeline.Unpause()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return skipped, nil
+}
+
+// restoreResourceTypes saves the versions of the custom resource types, which
+// the resource configs of the resources using them depend on. A type is only
+// restored once the type it's based on has been, as its version is in turn
+// part of the type's own resource config.
+func restoreResourceTypes(pipeline db.Pipeline, variables vars.Variables, typeBundles []atc.ResourceTypeBundle, isAdmin bool) ([]string, error) {
+	var skipped []string
+
+	pending := map[string]atc.Version{}
+	for _, typeBundle := range typeBundles {
+		if typeBundle.Version != nil {
+			pending[typeBundle.Name] = typeBundle.Version
+		}
+	}
+
+	for len(pending) > 0 {
+		restored := 0
+
+		for _, typeBundle := range typeBundles {
+			version, isPending := pending[typeBundle.Name]
+			if !isPending {
+				continue
+			}
+
+			resourceType, found, err := pipeline.ResourceType(typeBundle.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			if !found {
+				skipped = append(skipped, fmt.Sprintf("version of resource type '%s': not in the config", typeBundle.Name))
+				delete(pending, typeBundle.Name)
+				restored++
+				continue
+			}
+
+			if _, parentPending := pending[resourceType.Type()]; parentPending {
+				continue
+			}
+
+			reason, err := restoreResourceType(pipeline, resourceType, variables, version, isAdmin)
+			if err != nil {
+				return nil, err
+			}
+
+			if reason != "" {
+				skipped = append(skipped, fmt.Sprintf("version of resource type '%s': %s", typeBundle.Name, reason))
+			}
+
+			delete(pending, typeBundle.Name)
+			restored++
+		}
+
+		// only types based on each other are left
+		if restored == 0 {
+			for name := range pending {
+				skipped = append(skipped, fmt.Sprintf("version of resource type '%s': its type is never restored", name))
+			}
+
+			break
+		}
+	}
+
+	return skipped, nil
+}
+
+func restoreResourceType(pipeline db.Pipeline, resourceType db.ResourceType, variables vars.Variables, version atc.Version, isAdmin bool) (string, error) {
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return "", err
+	}
+
+	versionedResourceTypes, err := creds.NewVersionedResourceTypes(variables, resourceTypes.Deserialize()).Evaluate()
+	if err != nil {
+		return err.Error(), nil
+	}
+
+	source, err := creds.NewSource(variables, resourceType.Source()).Evaluate()
+	if err != nil {
+		return err.Error(), nil
+	}
+
+	scope, err := resourceType.SetResourceConfig(source, versionedResourceTypes.Without(resourceType.Name()))
+	if err != nil {
+		return "", err
+	}
+
+	reason, err := unrestorableScope(scope, isAdmin)
+	if err != nil || reason != "" {
+		return reason, err
+	}
+
+	return "", scope.SaveVersions([]atc.Version{version})
+}
+
+func restoreResource(resource db.Resource, variables vars.Variables, versionedResourceTypes atc.VersionedResourceTypes, resourceBundle atc.ResourceBundle, isAdmin bool) (string, error) {
+	source, err := creds.NewSource(variables, resource.Source()).Evaluate()
+	if err != nil {
+		return err.Error(), nil
+	}
+
+	scope, err := resource.SetResourceConfig(source, versionedResourceTypes)
+	if err != nil {
+		return "", err
+	}
+
+	reason, err := unrestorableScope(scope, isAdmin)
+	if err != nil || reason != "" {
+		return reason, err
+	}
+
+	versions := make([]atc.Version, len(resourceBundle.Versions))
+	for i, bundledVersion := range resourceBundle.Versions {
+		versions[i] = bundledVersion.Version
+	}
+
+	err = scope.SaveVersions(versions)
+	if err != nil {
+		return "", err
+	}
+
+	// pick up the resource config scope which was just set
+	_, err = resource.Reload()
+	if err != nil {
+		return "", err
+	}
+
+	for _, bundledVersion := range resourceBundle.Versions {
+		if len(bundledVersion.Metadata) > 0 {
+			_, err = resource.UpdateMetadata(bundledVersion.Version, db.NewResourceConfigMetadataFields(bundledVersion.Metadata))
+			if err != nil {
+				return "", err
+			}
+		}
+
+		if !bundledVersion.Enabled {
+			rcvID, found, err := resource.ResourceConfigVersionID(bundledVersion.Version)
+			if err != nil {
+				return "", err
+			}
+
+			if found {
+				err = resource.DisableVersion(rcvID)
+				if err != nil {
+					return "", err
+				}
+			}
+		}
+	}
+
+	if resourceBundle.PinnedVersion == nil {
+		return "", nil
+	}
+
+	rcvID, found, err := resource.ResourceConfigVersionID(resourceBundle.PinnedVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if !found {
+		return "the pinned version is not one of its versions", nil
+	}
+
+	err = resource.PinVersion(rcvID)
+	if err != nil {
+		return "", err
+	}
+
+	if resourceBundle.PinComment != "" {
+		err = resource.SetPinComment(resourceBundle.PinComment)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+// unrestorableScope returns why versions can't be restored into the resource
+// config scope, if they can't. Scopes which aren't scoped to a resource are
+// shared with every resource and resource type of the same config, which may
+// belong to other teams, and scopes which already have versions have a history
+// of their own.
+func unrestorableScope(scope db.ResourceConfigScope, isAdmin bool) (string, error) {
+	if scope.Resource() == nil && !isAdmin {
+		return "its versions are shared with other teams' resources and can only be restored by an admin", nil
+	}
+
+	_, found, err := scope.LatestVersion()
+	if err != nil {
+		return "", err
+	}
+
+	if found {
+		return "its resource config already has versions", nil
+	}
+
+	return "", nil
+}
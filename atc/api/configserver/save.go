@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
@@ -27,6 +28,11 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		checkCredentials = true
 	}
 
+	dryRun := false
+	if _, exists := query[atc.SaveConfigDryRun]; exists {
+		dryRun = true
+	}
+
 	var version db.ConfigVersion
 	if configVersionStr := r.Header.Get(atc.ConfigVersionHeader); len(configVersionStr) != 0 {
 		_, err := fmt.Sscanf(configVersionStr, "%d", &version)
@@ -37,6 +43,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+	if err != nil {
+		session.Error("malformed-instance-vars", err)
+		s.handleBadRequest(w, err.Error())
+		return
+	}
+
 	var config atc.Config
 	switch r.Header.Get("Content-type") {
 	case "application/json", "application/x-yaml":
@@ -57,7 +70,7 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 
 		for k := range ignoredUnknownToplevels {
 			switch k {
-			case "groups", "jobs", "resources", "resource_types":
+			case "groups", "jobs", "resources", "resource_types", "var_sources", "vars", "display", "freeze_windows":
 			default:
 				delete(ignoredUnknownToplevels, k)
 			}
@@ -93,12 +106,19 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
-	if checkCredentials {
-		variables := creds.NewVariables(s.secretManager, teamName, pipelineName)
-
-		errs := validateCredParams(variables, config, session)
-		if errs != nil {
-			s.handleBadRequest(w, fmt.Sprintf("credential validation failed\n\n%s", errs))
+	// a dry run reports the credentials that can't be resolved rather than
+	// rejecting the config
+	var credErrs error
+	if checkCredentials || dryRun {
+		variables := vars.NewMultiVars([]vars.Variables{
+			creds.NewVariables(s.secretManager, teamName, pipelineName),
+			creds.NewVarSourceVariables(session, s.varSourcePool, teamName, pipelineName, config.VarSources),
+			vars.StaticVariables(config.Vars),
+		})
+
+		credErrs = validateCredParams(variables, config, session)
+		if credErrs != nil && !dryRun {
+			s.handleBadRequest(w, fmt.Sprintf("credential validation failed\n\n%s", credErrs))
 			return
 		}
 	}
@@ -118,7 +138,48 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, true)
+	pipelineRef := atc.PipelineRef{
+		Name:         pipelineName,
+		InstanceVars: instanceVars,
+	}
+
+	if dryRun {
+		errorMessages, err = team.ValidatePassedJobs(config)
+		if err != nil {
+			session.Error("failed-to-validate-passed-jobs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if len(errorMessages) > 0 {
+			session.Info("ignoring-invalid-config")
+			s.handleBadRequest(w, errorMessages...)
+			return
+		}
+
+		impact, err := configImpact(team, pipelineRef, config, credErrs)
+		if err != nil {
+			session.Error("failed-to-determine-config-impact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		s.writeSaveConfigResponse(w, atc.SaveConfigResponse{Warnings: warnings, DryRun: &impact})
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+
+	_, created, err := team.SavePipeline(pipelineRef, config, version, true, acc.UserName())
+	if invalidErr, ok := err.(db.InvalidPassedJobsError); ok {
+		session.Info("ignoring-invalid-config")
+		s.handleBadRequest(w, invalidErr.Errors...)
+		return
+	}
+
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,163 @@
+package configserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListConfigVersions(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-config-versions")
+
+		configVersions, err := pipeline.ConfigVersions()
+		if err != nil {
+			logger.Error("failed-to-get-config-versions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presentedVersions := []atc.PipelineConfigVersion{}
+		for _, configVersion := range configVersions {
+			presentedVersion := present.PipelineConfigVersion(configVersion)
+			presentedVersion.Config = nil
+
+			presentedVersions = append(presentedVersions, presentedVersion)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(presentedVersions)
+		if err != nil {
+			logger.Error("failed-to-encode-config-versions", err)
+		}
+	})
+}
+
+func (s *Server) GetConfigVersion(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-config-version")
+
+		version, err := strconv.Atoi(r.FormValue(":config_version"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		configVersion, found, err := pipeline.FindConfigVersion(db.ConfigVersion(version))
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("config-version-not-found", lager.Data{"version": version})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(present.PipelineConfigVersion(configVersion))
+		if err != nil {
+			logger.Error("failed-to-encode-config-version", err)
+		}
+	})
+}
+
+// RollbackConfig saves the pipeline with the config it had at the given
+// version. The stored config is saved as-is, so the credentials of its var
+// sources are kept rather than the redacted values clients are shown, and it
+// is validated the same way as any other config being saved.
+func (s *Server) RollbackConfig(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("rollback-config")
+
+		version, err := strconv.Atoi(r.FormValue(":config_version"))
+		if err != nil {
+			s.handleBadRequest(w, fmt.Sprintf("config version is malformed: %s", err))
+			return
+		}
+
+		currentVersion := pipeline.ConfigVersion()
+		if configVersionStr := r.Header.Get(atc.ConfigVersionHeader); len(configVersionStr) != 0 {
+			_, err := fmt.Sscanf(configVersionStr, "%d", &currentVersion)
+			if err != nil {
+				logger.Error("malformed-config-version", err)
+				s.handleBadRequest(w, fmt.Sprintf("current config version is malformed: %s", err))
+				return
+			}
+		}
+
+		configVersion, found, err := pipeline.FindConfigVersion(db.ConfigVersion(version))
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("config-version-not-found", lager.Data{"version": version})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		config := configVersion.Config
+
+		warnings, errorMessages := config.Validate()
+		if len(errorMessages) > 0 {
+			logger.Info("ignoring-invalid-config")
+			s.handleBadRequest(w, errorMessages...)
+			return
+		}
+
+		team, found, err := s.teamFactory.FindTeam(pipeline.TeamName())
+		if err != nil {
+			logger.Error("failed-to-find-team", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("team-not-found")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		pipelineRef := atc.PipelineRef{
+			Name:         pipeline.Name(),
+			InstanceVars: pipeline.InstanceVars(),
+		}
+
+		_, _, err = team.SavePipeline(pipelineRef, config, currentVersion, true, accessor.GetAccessor(r).UserName())
+		if invalidErr, ok := err.(db.InvalidPassedJobsError); ok {
+			logger.Info("ignoring-invalid-config")
+			s.handleBadRequest(w, invalidErr.Errors...)
+			return
+		}
+
+		if err != nil {
+			logger.Error("failed-to-save-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, "failed to save config: %s", err)
+			return
+		}
+
+		logger.Info("rolled-back", lager.Data{"version": version})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		s.writeSaveConfigResponse(w, atc.SaveConfigResponse{Warnings: warnings})
+	})
+}
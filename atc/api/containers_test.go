@@ -362,10 +362,11 @@ var _ = Describe("Containers API", func() {
 					_, err := client.Do(req)
 					Expect(err).NotTo(HaveOccurred())
 
-					pipelineName, resourceName, secretManager := dbTeam.FindCheckContainersArgsForCall(0)
-					Expect(pipelineName).To(Equal("some-pipeline"))
+					_, pipelineRef, resourceName, secretManager, varSourcePool := dbTeam.FindCheckContainersArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(resourceName).To(Equal("some-resource"))
 					Expect(secretManager).To(Equal(fakeSecretManager))
+					Expect(varSourcePool).To(Equal(fakeVarSourcePool))
 				})
 			})
 		})
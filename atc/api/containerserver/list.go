@@ -21,7 +21,7 @@ func (s *Server) ListContainers(team db.Team) http.Handler {
 			"params": params,
 		})
 
-		containerLocator, err := createContainerLocatorFromRequest(team, r, s.secretManager)
+		containerLocator, err := createContainerLocatorFromRequest(hLog, team, r, s.secretManager, s.varSourcePool)
 		if err != nil {
 			hLog.Error("failed-to-parse-request", err)
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -59,7 +59,7 @@ type containerLocator interface {
 	Locate() ([]db.Container, map[int]time.Time, error)
 }
 
-func createContainerLocatorFromRequest(team db.Team, r *http.Request, secretManager creds.Secrets) (containerLocator, error) {
+func createContainerLocatorFromRequest(logger lager.Logger, team db.Team, r *http.Request, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) (containerLocator, error) {
 	query := r.URL.Query()
 	delete(query, ":team_name")
 
@@ -70,11 +70,21 @@ func createContainerLocatorFromRequest(team db.Team, r *http.Request, secretMana
 	}
 
 	if query.Get("type") == "check" {
+		instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+		if err != nil {
+			return nil, err
+		}
+
 		return &checkContainerLocator{
-			team:          team,
-			pipelineName:  query.Get("pipeline_name"),
+			logger: logger,
+			team:   team,
+			pipelineRef: atc.PipelineRef{
+				Name:         query.Get("pipeline_name"),
+				InstanceVars: instanceVars,
+			},
 			resourceName:  query.Get("resource_name"),
 			secretManager: secretManager,
+			varSourcePool: varSourcePool,
 		}, nil
 	}
 
@@ -132,14 +142,16 @@ func (l *allContainersLocator) Locate() ([]db.Container, map[int]time.Time, erro
 }
 
 type checkContainerLocator struct {
+	logger        lager.Logger
 	team          db.Team
-	pipelineName  string
+	pipelineRef   atc.PipelineRef
 	resourceName  string
 	secretManager creds.Secrets
+	varSourcePool creds.VarSourcePool
 }
 
 func (l *checkContainerLocator) Locate() ([]db.Container, map[int]time.Time, error) {
-	return l.team.FindCheckContainers(l.pipelineName, l.resourceName, l.secretManager)
+	return l.team.FindCheckContainers(l.logger, l.pipelineRef, l.resourceName, l.secretManager, l.varSourcePool)
 }
 
 type stepContainerLocator struct {
@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/api/artifactserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
+	"github.com/concourse/concourse/atc/api/checkserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
 	"github.com/concourse/concourse/atc/api/configserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
@@ -49,15 +50,13 @@ func NewHandler(
 	containerRepository db.ContainerRepository,
 	destroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
-	dbResourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
 	dbUserFactory db.UserFactory,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
 	workerClient worker.Client,
 
-	scannerFactory resourceserver.ScannerFactory,
-
 	sink *lager.ReconfigurableSink,
 
 	isTLSEnabled bool,
@@ -66,6 +65,7 @@ func NewHandler(
 	version string,
 	workerVersion string,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 ) (http.Handler, error) {
@@ -80,17 +80,18 @@ func NewHandler(
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
 	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
-	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory)
-	resourceServer := resourceserver.NewServer(logger, scannerFactory, secretManager, dbResourceFactory, dbResourceConfigFactory)
+	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
+	resourceServer := resourceserver.NewServer(logger, dbCheckFactory, secretManager, varSourcePool, dbResourceFactory, dbTeamFactory)
+	checkServer := checkserver.NewServer(logger, dbCheckFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
-	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager)
+	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager, varSourcePool)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
 	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
-	containerServer := containerserver.NewServer(logger, workerClient, secretManager, interceptTimeoutFactory, containerRepository, destroyer)
+	containerServer := containerserver.NewServer(logger, workerClient, secretManager, varSourcePool, interceptTimeoutFactory, containerRepository, destroyer)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
@@ -98,8 +99,13 @@ func NewHandler(
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 
 	handlers := map[string]http.Handler{
-		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
-		atc.SaveConfig: http.HandlerFunc(configServer.SaveConfig),
+		atc.GetConfig:                  http.HandlerFunc(configServer.GetConfig),
+		atc.SaveConfig:                 http.HandlerFunc(configServer.SaveConfig),
+		atc.ListPipelineConfigVersions: pipelineHandlerFactory.HandlerFor(configServer.ListConfigVersions),
+		atc.GetPipelineConfigVersion:   pipelineHandlerFactory.HandlerFor(configServer.GetConfigVersion),
+		atc.RollbackPipelineConfig:     pipelineHandlerFactory.HandlerFor(configServer.RollbackConfig),
+		atc.ExportPipeline:             pipelineHandlerFactory.HandlerFor(configServer.ExportPipeline),
+		atc.ImportPipeline:             http.HandlerFunc(configServer.ImportPipeline),
 
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
@@ -137,6 +143,7 @@ func NewHandler(
 		atc.OrderPipelines:      http.HandlerFunc(pipelineServer.OrderPipelines),
 		atc.PausePipeline:       pipelineHandlerFactory.HandlerFor(pipelineServer.PausePipeline),
 		atc.UnpausePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.UnpausePipeline),
+		atc.ArchivePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.ArchivePipeline),
 		atc.ExposePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.ExposePipeline),
 		atc.HidePipeline:        pipelineHandlerFactory.HandlerFor(pipelineServer.HidePipeline),
 		atc.GetVersionsDB:       pipelineHandlerFactory.HandlerFor(pipelineServer.GetVersionsDB),
@@ -153,8 +160,14 @@ func NewHandler(
 		atc.SetPinCommentOnResource: pipelineHandlerFactory.HandlerFor(resourceServer.SetPinCommentOnResource),
 		atc.CheckResource:           pipelineHandlerFactory.HandlerFor(resourceServer.CheckResource),
 		atc.CheckResourceWebHook:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
+		atc.PipelineWebHook:         pipelineHandlerFactory.HandlerFor(resourceServer.PipelineWebHook),
+		atc.TeamWebHook:             http.HandlerFunc(resourceServer.TeamWebHook),
 		atc.CheckResourceType:       pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 
+		atc.GetCheck:           http.HandlerFunc(checkServer.GetCheck),
+		atc.CheckEvents:        http.HandlerFunc(checkServer.CheckEvents),
+		atc.ListResourceChecks: pipelineHandlerFactory.HandlerFor(resourceServer.ListResourceChecks),
+
 		atc.ListResourceVersions:          pipelineHandlerFactory.HandlerFor(versionServer.ListResourceVersions),
 		atc.GetResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.GetResourceVersion),
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
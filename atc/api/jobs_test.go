@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -477,6 +479,41 @@ var _ = Describe("Jobs API", func() {
 
 					})
 
+					Context("when the job was paused with a comment", func() {
+						BeforeEach(func() {
+							fakeJob.PausedByReturns("some-user")
+							fakeJob.PauseCommentReturns("waiting on a fix upstream")
+						})
+
+						It("returns who paused it and why", func() {
+							var job atc.Job
+							err := json.NewDecoder(response.Body).Decode(&job)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(job.PausedBy).To(Equal("some-user"))
+							Expect(job.PauseComment).To(Equal("waiting on a fix upstream"))
+						})
+					})
+
+					Context("when the job is in an open freeze window", func() {
+						BeforeEach(func() {
+							fakePipeline.FreezeWindowsReturns(atc.FreezeWindowConfigs{
+								{Name: "other", Start: "* * * * *", Duration: "1h", Jobs: []string{"other-job"}},
+								{Name: "always", Start: "* * * * *", Duration: "1h", Jobs: []string{"some-job"}},
+							})
+						})
+
+						It("returns the window freezing it", func() {
+							var job atc.Job
+							err := json.NewDecoder(response.Body).Decode(&job)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(job.Frozen).ToNot(BeNil())
+							Expect(job.Frozen.Window).To(Equal("always"))
+							Expect(time.Unix(job.Frozen.Until, 0)).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+						})
+					})
+
 					Context("when there are no running or finished builds", func() {
 						BeforeEach(func() {
 							fakeJob.FinishedAndNextBuildReturns(nil, nil, nil)
@@ -1483,6 +1520,39 @@ var _ = Describe("Jobs API", func() {
 						})
 					})
 
+					Context("when the job is frozen", func() {
+						BeforeEach(func() {
+							fakePipeline.TeamNameReturns("some-team")
+							fakePipeline.FreezeWindowsReturns(atc.FreezeWindowConfigs{
+								{Name: "always", Start: "* * * * *", Duration: "1h", Jobs: []string{"some-job"}},
+							})
+						})
+
+						It("returns 409 with the window", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(ContainSubstring("job is frozen by window 'always'"))
+						})
+
+						It("does not trigger the build", func() {
+							Expect(fakeJob.CreateBuildCallCount()).To(Equal(0))
+						})
+
+						Context("when the requester owns the team", func() {
+							BeforeEach(func() {
+								fakeaccess.IsOwnerReturns(true)
+								fakeJob.CreateBuildReturns(nil, errors.New("nopers"))
+							})
+
+							It("tries to trigger the build", func() {
+								Expect(fakeaccess.IsOwnerArgsForCall(0)).To(Equal("some-team"))
+								Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
+							})
+						})
+					})
+
 					Context("when triggering the build fails", func() {
 						BeforeEach(func() {
 							fakeJob.CreateBuildReturns(nil, errors.New("nopers"))
@@ -1526,6 +1596,7 @@ var _ = Describe("Jobs API", func() {
 
 							BeforeEach(func() {
 								fakeResource = new(dbfakes.FakeResource)
+								fakeResource.IDReturns(7)
 								fakeResource.NameReturns("some-input")
 
 								fakePipeline.ResourcesReturns([]db.Resource{fakeResource}, nil)
@@ -1539,8 +1610,12 @@ var _ = Describe("Jobs API", func() {
 								Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
 							})
 
-							It("notifies a scan of the resource", func() {
-								Expect(fakeResource.NotifyScanCallCount()).To(Equal(1))
+							It("creates a check of the resource", func() {
+								Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+								resourceID, createdBy, from := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+								Expect(resourceID).To(Equal(7))
+								Expect(createdBy).To(Equal(atc.CheckCreatedByBuild))
+								Expect(from).To(BeNil())
 							})
 
 							It("returns the build", func() {
@@ -1917,11 +1992,16 @@ var _ = Describe("Jobs API", func() {
 
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", func() {
 		var response *http.Response
+		var requestBody io.Reader
+
+		BeforeEach(func() {
+			requestBody = nil
+		})
 
 		JustBeforeEach(func() {
 			var err error
 
-			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/job-name/pause", nil)
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/job-name/pause", requestBody)
 			Expect(err).NotTo(HaveOccurred())
 
 			response, err = client.Do(request)
@@ -1949,6 +2029,30 @@ var _ = Describe("Jobs API", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusOK))
 				})
 
+				Context("when a comment is given", func() {
+					BeforeEach(func() {
+						fakeaccess.UserNameReturns("some-user")
+						requestBody = strings.NewReader(`{"comment":"waiting on a fix upstream"}`)
+					})
+
+					It("records the comment and who paused the job", func() {
+						pausedBy, comment := fakeJob.PauseArgsForCall(0)
+						Expect(pausedBy).To(Equal("some-user"))
+						Expect(comment).To(Equal("waiting on a fix upstream"))
+					})
+				})
+
+				Context("when the request body is malformed", func() {
+					BeforeEach(func() {
+						requestBody = strings.NewReader(`{`)
+					})
+
+					It("returns a 400 without pausing the job", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeJob.PauseCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when the job is not found", func() {
 					BeforeEach(func() {
 						fakePipeline.JobReturns(nil, false, nil)
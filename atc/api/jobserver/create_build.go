@@ -2,8 +2,13 @@ package jobserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -32,6 +37,15 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		// only the team's owners may trigger a job during a freeze window
+		if freeze := jobFreeze(pipeline, jobName); freeze != nil && !accessor.GetAccessor(r).IsOwner(pipeline.TeamName()) {
+			logger.Info("job-frozen", lager.Data{"window": freeze.Window})
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "job is frozen by window '%s' until %s; only team owners can trigger it", freeze.Window, time.Unix(freeze.Until, 0).UTC().Format(time.RFC3339))
+			return
+		}
+
 		build, err := job.CreateBuild()
 		if err != nil {
 			logger.Error("failed-to-create-job-build", err)
@@ -49,8 +63,9 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 		for _, input := range job.Config().Inputs() {
 			resource, found := resources.Lookup(input.Resource)
 			if found {
-				if err = resource.NotifyScan(); err != nil {
-					logger.Error("failed-to-notify-scan", err)
+				_, err = s.checkFactory.CreateResourceCheck(resource.ID(), atc.CheckCreatedByBuild, nil)
+				if err != nil {
+					logger.Error("failed-to-create-check", err)
 				}
 			}
 		}
@@ -0,0 +1,17 @@
+package jobserver
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func jobFreeze(pipeline db.Pipeline, jobName string) *atc.Freeze {
+	freeze, frozen := pipeline.FreezeWindows().FreezeFor(jobName, time.Now())
+	if !frozen {
+		return nil
+	}
+
+	return &freeze
+}
@@ -43,6 +43,7 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			finished,
 			next,
 			nil,
+			jobFreeze(pipeline, job.Name()),
 		))
 		if err != nil {
 			logger.Error("failed-to-encode-job", err)
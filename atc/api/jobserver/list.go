@@ -34,6 +34,7 @@ func (s *Server) ListJobs(pipeline db.Pipeline) http.Handler {
 					job.FinishedBuild,
 					job.NextBuild,
 					job.TransitionBuild,
+					jobFreeze(pipeline, job.Job.Name()),
 				),
 			)
 		}
@@ -41,6 +41,8 @@ func (s *Server) ListAllJobs(w http.ResponseWriter, r *http.Request) {
 				job.FinishedBuild,
 				job.NextBuild,
 				job.TransitionBuild,
+				// freeze windows are only shown for a single pipeline's jobs
+				nil,
 			),
 		)
 	}
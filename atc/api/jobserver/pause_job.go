@@ -1,8 +1,13 @@
 package jobserver
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/tedsuo/rata"
 )
@@ -12,6 +17,14 @@ func (s *Server) PauseJob(pipeline db.Pipeline) http.Handler {
 		logger := s.logger.Session("pause-job")
 		jobName := rata.Param(r, "job_name")
 
+		var reqBody atc.PauseRequest
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
 		job, found, err := pipeline.Job(jobName)
 		if err != nil {
 			logger.Error("failed-to-get-job", err)
@@ -24,7 +37,7 @@ func (s *Server) PauseJob(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		err = job.Pause()
+		err = job.Pause(accessor.GetAccessor(r).UserName(), reqBody.Comment)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
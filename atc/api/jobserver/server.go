@@ -14,6 +14,7 @@ type Server struct {
 	rejector      auth.Rejector
 	secretManager creds.Secrets
 	jobFactory    db.JobFactory
+	checkFactory  db.CheckFactory
 }
 
 func NewServer(
@@ -21,6 +22,7 @@ func NewServer(
 	externalURL string,
 	secretManager creds.Secrets,
 	jobFactory db.JobFactory,
+	checkFactory db.CheckFactory,
 ) *Server {
 	return &Server{
 		logger:        logger,
@@ -28,5 +30,6 @@ func NewServer(
 		rejector:      auth.UnauthorizedRejector{},
 		secretManager: secretManager,
 		jobFactory:    jobFactory,
+		checkFactory:  checkFactory,
 	}
 }
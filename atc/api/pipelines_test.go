@@ -8,6 +8,8 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -139,6 +141,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "public-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "main",
 					"groups": [
 						{
@@ -153,6 +156,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "another-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "another"
 				}]`))
 			})
@@ -180,6 +184,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "private-pipeline",
 					"paused": false,
 					"public": false,
+					"archived": false,
 					"team_name": "main",
 					"groups": [
 						{
@@ -194,6 +199,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "public-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "main",
 					"groups": [
 						{
@@ -208,6 +214,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "another-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "another"
 				}]`))
 			})
@@ -248,9 +255,14 @@ var _ = Describe("Pipelines API", func() {
 
 	Describe("GET /api/v1/teams/:team_name/pipelines", func() {
 		var response *http.Response
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/main/pipelines", nil)
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/main/pipelines"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -288,6 +300,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "private-pipeline",
 						"paused": false,
 						"public": false,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -302,6 +315,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "public-pipeline",
 						"paused": true,
 						"public": true,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -313,6 +327,47 @@ var _ = Describe("Pipelines API", func() {
 					}]`))
 			})
 
+			Context("when the team has an archived pipeline", func() {
+				BeforeEach(func() {
+					archivedPipeline := new(dbfakes.FakePipeline)
+					archivedPipeline.IDReturns(5)
+					archivedPipeline.NameReturns("archived-pipeline")
+					archivedPipeline.TeamNameReturns("main")
+					archivedPipeline.PausedReturns(true)
+					archivedPipeline.ArchivedReturns(true)
+
+					fakeTeam.PipelinesReturns([]db.Pipeline{
+						privatePipeline,
+						archivedPipeline,
+					}, nil)
+				})
+
+				It("leaves the archived pipeline out", func() {
+					var pipelines []atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipelines)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipelines).To(HaveLen(1))
+					Expect(pipelines[0].Name).To(Equal("private-pipeline"))
+				})
+
+				Context("when archived pipelines are asked for", func() {
+					BeforeEach(func() {
+						query = "?include_archived=true"
+					})
+
+					It("includes the archived pipeline", func() {
+						var pipelines []atc.Pipeline
+						err := json.NewDecoder(response.Body).Decode(&pipelines)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(pipelines).To(HaveLen(2))
+						Expect(pipelines[1].Name).To(Equal("archived-pipeline"))
+						Expect(pipelines[1].Archived).To(BeTrue())
+					})
+				})
+			})
+
 			Context("when the call to get active pipelines fails", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelinesReturns(nil, errors.New("disaster"))
@@ -340,6 +395,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "public-pipeline",
 						"paused": true,
 						"public": true,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -368,6 +424,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "public-pipeline",
 						"paused": true,
 						"public": true,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -384,14 +441,19 @@ var _ = Describe("Pipelines API", func() {
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name", func() {
 		var response *http.Response
 		var fakePipeline *dbfakes.FakePipeline
+		var query string
 
 		BeforeEach(func() {
+			query = ""
+
 			fakePipeline = new(dbfakes.FakePipeline)
 			fakePipeline.IDReturns(4)
 			fakePipeline.NameReturns("some-specific-pipeline")
 			fakePipeline.PausedReturns(false)
 			fakePipeline.PublicReturns(true)
 			fakePipeline.TeamNameReturns("a-team")
+			fakePipeline.ParentJobIDReturns(7)
+			fakePipeline.ParentBuildIDReturns(42)
 			fakePipeline.GroupsReturns(atc.GroupConfigs{
 				{
 					Name:      "group1",
@@ -407,7 +469,7 @@ var _ = Describe("Pipelines API", func() {
 		})
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/some-specific-pipeline", nil)
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/some-specific-pipeline"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -451,7 +513,10 @@ var _ = Describe("Pipelines API", func() {
 						"name": "some-specific-pipeline",
 						"paused": false,
 						"public": true,
+						"archived": false,
 						"team_name": "a-team",
+						"parent_job_id": 7,
+						"parent_build_id": 42,
 						"groups": [
 							{
 								"name": "group1",
@@ -466,6 +531,103 @@ var _ = Describe("Pipelines API", func() {
 						]
 					}`))
 			})
+
+			Context("when the pipeline has a display config", func() {
+				BeforeEach(func() {
+					fakePipeline.DisplayReturns(&atc.DisplayConfig{
+						BackgroundImage: "https://example.com/image.png",
+						DefaultGroup:    "group2",
+					})
+				})
+
+				It("includes it in the pipeline JSON", func() {
+					var pipeline atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipeline)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipeline.Display).To(Equal(&atc.DisplayConfig{
+						BackgroundImage: "https://example.com/image.png",
+						DefaultGroup:    "group2",
+					}))
+				})
+			})
+
+			Context("when the pipeline was paused with a comment", func() {
+				BeforeEach(func() {
+					fakePipeline.PausedReturns(true)
+					fakePipeline.PausedByReturns("some-user")
+					fakePipeline.PauseCommentReturns("upgrading the database")
+				})
+
+				It("includes who paused it and why", func() {
+					var pipeline atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipeline)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipeline.PausedBy).To(Equal("some-user"))
+					Expect(pipeline.PauseComment).To(Equal("upgrading the database"))
+				})
+			})
+
+			Context("when the pipeline has freeze windows", func() {
+				BeforeEach(func() {
+					fakePipeline.FreezeWindowsReturns(atc.FreezeWindowConfigs{
+						{Name: "always", Start: "* * * * *", Duration: "1h"},
+						{Name: "never", Start: "0 0 29 2 *", Duration: "1m"},
+					})
+				})
+
+				It("includes the ones which are open", func() {
+					var pipeline atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipeline)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipeline.Frozen).To(HaveLen(1))
+					Expect(pipeline.Frozen[0].Window).To(Equal("always"))
+				})
+			})
+
+			It("looks up the pipeline without instance vars", func() {
+				pipelineRef := fakeTeam.PipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-specific-pipeline"}))
+			})
+
+			Context("when the pipeline is an instance", func() {
+				BeforeEach(func() {
+					query = "?instance_vars=" + url.QueryEscape(`{"branch":"release-1.2"}`)
+					fakePipeline.InstanceVarsReturns(atc.InstanceVars{"branch": "release-1.2"})
+				})
+
+				It("looks up the instance by its instance vars", func() {
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{
+						Name:         "some-specific-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "release-1.2"},
+					}))
+				})
+
+				It("returns the instance vars", func() {
+					var pipeline atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipeline)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipeline.InstanceVars).To(Equal(atc.InstanceVars{"branch": "release-1.2"}))
+				})
+			})
+
+			Context("when the instance vars are malformed", func() {
+				BeforeEach(func() {
+					query = "?instance_vars=bogus"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not look up the pipeline", func() {
+					Expect(fakeTeam.PipelineCallCount()).To(BeZero())
+				})
+			})
 		})
 
 		Context("when authenticated as another team", func() {
@@ -828,8 +990,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline-name"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline-name"}))
 				})
 
 				It("deletes the named pipeline from the database", func() {
@@ -873,11 +1035,16 @@ var _ = Describe("Pipelines API", func() {
 
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/pause", func() {
 		var response *http.Response
+		var requestBody io.Reader
+
+		BeforeEach(func() {
+			requestBody = nil
+		})
 
 		JustBeforeEach(func() {
 			var err error
 
-			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/pause", nil)
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/pause", requestBody)
 			Expect(err).NotTo(HaveOccurred())
 
 			response, err = client.Do(request)
@@ -901,8 +1068,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the pipeline succeeds", func() {
@@ -914,6 +1081,19 @@ var _ = Describe("Pipelines API", func() {
 					It("returns 200", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusOK))
 					})
+
+					Context("when a comment is given", func() {
+						BeforeEach(func() {
+							fakeaccess.UserNameReturns("some-user")
+							requestBody = strings.NewReader(`{"comment":"upgrading the database"}`)
+						})
+
+						It("records the comment and who paused the pipeline", func() {
+							pausedBy, comment := dbPipeline.PauseArgsForCall(0)
+							Expect(pausedBy).To(Equal("some-user"))
+							Expect(comment).To(Equal("upgrading the database"))
+						})
+					})
 				})
 
 				Context("when pausing the pipeline fails", func() {
@@ -981,8 +1161,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the pipeline succeeds", func() {
@@ -996,6 +1176,20 @@ var _ = Describe("Pipelines API", func() {
 					})
 				})
 
+				Context("when the pipeline is archived", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchivedReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not unpause the pipeline", func() {
+						Expect(dbPipeline.UnpauseCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when unpausing the pipeline fails", func() {
 					BeforeEach(func() {
 						fakeTeam.PipelineReturns(dbPipeline, true, nil)
@@ -1030,6 +1224,84 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/archive", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/archive", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+				})
+
+				It("injects the proper pipelineDB", func() {
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+				})
+
+				Context("when archiving the pipeline succeeds", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchiveReturns(nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("archives the pipeline", func() {
+						Expect(dbPipeline.ArchiveCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when archiving the pipeline fails", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchiveReturns(errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/expose", func() {
 		var response *http.Response
 
@@ -1061,8 +1333,8 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when exposing the pipeline succeeds", func() {
@@ -1139,8 +1411,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when hiding the pipeline succeeds", func() {
@@ -1459,8 +1731,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				It("returns 204", func() {
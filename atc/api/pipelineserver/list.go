@@ -40,6 +40,10 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.FormValue("include_archived") != "true" {
+		pipelines = unarchivedPipelines(pipelines)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 
 	err = json.NewEncoder(w).Encode(present.Pipelines(pipelines))
@@ -48,3 +52,15 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// archived pipelines are only listed when explicitly asked for
+func unarchivedPipelines(pipelines []db.Pipeline) []db.Pipeline {
+	unarchived := []db.Pipeline{}
+	for _, pipeline := range pipelines {
+		if !pipeline.Archived() {
+			unarchived = append(unarchived, pipeline)
+		}
+	}
+
+	return unarchived
+}
@@ -1,15 +1,28 @@
 package pipelineserver
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
 func (s *Server) PausePipeline(pipelineDB db.Pipeline) http.Handler {
 	logger := s.logger.Session("pause-pipeline")
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		err := pipelineDB.Pause()
+		var reqBody atc.PauseRequest
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = pipelineDB.Pause(accessor.GetAccessor(r).UserName(), reqBody.Comment)
 		if err != nil {
 			logger.Error("failed-to-pause-pipeline", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,33 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Check(check db.Check) atc.Check {
+	atcCheck := atc.Check{
+		ID:        check.ID(),
+		Status:    check.Status(),
+		CreatedBy: check.CreatedBy(),
+		Plan:      check.Plan(),
+	}
+
+	if !check.CreateTime().IsZero() {
+		atcCheck.CreateTime = check.CreateTime().Unix()
+	}
+
+	if !check.StartTime().IsZero() {
+		atcCheck.StartTime = check.StartTime().Unix()
+	}
+
+	if !check.EndTime().IsZero() {
+		atcCheck.EndTime = check.EndTime().Unix()
+	}
+
+	if check.CheckError() != nil {
+		atcCheck.CheckError = check.CheckError().Error()
+	}
+
+	return atcCheck
+}
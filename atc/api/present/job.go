@@ -11,6 +11,7 @@ func Job(
 	finishedBuild db.Build,
 	nextBuild db.Build,
 	transitionBuild db.Build,
+	freeze *atc.Freeze,
 ) atc.Job {
 	var presentedNextBuild, presentedFinishedBuild, presentedTransitionBuild *atc.Build
 
@@ -52,9 +53,13 @@ func Job(
 
 		Name:                 job.Name(),
 		PipelineName:         job.PipelineName(),
+		PipelineInstanceVars: job.PipelineInstanceVars(),
 		TeamName:             teamName,
 		DisableManualTrigger: job.Config().DisableManualTrigger,
 		Paused:               job.Paused(),
+		PausedBy:             job.PausedBy(),
+		PauseComment:         job.PauseComment(),
+		Frozen:               freeze,
 		FirstLoggedBuildID:   job.FirstLoggedBuildID(),
 		FinishedBuild:        presentedFinishedBuild,
 		NextBuild:            presentedNextBuild,
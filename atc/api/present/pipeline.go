@@ -1,17 +1,27 @@
 package present
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
 func Pipeline(savedPipeline db.Pipeline) atc.Pipeline {
 	return atc.Pipeline{
-		ID:       savedPipeline.ID(),
-		Name:     savedPipeline.Name(),
-		TeamName: savedPipeline.TeamName(),
-		Paused:   savedPipeline.Paused(),
-		Public:   savedPipeline.Public(),
-		Groups:   savedPipeline.Groups(),
+		ID:            savedPipeline.ID(),
+		Name:          savedPipeline.Name(),
+		InstanceVars:  savedPipeline.InstanceVars(),
+		TeamName:      savedPipeline.TeamName(),
+		Paused:        savedPipeline.Paused(),
+		PausedBy:      savedPipeline.PausedBy(),
+		PauseComment:  savedPipeline.PauseComment(),
+		Frozen:        savedPipeline.FreezeWindows().Open(time.Now()),
+		Public:        savedPipeline.Public(),
+		Archived:      savedPipeline.Archived(),
+		Groups:        savedPipeline.Groups(),
+		Display:       savedPipeline.Display(),
+		ParentBuildID: savedPipeline.ParentBuildID(),
+		ParentJobID:   savedPipeline.ParentJobID(),
 	}
 }
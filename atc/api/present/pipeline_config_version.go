@@ -0,0 +1,18 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func PipelineConfigVersion(configVersion db.PipelineConfigVersion) atc.PipelineConfigVersion {
+	config := configVersion.Config
+	config.VarSources = config.VarSources.Redacted()
+
+	return atc.PipelineConfigVersion{
+		Version:   int(configVersion.Version),
+		CreatedBy: configVersion.CreatedBy,
+		CreatedAt: configVersion.CreatedAt.Unix(),
+		Config:    &config,
+	}
+}
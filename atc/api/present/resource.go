@@ -21,16 +21,24 @@ func Resource(resource db.Resource, showCheckError bool, teamName string) atc.Re
 	}
 
 	atcResource := atc.Resource{
-		Name:         resource.Name(),
-		PipelineName: resource.PipelineName(),
-		TeamName:     teamName,
-		Type:         resource.Type(),
-		Icon:         resource.Icon(),
+		Name:                 resource.Name(),
+		PipelineName:         resource.PipelineName(),
+		PipelineInstanceVars: resource.PipelineInstanceVars(),
+		TeamName:             teamName,
+		Type:                 resource.Type(),
+		Icon:                 resource.Icon(),
 
 		FailingToCheck:  failingToCheck,
 		CheckSetupError: checkErrString,
 		CheckError:      rcCheckErrString,
 		PinComment:      resource.PinComment(),
+
+		ConsecutiveCheckErrors: resource.ConsecutiveCheckErrors(),
+		LatestCheckID:          resource.LatestCheckID(),
+	}
+
+	if resource.CheckInterval() != 0 {
+		atcResource.CheckInterval = resource.CheckInterval().String()
 	}
 
 	if !resource.LastCheckEndTime().IsZero() {
@@ -40,6 +40,12 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		State:            string(workerInfo.State()),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+		Hermetic:         workerInfo.Hermetic(),
+	}
+
+	capacity := workerInfo.Capacity()
+	if capacity.CPU != nil || capacity.Memory != nil {
+		atcWorker.Capacity = &capacity
 	}
 
 	if !workerInfo.StartTime().IsZero() {
@@ -2,14 +2,18 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
-	"github.com/google/jsonapi"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -18,8 +22,6 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
-	"github.com/concourse/concourse/atc/radar/radarfakes"
-	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -561,14 +563,10 @@ var _ = Describe("Resources API", func() {
 	})
 
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", func() {
-		var fakeScanner *radarfakes.FakeScanner
 		var checkRequestBody atc.CheckRequestBody
 		var response *http.Response
 
 		BeforeEach(func() {
-			fakeScanner = new(radarfakes.FakeScanner)
-			fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
-
 			checkRequestBody = atc.CheckRequestBody{}
 		})
 
@@ -609,27 +607,50 @@ var _ = Describe("Resources API", func() {
 			})
 
 			Context("when it finds the resource", func() {
+				var fakeCheck *dbfakes.FakeCheck
+
 				BeforeEach(func() {
 					fakeResource := new(dbfakes.FakeResource)
 					fakeResource.IDReturns(1)
 					fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+					fakeCheck = new(dbfakes.FakeCheck)
+					fakeCheck.IDReturns(10)
+					fakeCheck.StatusReturns(atc.CheckStatusPending)
+					fakeCheck.CreatedByReturns(atc.CheckCreatedByManual)
+					fakeCheck.CreateTimeReturns(time.Unix(100, 0))
+					fakeCheck.PlanReturns(atc.CheckPlan{Resource: "resource-name"})
+					dbCheckFactory.CreateResourceCheckReturns(fakeCheck, nil)
 				})
 
 				It("injects the proper pipelineDB", func() {
 					Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := dbTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := dbTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
-				It("tries to scan with no version specified", func() {
-					Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-					_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
+				It("creates a manual check with no version specified", func() {
+					Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+					actualResourceID, createdBy, actualFromVersion := dbCheckFactory.CreateResourceCheckArgsForCall(0)
 					Expect(actualResourceID).To(Equal(1))
+					Expect(createdBy).To(Equal(atc.CheckCreatedByManual))
 					Expect(actualFromVersion).To(BeNil())
 				})
 
-				It("returns 200", func() {
-					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				It("returns 201 with the check", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"id": 10,
+						"status": "pending",
+						"created_by": "manual",
+						"create_time": 100,
+						"plan": {"resource": "resource-name"}
+					}`))
 				})
 
 				Context("when checking with a version specified", func() {
@@ -641,38 +662,17 @@ var _ = Describe("Resources API", func() {
 						}
 					})
 
-					It("tries to scan with the version specified", func() {
-						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-						_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
+					It("creates a check from the version specified", func() {
+						Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+						actualResourceID, _, actualFromVersion := dbCheckFactory.CreateResourceCheckArgsForCall(0)
 						Expect(actualResourceID).To(Equal(1))
 						Expect(actualFromVersion).To(Equal(checkRequestBody.From))
 					})
 				})
 
-				Context("when checking fails with ResourceNotFoundError", func() {
-					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(db.ResourceNotFoundError{})
-					})
-
-					It("returns 404", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
-					})
-				})
-
-				Context("when checking the resource fails with ResourceTypeNotFoundError", func() {
-					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(db.ResourceTypeNotFoundError{ID: 13})
-					})
-
-					It("returns jsonapi 400", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
-						Expect(response.Header.Get("Content-Type")).To(Equal(jsonapi.MediaType))
-					})
-				})
-
-				Context("when checking the resource fails internally", func() {
+				Context("when creating the check fails", func() {
 					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(errors.New("welp"))
+						dbCheckFactory.CreateResourceCheckReturns(nil, errors.New("welp"))
 					})
 
 					It("returns 500", func() {
@@ -684,35 +684,6 @@ var _ = Describe("Resources API", func() {
 						Expect(body).To(Equal("welp"))
 					})
 				})
-
-				Context("when checking the resource fails with ErrResourceScriptFailed", func() {
-					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(
-							resource.ErrResourceScriptFailed{
-								ExitStatus: 42,
-								Stderr:     "my tooth",
-							},
-						)
-					})
-
-					It("returns 400", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
-					})
-
-					It("returns the script's exit status and stderr", func() {
-						body, err := ioutil.ReadAll(response.Body)
-						Expect(err).NotTo(HaveOccurred())
-
-						Expect(body).To(MatchJSON(`{
-						"exit_status": 42,
-						"stderr": "my tooth"
-					}`))
-					})
-
-					It("returns application/json", func() {
-						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
-					})
-				})
 			})
 		})
 
@@ -1126,6 +1097,63 @@ var _ = Describe("Resources API", func() {
 							}`))
 					})
 				})
+
+				Context("when the resource is backing off from failing checks", func() {
+					BeforeEach(func() {
+						resource1 := new(dbfakes.FakeResource)
+						resource1.CheckErrorReturns(errors.New("rate limited"))
+						resource1.PipelineNameReturns("a-pipeline")
+						resource1.NameReturns("resource-1")
+						resource1.TypeReturns("type-1")
+						resource1.LastCheckEndTimeReturns(time.Unix(1513364881, 0))
+						resource1.CheckIntervalReturns(4 * time.Minute)
+						resource1.ConsecutiveCheckErrorsReturns(2)
+						fakePipeline.ResourceReturns(resource1, true, nil)
+					})
+
+					It("returns the effective check interval and the number of failed checks", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`
+							{
+								"name": "resource-1",
+								"pipeline_name": "a-pipeline",
+								"team_name": "a-team",
+								"type": "type-1",
+								"last_checked": 1513364881,
+								"failing_to_check": true,
+								"check_error": "rate limited",
+								"check_interval": "4m0s",
+								"consecutive_check_errors": 2
+							}`))
+					})
+				})
+
+				Context("when the resource has been checked", func() {
+					BeforeEach(func() {
+						resource1 := new(dbfakes.FakeResource)
+						resource1.PipelineNameReturns("a-pipeline")
+						resource1.NameReturns("resource-1")
+						resource1.TypeReturns("type-1")
+						resource1.LatestCheckIDReturns(42)
+						fakePipeline.ResourceReturns(resource1, true, nil)
+					})
+
+					It("returns the id of its latest check", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`
+							{
+								"name": "resource-1",
+								"pipeline_name": "a-pipeline",
+								"team_name": "a-team",
+								"type": "type-1",
+								"latest_check_id": 42
+							}`))
+					})
+				})
 			})
 		})
 
@@ -1186,6 +1214,103 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			fakePipeline.PublicReturns(true)
+
+			fakeResource := new(dbfakes.FakeResource)
+			fakeResource.IDReturns(1)
+			fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+			fakeCheck := new(dbfakes.FakeCheck)
+			fakeCheck.IDReturns(10)
+			fakeCheck.StatusReturns(atc.CheckStatusErrored)
+			fakeCheck.CreatedByReturns(atc.CheckCreatedByInterval)
+			fakeCheck.CreateTimeReturns(time.Unix(100, 0))
+			fakeCheck.PlanReturns(atc.CheckPlan{Resource: "some-resource"})
+			fakeCheck.CheckErrorReturns(errors.New("nope"))
+
+			dbCheckFactory.ResourceChecksReturns([]db.Check{fakeCheck}, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/checks?limit=5")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			It("looks up the latest checks of the resource", func() {
+				Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-resource"))
+
+				resourceID, limit := dbCheckFactory.ResourceChecksArgsForCall(0)
+				Expect(resourceID).To(Equal(1))
+				Expect(limit).To(Equal(5))
+			})
+
+			It("returns 200 with the checks", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[{
+					"id": 10,
+					"status": "errored",
+					"created_by": "interval",
+					"create_time": 100,
+					"plan": {"resource": "some-resource"},
+					"check_error": "nope"
+				}]`))
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the checks fails", func() {
+				BeforeEach(func() {
+					dbCheckFactory.ResourceChecksReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns the checks without their errors", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				var checks []atc.Check
+				err := json.NewDecoder(response.Body).Decode(&checks)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(checks).To(HaveLen(1))
+				Expect(checks[0].CheckError).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", func() {
 		var checkRequestBody atc.CheckRequestBody
 		var response *http.Response
@@ -1228,14 +1353,9 @@ var _ = Describe("Resources API", func() {
 		})
 
 		Context("when authenticated and authorized", func() {
-			var fakeScanner *radarfakes.FakeScanner
-
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 				fakeaccess.IsAuthorizedReturns(true)
-
-				fakeScanner = new(radarfakes.FakeScanner)
-				fakeScannerFactory.NewResourceTypeScannerReturns(fakeScanner)
 			})
 
 			Context("when looking up the resource type fails", func() {
@@ -1261,14 +1381,35 @@ var _ = Describe("Resources API", func() {
 					fakeResourceType := new(dbfakes.FakeResourceType)
 					fakeResourceType.IDReturns(1)
 					fakePipeline.ResourceTypeReturns(fakeResourceType, true, nil)
+
+					fakeCheck := new(dbfakes.FakeCheck)
+					fakeCheck.IDReturns(10)
+					fakeCheck.StatusReturns(atc.CheckStatusPending)
+					fakeCheck.CreatedByReturns(atc.CheckCreatedByManual)
+					fakeCheck.PlanReturns(atc.CheckPlan{ResourceType: "resource-type-name"})
+					dbCheckFactory.CreateResourceTypeCheckReturns(fakeCheck, nil)
 				})
 
-				It("returns 200", func() {
-					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				It("returns 201 with the check", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"id": 10,
+						"status": "pending",
+						"created_by": "manual",
+						"plan": {"resource_type": "resource-type-name"}
+					}`))
 				})
 
-				It("calls Scan", func() {
-					Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+				It("creates a manual check", func() {
+					Expect(dbCheckFactory.CreateResourceTypeCheckCallCount()).To(Equal(1))
+					actualResourceTypeID, createdBy, actualFromVersion := dbCheckFactory.CreateResourceTypeCheckArgsForCall(0)
+					Expect(actualResourceTypeID).To(Equal(1))
+					Expect(createdBy).To(Equal(atc.CheckCreatedByManual))
+					Expect(actualFromVersion).To(BeNil())
 				})
 
 				Context("when checking with a version specified", func() {
@@ -1280,28 +1421,16 @@ var _ = Describe("Resources API", func() {
 						}
 					})
 
-					It("tries to scan with the version specified", func() {
-						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-						_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-						Expect(actualResourceID).To(Equal(1))
+					It("creates a check from the version specified", func() {
+						Expect(dbCheckFactory.CreateResourceTypeCheckCallCount()).To(Equal(1))
+						_, _, actualFromVersion := dbCheckFactory.CreateResourceTypeCheckArgsForCall(0)
 						Expect(actualFromVersion).To(Equal(checkRequestBody.From))
 					})
 				})
 
-				Context("when resource type checking fails with ResourceNotFoundError", func() {
-					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(db.ResourceTypeNotFoundError{})
-					})
-
-					It("returns 404", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
-					})
-				})
-
-				Context("when resource type fails with unexpected error", func() {
+				Context("when creating the check fails", func() {
 					BeforeEach(func() {
-						err := errors.New("some-error")
-						fakeScanner.ScanFromVersionReturns(err)
+						dbCheckFactory.CreateResourceTypeCheckReturns(nil, errors.New("some-error"))
 					})
 
 					It("returns 500", func() {
@@ -1314,26 +1443,17 @@ var _ = Describe("Resources API", func() {
 
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
-			fakeScanner               *radarfakes.FakeScanner
-			checkRequestBody          atc.CheckRequestBody
-			response                  *http.Response
-			fakeResource              *dbfakes.FakeResource
-			fakeResourceConfig        *dbfakes.FakeResourceConfig
-			fakeResourceConfigVersion *dbfakes.FakeResourceConfigVersion
-			fakeResourceConfigScope   *dbfakes.FakeResourceConfigScope
+			checkRequestBody atc.CheckRequestBody
+			response         *http.Response
+			fakeResource     *dbfakes.FakeResource
 		)
 
 		BeforeEach(func() {
-			fakeScanner = new(radarfakes.FakeScanner)
-			fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
 			checkRequestBody = atc.CheckRequestBody{}
 
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
 			fakeResource.IDReturns(10)
-			fakeResourceConfig = new(dbfakes.FakeResourceConfig)
-			fakeResourceConfigVersion = new(dbfakes.FakeResourceConfigVersion)
-			fakeResourceConfigScope = new(dbfakes.FakeResourceConfigScope)
 		})
 
 		JustBeforeEach(func() {
@@ -1357,8 +1477,6 @@ var _ = Describe("Resources API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				fakeResource.WebhookTokenReturns(token)
 				fakePipeline.ResourceReturns(fakeResource, true, nil)
-				fakeResource.ResourceConfigIDReturns(1)
-				fakeResource.ResourceConfigScopeIDReturns(2)
 			})
 
 			It("injects the proper pipelineDB", func() {
@@ -1372,107 +1490,25 @@ var _ = Describe("Resources API", func() {
 				Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("resource-name"))
 			})
 
-			Context("when finding the resource succeeds", func() {
-				BeforeEach(func() {
-					fakePipeline.ResourceReturns(fakeResource, true, nil)
-				})
-
-				It("tries to find the resource config using the resource config id", func() {
-					Eventually(dbResourceConfigFactory.FindResourceConfigByIDCallCount).Should(Equal(1))
-					Expect(dbResourceConfigFactory.FindResourceConfigByIDArgsForCall(0)).To(Equal(1))
-				})
-
-				Context("when finding the resource config succeeds", func() {
-					BeforeEach(func() {
-						dbResourceConfigFactory.FindResourceConfigByIDReturns(fakeResourceConfig, true, nil)
-					})
-
-					It("tries to find the resource config scope using the resource config scope id", func() {
-						Eventually(fakeResourceConfig.FindResourceConfigScopeByIDCallCount).Should(Equal(1))
-						resourceConfigScopeID, resource := fakeResourceConfig.FindResourceConfigScopeByIDArgsForCall(0)
-						Expect(resourceConfigScopeID).To(Equal(2))
-						Expect(resource).To(Equal(fakeResource))
-					})
-
-					Context("when finding the resource config scope succeeds", func() {
-
-						BeforeEach(func() {
-							fakeResourceConfig.FindResourceConfigScopeByIDReturns(fakeResourceConfigScope, true, nil)
-						})
-
-						Context("when the latest version is found", func() {
-							BeforeEach(func() {
-								fakeResourceConfigVersion.IDReturns(4)
-								fakeResourceConfigVersion.VersionReturns(db.Version{"some": "version"})
-								fakeResourceConfigVersion.MetadataReturns([]db.ResourceConfigMetadataField{
-									{
-										Name:  "some",
-										Value: "metadata",
-									},
-								})
-
-								fakeResourceConfigScope.LatestVersionReturns(fakeResourceConfigVersion, true, nil)
-							})
-
-							It("tries to scan with the latest version", func() {
-								Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-								_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-								Expect(actualResourceID).To(Equal(10))
-								Expect(actualFromVersion).To(Equal(atc.Version{"some": "version"}))
-							})
-
-							It("returns 200", func() {
-								Expect(response.StatusCode).To(Equal(http.StatusOK))
-							})
-						})
-
-						Context("when the latest version is not found", func() {
-							BeforeEach(func() {
-								fakeResourceConfigScope.LatestVersionReturns(nil, false, nil)
-							})
-
-							It("tries to scan with no version specified", func() {
-								Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-								_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-								Expect(actualResourceID).To(Equal(10))
-								Expect(actualFromVersion).To(BeNil())
-							})
-
-							It("returns 200", func() {
-								Expect(response.StatusCode).To(Equal(http.StatusOK))
-							})
-						})
-
-						Context("when failing to get latest version for resource", func() {
-							BeforeEach(func() {
-								fakeResourceConfigScope.LatestVersionReturns(nil, false, errors.New("disaster"))
-							})
-
-							It("does not scan from version", func() {
-								Consistently(fakeScanner.ScanFromVersionCallCount).Should(Equal(0))
-							})
-						})
-					})
+			It("creates a webhook check of the resource", func() {
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+				actualResourceID, createdBy, actualFromVersion := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+				Expect(actualResourceID).To(Equal(10))
+				Expect(createdBy).To(Equal(atc.CheckCreatedByWebhook))
+				Expect(actualFromVersion).To(BeNil())
+			})
 
-					Context("when the resource config scope is not found", func() {
-						BeforeEach(func() {
-							fakeResourceConfig.FindResourceConfigScopeByIDReturns(nil, false, nil)
-						})
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
 
-						It("tries to scan", func() {
-							Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-						})
-					})
+			Context("when creating the check fails", func() {
+				BeforeEach(func() {
+					dbCheckFactory.CreateResourceCheckReturns(nil, errors.New("disaster"))
 				})
 
-				Context("when the resource config is not found", func() {
-					BeforeEach(func() {
-						dbResourceConfigFactory.FindResourceConfigByIDReturns(nil, false, nil)
-					})
-
-					It("tries to scan", func() {
-						Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-					})
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 				})
 			})
 
@@ -1508,4 +1544,502 @@ var _ = Describe("Resources API", func() {
 			})
 		})
 	})
+
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/webhook", func() {
+		var (
+			payload         string
+			headers         http.Header
+			query           string
+			response        *http.Response
+			matchedResource *dbfakes.FakeResource
+			otherResource   *dbfakes.FakeResource
+		)
+
+		sign := func(secret string, body string) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			_, _ = mac.Write([]byte(body))
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		BeforeEach(func() {
+			payload = `{
+				"ref": "refs/heads/master",
+				"repository": {
+					"clone_url": "https://github.com/concourse/concourse.git",
+					"ssh_url": "git@github.com:concourse/concourse.git"
+				}
+			}`
+
+			headers = http.Header{}
+			headers.Set("X-GitHub-Event", "push")
+			headers.Set("X-Hub-Signature-256", sign("some-token", payload))
+
+			query = ""
+
+			matchedResource = new(dbfakes.FakeResource)
+			matchedResource.IDReturns(10)
+			matchedResource.NameReturns("matched-resource")
+			matchedResource.WebhookTokenReturns("some-token")
+			matchedResource.WebhookFilterReturns(&atc.WebhookFilter{
+				Repository: "git@github.com:concourse/concourse",
+				Branch:     "master",
+			})
+
+			otherResource = new(dbfakes.FakeResource)
+			otherResource.IDReturns(11)
+			otherResource.NameReturns("other-resource")
+			otherResource.WebhookTokenReturns("some-token")
+			otherResource.WebhookFilterReturns(&atc.WebhookFilter{
+				Repository: "github.com/concourse/concourse",
+				Branch:     "release/*",
+			})
+
+			unfilteredResource := new(dbfakes.FakeResource)
+			unfilteredResource.IDReturns(12)
+			unfilteredResource.WebhookTokenReturns("some-token")
+
+			fakePipeline.ResourcesReturns(db.Resources{matchedResource, otherResource, unfilteredResource}, nil)
+
+			fakeCheck := new(dbfakes.FakeCheck)
+			fakeCheck.IDReturns(42)
+			fakeCheck.StatusReturns(atc.CheckStatusPending)
+			fakeCheck.CreatedByReturns(atc.CheckCreatedByWebhook)
+			dbCheckFactory.CreateResourceCheckReturns(fakeCheck, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/webhook"+query, bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header = headers
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns 200 with the checks it created", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`[{"id": 42, "status": "pending", "created_by": "webhook", "plan": {}}]`))
+		})
+
+		It("only checks the resources whose filter matches", func() {
+			Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+			resourceID, createdBy, from := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+			Expect(resourceID).To(Equal(10))
+			Expect(createdBy).To(Equal(atc.CheckCreatedByWebhook))
+			Expect(from).To(BeNil())
+		})
+
+		Context("when the payload was signed with sha1", func() {
+			BeforeEach(func() {
+				mac := hmac.New(sha1.New, []byte("some-token"))
+				_, _ = mac.Write([]byte(payload))
+
+				headers.Del("X-Hub-Signature-256")
+				headers.Set("X-Hub-Signature", "sha1="+hex.EncodeToString(mac.Sum(nil)))
+			})
+
+			It("checks the matching resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the event is not a push", func() {
+			BeforeEach(func() {
+				headers.Set("X-GitHub-Event", "issues")
+			})
+
+			It("checks nothing", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when no resource's filter matches", func() {
+			BeforeEach(func() {
+				payload = `{
+					"ref": "refs/heads/master",
+					"repository": {"clone_url": "https://github.com/concourse/other.git"}
+				}`
+
+				headers.Set("X-Hub-Signature-256", sign("wrong-token", payload))
+			})
+
+			It("checks nothing without evaluating any token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(BeZero())
+				Expect(fakePipeline.VariablesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is unsigned", func() {
+			BeforeEach(func() {
+				headers.Del("X-Hub-Signature-256")
+			})
+
+			It("returns 401 without getting the resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakePipeline.ResourcesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the signature does not match any resource's token", func() {
+			BeforeEach(func() {
+				headers.Set("X-Hub-Signature-256", sign("wrong-token", payload))
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is from GitLab", func() {
+			BeforeEach(func() {
+				payload = `{
+					"ref": "refs/heads/release/v1",
+					"project": {"git_http_url": "https://github.com/concourse/concourse.git"}
+				}`
+
+				headers = http.Header{}
+				headers.Set("X-Gitlab-Event", "Push Hook")
+				headers.Set("X-Gitlab-Token", "some-token")
+			})
+
+			It("checks the matching resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+				resourceID, _, _ := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+				Expect(resourceID).To(Equal(11))
+			})
+
+			Context("when the token is wrong", func() {
+				BeforeEach(func() {
+					headers.Set("X-Gitlab-Token", "wrong-token")
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+		})
+
+		Context("when the payload is from Bitbucket Server", func() {
+			BeforeEach(func() {
+				payload = `{
+					"repository": {
+						"links": {
+							"clone": [
+								{"href": "ssh://git@github.com:7999/concourse/concourse.git", "name": "ssh"}
+							]
+						}
+					},
+					"changes": [
+						{"refId": "refs/heads/feature"},
+						{"refId": "refs/heads/master"}
+					]
+				}`
+
+				headers = http.Header{}
+				headers.Set("X-Event-Key", "repo:refs_changed")
+				headers.Set("X-Hub-Signature", sign("some-token", payload))
+			})
+
+			It("checks the matching resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+				resourceID, _, _ := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+				Expect(resourceID).To(Equal(10))
+			})
+		})
+
+		Context("when the payload is from Bitbucket Cloud", func() {
+			BeforeEach(func() {
+				payload = `{
+					"repository": {
+						"links": {"html": {"href": "https://github.com/concourse/concourse"}}
+					},
+					"push": {
+						"changes": [{"new": {"type": "branch", "name": "release/v2"}}]
+					}
+				}`
+
+				headers = http.Header{}
+				headers.Set("X-Event-Key", "repo:push")
+				headers.Set("X-Hook-UUID", "some-uuid")
+				headers.Set("X-Hub-Signature", sign("some-token", payload))
+			})
+
+			It("checks the matching resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+				resourceID, _, _ := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+				Expect(resourceID).To(Equal(11))
+			})
+
+			Context("when the webhook has no secret to sign the payload with", func() {
+				BeforeEach(func() {
+					headers.Del("X-Hub-Signature")
+				})
+
+				Context("when the webhook's URL has the token", func() {
+					BeforeEach(func() {
+						query = "?webhook_token=some-token"
+					})
+
+					It("checks the matching resources", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the webhook's URL has the wrong token", func() {
+					BeforeEach(func() {
+						query = "?webhook_token=wrong-token"
+					})
+
+					It("returns 401", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+
+				Context("when the webhook's URL has no token", func() {
+					It("returns 401", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+			})
+		})
+
+		Context("when an unsigned payload claims to be from Bitbucket Server", func() {
+			BeforeEach(func() {
+				headers = http.Header{}
+				headers.Set("X-Event-Key", "repo:refs_changed")
+				query = "?webhook_token=some-token"
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when the sender is unknown", func() {
+			BeforeEach(func() {
+				headers = http.Header{}
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the payload is malformed", func() {
+			BeforeEach(func() {
+				payload = "nope"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when getting the resources fails", func() {
+			BeforeEach(func() {
+				fakePipeline.ResourcesReturns(nil, errors.New("oops"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when creating a check fails", func() {
+			BeforeEach(func() {
+				dbCheckFactory.CreateResourceCheckReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/webhook", func() {
+		var (
+			payload  string
+			headers  http.Header
+			response *http.Response
+		)
+
+		newPipeline := func(name string, resourceID int, repository string) *dbfakes.FakePipeline {
+			resource := new(dbfakes.FakeResource)
+			resource.IDReturns(resourceID)
+			resource.WebhookTokenReturns("((webhook-token))")
+			resource.WebhookFilterReturns(&atc.WebhookFilter{
+				Repository: repository,
+			})
+
+			pipeline := new(dbfakes.FakePipeline)
+			pipeline.NameReturns(name)
+			pipeline.ResourcesReturns(db.Resources{resource}, nil)
+			return pipeline
+		}
+
+		sign := func(secret string, body string) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			_, _ = mac.Write([]byte(body))
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		BeforeEach(func() {
+			payload = `{
+				"ref": "refs/heads/master",
+				"repository": {"clone_url": "https://github.com/concourse/concourse.git"}
+			}`
+
+			headers = http.Header{}
+			headers.Set("X-GitHub-Event", "push")
+			headers.Set("X-Hub-Signature-256", sign("team-secret", payload))
+
+			fakeSecretManager.GetStub = func(secretPath string) (interface{}, *time.Time, bool, error) {
+				if secretPath == "team_webhook_secret" {
+					return "team-secret", nil, true, nil
+				}
+
+				return nil, nil, false, nil
+			}
+
+			archivedPipeline := newPipeline("archived-pipeline", 30, "github.com/concourse/concourse")
+			archivedPipeline.ArchivedReturns(true)
+
+			dbTeam.NameReturns("a-team")
+			dbTeam.PipelinesReturns([]db.Pipeline{
+				newPipeline("some-pipeline", 10, "github.com/concourse/concourse"),
+				newPipeline("other-pipeline", 20, "github.com/concourse/other"),
+				archivedPipeline,
+			}, nil)
+
+			fakeCheck := new(dbfakes.FakeCheck)
+			fakeCheck.IDReturns(42)
+			fakeCheck.StatusReturns(atc.CheckStatusPending)
+			fakeCheck.CreatedByReturns(atc.CheckCreatedByWebhook)
+			dbCheckFactory.CreateResourceCheckReturns(fakeCheck, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/webhook", bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header = headers
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("finds the team", func() {
+			Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+		})
+
+		It("only checks the matching resources of the team's active pipelines", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+			resourceID, createdBy, _ := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+			Expect(resourceID).To(Equal(10))
+			Expect(createdBy).To(Equal(atc.CheckCreatedByWebhook))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`[{"id": 42, "status": "pending", "created_by": "webhook", "plan": {}}]`))
+		})
+
+		It("only looks up the team's secret", func() {
+			Expect(fakeSecretManager.GetCallCount()).To(Equal(1))
+			Expect(fakeSecretManager.GetArgsForCall(0)).To(Equal("team_webhook_secret"))
+		})
+
+		Context("when the payload is not signed with the team's secret", func() {
+			BeforeEach(func() {
+				headers.Set("X-Hub-Signature-256", sign("some-token", payload))
+			})
+
+			It("returns 401 without getting the pipelines", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbTeam.PipelinesCallCount()).To(BeZero())
+				Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is unsigned", func() {
+			BeforeEach(func() {
+				headers.Del("X-Hub-Signature-256")
+			})
+
+			It("returns 401 without finding the team", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbTeamFactory.FindTeamCallCount()).To(BeZero())
+				Expect(fakeSecretManager.GetCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is too large", func() {
+			BeforeEach(func() {
+				payload = strings.Repeat(" ", 5*1024*1024) + payload
+				headers.Set("X-Hub-Signature-256", sign("team-secret", payload))
+			})
+
+			It("returns 413 without finding the team", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusRequestEntityTooLarge))
+				Expect(dbTeamFactory.FindTeamCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the team has no webhook secret", func() {
+			BeforeEach(func() {
+				fakeSecretManager.GetStub = nil
+				fakeSecretManager.GetReturns(nil, nil, false, nil)
+			})
+
+			It("returns 401 without getting the pipelines", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbTeam.PipelinesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when getting the team's webhook secret fails", func() {
+			BeforeEach(func() {
+				fakeSecretManager.GetStub = nil
+				fakeSecretManager.GetReturns(nil, nil, false, errors.New("disaster"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when the team is not found", func() {
+			BeforeEach(func() {
+				dbTeamFactory.FindTeamReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when getting the pipelines fails", func() {
+			BeforeEach(func() {
+				dbTeam.PipelinesReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
 })
@@ -6,12 +6,13 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
-	"github.com/concourse/concourse/atc/resource"
-	"github.com/google/jsonapi"
 	"github.com/tedsuo/rata"
 )
 
+// CheckResource queues up a check of the resource and responds with it right
+// away. Its progress can be followed through GetCheck.
 func (s *Server) CheckResource(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource")
 
@@ -39,39 +40,24 @@ func (s *Server) CheckResource(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
-
-		err = scanner.ScanFromVersion(logger, dbResource.ID(), reqBody.From)
-		switch scanErr := err.(type) {
-		case resource.ErrResourceScriptFailed:
-			checkResponseBody := atc.CheckResponseBody{
-				ExitStatus: scanErr.ExitStatus,
-				Stderr:     scanErr.Stderr,
-			}
-
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusBadRequest)
-			err = json.NewEncoder(w).Encode(checkResponseBody)
-			if err != nil {
-				logger.Error("failed-to-encode-check-response-body", err)
-				w.WriteHeader(http.StatusInternalServerError)
-				_, _ = w.Write([]byte(err.Error()))
-			}
-		case db.ResourceNotFoundError:
-			w.WriteHeader(http.StatusNotFound)
-		case db.ResourceTypeNotFoundError:
-			w.Header().Set("Content-Type", jsonapi.MediaType)
-			w.WriteHeader(http.StatusBadRequest)
-			_ = jsonapi.MarshalErrors(w, []*jsonapi.ErrorObject{{
-				Title:  "Resource Type Not Found Error",
-				Detail: err.Error(),
-				Status: "400",
-			}})
-		case error:
+		check, err := s.checkFactory.CreateResourceCheck(dbResource.ID(), atc.CheckCreatedByManual, reqBody.From)
+		if err != nil {
+			logger.Error("failed-to-create-check", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(err.Error()))
-		default:
-			w.WriteHeader(http.StatusOK)
+			return
 		}
+
+		respondWithCheck(logger, w, check)
 	})
 }
+
+func respondWithCheck(logger lager.Logger, w http.ResponseWriter, check db.Check) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err := json.NewEncoder(w).Encode(present.Check(check))
+	if err != nil {
+		logger.Error("failed-to-encode-check", err)
+	}
+}
@@ -37,17 +37,14 @@ func (s *Server) CheckResourceType(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		scanner := s.scannerFactory.NewResourceTypeScanner(dbPipeline)
-
-		err = scanner.ScanFromVersion(logger, dbResourceType.ID(), reqBody.From)
-		switch err.(type) {
-		case db.ResourceTypeNotFoundError:
-			w.WriteHeader(http.StatusNotFound)
-		case error:
+		check, err := s.checkFactory.CreateResourceTypeCheck(dbResourceType.ID(), atc.CheckCreatedByManual, reqBody.From)
+		if err != nil {
+			logger.Error("failed-to-create-check", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(err.Error()))
-		default:
-			w.WriteHeader(http.StatusOK)
+			return
 		}
+
+		respondWithCheck(logger, w, check)
 	})
 }
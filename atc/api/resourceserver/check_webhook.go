@@ -38,7 +38,7 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		variables := creds.NewVariables(s.secretManager, dbPipeline.TeamName(), dbPipeline.Name())
+		variables := dbPipeline.Variables(logger, s.secretManager, s.varSourcePool)
 		token, err := creds.NewString(variables, pipelineResource.WebhookToken()).Evaluate()
 		if token != webhookToken {
 			logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
@@ -46,37 +46,12 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		go func() {
-			var fromVersion atc.Version
-			resourceConfigID := pipelineResource.ResourceConfigID()
-			resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigID)
-			if err != nil {
-				logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigID})
-				return
-			}
-
-			if found {
-				resourceConfigScope, found, err := resourceConfig.FindResourceConfigScopeByID(pipelineResource.ResourceConfigScopeID(), pipelineResource)
-				if err != nil {
-					logger.Error("failed-to-get-resource-config-scope", err, lager.Data{"resource-config-scope-id": pipelineResource.ResourceConfigScopeID()})
-					return
-				}
-
-				if found {
-					latestVersion, found, err := resourceConfigScope.LatestVersion()
-					if err != nil {
-						logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigID})
-						return
-					}
-					if found {
-						fromVersion = atc.Version(latestVersion.Version())
-					}
-				}
-			}
-
-			scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
-			scanner.ScanFromVersion(logger, pipelineResource.ID(), fromVersion)
-		}()
+		_, err = s.checkFactory.CreateResourceCheck(pipelineResource.ID(), atc.CheckCreatedByWebhook, nil)
+		if err != nil {
+			logger.Error("failed-to-create-check", err, lager.Data{"resource-name": resourceName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 
 		w.WriteHeader(http.StatusOK)
 	})
@@ -0,0 +1,69 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListResourceChecks responds with the latest checks of the resource, newest
+// first. Their errors are only shown to members of the pipeline's team.
+func (s *Server) ListResourceChecks(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-resource-checks")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := r.FormValue(":resource_name")
+		teamName := r.FormValue(":team_name")
+
+		limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+		if limit <= 0 {
+			limit = atc.PaginationAPIDefaultLimit
+		}
+
+		dbResource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		dbChecks, err := s.checkFactory.ResourceChecks(dbResource.ID(), limit)
+		if err != nil {
+			logger.Error("failed-to-get-resource-checks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		showCheckError := accessor.GetAccessor(r).IsAuthorized(teamName)
+
+		checks := []atc.Check{}
+		for _, dbCheck := range dbChecks {
+			check := present.Check(dbCheck)
+			if !showCheckError {
+				check.CheckError = ""
+			}
+
+			checks = append(checks, check)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(checks)
+		if err != nil {
+			logger.Error("failed-to-encode-checks", err)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const maxWebhookPayloadSize = 25 * 1024 * 1024
+
+// PipelineWebHook checks each of the pipeline's resources whose webhook_filter
+// matches the payload sent by GitHub, GitLab, or Bitbucket, so that a single
+// webhook of a repository covers every resource of the pipeline using it. The
+// payload must be signed with the resource's webhook_token for it to be
+// checked.
+func (s *Server) PipelineWebHook(dbPipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("pipeline-webhook")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivery, ok := readWebhook(logger, w, r, maxWebhookPayloadSize)
+		if !ok {
+			return
+		}
+
+		logger := logger.WithData(lager.Data{"pipeline": dbPipeline.Name()})
+
+		resources, err := matchingWebhookResources(dbPipeline, delivery)
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if len(resources) == 0 {
+			respondWithWebhookChecks(logger, w, []atc.Check{})
+			return
+		}
+
+		variables := dbPipeline.Variables(logger, s.secretManager, s.varSourcePool)
+
+		// resources of the same repository are likely to share their token, so
+		// each one is only evaluated once
+		verifiedTokens := map[string]bool{}
+
+		verified := db.Resources{}
+		for _, resource := range resources {
+			if resource.WebhookToken() == "" {
+				continue
+			}
+
+			ok, evaluated := verifiedTokens[resource.WebhookToken()]
+			if !evaluated {
+				token, err := creds.NewString(variables, resource.WebhookToken()).Evaluate()
+				if err != nil {
+					logger.Error("failed-to-evaluate-webhook-token", err, lager.Data{"resource-name": resource.Name()})
+				}
+
+				ok = err == nil && delivery.verify(token)
+				verifiedTokens[resource.WebhookToken()] = ok
+			}
+
+			if ok {
+				verified = append(verified, resource)
+			}
+		}
+
+		if len(verified) == 0 {
+			logger.Info("unverified-payload")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		checks, err := s.createWebhookChecks(logger, verified)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		respondWithWebhookChecks(logger, w, checks)
+	})
+}
+
+// readWebhook reads and parses the webhook payload of the request, responding
+// with the appropriate error status if it can't. Payloads which aren't signed
+// at all, or which are larger than maxSize, are rejected before being read.
+func readWebhook(logger lager.Logger, w http.ResponseWriter, r *http.Request, maxSize int64) (webhookDelivery, bool) {
+	signature, err := webhookSignature(r)
+	if err != nil {
+		logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return webhookDelivery{}, false
+	}
+
+	if signature == "" {
+		logger.Info("unsigned-payload")
+		w.WriteHeader(http.StatusUnauthorized)
+		return webhookDelivery{}, false
+	}
+
+	if r.ContentLength > maxSize {
+		logger.Info("payload-too-large", lager.Data{"size": r.ContentLength})
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return webhookDelivery{}, false
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxSize))
+	if err != nil {
+		logger.Error("failed-to-read-payload", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return webhookDelivery{}, false
+	}
+
+	delivery, err := parseWebhook(r, body)
+	if err != nil {
+		logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return webhookDelivery{}, false
+	}
+
+	return delivery, true
+}
+
+// matchingWebhookResources returns the pipeline's resources whose
+// webhook_filter matches the delivery. It doesn't verify the delivery, so that
+// no credentials are looked up for payloads which wouldn't check anything.
+func matchingWebhookResources(dbPipeline db.Pipeline, delivery webhookDelivery) (db.Resources, error) {
+	resources, err := dbPipeline.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	matching := db.Resources{}
+	for _, resource := range resources {
+		filter := resource.WebhookFilter()
+		if filter != nil && filter.Matches(delivery.payload) {
+			matching = append(matching, resource)
+		}
+	}
+
+	return matching, nil
+}
+
+// createWebhookChecks checks each of the given resources.
+func (s *Server) createWebhookChecks(logger lager.Logger, resources db.Resources) ([]atc.Check, error) {
+	checks := []atc.Check{}
+	for _, resource := range resources {
+		check, err := s.checkFactory.CreateResourceCheck(resource.ID(), atc.CheckCreatedByWebhook, nil)
+		if err != nil {
+			logger.Error("failed-to-create-check", err, lager.Data{"resource-name": resource.Name()})
+			return nil, err
+		}
+
+		checks = append(checks, present.Check(check))
+	}
+
+	return checks, nil
+}
+
+func respondWithWebhookChecks(logger lager.Logger, w http.ResponseWriter, checks []atc.Check) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err := json.NewEncoder(w).Encode(checks)
+	if err != nil {
+		logger.Error("failed-to-encode-checks", err)
+	}
+}
@@ -4,36 +4,31 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
-	"github.com/concourse/concourse/atc/radar"
 )
 
-//go:generate counterfeiter . ScannerFactory
-
-type ScannerFactory interface {
-	NewResourceScanner(pipeline db.Pipeline) radar.Scanner
-	NewResourceTypeScanner(dbPipeline db.Pipeline) radar.Scanner
-}
-
 type Server struct {
-	logger                lager.Logger
-	scannerFactory        ScannerFactory
-	secretManager         creds.Secrets
-	resourceFactory       db.ResourceFactory
-	resourceConfigFactory db.ResourceConfigFactory
+	logger          lager.Logger
+	checkFactory    db.CheckFactory
+	secretManager   creds.Secrets
+	varSourcePool   creds.VarSourcePool
+	resourceFactory db.ResourceFactory
+	teamFactory     db.TeamFactory
 }
 
 func NewServer(
 	logger lager.Logger,
-	scannerFactory ScannerFactory,
+	checkFactory db.CheckFactory,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 	resourceFactory db.ResourceFactory,
-	resourceConfigFactory db.ResourceConfigFactory,
+	teamFactory db.TeamFactory,
 ) *Server {
 	return &Server{
-		logger:                logger,
-		scannerFactory:        scannerFactory,
-		secretManager:         secretManager,
-		resourceFactory:       resourceFactory,
-		resourceConfigFactory: resourceConfigFactory,
+		logger:          logger,
+		checkFactory:    checkFactory,
+		secretManager:   secretManager,
+		varSourcePool:   varSourcePool,
+		resourceFactory: resourceFactory,
+		teamFactory:     teamFactory,
 	}
 }
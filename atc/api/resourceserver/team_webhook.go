@@ -0,0 +1,101 @@
+package resourceserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
+)
+
+const (
+	maxTeamWebhookPayloadSize = 5 * 1024 * 1024
+
+	// teamWebhookSecretVar is the team's credential its webhook payloads must
+	// be signed with
+	teamWebhookSecretVar = "team_webhook_secret"
+)
+
+// TeamWebHook is PipelineWebHook for every pipeline of the team, so that a
+// single webhook of a repository, e.g. one configured for a whole GitHub
+// organization, covers every resource of the team using it.
+//
+// Rather than by each resource's webhook_token, the payload must be signed
+// with the team's team_webhook_secret credential, which is verified before
+// any of the team's pipelines are looked at. Teams without one can't use it.
+func (s *Server) TeamWebHook(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("team-webhook")
+
+	teamName := r.FormValue(":team_name")
+
+	delivery, ok := readWebhook(logger, w, r, maxTeamWebhookPayloadSize)
+	if !ok {
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Debug("team-not-found", lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	secret, found, err := creds.NewVariables(s.secretManager, team.Name(), "").Get(vars.VariableDefinition{
+		Name: teamWebhookSecretVar,
+	})
+	if err != nil {
+		logger.Error("failed-to-get-team-webhook-secret", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-webhook-secret-not-found", lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if token, ok := secret.(string); !ok || token == "" || !delivery.verify(token) {
+		logger.Info("unverified-payload")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	pipelines, err := team.Pipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	checks := []atc.Check{}
+	for _, pipeline := range pipelines {
+		if pipeline.Archived() {
+			continue
+		}
+
+		resources, err := matchingWebhookResources(pipeline, delivery)
+		if err != nil {
+			logger.Error("failed-to-get-resources", err, lager.Data{"pipeline": pipeline.Name()})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		pipelineChecks, err := s.createWebhookChecks(logger, resources)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		checks = append(checks, pipelineChecks...)
+	}
+
+	respondWithWebhookChecks(logger, w, checks)
+}
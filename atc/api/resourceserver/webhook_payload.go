@@ -0,0 +1,270 @@
+package resourceserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var errUnknownWebhookSender = errors.New("unknown webhook sender")
+
+// webhookDelivery is a parsed webhook payload, along with a way of verifying
+// that it was sent by someone who knows a secret.
+type webhookDelivery struct {
+	payload atc.WebhookPayload
+	verify  func(secret string) bool
+}
+
+// parseWebhook parses a webhook payload sent by GitHub, GitLab, or Bitbucket,
+// telling which from the headers each of them set.
+func parseWebhook(r *http.Request, body []byte) (webhookDelivery, error) {
+	header := r.Header
+
+	switch {
+	case header.Get("X-GitHub-Event") != "":
+		return parseGitHubWebhook(header, body)
+	case header.Get("X-Gitlab-Event") != "":
+		return parseGitLabWebhook(header, body)
+	case header.Get("X-Event-Key") != "":
+		return parseBitbucketWebhook(header, r.URL.Query().Get("webhook_token"), body)
+	default:
+		return webhookDelivery{}, errUnknownWebhookSender
+	}
+}
+
+// webhookSignature returns whatever the sender of the request authenticates
+// its payload with, i.e. its signature or token, without reading the payload.
+// It is empty if the payload is unsigned, in which case it can't be verified
+// by any secret.
+func webhookSignature(r *http.Request) (string, error) {
+	header := r.Header
+
+	switch {
+	case header.Get("X-GitHub-Event") != "":
+		if signature := header.Get("X-Hub-Signature-256"); signature != "" {
+			return signature, nil
+		}
+
+		return header.Get("X-Hub-Signature"), nil
+	case header.Get("X-Gitlab-Event") != "":
+		return header.Get("X-Gitlab-Token"), nil
+	case header.Get("X-Event-Key") != "":
+		if signature := header.Get("X-Hub-Signature"); signature != "" || header.Get("X-Hook-UUID") == "" {
+			return signature, nil
+		}
+
+		return r.URL.Query().Get("webhook_token"), nil
+	default:
+		return "", errUnknownWebhookSender
+	}
+}
+
+func parseGitHubWebhook(header http.Header, body []byte) (webhookDelivery, error) {
+	var payload struct {
+		Ref        string `json:"ref"`
+		Repository struct {
+			CloneURL string `json:"clone_url"`
+			SSHURL   string `json:"ssh_url"`
+			HTMLURL  string `json:"html_url"`
+		} `json:"repository"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return webhookDelivery{}, err
+	}
+
+	return webhookDelivery{
+		payload: atc.WebhookPayload{
+			Event: webhookEvent(header.Get("X-GitHub-Event"), "push"),
+			Repositories: nonEmpty(
+				payload.Repository.CloneURL,
+				payload.Repository.SSHURL,
+				payload.Repository.HTMLURL,
+			),
+			Branches: branchesOf(payload.Ref),
+		},
+		verify: func(secret string) bool {
+			if signature := header.Get("X-Hub-Signature-256"); signature != "" {
+				return verifySignature(sha256.New, "sha256=", secret, body, signature)
+			}
+
+			return verifySignature(sha1.New, "sha1=", secret, body, header.Get("X-Hub-Signature"))
+		},
+	}, nil
+}
+
+func parseGitLabWebhook(header http.Header, body []byte) (webhookDelivery, error) {
+	var payload struct {
+		Ref     string `json:"ref"`
+		Project struct {
+			GitHTTPURL string `json:"git_http_url"`
+			GitSSHURL  string `json:"git_ssh_url"`
+			WebURL     string `json:"web_url"`
+		} `json:"project"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return webhookDelivery{}, err
+	}
+
+	return webhookDelivery{
+		payload: atc.WebhookPayload{
+			Event: webhookEvent(header.Get("X-Gitlab-Event"), "Push Hook", "Tag Push Hook"),
+			Repositories: nonEmpty(
+				payload.Project.GitHTTPURL,
+				payload.Project.GitSSHURL,
+				payload.Project.WebURL,
+			),
+			Branches: branchesOf(payload.Ref),
+		},
+		verify: func(secret string) bool {
+			// GitLab sends the secret itself rather than signing the payload
+			token := header.Get("X-Gitlab-Token")
+			return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+		},
+	}, nil
+}
+
+// parseBitbucketWebhook parses the payloads of both Bitbucket Cloud and
+// Bitbucket Server, which differ in where they put the repository's links and
+// the changed refs.
+//
+// Bitbucket Server signs its payloads like GitHub does. Bitbucket Cloud only
+// does so for webhooks configured with a secret, so its unsigned payloads are
+// verified by the webhook_token query parameter of the webhook's URL instead,
+// as with the per-resource webhook.
+func parseBitbucketWebhook(header http.Header, queryToken string, body []byte) (webhookDelivery, error) {
+	type link struct {
+		Href string `json:"href"`
+	}
+
+	var payload struct {
+		Repository struct {
+			Links map[string]json.RawMessage `json:"links"`
+		} `json:"repository"`
+
+		// Bitbucket Cloud
+		Push struct {
+			Changes []struct {
+				New *struct {
+					Type string `json:"type"`
+					Name string `json:"name"`
+				} `json:"new"`
+			} `json:"changes"`
+		} `json:"push"`
+
+		// Bitbucket Server
+		Changes []struct {
+			RefID string `json:"refId"`
+		} `json:"changes"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return webhookDelivery{}, err
+	}
+
+	var repositories []string
+	for _, rawLinks := range payload.Repository.Links {
+		var links []link
+		if json.Unmarshal(rawLinks, &links) != nil {
+			var single link
+			if json.Unmarshal(rawLinks, &single) != nil {
+				continue
+			}
+
+			links = []link{single}
+		}
+
+		for _, l := range links {
+			repositories = append(repositories, nonEmpty(l.Href)...)
+		}
+	}
+
+	var branches []string
+	for _, change := range payload.Push.Changes {
+		if change.New != nil && change.New.Type == "branch" {
+			branches = append(branches, change.New.Name)
+		}
+	}
+
+	for _, change := range payload.Changes {
+		branches = append(branches, branchesOf(change.RefID)...)
+	}
+
+	return webhookDelivery{
+		payload: atc.WebhookPayload{
+			Event:        webhookEvent(header.Get("X-Event-Key"), "repo:push", "repo:refs_changed"),
+			Repositories: repositories,
+			Branches:     branches,
+		},
+		verify: func(secret string) bool {
+			signature := header.Get("X-Hub-Signature")
+
+			// only Bitbucket Cloud sets X-Hook-UUID
+			if signature == "" && header.Get("X-Hook-UUID") != "" {
+				return queryToken != "" && subtle.ConstantTimeCompare([]byte(queryToken), []byte(secret)) == 1
+			}
+
+			return verifySignature(sha256.New, "sha256=", secret, body, signature)
+		},
+	}, nil
+}
+
+// webhookEvent returns atc.WebhookEventPush if the event is any of the given
+// push events, and the event itself otherwise.
+func webhookEvent(event string, pushEvents ...string) string {
+	for _, pushEvent := range pushEvents {
+		if event == pushEvent {
+			return atc.WebhookEventPush
+		}
+	}
+
+	return event
+}
+
+func branchesOf(ref string) []string {
+	if !strings.HasPrefix(ref, "refs/heads/") {
+		return nil
+	}
+
+	return []string{strings.TrimPrefix(ref, "refs/heads/")}
+}
+
+func nonEmpty(values ...string) []string {
+	var nonEmpty []string
+	for _, value := range values {
+		if value != "" {
+			nonEmpty = append(nonEmpty, value)
+		}
+	}
+
+	return nonEmpty
+}
+
+func verifySignature(newHash func() hash.Hash, prefix string, secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, prefix) {
+		return false
+	}
+
+	given, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return hmac.Equal(given, mac.Sum(nil))
+}
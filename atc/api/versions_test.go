@@ -1167,4 +1167,53 @@ var _ = Describe("Versions API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/causality", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/versions/42/causality")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the causality is found", func() {
+				BeforeEach(func() {
+					fakePipeline.CausalityReturns([]db.Cause{
+						{ResourceVersionID: 42, BuildID: 1},
+						{ResourceVersionID: 43, BuildID: 2},
+					}, nil)
+				})
+
+				It("looks up the causality of the version", func() {
+					Expect(fakePipeline.CausalityArgsForCall(0)).To(Equal(42))
+				})
+
+				It("returns 200 with the causes", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{"resource_version_id": 42, "build_id": 1},
+						{"resource_version_id": 43, "build_id": 2}
+					]`))
+				})
+			})
+
+			Context("when looking up the causality fails", func() {
+				BeforeEach(func() {
+					fakePipeline.CausalityReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
@@ -798,6 +798,11 @@ var _ = Describe("Workers API", func() {
 			fakeWorker.StateReturns(db.WorkerStateRunning)
 			fakeWorker.TeamNameReturns("some-team")
 			fakeWorker.EphemeralReturns(true)
+			fakeWorker.HermeticReturns(true)
+
+			cpu := uint64(1024)
+			memory := uint64(2048)
+			fakeWorker.CapacityReturns(atc.ContainerLimits{CPU: &cpu, Memory: &memory})
 
 			ttlStr = "30s"
 			ttl, err = time.ParseDuration(ttlStr)
@@ -845,6 +850,8 @@ var _ = Describe("Workers API", func() {
 				"resource_types": null,
 				"platform": "penguin",
 				"ephemeral": true,
+				"hermetic": true,
+				"capacity": {"cpu": 1024, "memory": 2048},
 				"tags": ["some-tag"],
 				"team": "some-team",
 				"start_time": 0,
@@ -116,6 +116,10 @@ type RunCommand struct {
 	GlobalResourceCheckTimeout   time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
+	ResourceCheckingMaxBackoff   time.Duration `long:"resource-checking-max-backoff" default:"1h" description:"Longest interval to back off to when checking a resource fails repeatedly. The interval doubles with each failed check."`
+	ResourceCheckWorkers         int           `long:"resource-check-workers" default:"16" description:"Maximum number of resource checks to run at once on this ATC."`
+
+	ResourceTypeCheckRateLimits map[string]float64 `long:"resource-type-check-rate-limit" description:"Maximum number of checks per second of resources of the given type, across all pipelines and ATCs. Can be specified multiple times." value-name:"TYPE:CHECKS_PER_SECOND"`
 
 	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" description:"Method by which a worker is selected during container placement."`
 	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
@@ -150,8 +154,12 @@ type RunCommand struct {
 	GC struct {
 		Interval time.Duration `long:"interval" default:"30s" description:"Interval on which to perform garbage collection."`
 
-		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
-		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
+		OneOffBuildGracePeriod  time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
+		MissingGracePeriod      time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
+		CheckStalePeriod        time.Duration `long:"check-stale-period" default:"2h" description:"Period after which to error resource checks which were started but never finished, e.g. as the ATC running them went away."`
+		CheckRecyclePeriod      time.Duration `long:"check-recycle-period" default:"6h" description:"Period after which to remove finished resource checks."`
+		CheckLogsToRetain       int           `long:"check-logs-to-retain" default:"10" description:"Number of checks, along with their logs, to keep for each resource and resource type."`
+		PipelineConfigsToRetain int           `long:"pipeline-configs-to-retain" default:"100" description:"Number of configs to keep for each pipeline, which can be rolled back to. 0 means all."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
@@ -424,7 +432,9 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
-	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory, secretManager)
+	varSourcePool := creds.NewVarSourcePool(cmd.CredentialManagement, secretManager, creds.ManagerFactories(), clock.NewClock())
+
+	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory, secretManager, varSourcePool)
 	if err != nil {
 		return nil, err
 	}
@@ -467,6 +477,7 @@ func (cmd *RunCommand) constructMembers(
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 ) ([]grouper.Member, error) {
 	if cmd.TelemetryOptIn {
 		url := fmt.Sprintf("http://telemetry.concourse-ci.org/?version=%s", concourse.Version)
@@ -478,12 +489,12 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager, varSourcePool)
 	if err != nil {
 		return nil, err
 	}
 
-	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, secretManager)
+	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, secretManager, varSourcePool)
 	if err != nil {
 		return nil, err
 	}
@@ -498,6 +509,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 ) ([]grouper.Member, error) {
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 	userFactory := db.NewUserFactory(dbConn)
@@ -570,19 +582,6 @@ func (cmd *RunCommand) constructAPIMembers(
 	pool := worker.NewPool(workerProvider)
 	workerClient := worker.NewClient(pool, workerProvider)
 
-	checkContainerStrategy := worker.NewRandomPlacementStrategy()
-
-	radarScannerFactory := radar.NewScannerFactory(
-		pool,
-		resourceFactory,
-		dbResourceConfigFactory,
-		cmd.ResourceTypeCheckingInterval,
-		cmd.ResourceCheckingInterval,
-		cmd.ExternalURL.String(),
-		secretManager,
-		checkContainerStrategy,
-	)
-
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
@@ -590,6 +589,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
 	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
 
 	apiHandler, err := cmd.constructAPIHandler(
@@ -604,11 +604,11 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbContainerRepository,
 		gcContainerDestroyer,
 		dbBuildFactory,
-		dbResourceConfigFactory,
+		dbCheckFactory,
 		userFactory,
 		workerClient,
-		radarScannerFactory,
 		secretManager,
+		varSourcePool,
 		credsManagers,
 		accessFactory,
 	)
@@ -695,6 +695,7 @@ func (cmd *RunCommand) constructBackendMembers(
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 ) ([]grouper.Member, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -723,6 +724,7 @@ func (cmd *RunCommand) constructBackendMembers(
 	dbWorkerBaseResourceTypeFactory := db.NewWorkerBaseResourceTypeFactory(dbConn)
 	dbTaskCacheFactory := db.NewTaskCacheFactory(dbConn)
 	dbWorkerTaskCacheFactory := db.NewWorkerTaskCacheFactory(dbConn)
+	dbTaskOutputCacheFactory := db.NewTaskOutputCacheFactory(dbConn)
 	dbVolumeRepository := db.NewVolumeRepository(dbConn)
 	dbWorkerFactory := db.NewWorkerFactory(dbConn)
 	workerVersion, err := workerVersion()
@@ -767,18 +769,28 @@ func (cmd *RunCommand) constructBackendMembers(
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
 		secretManager,
+		varSourcePool,
 		defaultLimits,
 		buildContainerStrategy,
 		resourceFactory,
+		teamFactory,
+		dbTaskOutputCacheFactory,
 		lockFactory,
 	)
 
-	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
+	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory()
+
+	radarScannerFactory := radar.NewScannerFactory(
+		logger.Session("scanner-factory"),
 		pool,
 		resourceFactory,
 		dbResourceConfigFactory,
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
+		cmd.ResourceCheckingMaxBackoff,
+		cmd.ExternalURL.String(),
+		secretManager,
+		varSourcePool,
 		checkContainerStrategy,
 	)
 
@@ -788,6 +800,9 @@ func (cmd *RunCommand) constructBackendMembers(
 	dbArtifactLifecycle := db.NewArtifactLifecycle(dbConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(dbConn)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
+	dbCheckLifecycle := db.NewCheckLifecycle(dbConn)
+	dbPipelineConfigLifecycle := db.NewPipelineConfigLifecycle(dbConn)
 	bus := dbConn.Bus()
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	members := []grouper.Member{
@@ -795,9 +810,8 @@ func (cmd *RunCommand) constructBackendMembers(
 			Syncer: cmd.constructPipelineSyncer(
 				logger.Session("pipelines"),
 				dbPipelineFactory,
+				dbCheckFactory,
 				radarSchedulerFactory,
-				secretManager,
-				bus,
 			),
 			Interval: 10 * time.Second,
 			Clock:    clock.NewClock(),
@@ -863,6 +877,58 @@ func (cmd *RunCommand) constructBackendMembers(
 			clock.NewClock(),
 			30*time.Second,
 		)},
+		{Name: "check-collector", Runner: lockrunner.NewRunner(
+			logger.Session("check-collector"),
+			gc.NewCheckCollector(
+				dbCheckLifecycle,
+				cmd.GC.CheckStalePeriod,
+				cmd.GC.CheckRecyclePeriod,
+				cmd.GC.CheckLogsToRetain,
+			),
+			"check-collector",
+			lockFactory,
+			clock.NewClock(),
+			cmd.GC.Interval,
+		)},
+		{Name: "pipeline-config-collector", Runner: lockrunner.NewRunner(
+			logger.Session("pipeline-config-collector"),
+			gc.NewPipelineConfigCollector(
+				dbPipelineConfigLifecycle,
+				cmd.GC.PipelineConfigsToRetain,
+			),
+			"pipeline-config-collector",
+			lockFactory,
+			clock.NewClock(),
+			cmd.GC.Interval,
+		)},
+	}
+
+	if !cmd.Developer.Noop {
+		members = append(members, grouper.Member{
+			Name: "checker", Runner: radar.CheckRunner{
+				Logger:         logger.Session("checker"),
+				CheckFactory:   dbCheckFactory,
+				ScannerFactory: radarScannerFactory,
+				Notifications:  bus,
+				Workers:        cmd.ResourceCheckWorkers,
+				Interval:       10 * time.Second,
+				Clock:          clock.NewClock(),
+			}},
+			grouper.Member{Name: "check-scheduler", Runner: lockrunner.NewRunner(
+				logger.Session("check-scheduler"),
+				radar.NewCheckScheduler(
+					dbCheckFactory,
+					cmd.ResourceCheckingInterval,
+					cmd.ResourceCheckingMaxBackoff,
+					cmd.ResourceTypeCheckRateLimits,
+					10*time.Second,
+				),
+				"check-scheduler",
+				lockFactory,
+				clock.NewClock(),
+				10*time.Second,
+			)},
+		)
 	}
 
 	//Syslog Drainer Configuration
@@ -1250,9 +1316,12 @@ func (cmd *RunCommand) constructEngine(
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	resourceFactory resource.ResourceFactory,
+	teamFactory db.TeamFactory,
+	taskOutputCacheFactory db.TaskOutputCacheFactory,
 	lockFactory lock.LockFactory,
 ) engine.Engine {
 
@@ -1266,6 +1335,8 @@ func (cmd *RunCommand) constructEngine(
 		defaultLimits,
 		strategy,
 		resourceFactory,
+		teamFactory,
+		taskOutputCacheFactory,
 	)
 
 	stepBuilder := builder.NewStepBuilder(
@@ -1275,7 +1346,7 @@ func (cmd *RunCommand) constructEngine(
 		lockFactory,
 	)
 
-	return engine.NewEngine(stepBuilder)
+	return engine.NewEngine(stepBuilder, varSourcePool)
 }
 
 func (cmd *RunCommand) constructHTTPHandler(
@@ -1321,11 +1392,11 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbContainerRepository db.ContainerRepository,
 	gcContainerDestroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
-	resourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
 	dbUserFactory db.UserFactory,
 	workerClient worker.Client,
-	radarScannerFactory radar.ScannerFactory,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 ) (http.Handler, error) {
@@ -1374,13 +1445,12 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbContainerRepository,
 		gcContainerDestroyer,
 		dbBuildFactory,
-		resourceConfigFactory,
+		dbCheckFactory,
 		dbUserFactory,
 
 		buildserver.NewEventHandler,
 
 		workerClient,
-		radarScannerFactory,
 
 		reconfigurableSink,
 
@@ -1390,6 +1460,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		concourse.Version,
 		concourse.WorkerVersion,
 		secretManager,
+		varSourcePool,
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 	)
@@ -1419,15 +1490,13 @@ func (h tlsRedirectHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (cmd *RunCommand) constructPipelineSyncer(
 	logger lager.Logger,
 	pipelineFactory db.PipelineFactory,
+	checkFactory db.CheckFactory,
 	radarSchedulerFactory pipelines.RadarSchedulerFactory,
-	secretManager creds.Secrets,
-	bus db.NotificationsBus,
 ) *pipelines.Syncer {
 	return pipelines.NewSyncer(
 		logger,
 		pipelineFactory,
 		func(pipeline db.Pipeline) ifrit.Runner {
-			variables := creds.NewVariables(secretManager, pipeline.TeamName(), pipeline.Name())
 			return grouper.NewParallel(os.Interrupt, grouper.Members{
 				{
 					Name: fmt.Sprintf("radar:%d", pipeline.ID()),
@@ -1437,9 +1506,10 @@ func (cmd *RunCommand) constructPipelineSyncer(
 							"pipeline": pipeline.Name(),
 						}),
 						cmd.Developer.Noop,
-						radarSchedulerFactory.BuildScanRunnerFactory(pipeline, cmd.ExternalURL.String(), variables, bus),
+						checkFactory,
 						pipeline,
-						1*time.Minute,
+						10*time.Second,
+						cmd.ResourceTypeCheckingInterval,
 					),
 				},
 				{
@@ -87,6 +87,11 @@ func (a *auditor) Audit(action string, userName string, r *http.Request) {
 var loggingLevels = map[string]string{
 	atc.SaveConfig:                    "EnableSystemAuditLog",
 	atc.GetConfig:                     "EnableSystemAuditLog",
+	atc.ListPipelineConfigVersions:    "EnableSystemAuditLog",
+	atc.GetPipelineConfigVersion:      "EnableSystemAuditLog",
+	atc.RollbackPipelineConfig:        "EnableSystemAuditLog",
+	atc.ExportPipeline:                "EnableSystemAuditLog",
+	atc.ImportPipeline:                "EnableSystemAuditLog",
 	atc.GetCC:                         "EnableSystemAuditLog",
 	atc.GetBuild:                      "EnableBuildAuditLog",
 	atc.GetBuildPlan:                  "EnableBuildAuditLog",
@@ -117,7 +122,12 @@ var loggingLevels = map[string]string{
 	atc.SetPinCommentOnResource:       "EnableResourceAuditLog",
 	atc.CheckResource:                 "EnableResourceAuditLog",
 	atc.CheckResourceWebHook:          "EnableResourceAuditLog",
+	atc.PipelineWebHook:               "EnableResourceAuditLog",
+	atc.TeamWebHook:                   "EnableResourceAuditLog",
 	atc.CheckResourceType:             "EnableResourceAuditLog",
+	atc.GetCheck:                      "EnableResourceAuditLog",
+	atc.CheckEvents:                   "EnableResourceAuditLog",
+	atc.ListResourceChecks:            "EnableResourceAuditLog",
 	atc.ListResourceVersions:          "EnableResourceAuditLog",
 	atc.GetResourceVersion:            "EnableResourceAuditLog",
 	atc.EnableResourceVersion:         "EnableResourceAuditLog",
@@ -133,6 +143,7 @@ var loggingLevels = map[string]string{
 	atc.OrderPipelines:                "EnablePipelineAuditLog",
 	atc.PausePipeline:                 "EnablePipelineAuditLog",
 	atc.UnpausePipeline:               "EnablePipelineAuditLog",
+	atc.ArchivePipeline:               "EnablePipelineAuditLog",
 	atc.ExposePipeline:                "EnablePipelineAuditLog",
 	atc.HidePipeline:                  "EnablePipelineAuditLog",
 	atc.RenamePipeline:                "EnablePipelineAuditLog",
@@ -12,16 +12,17 @@ const (
 )
 
 type Build struct {
-	ID           int    `json:"id"`
-	TeamName     string `json:"team_name"`
-	Name         string `json:"name"`
-	Status       string `json:"status"`
-	JobName      string `json:"job_name,omitempty"`
-	APIURL       string `json:"api_url"`
-	PipelineName string `json:"pipeline_name,omitempty"`
-	StartTime    int64  `json:"start_time,omitempty"`
-	EndTime      int64  `json:"end_time,omitempty"`
-	ReapTime     int64  `json:"reap_time,omitempty"`
+	ID                   int          `json:"id"`
+	TeamName             string       `json:"team_name"`
+	Name                 string       `json:"name"`
+	Status               string       `json:"status"`
+	JobName              string       `json:"job_name,omitempty"`
+	APIURL               string       `json:"api_url"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	StartTime            int64        `json:"start_time,omitempty"`
+	EndTime              int64        `json:"end_time,omitempty"`
+	ReapTime             int64        `json:"reap_time,omitempty"`
 }
 
 func (b Build) IsRunning() bool {
@@ -41,6 +42,15 @@ func (b Build) OneOff() bool {
 	return b.JobName == ""
 }
 
+// A BuildAttempt is the outcome of one attempt of a step that may be retried.
+type BuildAttempt struct {
+	Attempt   int         `json:"attempt"`
+	Status    BuildStatus `json:"status"`
+	Error     string      `json:"error,omitempty"`
+	StartTime int64       `json:"start_time"`
+	EndTime   int64       `json:"end_time"`
+}
+
 type BuildPreparationStatus string
 
 const (
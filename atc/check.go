@@ -0,0 +1,53 @@
+package atc
+
+type CheckStatus string
+
+const (
+	CheckStatusPending   CheckStatus = "pending"
+	CheckStatusStarted   CheckStatus = "started"
+	CheckStatusSucceeded CheckStatus = "succeeded"
+	CheckStatusErrored   CheckStatus = "errored"
+
+	// CheckStatusSkipped is the status of an interval check which wasn't run,
+	// as its resource was already being checked, or was checked less than the
+	// interval ago.
+	CheckStatusSkipped CheckStatus = "skipped"
+)
+
+// CheckCreator records what asked for a check to be run.
+type CheckCreator string
+
+const (
+	CheckCreatedByInterval CheckCreator = "interval"
+	CheckCreatedByWebhook  CheckCreator = "webhook"
+	CheckCreatedByManual   CheckCreator = "manual"
+	CheckCreatedByBuild    CheckCreator = "build"
+)
+
+// CheckPlan is what a check runs: a check of either a resource or a resource
+// type, optionally from a given version.
+type CheckPlan struct {
+	Resource     string  `json:"resource,omitempty"`
+	ResourceType string  `json:"resource_type,omitempty"`
+	From         Version `json:"from,omitempty"`
+}
+
+type Check struct {
+	ID         int          `json:"id"`
+	Status     CheckStatus  `json:"status"`
+	CreatedBy  CheckCreator `json:"created_by"`
+	CreateTime int64        `json:"create_time,omitempty"`
+	StartTime  int64        `json:"start_time,omitempty"`
+	EndTime    int64        `json:"end_time,omitempty"`
+	Plan       CheckPlan    `json:"plan"`
+	CheckError string       `json:"check_error,omitempty"`
+}
+
+func (c Check) IsRunning() bool {
+	switch c.Status {
+	case CheckStatusPending, CheckStatusStarted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,338 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The roots which a condition's references may start with.
+const (
+	ConditionRootBuild  = "build"
+	ConditionRootVars   = "vars"
+	ConditionRootInputs = "inputs"
+)
+
+// ConditionBuildFields are the fields of the build's metadata which a
+// condition may refer to, e.g. build.job_name.
+var ConditionBuildFields = []string{
+	"id",
+	"name",
+	"team_id",
+	"team_name",
+	"job_id",
+	"job_name",
+	"pipeline_id",
+	"pipeline_name",
+}
+
+// A Condition is a parsed `if` expression. It is made of comparisons joined
+// by && and ||, with && binding tighter:
+//
+//	build.job_name == "deploy" && inputs.repo.ref != 'abcdef' || vars.force == true
+//
+// Each side of a comparison is either a literal (a quoted string, a number
+// or version such as 1.2.3, true or false) or a reference to build metadata (build.<field>), a
+// build-local var or one of its fields (vars.<name>[.<field>...]), or a
+// field of an input's version (inputs.<name>.<field>).
+type Condition struct {
+	// a disjunction of conjunctions
+	disjuncts [][]comparison
+}
+
+type comparison struct {
+	left   conditionOperand
+	right  conditionOperand
+	negate bool
+}
+
+// a conditionOperand is either a reference (when ref is non-empty) or a
+// literal value
+type conditionOperand struct {
+	ref     []string
+	literal interface{}
+}
+
+// A ConditionResolver looks up the value of a reference within a condition,
+// e.g. []string{"build", "job_name"}.
+type ConditionResolver interface {
+	Resolve(ref []string) (interface{}, error)
+}
+
+// ParseCondition parses and validates a condition.
+func ParseCondition(expr string) (Condition, error) {
+	tokens, err := tokenizeCondition(expr)
+	if err != nil {
+		return Condition{}, err
+	}
+
+	if len(tokens) == 0 {
+		return Condition{}, errors.New("condition is empty")
+	}
+
+	var condition Condition
+	var all []comparison
+
+	for len(tokens) > 0 {
+		if len(tokens) < 3 {
+			return Condition{}, fmt.Errorf("incomplete comparison: %s", joinTokens(tokens))
+		}
+
+		left, err := parseConditionOperand(tokens[0])
+		if err != nil {
+			return Condition{}, err
+		}
+
+		var negate bool
+		switch tokens[1].text {
+		case "==":
+		case "!=":
+			negate = true
+		default:
+			return Condition{}, fmt.Errorf("expected == or != after '%s', got '%s'", tokens[0].text, tokens[1].text)
+		}
+
+		right, err := parseConditionOperand(tokens[2])
+		if err != nil {
+			return Condition{}, err
+		}
+
+		all = append(all, comparison{
+			left:   left,
+			right:  right,
+			negate: negate,
+		})
+
+		tokens = tokens[3:]
+		if len(tokens) == 0 {
+			break
+		}
+
+		switch tokens[0].text {
+		case "&&":
+		case "||":
+			condition.disjuncts = append(condition.disjuncts, all)
+			all = nil
+		default:
+			return Condition{}, fmt.Errorf("expected && or ||, got '%s'", tokens[0].text)
+		}
+
+		tokens = tokens[1:]
+		if len(tokens) == 0 {
+			return Condition{}, errors.New("condition ends with an operator")
+		}
+	}
+
+	condition.disjuncts = append(condition.disjuncts, all)
+
+	return condition, nil
+}
+
+// Evaluate determines whether the condition holds, resolving its references
+// with the given resolver. Values are compared by their string form, so
+// 42 == "42" holds.
+func (condition Condition) Evaluate(resolver ConditionResolver) (bool, error) {
+	for _, all := range condition.disjuncts {
+		holds := true
+
+		for _, comparison := range all {
+			left, err := comparison.left.value(resolver)
+			if err != nil {
+				return false, err
+			}
+
+			right, err := comparison.right.value(resolver)
+			if err != nil {
+				return false, err
+			}
+
+			equal := conditionString(left) == conditionString(right)
+			if equal == comparison.negate {
+				holds = false
+				break
+			}
+		}
+
+		if holds {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (operand conditionOperand) value(resolver ConditionResolver) (interface{}, error) {
+	if len(operand.ref) == 0 {
+		return operand.literal, nil
+	}
+
+	return resolver.Resolve(operand.ref)
+}
+
+func conditionString(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type conditionToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeCondition(expr string) ([]conditionToken, error) {
+	var tokens []conditionToken
+
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated string: %s", string(runes[i:]))
+			}
+
+			tokens = append(tokens, conditionToken{text: string(runes[i+1 : end]), quoted: true})
+			i = end + 1
+
+		case r == '=' || r == '!' || r == '&' || r == '|':
+			if i+1 == len(runes) {
+				return nil, fmt.Errorf("unknown operator: %s", string(r))
+			}
+
+			op := string(runes[i : i+2])
+			switch op {
+			case "==", "!=", "&&", "||":
+			default:
+				return nil, fmt.Errorf("unknown operator: %s", string(r))
+			}
+
+			tokens = append(tokens, conditionToken{text: op})
+			i += 2
+
+		case isConditionWordRune(r):
+			end := i
+			for end < len(runes) && isConditionWordRune(runes[end]) {
+				end++
+			}
+
+			tokens = append(tokens, conditionToken{text: string(runes[i:end])})
+			i = end
+
+		default:
+			return nil, fmt.Errorf("unexpected character: %s", string(r))
+		}
+	}
+
+	return tokens, nil
+}
+
+func isConditionWordRune(r rune) bool {
+	return r == '.' || r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func joinTokens(tokens []conditionToken) string {
+	var texts []string
+	for _, token := range tokens {
+		texts = append(texts, token.text)
+	}
+
+	return strings.Join(texts, " ")
+}
+
+func parseConditionOperand(token conditionToken) (conditionOperand, error) {
+	if token.quoted {
+		return conditionOperand{literal: token.text}, nil
+	}
+
+	switch token.text {
+	case "==", "!=", "&&", "||":
+		return conditionOperand{}, fmt.Errorf("expected a value, got '%s'", token.text)
+	case "true":
+		return conditionOperand{literal: true}, nil
+	case "false":
+		return conditionOperand{literal: false}, nil
+	}
+
+	// numbers and versions are compared as written, so that 1.0 and 1.2.3
+	// match vars set to "1.0" and "1.2.3"
+	if isConditionNumber(token.text) {
+		return conditionOperand{literal: token.text}, nil
+	}
+
+	ref := strings.Split(token.text, ".")
+	for _, segment := range ref {
+		if segment == "" {
+			return conditionOperand{}, fmt.Errorf("invalid reference: %s", token.text)
+		}
+	}
+
+	switch ref[0] {
+	case ConditionRootBuild:
+		if len(ref) != 2 || !isConditionBuildField(ref[1]) {
+			return conditionOperand{}, fmt.Errorf(
+				"invalid reference: %s (build fields are %s)",
+				token.text,
+				strings.Join(ConditionBuildFields, ", "),
+			)
+		}
+
+	case ConditionRootVars:
+		if len(ref) < 2 {
+			return conditionOperand{}, fmt.Errorf("invalid reference: %s (expected vars.<name>)", token.text)
+		}
+
+	case ConditionRootInputs:
+		if len(ref) != 3 {
+			return conditionOperand{}, fmt.Errorf("invalid reference: %s (expected inputs.<name>.<version field>)", token.text)
+		}
+
+	default:
+		return conditionOperand{}, fmt.Errorf(
+			"invalid reference: %s (must start with %s., %s. or %s.)",
+			token.text,
+			ConditionRootBuild,
+			ConditionRootVars,
+			ConditionRootInputs,
+		)
+	}
+
+	return conditionOperand{ref: ref}, nil
+}
+
+func isConditionNumber(text string) bool {
+	_, err := strconv.ParseFloat(text, 64)
+	if err == nil {
+		return true
+	}
+
+	return unicode.IsDigit([]rune(text)[0])
+}
+
+func isConditionBuildField(field string) bool {
+	for _, f := range ConditionBuildFields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
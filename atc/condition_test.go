@@ -0,0 +1,122 @@
+package atc_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type mapResolver map[string]interface{}
+
+func (resolver mapResolver) Resolve(ref []string) (interface{}, error) {
+	val, found := resolver[strings.Join(ref, ".")]
+	if !found {
+		return nil, errors.New("not found: " + strings.Join(ref, "."))
+	}
+
+	return val, nil
+}
+
+var _ = Describe("Condition", func() {
+	var resolver mapResolver
+
+	BeforeEach(func() {
+		resolver = mapResolver{
+			"build.job_name":   "some-job",
+			"build.id":         42,
+			"vars.flag":        true,
+			"vars.image.tag":   "some-tag",
+			"inputs.repo.ref":  "abcdef",
+			"vars.nothing-set": nil,
+			"vars.go":          "1.0",
+			"vars.version":     "1.2.3",
+		}
+	})
+
+	evaluate := func(expr string) (bool, error) {
+		condition, err := atc.ParseCondition(expr)
+		Expect(err).ToNot(HaveOccurred())
+
+		return condition.Evaluate(resolver)
+	}
+
+	holds := func(expr string) bool {
+		result, err := evaluate(expr)
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	Describe("Evaluate", func() {
+		It("compares references with string literals", func() {
+			Expect(holds(`build.job_name == "some-job"`)).To(BeTrue())
+			Expect(holds(`build.job_name == 'other-job'`)).To(BeFalse())
+			Expect(holds(`build.job_name != "other-job"`)).To(BeTrue())
+		})
+
+		It("compares numbers and bools by their string form", func() {
+			Expect(holds(`build.id == 42`)).To(BeTrue())
+			Expect(holds(`build.id == "42"`)).To(BeTrue())
+			Expect(holds(`vars.flag == true`)).To(BeTrue())
+			Expect(holds(`vars.flag != false`)).To(BeTrue())
+		})
+
+		It("compares numbers as they are written", func() {
+			Expect(holds(`vars.go == 1.0`)).To(BeTrue())
+			Expect(holds(`vars.go == 1`)).To(BeFalse())
+			Expect(holds(`vars.go != 1.00`)).To(BeTrue())
+		})
+
+		It("compares versions as they are written", func() {
+			Expect(holds(`vars.version == 1.2.3`)).To(BeTrue())
+			Expect(holds(`vars.version != 1.2.4`)).To(BeTrue())
+			Expect(holds(`1.2.3 == vars.version`)).To(BeTrue())
+		})
+
+		It("compares references with each other", func() {
+			Expect(holds(`vars.image.tag == vars.image.tag`)).To(BeTrue())
+			Expect(holds(`inputs.repo.ref == vars.image.tag`)).To(BeFalse())
+		})
+
+		It("treats null as an empty string", func() {
+			Expect(holds(`vars.nothing-set == ""`)).To(BeTrue())
+		})
+
+		It("binds && tighter than ||", func() {
+			Expect(holds(`build.id == 1 && vars.flag == true || inputs.repo.ref == "abcdef"`)).To(BeTrue())
+			Expect(holds(`build.id == 1 || vars.flag == true && inputs.repo.ref == "nope"`)).To(BeFalse())
+			Expect(holds(`build.id == 42 && vars.flag == true && inputs.repo.ref == "abcdef"`)).To(BeTrue())
+		})
+
+		It("returns errors from resolving references", func() {
+			_, err := evaluate(`vars.bogus == "some-value"`)
+			Expect(err).To(MatchError("not found: vars.bogus"))
+		})
+	})
+
+	Describe("ParseCondition", func() {
+		It("rejects invalid conditions", func() {
+			for expr, message := range map[string]string{
+				``:                         "condition is empty",
+				`build.job_name`:           "incomplete comparison: build.job_name",
+				`build.job_name = "a"`:     "unknown operator: =",
+				`build.job_name == "a`:     `unterminated string: "a`,
+				`build.job_name == "a" &&`: "condition ends with an operator",
+				`build.job_name "a" "b"`:   "expected == or != after 'build.job_name', got 'a'",
+				`build.id == 1 build.id`:   "expected && or ||, got 'build.id'",
+				`build.bogus == "a"`:       "invalid reference: build.bogus (build fields are id, name, team_id, team_name, job_id, job_name, pipeline_id, pipeline_name)",
+				`vars == "a"`:              "invalid reference: vars (expected vars.<name>)",
+				`inputs.repo == "a"`:       "invalid reference: inputs.repo (expected inputs.<name>.<version field>)",
+				`bogus.field == "a"`:       "invalid reference: bogus.field (must start with build., vars. or inputs.)",
+				`build.id == (1)`:          "unexpected character: (",
+				`build..id == 1`:           "invalid reference: build..id",
+				`build.id == == `:          "expected a value, got '=='",
+			} {
+				_, err := atc.ParseCondition(expr)
+				Expect(err).To(MatchError(message), "parsing "+expr)
+			}
+		})
+	})
+})
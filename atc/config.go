@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/concourse/concourse/vars"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -17,10 +18,150 @@ const DefaultTeamName = "main"
 type Tags []string
 
 type Config struct {
-	Groups        GroupConfigs    `json:"groups,omitempty"`
-	Resources     ResourceConfigs `json:"resources,omitempty"`
-	ResourceTypes ResourceTypes   `json:"resource_types,omitempty"`
-	Jobs          JobConfigs      `json:"jobs,omitempty"`
+	Groups        GroupConfigs     `json:"groups,omitempty"`
+	Resources     ResourceConfigs  `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes    `json:"resource_types,omitempty"`
+	Jobs          JobConfigs       `json:"jobs,omitempty"`
+	VarSources    VarSourceConfigs `json:"var_sources,omitempty"`
+
+	// Vars are interpolated into the pipeline's ((var)) references at runtime,
+	// for any var not found by a credential manager.
+	Vars    map[string]interface{} `json:"vars,omitempty"`
+	Display *DisplayConfig         `json:"display,omitempty"`
+
+	FreezeWindows FreezeWindowConfigs `json:"freeze_windows,omitempty"`
+}
+
+type DisplayConfig struct {
+	BackgroundImage string `json:"background_image,omitempty"`
+	DefaultGroup    string `json:"default_group,omitempty"`
+}
+
+// VarSourceTypes are the credential manager types which may be configured as
+// a pipeline's var sources.
+var VarSourceTypes = []string{
+	"vault",
+	"credhub",
+	"ssm",
+	"secretsmanager",
+}
+
+type VarSourceConfig struct {
+	Name   string      `json:"name"`
+	Type   string      `json:"type"`
+	Config interface{} `json:"config"`
+}
+
+type VarSourceConfigs []VarSourceConfig
+
+func (sources VarSourceConfigs) Lookup(name string) (VarSourceConfig, bool) {
+	for _, source := range sources {
+		if source.Name == name {
+			return source, true
+		}
+	}
+
+	return VarSourceConfig{}, false
+}
+
+// varSourcePublicOptions are the var source options which are shown as-is
+// when a pipeline's config is read back; everything else may be a credential.
+var varSourcePublicOptions = map[string]bool{
+	"url":                  true,
+	"region":               true,
+	"server_name":          true,
+	"auth_backend":         true,
+	"auth_backend_max_ttl": true,
+	"retry_max":            true,
+	"retry_initial":        true,
+	"insecure_skip_verify": true,
+}
+
+// Redacted returns the var sources with the credentials in their configs
+// replaced by ((redacted)), so that they are never returned to anyone who can
+// view the pipeline. ((var)) references are kept as they reveal nothing.
+func (sources VarSourceConfigs) Redacted() VarSourceConfigs {
+	if sources == nil {
+		return nil
+	}
+
+	redacted := make(VarSourceConfigs, len(sources))
+	for i, source := range sources {
+		redacted[i] = source
+
+		options, ok := source.Config.(map[string]interface{})
+		if !ok {
+			redacted[i].Config = redactValue(source.Config)
+			continue
+		}
+
+		config := map[string]interface{}{}
+		for key, value := range options {
+			if varSourcePublicOptions[key] {
+				config[key] = value
+			} else {
+				config[key] = redactValue(value)
+			}
+		}
+
+		redacted[i].Config = config
+	}
+
+	return redacted
+}
+
+// HasRedactedValues returns true if the var source's config still contains
+// a ((redacted)) value, e.g. because it was read back from another pipeline.
+func (source VarSourceConfig) HasRedactedValues() bool {
+	return hasRedactedValue(source.Config)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		if vars.IsReference(v) {
+			return v
+		}
+
+		return vars.RedactedValue
+	case map[string]interface{}:
+		redacted := map[string]interface{}{}
+		for key, val := range v {
+			redacted[key] = redactValue(val)
+		}
+
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, val := range v {
+			redacted[i] = redactValue(val)
+		}
+
+		return redacted
+	default:
+		return v
+	}
+}
+
+func hasRedactedValue(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == vars.RedactedValue
+	case map[string]interface{}:
+		for _, val := range v {
+			if hasRedactedValue(val) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			if hasRedactedValue(val) {
+				return true
+			}
+		}
+	}
+
+	return false
 }
 
 type GroupConfig struct {
@@ -42,16 +183,17 @@ func (groups GroupConfigs) Lookup(name string) (GroupConfig, int, bool) {
 }
 
 type ResourceConfig struct {
-	Name         string  `json:"name"`
-	Public       bool    `json:"public,omitempty"`
-	WebhookToken string  `json:"webhook_token,omitempty"`
-	Type         string  `json:"type"`
-	Source       Source  `json:"source"`
-	CheckEvery   string  `json:"check_every,omitempty"`
-	CheckTimeout string  `json:"check_timeout,omitempty"`
-	Tags         Tags    `json:"tags,omitempty"`
-	Version      Version `json:"version,omitempty"`
-	Icon         string  `json:"icon,omitempty"`
+	Name          string         `json:"name"`
+	Public        bool           `json:"public,omitempty"`
+	WebhookToken  string         `json:"webhook_token,omitempty"`
+	WebhookFilter *WebhookFilter `json:"webhook_filter,omitempty"`
+	Type          string         `json:"type"`
+	Source        Source         `json:"source"`
+	CheckEvery    string         `json:"check_every,omitempty"`
+	CheckTimeout  string         `json:"check_timeout,omitempty"`
+	Tags          Tags           `json:"tags,omitempty"`
+	Version       Version        `json:"version,omitempty"`
+	Icon          string         `json:"icon,omitempty"`
 }
 
 type ResourceType struct {
@@ -245,6 +387,21 @@ func (c *InParallelConfig) UnmarshalJSON(payload []byte) error {
 	return nil
 }
 
+// An AcrossVarConfig is a var which an `across` step is run over, along with
+// the values it should take on.
+type AcrossVarConfig struct {
+	Var         string        `json:"var"`
+	Values      []interface{} `json:"values,omitempty"`
+	MaxInFlight int           `json:"max_in_flight,omitempty"`
+}
+
+// The outcomes of an attempt which `retry_on` may restrict retrying to. By
+// default both are retried.
+const (
+	RetryOnError   = "error"
+	RetryOnFailure = "failure"
+)
+
 // A PlanConfig is a flattened set of configuration corresponding to
 // a particular Plan, where Source and Version are populated lazily.
 type PlanConfig struct {
@@ -286,12 +443,30 @@ type PlanConfig struct {
 	Task string `json:"task,omitempty"`
 	// run task privileged
 	Privileged bool `json:"privileged,omitempty"`
+	// run task without external network access
+	Hermetic bool `json:"hermetic,omitempty"`
 	// task config path, e.g. foo/build.yml
 	TaskConfigPath string `json:"file,omitempty"`
 	// task variables, if task is specified as external file via TaskConfigPath
 	TaskVars Params `json:"vars,omitempty"`
 	// inlined task config
 	TaskConfig *TaskConfig `json:"config,omitempty"`
+	// skip running the task if a previous run with the same config, image and
+	// input versions succeeded, reusing its outputs instead
+	SkipIfUnchanged bool `json:"skip_if_unchanged,omitempty"`
+
+	// name of the pipeline to configure, using the config at TaskConfigPath
+	// and TaskVars as its vars
+	SetPipeline string `json:"set_pipeline,omitempty"`
+	// files containing vars for the pipeline, e.g. foo/vars.yml
+	VarFiles []string `json:"var_files,omitempty"`
+
+	// name of the build-local var to load the file at TaskConfigPath into
+	LoadVar string `json:"load_var,omitempty"`
+	// how to parse the loaded file: json, yaml, trim or raw
+	Format string `json:"format,omitempty"`
+	// whether the loaded value may be shown in the build's output
+	Reveal bool `json:"reveal,omitempty"`
 
 	// used by Get and Put for specifying params to the resource
 	// used by Task for passing params to external task config
@@ -307,6 +482,9 @@ type PlanConfig struct {
 	// used by Put to specify params for the subsequent Get
 	GetParams Params `json:"get_params,omitempty"`
 
+	// used by Get and Put to limit the resources of the step's container
+	Limits *ContainerLimits `json:"container_limits,omitempty"`
+
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `json:"tags,omitempty"`
 
@@ -337,6 +515,25 @@ type PlanConfig struct {
 	// repeat the step up to N times, until it works
 	Attempts int `json:"attempts,omitempty"`
 
+	// used by `attempts` to wait between attempts
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// used by `attempts` to only retry errors, or only retry failures
+	RetryOn string `json:"retry_on,omitempty"`
+
+	// used by `attempts` to run something after an attempt that is about to be
+	// retried
+	OnRetry *PlanConfig `json:"on_retry,omitempty"`
+
+	// run the step once for every combination of the given vars' values
+	Across []AcrossVarConfig `json:"across,omitempty"`
+
+	// used by `across` to stop running combinations once one of them fails
+	FailFast bool `json:"fail_fast,omitempty"`
+
+	// used on any step to only run it when the given condition holds
+	If string `json:"if,omitempty"`
+
 	Version *VersionConfig `json:"version,omitempty"`
 }
 
@@ -357,6 +554,14 @@ func (config PlanConfig) Name() string {
 		return config.Task
 	}
 
+	if config.SetPipeline != "" {
+		return config.SetPipeline
+	}
+
+	if config.LoadVar != "" {
+		return config.LoadVar
+	}
+
 	return ""
 }
 
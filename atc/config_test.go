@@ -46,4 +46,44 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("VarSourceConfigs", func() {
+		Describe("Redacted", func() {
+			It("redacts credentials but keeps public options and var references", func() {
+				sources := VarSourceConfigs{
+					{
+						Name: "some-source",
+						Type: "vault",
+						Config: map[string]interface{}{
+							"url":                  "https://vault.example.com",
+							"insecure_skip_verify": true,
+							"client_token":         "some-token",
+							"auth_param": map[string]interface{}{
+								"role_id":   "((role-id))",
+								"secret_id": "some-secret-id",
+							},
+						},
+					},
+				}
+
+				Expect(sources.Redacted()).To(Equal(VarSourceConfigs{
+					{
+						Name: "some-source",
+						Type: "vault",
+						Config: map[string]interface{}{
+							"url":                  "https://vault.example.com",
+							"insecure_skip_verify": true,
+							"client_token":         "((redacted))",
+							"auth_param": map[string]interface{}{
+								"role_id":   "((role-id))",
+								"secret_id": "((redacted))",
+							},
+						},
+					},
+				}))
+
+				Expect(sources[0].Config.(map[string]interface{})["client_token"]).To(Equal("some-token"))
+			})
+		})
+	})
 })
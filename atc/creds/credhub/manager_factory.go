@@ -1,12 +1,24 @@
 package credhub
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
 
 type credhubManagerFactory struct{}
 
+// varSourceOptions are the options a pipeline's credhub var source may set.
+// The CA and client certs are files on the ATC's host, and the path prefix
+// would let the pipeline look up secrets outside of its team.
+var varSourceOptions = []string{
+	"url",
+	"client_id",
+	"client_secret",
+	"insecure_skip_verify",
+}
+
 func init() {
 	creds.Register("credhub", NewCredHubManagerFactory())
 }
@@ -27,3 +39,18 @@ func (factory *credhubManagerFactory) AddConfig(group *flags.Group) creds.Manage
 
 	return manager
 }
+
+func (factory *credhubManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &CredHubManager{}
+
+	err := creds.ParseVarSourceConfig(manager, config, varSourceOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.UAA.ClientId == "" || manager.UAA.ClientSecret == "" {
+		return nil, errors.New("must configure client_id and client_secret")
+	}
+
+	return manager, nil
+}
@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -27,3 +29,10 @@ func (factory *kubernetesManagerFactory) AddConfig(group *flags.Group) creds.Man
 
 	return manager
 }
+
+// NewInstance always fails: the kubernetes manager only authenticates as the
+// ATC, through its in-cluster service account or a kubeconfig on its host,
+// so it cannot back a pipeline's var source.
+func (factory *kubernetesManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	return nil, errors.New("kubernetes cannot be used as a var source")
+}
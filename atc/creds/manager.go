@@ -1,10 +1,14 @@
 package creds
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/jessevdk/go-flags"
 )
 
+//go:generate counterfeiter . Manager
+
 type Manager interface {
 	IsConfigured() bool
 	Validate() error
@@ -14,8 +18,14 @@ type Manager interface {
 	NewSecretsFactory(lager.Logger) (SecretsFactory, error)
 }
 
+//go:generate counterfeiter . ManagerFactory
+
 type ManagerFactory interface {
 	AddConfig(*flags.Group) Manager
+
+	// NewInstance returns a manager configured by a pipeline's var source
+	// config rather than by flags.
+	NewInstance(interface{}) (Manager, error)
 }
 
 type Managers map[string]Manager
@@ -23,6 +33,8 @@ type Managers map[string]Manager
 type CredentialManagementConfig struct {
 	RetryConfig SecretRetryConfig
 	CacheConfig SecretCacheConfig
+
+	VarSourceTTL time.Duration `long:"var-source-ttl" default:"10m" description:"How long the credential manager of a pipeline's var source is kept after it was last used."`
 }
 
 type HealthResponse struct {
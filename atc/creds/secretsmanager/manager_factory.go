@@ -1,12 +1,24 @@
 package secretsmanager
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
 
 type managerFactory struct{}
 
+// varSourceOptions are the options a pipeline's secretsmanager var source may set. The
+// secret templates would let the pipeline look up secrets outside of its
+// team, and credentials must be given so that the ATC's own are never used.
+var varSourceOptions = []string{
+	"access_key",
+	"secret_key",
+	"session_token",
+	"region",
+}
+
 func init() {
 	creds.Register("secretsmanager", NewManagerFactory())
 }
@@ -27,3 +39,18 @@ func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
 	subGroup.Namespace = "aws-secretsmanager"
 	return manager
 }
+
+func (factory *managerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &Manager{}
+
+	err := creds.ParseVarSourceConfig(manager, config, varSourceOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.AwsAccessKeyID == "" || manager.AwsSecretAccessKey == "" {
+		return nil, errors.New("must configure access_key and secret_key")
+	}
+
+	return manager, nil
+}
@@ -1,12 +1,24 @@
 package ssm
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
 
 type ssmManagerFactory struct{}
 
+// varSourceOptions are the options a pipeline's ssm var source may set. The
+// secret templates would let the pipeline look up secrets outside of its
+// team, and credentials must be given so that the ATC's own are never used.
+var varSourceOptions = []string{
+	"access_key",
+	"secret_key",
+	"session_token",
+	"region",
+}
+
 func init() {
 	creds.Register("ssm", NewSsmManagerFactory())
 }
@@ -25,3 +37,18 @@ func (factory *ssmManagerFactory) AddConfig(group *flags.Group) creds.Manager {
 	subGroup.Namespace = "aws-ssm"
 	return manager
 }
+
+func (factory *ssmManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &SsmManager{}
+
+	err := creds.ParseVarSourceConfig(manager, config, varSourceOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.AwsAccessKeyID == "" || manager.AwsSecretAccessKey == "" {
+		return nil, errors.New("must configure access_key and secret_key")
+	}
+
+	return manager, nil
+}
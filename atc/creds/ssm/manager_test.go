@@ -109,4 +109,54 @@ var _ = Describe("SsmManager", func() {
 			Expect(manager.Validate()).ToNot(BeNil())
 		})
 	})
+
+	Describe("NewInstance()", func() {
+		var (
+			config interface{}
+			err    error
+		)
+
+		JustBeforeEach(func() {
+			_, err = ssm.NewSsmManagerFactory().NewInstance(config)
+		})
+
+		Context("with explicit credentials", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"region":     "some-region",
+					"access_key": "some-access-key",
+					"secret_key": "some-secret-key",
+				}
+			})
+
+			It("succeeds", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("without credentials", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{"region": "some-region"}
+			})
+
+			It("does not fall back to the ATC's credentials", func() {
+				Expect(err).To(MatchError("must configure access_key and secret_key"))
+			})
+		})
+
+		Context("with a secret template", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"region":               "some-region",
+					"access_key":           "some-access-key",
+					"secret_key":           "some-secret-key",
+					"team_secret_template": "/concourse/other-team/{{.Secret}}",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("option 'team_secret_template' cannot be set by a var source"))
+			})
+		})
+	})
 })
@@ -0,0 +1,298 @@
+package creds
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// ParseVarSourceConfig applies the flag defaults of the given manager and
+// then sets the options from a var source's config. Options are keyed by
+// their flag name with dashes replaced by underscores, e.g. client_token.
+//
+// Var sources are configured by pipeline authors, so only the allowed options
+// may be set. Each manager leaves out the options which read files from the
+// ATC's host or which change the paths that scope lookups to the team.
+func ParseVarSourceConfig(manager Manager, config interface{}, allowed ...string) error {
+	var options map[string]interface{}
+	if config != nil {
+		var ok bool
+		options, ok = config.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("config must be a map, got %T", config)
+		}
+	}
+
+	isAllowed := map[string]bool{}
+	for _, option := range allowed {
+		isAllowed[option] = true
+	}
+
+	keys := []string{}
+	for key := range options {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	args := []string{}
+	for _, key := range keys {
+		if !isAllowed[key] {
+			return fmt.Errorf("option '%s' cannot be set by a var source", key)
+		}
+
+		flag := "--" + strings.Replace(key, "_", "-", -1)
+
+		switch value := options[key].(type) {
+		case nil:
+		case bool:
+			if value {
+				args = append(args, flag)
+			}
+		case []interface{}:
+			for _, v := range value {
+				args = append(args, fmt.Sprintf("%s=%v", flag, v))
+			}
+		case map[string]interface{}:
+			for k, v := range value {
+				args = append(args, fmt.Sprintf("%s=%s:%v", flag, k, v))
+			}
+		default:
+			args = append(args, fmt.Sprintf("%s=%v", flag, value))
+		}
+	}
+
+	parser := flags.NewParser(manager, flags.None)
+
+	rest, err := parser.ParseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	if len(rest) > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(rest, " "))
+	}
+
+	return nil
+}
+
+//go:generate counterfeiter . VarSourcePool
+
+// VarSourcePool hands out the secrets of var sources, sharing one instance of
+// a credential manager between every var source with the same type and
+// config.
+//
+// A var source's config may reference ((vars)) from the team's own
+// credential manager, so that its credentials need not be written into the
+// pipeline's config.
+type VarSourcePool interface {
+	FindOrCreate(logger lager.Logger, teamName string, pipelineName string, varSource atc.VarSourceConfig) (Secrets, error)
+	Size() int
+}
+
+type varSourcePool struct {
+	credConfig  CredentialManagementConfig
+	teamSecrets Secrets
+	factories   map[string]ManagerFactory
+	clock       clock.Clock
+
+	entries     map[string]*varSourceEntry
+	secretsLock sync.Mutex
+}
+
+type varSourceEntry struct {
+	manager  Manager
+	secrets  Secrets
+	lastUsed time.Time
+}
+
+// closer is implemented by managers which must release resources, e.g. a
+// login loop, once their var source is evicted from the pool.
+type closer interface {
+	Close()
+}
+
+func NewVarSourcePool(credConfig CredentialManagementConfig, teamSecrets Secrets, factories map[string]ManagerFactory, clock clock.Clock) VarSourcePool {
+	return &varSourcePool{
+		credConfig:  credConfig,
+		teamSecrets: teamSecrets,
+		factories:   factories,
+		clock:       clock,
+		entries:     map[string]*varSourceEntry{},
+	}
+}
+
+func (pool *varSourcePool) Size() int {
+	pool.secretsLock.Lock()
+	defer pool.secretsLock.Unlock()
+
+	return len(pool.entries)
+}
+
+func (pool *varSourcePool) FindOrCreate(logger lager.Logger, teamName string, pipelineName string, varSource atc.VarSourceConfig) (Secrets, error) {
+	var config interface{}
+	err := evaluate(NewVariables(pool.teamSecrets, teamName, pipelineName), varSource.Config, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := json.Marshal(map[string]interface{}{
+		"type":   varSource.Type,
+		"config": config,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if secrets, found := pool.find(string(key)); found {
+		return secrets, nil
+	}
+
+	// the manager is built without holding the lock, as initializing it may
+	// reach out to the credential manager's server
+	entry, err := pool.create(logger, varSource, config)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.secretsLock.Lock()
+	defer pool.secretsLock.Unlock()
+
+	if existing, found := pool.entries[string(key)]; found {
+		closeManager(entry.manager)
+		existing.lastUsed = pool.clock.Now()
+		return existing.secrets, nil
+	}
+
+	entry.lastUsed = pool.clock.Now()
+	pool.entries[string(key)] = entry
+
+	return entry.secrets, nil
+}
+
+// find returns the secrets of a pooled var source, evicting every var source
+// which has not been used within the TTL.
+func (pool *varSourcePool) find(key string) (Secrets, bool) {
+	pool.secretsLock.Lock()
+	defer pool.secretsLock.Unlock()
+
+	now := pool.clock.Now()
+
+	for k, entry := range pool.entries {
+		if now.Sub(entry.lastUsed) > pool.credConfig.VarSourceTTL {
+			closeManager(entry.manager)
+			delete(pool.entries, k)
+		}
+	}
+
+	entry, found := pool.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	entry.lastUsed = now
+
+	return entry.secrets, true
+}
+
+func (pool *varSourcePool) create(logger lager.Logger, varSource atc.VarSourceConfig, config interface{}) (*varSourceEntry, error) {
+	factory, found := pool.factories[varSource.Type]
+	if !found {
+		return nil, fmt.Errorf("unknown credential manager type: %s", varSource.Type)
+	}
+
+	manager, err := factory.NewInstance(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s config: %s", varSource.Type, err)
+	}
+
+	credsLogger := logger.Session("var-source", lager.Data{
+		"name": varSource.Name,
+		"type": varSource.Type,
+	})
+
+	err = manager.Init(credsLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("credential manager '%s' misconfigured: %s", varSource.Type, err)
+	}
+
+	secretsFactory, err := manager.NewSecretsFactory(credsLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := secretsFactory.NewSecrets()
+	secrets = NewRetryableSecrets(secrets, pool.credConfig.RetryConfig)
+	if pool.credConfig.CacheConfig.Enabled {
+		secrets = NewCachedSecrets(secrets, pool.credConfig.CacheConfig)
+	}
+
+	return &varSourceEntry{
+		manager: manager,
+		secrets: secrets,
+	}, nil
+}
+
+func closeManager(manager Manager) {
+	if c, ok := manager.(closer); ok {
+		c.Close()
+	}
+}
+
+type varSourceVariables struct {
+	logger       lager.Logger
+	pool         VarSourcePool
+	teamName     string
+	pipelineName string
+	varSources   atc.VarSourceConfigs
+}
+
+// NewVarSourceVariables returns the vars of a pipeline's var sources, which
+// are referenced as ((source:path.field)).
+func NewVarSourceVariables(logger lager.Logger, pool VarSourcePool, teamName string, pipelineName string, varSources atc.VarSourceConfigs) vars.Variables {
+	return varSourceVariables{
+		logger:       logger,
+		pool:         pool,
+		teamName:     teamName,
+		pipelineName: pipelineName,
+		varSources:   varSources,
+	}
+}
+
+func (v varSourceVariables) Get(varDef vars.VariableDefinition) (interface{}, bool, error) {
+	if varDef.Source == "" || varDef.Source == vars.LocalVarSource {
+		return nil, false, nil
+	}
+
+	varSource, found := v.varSources.Lookup(varDef.Source)
+	if !found {
+		return nil, false, nil
+	}
+
+	secrets, err := v.pool.FindOrCreate(v.logger, v.teamName, v.pipelineName, varSource)
+	if err != nil {
+		return nil, false, fmt.Errorf("var source '%s': %s", varSource.Name, err)
+	}
+
+	varDef.Source = ""
+
+	return NewVariables(secrets, v.teamName, v.pipelineName).Get(varDef)
+}
+
+func (v varSourceVariables) List() ([]vars.VariableDefinition, error) {
+	return nil, nil
+}
@@ -0,0 +1,249 @@
+package creds_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VarSourcePool", func() {
+	var (
+		logger         *lagertest.TestLogger
+		fakeFactory    *credsfakes.FakeManagerFactory
+		fakeManager    *credsfakes.FakeManager
+		fakeSecrets    *credsfakes.FakeSecrets
+		teamSecrets    *credsfakes.FakeSecrets
+		fakeClock      *fakeclock.FakeClock
+		pool           creds.VarSourcePool
+		varSource      atc.VarSourceConfig
+		otherVarSource atc.VarSourceConfig
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeSecrets = new(credsfakes.FakeSecrets)
+		fakeSecretsFactory := new(credsfakes.FakeSecretsFactory)
+		fakeSecretsFactory.NewSecretsReturns(fakeSecrets)
+
+		fakeManager = new(credsfakes.FakeManager)
+		fakeManager.NewSecretsFactoryReturns(fakeSecretsFactory, nil)
+
+		fakeFactory = new(credsfakes.FakeManagerFactory)
+		fakeFactory.NewInstanceReturns(fakeManager, nil)
+
+		teamSecrets = new(credsfakes.FakeSecrets)
+		teamSecrets.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+			if path == "/concourse/some-team/vault-token" {
+				return "some-token", nil, true, nil
+			}
+
+			return nil, nil, false, nil
+		}
+		teamSecrets.NewSecretLookupPathsStub = func(teamName string, pipelineName string) []creds.SecretLookupPath {
+			return []creds.SecretLookupPath{
+				creds.NewSecretLookupWithPrefix("/concourse/" + teamName + "/"),
+			}
+		}
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		pool = creds.NewVarSourcePool(creds.CredentialManagementConfig{VarSourceTTL: time.Minute}, teamSecrets, map[string]creds.ManagerFactory{
+			"some-type": fakeFactory,
+		}, fakeClock)
+
+		varSource = atc.VarSourceConfig{
+			Name:   "some-source",
+			Type:   "some-type",
+			Config: map[string]interface{}{"url": "https://some-url"},
+		}
+
+		otherVarSource = atc.VarSourceConfig{
+			Name:   "other-source",
+			Type:   "some-type",
+			Config: map[string]interface{}{"url": "https://other-url"},
+		}
+	})
+
+	It("creates a manager from the var source's config", func() {
+		_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeFactory.NewInstanceCallCount()).To(Equal(1))
+		Expect(fakeFactory.NewInstanceArgsForCall(0)).To(Equal(varSource.Config))
+		Expect(fakeManager.InitCallCount()).To(Equal(1))
+		Expect(fakeManager.ValidateCallCount()).To(Equal(1))
+	})
+
+	It("shares a manager between var sources with the same config", func() {
+		_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+		Expect(err).ToNot(HaveOccurred())
+
+		varSource.Name = "renamed-source"
+		_, err = pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeFactory.NewInstanceCallCount()).To(Equal(1))
+		Expect(pool.Size()).To(Equal(1))
+	})
+
+	Context("when a var source has not been used within the TTL", func() {
+		var manager *closingManager
+
+		BeforeEach(func() {
+			manager = &closingManager{FakeManager: fakeManager}
+			fakeFactory.NewInstanceReturns(manager, nil)
+		})
+
+		It("evicts and closes its manager", func() {
+			_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClock.Increment(2 * time.Minute)
+
+			_, err = pool.FindOrCreate(logger, "some-team", "some-pipeline", otherVarSource)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(manager.closed).To(Equal(1))
+			Expect(pool.Size()).To(Equal(1))
+		})
+
+		It("keeps var sources which are still used", func() {
+			_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClock.Increment(30 * time.Second)
+
+			_, err = pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClock.Increment(45 * time.Second)
+
+			_, err = pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(manager.closed).To(Equal(0))
+			Expect(fakeFactory.NewInstanceCallCount()).To(Equal(1))
+		})
+	})
+
+	It("creates a manager per distinct config", func() {
+		_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = pool.FindOrCreate(logger, "some-team", "some-pipeline", otherVarSource)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeFactory.NewInstanceCallCount()).To(Equal(2))
+		Expect(pool.Size()).To(Equal(2))
+	})
+
+	Context("when the config references vars", func() {
+		BeforeEach(func() {
+			varSource.Config = map[string]interface{}{
+				"url":          "https://some-url",
+				"client_token": "((vault-token))",
+			}
+		})
+
+		It("interpolates them from the team's credential manager", func() {
+			_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeFactory.NewInstanceArgsForCall(0)).To(Equal(map[string]interface{}{
+				"url":          "https://some-url",
+				"client_token": "some-token",
+			}))
+		})
+
+		Context("when a var is not found", func() {
+			BeforeEach(func() {
+				varSource.Config = map[string]interface{}{"client_token": "((bogus))"}
+			})
+
+			It("returns an error", func() {
+				_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+				Expect(err).To(HaveOccurred())
+				Expect(fakeFactory.NewInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the type is unknown", func() {
+		BeforeEach(func() {
+			varSource.Type = "bogus"
+		})
+
+		It("returns an error", func() {
+			_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+			Expect(err).To(MatchError("unknown credential manager type: bogus"))
+		})
+	})
+
+	Context("when the manager is misconfigured", func() {
+		BeforeEach(func() {
+			fakeManager.ValidateReturns(errors.New("nope"))
+		})
+
+		It("returns an error and does not keep the manager", func() {
+			_, err := pool.FindOrCreate(logger, "some-team", "some-pipeline", varSource)
+			Expect(err).To(MatchError("credential manager 'some-type' misconfigured: nope"))
+			Expect(pool.Size()).To(Equal(0))
+		})
+	})
+
+	Describe("NewVarSourceVariables", func() {
+		var variables vars.Variables
+
+		BeforeEach(func() {
+			fakeSecrets.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+				if path == "some-secret" {
+					return "some-value", nil, true, nil
+				}
+
+				return nil, nil, false, nil
+			}
+
+			variables = creds.NewVarSourceVariables(logger, pool, "some-team", "some-pipeline", atc.VarSourceConfigs{varSource})
+		})
+
+		It("looks up vars from the named var source", func() {
+			value, found, err := variables.Get(vars.VariableDefinition{Source: "some-source", Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+
+		It("does not find vars without a source", func() {
+			_, found, err := variables.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+			Expect(fakeFactory.NewInstanceCallCount()).To(Equal(0))
+		})
+
+		It("does not find vars from an unknown source", func() {
+			_, found, err := variables.Get(vars.VariableDefinition{Source: "bogus", Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
+
+type closingManager struct {
+	*credsfakes.FakeManager
+
+	closed int
+}
+
+func (manager *closingManager) Close() {
+	manager.closed++
+}
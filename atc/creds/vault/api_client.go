@@ -168,6 +168,10 @@ func (ac *APIClient) baseClient() (*vaultapi.Client, error) {
 		return nil, err
 	}
 
+	// Only ever use the configured token or one from logging in, never a
+	// VAULT_TOKEN from the ATC's environment.
+	client.ClearToken()
+
 	err = client.SetAddress(ac.apiURL)
 	if err != nil {
 		return nil, err
@@ -22,6 +22,8 @@ type VaultManager struct {
 	TLS    TLS
 	Auth   AuthConfig
 	Client *APIClient
+
+	reAuther *ReAuther
 }
 
 type TLS struct {
@@ -120,7 +122,15 @@ func (manager VaultManager) Health() (*creds.HealthResponse, error) {
 	return health, nil
 }
 
-func (manager VaultManager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
-	ra := NewReAuther(manager.Client, manager.Auth.BackendMaxTTL, manager.Auth.RetryInitial, manager.Auth.RetryMax)
-	return NewVaultFactory(manager.Client, ra.LoggedIn(), manager.PathPrefix, manager.SharedPath), nil
+func (manager *VaultManager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	manager.reAuther = NewReAuther(manager.Client, manager.Auth.BackendMaxTTL, manager.Auth.RetryInitial, manager.Auth.RetryMax)
+	return NewVaultFactory(manager.Client, manager.reAuther.LoggedIn(), manager.PathPrefix, manager.SharedPath), nil
+}
+
+// Close stops logging in to vault once the manager is no longer used, e.g.
+// when a pipeline's var source is evicted.
+func (manager *VaultManager) Close() {
+	if manager.reAuther != nil {
+		manager.reAuther.Close()
+	}
 }
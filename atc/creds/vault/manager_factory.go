@@ -1,12 +1,29 @@
 package vault
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
 
 type vaultManagerFactory struct{}
 
+// varSourceOptions are the options a pipeline's vault var source may set. The
+// TLS cert paths are files on the ATC's host, and the path prefix and shared
+// path would let the pipeline look up secrets outside of its team.
+var varSourceOptions = []string{
+	"url",
+	"client_token",
+	"auth_backend",
+	"auth_backend_max_ttl",
+	"auth_param",
+	"retry_max",
+	"retry_initial",
+	"server_name",
+	"insecure_skip_verify",
+}
+
 func init() {
 	creds.Register("vault", NewVaultManagerFactory())
 }
@@ -27,3 +44,18 @@ func (factory *vaultManagerFactory) AddConfig(group *flags.Group) creds.Manager
 
 	return manager
 }
+
+func (factory *vaultManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &VaultManager{}
+
+	err := creds.ParseVarSourceConfig(manager, config, varSourceOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.Auth.ClientToken == "" && (manager.Auth.Backend == "" || len(manager.Auth.Params) == 0) {
+		return nil, errors.New("must configure client_token, or auth_backend with its auth_param credentials")
+	}
+
+	return manager, nil
+}
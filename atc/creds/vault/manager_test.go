@@ -1,6 +1,8 @@
 package vault_test
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc/creds/vault"
 	"github.com/jessevdk/go-flags"
 
@@ -56,4 +58,108 @@ var _ = Describe("VaultManager", func() {
 			Expect(manager.Validate()).ToNot(BeNil())
 		})
 	})
+
+	Describe("NewInstance()", func() {
+		var (
+			config  interface{}
+			manager *vault.VaultManager
+			err     error
+		)
+
+		JustBeforeEach(func() {
+			var m interface{}
+			m, err = vault.NewVaultManagerFactory().NewInstance(config)
+			if err == nil {
+				manager = m.(*vault.VaultManager)
+			}
+		})
+
+		Context("with a var source config", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"url":                  "https://vault.example.com",
+					"client_token":         "some-token",
+					"retry_max":            "10m",
+					"insecure_skip_verify": true,
+					"auth_param":           map[string]interface{}{"role_id": "some-role"},
+				}
+			})
+
+			It("configures the manager", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(manager.URL).To(Equal("https://vault.example.com"))
+				Expect(manager.Auth.ClientToken).To(Equal("some-token"))
+				Expect(manager.Auth.RetryMax).To(Equal(10 * time.Minute))
+				Expect(manager.Auth.Params).To(Equal(map[string]string{"role_id": "some-role"}))
+				Expect(manager.TLS.Insecure).To(BeTrue())
+			})
+
+			It("applies the defaults of unset options", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(manager.Auth.RetryInitial).To(Equal(time.Second))
+				Expect(manager.PathPrefix).To(Equal("/concourse"))
+			})
+		})
+
+		Context("with an option reserved for the ATC", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"url":          "https://vault.example.com",
+					"client_token": "some-token",
+					"path_prefix":  "/other-team",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("option 'path_prefix' cannot be set by a var source"))
+			})
+		})
+
+		Context("with a cert path on the ATC's host", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"url":          "https://vault.example.com",
+					"client_token": "some-token",
+					"client_cert":  "/etc/concourse/vault.pem",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("option 'client_cert' cannot be set by a var source"))
+			})
+		})
+
+		Context("without credentials", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"url":          "https://vault.example.com",
+					"auth_backend": "aws",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("must configure client_token, or auth_backend with its auth_param credentials"))
+			})
+		})
+
+		Context("with an unknown option", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{"bogus": "value"}
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the config is not a map", func() {
+			BeforeEach(func() {
+				config = "bogus"
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
@@ -25,6 +25,9 @@ type ReAuther struct {
 
 	loggedIn     chan struct{}
 	loggedInOnce *sync.Once
+
+	closed    chan struct{}
+	closeOnce *sync.Once
 }
 
 // NewReAuther with a retry time and a max retry time.
@@ -37,6 +40,9 @@ func NewReAuther(auther Auther, maxTTL, retry, max time.Duration) *ReAuther {
 
 		loggedIn:     make(chan struct{}, 1),
 		loggedInOnce: &sync.Once{},
+
+		closed:    make(chan struct{}),
+		closeOnce: &sync.Once{},
 	}
 
 	go ra.authLoop()
@@ -50,6 +56,13 @@ func (ra *ReAuther) LoggedIn() <-chan struct{} {
 	return ra.loggedIn
 }
 
+// Close stops the authorization loop.
+func (ra *ReAuther) Close() {
+	ra.closeOnce.Do(func() {
+		close(ra.closed)
+	})
+}
+
 // we can't renew a secret that has exceeded it's maxTTL or it's lease
 func (ra *ReAuther) renewable(leaseEnd, tokenEOL time.Time) bool {
 	now := time.Now()
@@ -68,11 +81,24 @@ func (ra *ReAuther) renewable(leaseEnd, tokenEOL time.Time) bool {
 }
 
 // sleep until the tokenEOl or half the lease duration
-func (ra *ReAuther) sleep(leaseEnd, tokenEOL time.Time) {
+func (ra *ReAuther) sleep(leaseEnd, tokenEOL time.Time) bool {
 	if ra.maxTTL != 0 && leaseEnd.After(tokenEOL) {
-		time.Sleep(time.Until(tokenEOL))
-	} else {
-		time.Sleep(time.Until(leaseEnd) / 2)
+		return ra.wait(time.Until(tokenEOL))
+	}
+
+	return ra.wait(time.Until(leaseEnd) / 2)
+}
+
+// wait returns false if the ReAuther was closed before the duration passed.
+func (ra *ReAuther) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ra.closed:
+		return false
 	}
 }
 
@@ -89,7 +115,10 @@ func (ra *ReAuther) authLoop() {
 		for {
 			lease, err := ra.auther.Login()
 			if err != nil {
-				time.Sleep(exp.NextBackOff())
+				if !ra.wait(exp.NextBackOff()) {
+					return
+				}
+
 				continue
 			}
 
@@ -102,7 +131,9 @@ func (ra *ReAuther) authLoop() {
 			now := time.Now()
 			tokenEOL = now.Add(ra.maxTTL)
 			leaseEnd = now.Add(lease)
-			ra.sleep(leaseEnd, tokenEOL)
+			if !ra.sleep(leaseEnd, tokenEOL) {
+				return
+			}
 
 			break
 		}
@@ -114,14 +145,19 @@ func (ra *ReAuther) authLoop() {
 
 			lease, err := ra.auther.Renew()
 			if err != nil {
-				time.Sleep(exp.NextBackOff())
+				if !ra.wait(exp.NextBackOff()) {
+					return
+				}
+
 				continue
 			}
 
 			exp.Reset()
 
 			leaseEnd = time.Now().Add(lease)
-			ra.sleep(leaseEnd, tokenEOL)
+			if !ra.sleep(leaseEnd, tokenEOL) {
+				return
+			}
 		}
 	}
 }
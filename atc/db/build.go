@@ -43,7 +43,7 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.schema, b.private_plan, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.drained, b.aborted, b.completed").
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.schema, b.private_plan, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, p.instance_vars, t.name, b.nonce, b.drained, b.aborted, b.completed").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
@@ -61,6 +61,7 @@ type Build interface {
 	JobName() string
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	Schema() string
@@ -92,6 +93,9 @@ type Build interface {
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
 
+	SaveAttempt(atc.PlanID, atc.BuildAttempt) error
+	Attempts() (map[atc.PlanID][]atc.BuildAttempt, error)
+
 	Artifacts() ([]WorkerArtifact, error)
 	Artifact(artifactID int) (WorkerArtifact, error)
 
@@ -102,6 +106,7 @@ type Build interface {
 	SaveImageResourceVersion(UsedResourceCache) error
 
 	Pipeline() (Pipeline, bool, error)
+	ConfiguredPipelines() ([]Pipeline, error)
 
 	Delete() (bool, error)
 	MarkAsAborted() error
@@ -122,10 +127,11 @@ type build struct {
 	teamID   int
 	teamName string
 
-	pipelineID   int
-	pipelineName string
-	jobID        int
-	jobName      string
+	pipelineID           int
+	pipelineName         string
+	pipelineInstanceVars atc.InstanceVars
+	jobID                int
+	jobName              string
 
 	isManuallyTriggered bool
 
@@ -158,19 +164,20 @@ func (r ResourceNotFoundInPipeline) Error() string {
 	return fmt.Sprintf("resource %s not found in pipeline %s", r.Resource, r.Pipeline)
 }
 
-func (b *build) ID() int                      { return b.id }
-func (b *build) Name() string                 { return b.name }
-func (b *build) JobID() int                   { return b.jobID }
-func (b *build) JobName() string              { return b.jobName }
-func (b *build) PipelineID() int              { return b.pipelineID }
-func (b *build) PipelineName() string         { return b.pipelineName }
-func (b *build) TeamID() int                  { return b.teamID }
-func (b *build) TeamName() string             { return b.teamName }
-func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
-func (b *build) Schema() string               { return b.schema }
-func (b *build) PrivatePlan() atc.Plan        { return b.privatePlan }
-func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
-func (b *build) HasPlan() bool                { return string(*b.publicPlan) != "{}" }
+func (b *build) ID() int                                { return b.id }
+func (b *build) Name() string                           { return b.name }
+func (b *build) JobID() int                             { return b.jobID }
+func (b *build) JobName() string                        { return b.jobName }
+func (b *build) PipelineID() int                        { return b.pipelineID }
+func (b *build) PipelineName() string                   { return b.pipelineName }
+func (b *build) PipelineInstanceVars() atc.InstanceVars { return b.pipelineInstanceVars }
+func (b *build) TeamID() int                            { return b.teamID }
+func (b *build) TeamName() string                       { return b.teamName }
+func (b *build) IsManuallyTriggered() bool              { return b.isManuallyTriggered }
+func (b *build) Schema() string                         { return b.schema }
+func (b *build) PrivatePlan() atc.Plan                  { return b.privatePlan }
+func (b *build) PublicPlan() *json.RawMessage           { return b.publicPlan }
+func (b *build) HasPlan() bool                          { return string(*b.publicPlan) != "{}" }
 func (b *build) IsNewerThanLastCheckOf(input Resource) bool {
 	return b.createTime.After(input.LastCheckEndTime())
 }
@@ -512,6 +519,21 @@ func (b *build) Pipeline() (Pipeline, bool, error) {
 	return pipeline, true, nil
 }
 
+// ConfiguredPipelines returns the pipelines which were last configured by a
+// set_pipeline step in this build.
+func (b *build) ConfiguredPipelines() ([]Pipeline, error) {
+	rows, err := pipelinesQuery.
+		Where(sq.Eq{"p.parent_build_id": b.id}).
+		OrderBy("p.id ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPipelines(b.conn, b.lockFactory, rows)
+}
+
 func (b *build) SaveImageResourceVersion(rc UsedResourceCache) error {
 	_, err := psql.Insert("build_image_resource_caches").
 		Columns("resource_cache_id", "build_id").
@@ -597,17 +619,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
-	t, found, err := tf.FindTeam(b.teamName)
-	if err != nil {
-		return BuildPreparation{}, false, err
-	}
-
-	if !found {
-		return BuildPreparation{}, false, nil
-	}
-
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := b.Pipeline()
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -797,6 +809,72 @@ func (b *build) Artifact(artifactID int) (WorkerArtifact, error) {
 	return &artifact, err
 }
 
+func (b *build) SaveAttempt(planID atc.PlanID, attempt atc.BuildAttempt) error {
+	_, err := psql.Insert("build_attempts").
+		Columns("build_id", "plan_id", "attempt", "status", "error", "start_time", "end_time").
+		Values(
+			b.id,
+			string(planID),
+			attempt.Attempt,
+			string(attempt.Status),
+			attempt.Error,
+			time.Unix(attempt.StartTime, 0),
+			time.Unix(attempt.EndTime, 0),
+		).
+		Suffix(`
+			ON CONFLICT (build_id, plan_id, attempt) DO UPDATE SET
+				status = EXCLUDED.status,
+				error = EXCLUDED.error,
+				start_time = EXCLUDED.start_time,
+				end_time = EXCLUDED.end_time
+		`).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// Attempts returns the outcome of every attempt of the build's retried steps,
+// keyed by the ID of their retry plan and in the order they were attempted.
+func (b *build) Attempts() (map[atc.PlanID][]atc.BuildAttempt, error) {
+	rows, err := psql.Select("plan_id", "attempt", "status", "error", "start_time", "end_time").
+		From("build_attempts").
+		Where(sq.Eq{
+			"build_id": b.id,
+		}).
+		OrderBy("plan_id", "attempt").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	attempts := map[atc.PlanID][]atc.BuildAttempt{}
+
+	for rows.Next() {
+		var (
+			planID             string
+			status             string
+			attempt            atc.BuildAttempt
+			startTime, endTime time.Time
+		)
+
+		err = rows.Scan(&planID, &attempt.Attempt, &status, &attempt.Error, &startTime, &endTime)
+		if err != nil {
+			return nil, err
+		}
+
+		attempt.Status = atc.BuildStatus(status)
+		attempt.StartTime = startTime.Unix()
+		attempt.EndTime = endTime.Unix()
+
+		attempts[atc.PlanID(planID)] = append(attempts[atc.PlanID(planID)], attempt)
+	}
+
+	return attempts, nil
+}
+
 func (b *build) Artifacts() ([]WorkerArtifact, error) {
 	artifacts := []WorkerArtifact{}
 
@@ -1108,13 +1186,14 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	var (
 		jobID, pipelineID                                      sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan sql.NullString
+		pipelineInstanceVars                                   sql.NullString
 		createTime, startTime, endTime, reapTime               pq.NullTime
 		nonce                                                  sql.NullString
 		drained, aborted, completed                            bool
 		status                                                 string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &schema, &privatePlan, &publicPlan, &createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &drained, &aborted, &completed)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &schema, &privatePlan, &publicPlan, &createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &pipelineInstanceVars, &b.teamName, &nonce, &drained, &aborted, &completed)
 	if err != nil {
 		return err
 	}
@@ -1133,6 +1212,13 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.aborted = aborted
 	b.completed = completed
 
+	if pipelineInstanceVars.Valid {
+		err = json.Unmarshal([]byte(pipelineInstanceVars.String), &b.pipelineInstanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	var (
 		noncense      *string
 		decryptedPlan []byte
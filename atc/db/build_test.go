@@ -286,6 +286,84 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("SaveAttempt", func() {
+		It("saves attempts so that they can be listed by plan", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			attempts, err := build.Attempts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attempts).To(BeEmpty())
+
+			err = build.SaveAttempt("some-plan", atc.BuildAttempt{
+				Attempt:   2,
+				Status:    atc.StatusSucceeded,
+				StartTime: 1000,
+				EndTime:   1010,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAttempt("some-plan", atc.BuildAttempt{
+				Attempt:   1,
+				Status:    atc.StatusErrored,
+				Error:     "worker vanished",
+				StartTime: 900,
+				EndTime:   990,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAttempt("other-plan", atc.BuildAttempt{
+				Attempt:   1,
+				Status:    atc.StatusFailed,
+				StartTime: 800,
+				EndTime:   850,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			attempts, err = build.Attempts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attempts).To(Equal(map[atc.PlanID][]atc.BuildAttempt{
+				"some-plan": {
+					{Attempt: 1, Status: atc.StatusErrored, Error: "worker vanished", StartTime: 900, EndTime: 990},
+					{Attempt: 2, Status: atc.StatusSucceeded, StartTime: 1000, EndTime: 1010},
+				},
+				"other-plan": {
+					{Attempt: 1, Status: atc.StatusFailed, StartTime: 800, EndTime: 850},
+				},
+			}))
+		})
+
+		It("replaces an attempt which is saved again, e.g. when the build is resumed", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAttempt("some-plan", atc.BuildAttempt{
+				Attempt:   1,
+				Status:    atc.StatusErrored,
+				Error:     "worker vanished",
+				StartTime: 900,
+				EndTime:   990,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAttempt("some-plan", atc.BuildAttempt{
+				Attempt:   1,
+				Status:    atc.StatusSucceeded,
+				StartTime: 1000,
+				EndTime:   1010,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			attempts, err := build.Attempts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attempts).To(Equal(map[atc.PlanID][]atc.BuildAttempt{
+				"some-plan": {
+					{Attempt: 1, Status: atc.StatusSucceeded, StartTime: 1000, EndTime: 1010},
+				},
+			}))
+		})
+	})
+
 	Describe("SaveEvent", func() {
 		It("saves and propagates events correctly", func() {
 			build, err := team.CreateOneOffBuild()
@@ -391,7 +469,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -530,7 +608,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -626,13 +704,13 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := createdPipeline.Job("some-job")
@@ -724,7 +802,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -739,7 +817,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -839,7 +917,7 @@ var _ = Describe("Build", func() {
 
 					Context("when pipeline is paused", func() {
 						BeforeEach(func() {
-							err := pipeline.Pause()
+							err := pipeline.Pause("", "")
 							Expect(err).NotTo(HaveOccurred())
 
 							expectedBuildPrep.PausedPipeline = db.BuildPreparationStatusBlocking
@@ -855,7 +933,7 @@ var _ = Describe("Build", func() {
 
 					Context("when job is paused", func() {
 						BeforeEach(func() {
-							err := job.Pause()
+							err := job.Pause("", "")
 							Expect(err).NotTo(HaveOccurred())
 
 							expectedBuildPrep.PausedJob = db.BuildPreparationStatusBlocking
@@ -930,7 +1008,7 @@ var _ = Describe("Build", func() {
 
 			Context("when inputs are not satisfied", func() {
 				BeforeEach(func() {
-					pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Resources: atc.ResourceConfigs{
 							{
 								Name: "some-resource",
@@ -945,7 +1023,7 @@ var _ = Describe("Build", func() {
 								Name: "some-job",
 							},
 						},
-					}, db.ConfigVersion(2), false)
+					}, db.ConfigVersion(2), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					expectedBuildPrep.InputsSatisfied = db.BuildPreparationStatusBlocking
 				})
@@ -998,7 +1076,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -1081,13 +1159,13 @@ var _ = Describe("Build", func() {
 			)
 
 			BeforeEach(func() {
-				pipeline, _, err := team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1163,7 +1241,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -0,0 +1,310 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . Check
+
+type Check interface {
+	ID() int
+	TeamID() int
+	TeamName() string
+	PipelineID() int
+	ResourceID() int
+	ResourceTypeID() int
+	Status() atc.CheckStatus
+	CreatedBy() atc.CheckCreator
+	Plan() atc.CheckPlan
+	CheckError() error
+	CreateTime() time.Time
+	StartTime() time.Time
+	EndTime() time.Time
+
+	Pipeline() (Pipeline, bool, error)
+
+	Events(from uint) (EventSource, error)
+	SaveEvent(event atc.Event) error
+
+	Finish(error) error
+	Skip() error
+	Reload() (bool, error)
+}
+
+var checksQuery = psql.Select("c.id, c.team_id, t.name, c.pipeline_id, c.resource_id, c.resource_type_id, c.status, c.created_by, c.plan, c.check_error, c.create_time, c.start_time, c.end_time").
+	From("checks c").
+	Join("teams t ON t.id = c.team_id")
+
+type check struct {
+	id             int
+	teamID         int
+	teamName       string
+	pipelineID     int
+	resourceID     int
+	resourceTypeID int
+	status         atc.CheckStatus
+	createdBy      atc.CheckCreator
+	plan           atc.CheckPlan
+	checkError     error
+	createTime     time.Time
+	startTime      time.Time
+	endTime        time.Time
+
+	// coalescedIDs are the checks started along with this one, which share
+	// its events and outcome.
+	coalescedIDs []int
+
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func newCheck(conn Conn, lockFactory lock.LockFactory) *check {
+	return &check{
+		conn:        conn,
+		lockFactory: lockFactory,
+	}
+}
+
+func (c *check) ID() int                     { return c.id }
+func (c *check) TeamID() int                 { return c.teamID }
+func (c *check) TeamName() string            { return c.teamName }
+func (c *check) PipelineID() int             { return c.pipelineID }
+func (c *check) ResourceID() int             { return c.resourceID }
+func (c *check) ResourceTypeID() int         { return c.resourceTypeID }
+func (c *check) Status() atc.CheckStatus     { return c.status }
+func (c *check) CreatedBy() atc.CheckCreator { return c.createdBy }
+func (c *check) Plan() atc.CheckPlan         { return c.plan }
+func (c *check) CheckError() error           { return c.checkError }
+func (c *check) CreateTime() time.Time       { return c.createTime }
+func (c *check) StartTime() time.Time        { return c.startTime }
+func (c *check) EndTime() time.Time          { return c.endTime }
+
+func (c *check) Reload() (bool, error) {
+	row := checksQuery.
+		Where(sq.Eq{"c.id": c.id}).
+		RunWith(c.conn).
+		QueryRow()
+
+	err := scanCheck(c, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *check) Pipeline() (Pipeline, bool, error) {
+	row := pipelinesQuery.
+		Where(sq.Eq{"p.id": c.pipelineID}).
+		RunWith(c.conn).
+		QueryRow()
+
+	pipeline := newPipeline(c.conn, c.lockFactory)
+	err := scanPipeline(pipeline, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return pipeline, true, nil
+}
+
+func (c *check) Events(from uint) (EventSource, error) {
+	notifier, err := newConditionNotifier(c.conn.Bus(), checkEventsChannel(c.id), func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newCheckEventSource(
+		c.id,
+		c.conn,
+		notifier,
+		from,
+	), nil
+}
+
+// SaveEvent saves the event to the check, and to each of the checks
+// coalesced into it.
+func (c *check) SaveEvent(event atc.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range c.ids() {
+		_, err = psql.Insert("check_events").
+			Columns("check_id", "event_id", "type", "version", "payload").
+			Values(
+				id,
+				sq.Expr("(SELECT COALESCE(MAX(event_id) + 1, 0) FROM check_events WHERE check_id = ?)", id),
+				string(event.EventType()),
+				string(event.Version()),
+				payload,
+			).
+			RunWith(c.conn).
+			Exec()
+		if err != nil {
+			return err
+		}
+
+		err = c.conn.Bus().Notify(checkEventsChannel(id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Finish records the outcome of the check, and of each of the checks coalesced
+// into it, which errored if the given error is not nil.
+func (c *check) Finish(checkErr error) error {
+	status := atc.CheckStatusSucceeded
+	var errString sql.NullString
+	if checkErr != nil {
+		status = atc.CheckStatusErrored
+		errString = sql.NullString{String: checkErr.Error(), Valid: true}
+	}
+
+	var endTime time.Time
+	err := psql.Update("checks").
+		Set("status", status).
+		Set("check_error", errString).
+		Set("end_time", sq.Expr("now()")).
+		Where(sq.Eq{"id": c.ids()}).
+		Suffix("RETURNING end_time").
+		RunWith(c.conn).
+		QueryRow().
+		Scan(&endTime)
+	if err != nil {
+		return err
+	}
+
+	c.status = status
+	c.checkError = checkErr
+	c.endTime = endTime
+
+	for _, id := range c.ids() {
+		err = c.conn.Bus().Notify(checkEventsChannel(id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Skip records that the check wasn't run. The checks coalesced into it are
+// queued up again, as they still have to be run.
+func (c *check) Skip() error {
+	tx, err := c.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var endTime time.Time
+	err = psql.Update("checks").
+		Set("status", atc.CheckStatusSkipped).
+		Set("end_time", sq.Expr("now()")).
+		Where(sq.Eq{"id": c.id}).
+		Suffix("RETURNING end_time").
+		RunWith(tx).
+		QueryRow().
+		Scan(&endTime)
+	if err != nil {
+		return err
+	}
+
+	requeued := len(c.coalescedIDs) > 0
+	if requeued {
+		_, err = psql.Update("checks").
+			Set("status", atc.CheckStatusPending).
+			Set("start_time", nil).
+			Where(sq.Eq{"id": c.coalescedIDs}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	c.status = atc.CheckStatusSkipped
+	c.endTime = endTime
+	c.coalescedIDs = nil
+
+	err = c.conn.Bus().Notify(checkEventsChannel(c.id))
+	if err != nil {
+		return err
+	}
+
+	if requeued {
+		return c.conn.Bus().Notify(checkCreatedChannel())
+	}
+
+	return nil
+}
+
+func (c *check) ids() []int {
+	return append([]int{c.id}, c.coalescedIDs...)
+}
+
+func checkEventsChannel(checkID int) string {
+	return fmt.Sprintf("check_events_%d", checkID)
+}
+
+func scanCheck(c *check, row scannable) error {
+	var (
+		resourceID, resourceTypeID     sql.NullInt64
+		checkError                     sql.NullString
+		plan                           []byte
+		createTime, startTime, endTime pq.NullTime
+	)
+
+	err := row.Scan(&c.id, &c.teamID, &c.teamName, &c.pipelineID, &resourceID, &resourceTypeID, &c.status, &c.createdBy, &plan, &checkError, &createTime, &startTime, &endTime)
+	if err != nil {
+		return err
+	}
+
+	c.plan = atc.CheckPlan{}
+	err = json.Unmarshal(plan, &c.plan)
+	if err != nil {
+		return err
+	}
+
+	c.resourceID = int(resourceID.Int64)
+	c.resourceTypeID = int(resourceTypeID.Int64)
+
+	c.checkError = nil
+	if checkError.Valid {
+		c.checkError = errors.New(checkError.String)
+	}
+
+	c.createTime = createTime.Time
+	c.startTime = startTime.Time
+	c.endTime = endTime.Time
+
+	return nil
+}
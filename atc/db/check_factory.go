@@ -0,0 +1,495 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+//go:generate counterfeiter . CheckFactory
+
+// CheckFactory creates the checks of resources and resource types, which are
+// queued up in the database until any ATC starts them.
+type CheckFactory interface {
+	Check(int) (Check, bool, error)
+
+	// CreateResourceCheck queues up a check of the resource. A pending check
+	// created by the same creator without a version to check from is reused
+	// rather than queueing up another.
+	CreateResourceCheck(resourceID int, createdBy atc.CheckCreator, from atc.Version) (Check, error)
+	CreateResourceTypeCheck(resourceTypeID int, createdBy atc.CheckCreator, from atc.Version) (Check, error)
+
+	// ScheduleResourceCheck queues up an interval check of the resource,
+	// unless one of it or of any other resource sharing its resource config
+	// scope is already queued or running, or was created less than the
+	// interval ago.
+	ScheduleResourceCheck(resourceID int, interval time.Duration) (bool, error)
+	ScheduleResourceTypeCheck(resourceTypeID int, interval time.Duration) (bool, error)
+
+	// StartNextCheck starts the oldest pending check, if there is one. Any
+	// pending manual or webhook checks of resources sharing its resource
+	// config scope are started along with it, and share its events and
+	// outcome, so that they are only checked once.
+	StartNextCheck() (Check, bool, error)
+
+	// Resources returns the active resources of every unpaused pipeline, i.e.
+	// those which are checked on an interval.
+	Resources() ([]Resource, error)
+
+	// CountRecentResourceChecks counts the checks of resources of the given
+	// type created by any ATC within the given duration.
+	CountRecentResourceChecks(resourceType string, within time.Duration) (int, error)
+
+	// ResourceChecks returns the latest checks of the resource, newest first,
+	// up to the given limit.
+	ResourceChecks(resourceID int, limit int) ([]Check, error)
+}
+
+type checkFactory struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func NewCheckFactory(conn Conn, lockFactory lock.LockFactory) CheckFactory {
+	return &checkFactory{
+		conn:        conn,
+		lockFactory: lockFactory,
+	}
+}
+
+func checkCreatedChannel() string {
+	return "check_created"
+}
+
+type checkTarget struct {
+	table    string
+	column   string
+	id       int
+	notFound error
+}
+
+// sameScopeResources selects the resources sharing the resource config scope
+// of the given resource.
+const sameScopeResources = `SELECT id FROM resources WHERE resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)`
+
+// scope matches the checks of the target, along with those of any resource
+// sharing its resource config scope when the target is a resource.
+func (target checkTarget) scope() sq.Sqlizer {
+	if target.table != "resources" {
+		return sq.Eq{target.column: target.id}
+	}
+
+	return sq.Or{
+		sq.Eq{"resource_id": target.id},
+		sq.Expr("resource_id IN ("+sameScopeResources+")", target.id),
+	}
+}
+
+// lock blocks until no other transaction is creating checks of the target, or
+// of any resource sharing its resource config scope, and holds off any others
+// until the transaction ends, so that they don't both create a check.
+func (target checkTarget) lock(tx Tx) error {
+	key := fmt.Sprintf("%s:%d", target.table, target.id)
+
+	if target.table == "resources" {
+		var scopeID sql.NullInt64
+		err := psql.Select("resource_config_scope_id").
+			From("resources").
+			Where(sq.Eq{"id": target.id}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&scopeID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		if scopeID.Valid {
+			key = fmt.Sprintf("resource_config_scopes:%d", scopeID.Int64)
+		}
+	}
+
+	id := lock.NewCheckCreatingLockID(key)
+
+	_, err := tx.Exec(`SELECT pg_advisory_xact_lock($1, $2)`, id[0], id[1])
+	return err
+}
+
+func resourceCheckTarget(resourceID int) checkTarget {
+	return checkTarget{
+		table:    "resources",
+		column:   "resource_id",
+		id:       resourceID,
+		notFound: ResourceNotFoundError{ID: resourceID},
+	}
+}
+
+func resourceTypeCheckTarget(resourceTypeID int) checkTarget {
+	return checkTarget{
+		table:    "resource_types",
+		column:   "resource_type_id",
+		id:       resourceTypeID,
+		notFound: ResourceTypeNotFoundError{ID: resourceTypeID},
+	}
+}
+
+func (f *checkFactory) Check(checkID int) (Check, bool, error) {
+	check := newCheck(f.conn, f.lockFactory)
+
+	row := checksQuery.
+		Where(sq.Eq{"c.id": checkID}).
+		RunWith(f.conn).
+		QueryRow()
+
+	err := scanCheck(check, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return check, true, nil
+}
+
+func (f *checkFactory) CreateResourceCheck(resourceID int, createdBy atc.CheckCreator, from atc.Version) (Check, error) {
+	return f.createCheck(resourceCheckTarget(resourceID), createdBy, from)
+}
+
+func (f *checkFactory) CreateResourceTypeCheck(resourceTypeID int, createdBy atc.CheckCreator, from atc.Version) (Check, error) {
+	return f.createCheck(resourceTypeCheckTarget(resourceTypeID), createdBy, from)
+}
+
+func (f *checkFactory) ScheduleResourceCheck(resourceID int, interval time.Duration) (bool, error) {
+	return f.scheduleCheck(resourceCheckTarget(resourceID), interval)
+}
+
+func (f *checkFactory) ScheduleResourceTypeCheck(resourceTypeID int, interval time.Duration) (bool, error) {
+	return f.scheduleCheck(resourceTypeCheckTarget(resourceTypeID), interval)
+}
+
+func (f *checkFactory) createCheck(target checkTarget, createdBy atc.CheckCreator, from atc.Version) (Check, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	err = target.lock(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if from == nil {
+		check := newCheck(f.conn, f.lockFactory)
+
+		err = scanCheck(check, checksQuery.
+			Where(sq.Eq{
+				"c." + target.column: target.id,
+				"c.status":           atc.CheckStatusPending,
+				"c.created_by":       createdBy,
+			}).
+			Where(sq.Expr("c.plan->>'from' IS NULL")).
+			OrderBy("c.id").
+			Limit(1).
+			RunWith(tx).
+			QueryRow())
+		if err == nil {
+			return check, tx.Commit()
+		}
+
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+	}
+
+	checkID, err := f.insertCheck(tx, target, createdBy, from)
+	if err != nil {
+		return nil, err
+	}
+
+	check := newCheck(f.conn, f.lockFactory)
+
+	err = scanCheck(check, checksQuery.
+		Where(sq.Eq{"c.id": checkID}).
+		RunWith(tx).
+		QueryRow())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.conn.Bus().Notify(checkCreatedChannel())
+	if err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+func (f *checkFactory) scheduleCheck(target checkTarget, interval time.Duration) (bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	err = target.lock(tx)
+	if err != nil {
+		return false, err
+	}
+
+	var recent int
+	err = psql.Select("COUNT(*)").
+		From("checks").
+		Where(target.scope()).
+		Where(sq.Eq{"created_by": atc.CheckCreatedByInterval}).
+		Where(sq.Or{
+			sq.Eq{"status": []atc.CheckStatus{atc.CheckStatusPending, atc.CheckStatusStarted}},
+			sq.Expr(fmt.Sprintf("now() - create_time < '%d seconds'::interval", int(interval.Seconds()))),
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&recent)
+	if err != nil {
+		return false, err
+	}
+
+	if recent > 0 {
+		return false, nil
+	}
+
+	_, err = f.insertCheck(tx, target, atc.CheckCreatedByInterval, nil)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = f.conn.Bus().Notify(checkCreatedChannel())
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (f *checkFactory) insertCheck(tx Tx, target checkTarget, createdBy atc.CheckCreator, from atc.Version) (int, error) {
+	var (
+		name               string
+		pipelineID, teamID int
+	)
+
+	err := psql.Select("r.name, r.pipeline_id, p.team_id").
+		From(target.table+" r").
+		Join("pipelines p ON p.id = r.pipeline_id").
+		Where(sq.Eq{"r.id": target.id}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&name, &pipelineID, &teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, target.notFound
+		}
+		return 0, err
+	}
+
+	plan := atc.CheckPlan{From: from}
+	if target.table == "resources" {
+		plan.Resource = name
+	} else {
+		plan.ResourceType = name
+	}
+
+	planPayload, err := json.Marshal(plan)
+	if err != nil {
+		return 0, err
+	}
+
+	var checkID int
+	err = psql.Insert("checks").
+		Columns("team_id", "pipeline_id", target.column, "status", "created_by", "plan").
+		Values(teamID, pipelineID, target.id, atc.CheckStatusPending, createdBy, planPayload).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&checkID)
+	if err != nil {
+		return 0, err
+	}
+
+	return checkID, nil
+}
+
+func (f *checkFactory) StartNextCheck() (Check, bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	var checkID int
+	err = tx.QueryRow(`
+		UPDATE checks
+		SET status = $1, start_time = now()
+		WHERE id = (
+			SELECT id
+			FROM checks
+			WHERE status = $2
+			ORDER BY id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`, atc.CheckStatusStarted, atc.CheckStatusPending).Scan(&checkID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	check := newCheck(f.conn, f.lockFactory)
+
+	err = scanCheck(check, checksQuery.
+		Where(sq.Eq{"c.id": checkID}).
+		RunWith(tx).
+		QueryRow())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if check.resourceID != 0 && check.plan.From == nil {
+		check.coalescedIDs, err = f.startCoalescedChecks(tx, check.resourceID)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return check, true, nil
+}
+
+// startCoalescedChecks starts the pending manual and webhook checks of the
+// resources sharing the resource config scope of the given resource, which
+// checking it will satisfy. Checks locked by another ATC starting them are
+// skipped rather than waited on.
+func (f *checkFactory) startCoalescedChecks(tx Tx, resourceID int) ([]int, error) {
+	// sq.Select rather than psql.Select, so that the subquery's placeholders
+	// are numbered along with the update's
+	pending, args, err := sq.Select("id").
+		From("checks").
+		Where(sq.Eq{
+			"status":     atc.CheckStatusPending,
+			"created_by": []atc.CheckCreator{atc.CheckCreatedByManual, atc.CheckCreatedByWebhook},
+		}).
+		Where(sq.Expr("plan->>'from' IS NULL")).
+		Where(sq.Expr("resource_id IN ("+sameScopeResources+")", resourceID)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := psql.Update("checks").
+		Set("status", atc.CheckStatusStarted).
+		Set("start_time", sq.Expr("now()")).
+		Where(sq.Expr("id IN ("+pending+")", args...)).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (f *checkFactory) Resources() ([]Resource, error) {
+	rows, err := resourcesQuery.
+		Where(sq.Eq{"p.paused": false}).
+		OrderBy("r.id ASC").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanResources(rows, f.conn, f.lockFactory)
+}
+
+func (f *checkFactory) CountRecentResourceChecks(resourceType string, within time.Duration) (int, error) {
+	var count int
+	err := psql.Select("COUNT(*)").
+		From("checks c").
+		Join("resources r ON r.id = c.resource_id").
+		Where(sq.Eq{"r.type": resourceType}).
+		Where(sq.Expr(fmt.Sprintf("now() - c.create_time < '%d milliseconds'::interval", int64(within/time.Millisecond)))).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (f *checkFactory) ResourceChecks(resourceID int, limit int) ([]Check, error) {
+	rows, err := checksQuery.
+		Where(sq.Eq{"c.resource_id": resourceID}).
+		OrderBy("c.id DESC").
+		Limit(uint64(limit)).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var checks []Check
+	for rows.Next() {
+		check := newCheck(f.conn, f.lockFactory)
+
+		err = scanCheck(check, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks, rows.Err()
+}
@@ -0,0 +1,376 @@
+package db_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckFactory", func() {
+	Describe("CreateResourceCheck", func() {
+		var (
+			check     db.Check
+			createErr error
+			from      atc.Version
+		)
+
+		BeforeEach(func() {
+			from = nil
+		})
+
+		JustBeforeEach(func() {
+			check, createErr = checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, from)
+		})
+
+		It("creates a pending check of the resource", func() {
+			Expect(createErr).ToNot(HaveOccurred())
+			Expect(check.Status()).To(Equal(atc.CheckStatusPending))
+			Expect(check.CreatedBy()).To(Equal(atc.CheckCreatedByManual))
+			Expect(check.TeamName()).To(Equal(defaultTeam.Name()))
+			Expect(check.PipelineID()).To(Equal(defaultPipeline.ID()))
+			Expect(check.ResourceID()).To(Equal(defaultResource.ID()))
+			Expect(check.ResourceTypeID()).To(BeZero())
+			Expect(check.Plan()).To(Equal(atc.CheckPlan{Resource: defaultResource.Name()}))
+			Expect(check.CreateTime()).ToNot(BeZero())
+		})
+
+		It("reuses the pending check when asked again", func() {
+			again, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(again.ID()).To(Equal(check.ID()))
+		})
+
+		It("does not reuse the pending check of another creator", func() {
+			other, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByWebhook, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(other.ID()).ToNot(Equal(check.ID()))
+		})
+
+		Context("when checking from a version", func() {
+			BeforeEach(func() {
+				from = atc.Version{"ref": "v1"}
+			})
+
+			It("records the version in the plan", func() {
+				Expect(check.Plan().From).To(Equal(from))
+			})
+
+			It("does not reuse the pending check", func() {
+				again, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, from)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(again.ID()).ToNot(Equal(check.ID()))
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			It("returns a not found error", func() {
+				_, err := checkFactory.CreateResourceCheck(12345, atc.CheckCreatedByManual, nil)
+				Expect(err).To(Equal(db.ResourceNotFoundError{ID: 12345}))
+			})
+		})
+	})
+
+	Describe("CreateResourceTypeCheck", func() {
+		It("creates a pending check of the resource type", func() {
+			check, err := checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(check.ResourceTypeID()).To(Equal(defaultResourceType.ID()))
+			Expect(check.ResourceID()).To(BeZero())
+			Expect(check.Plan()).To(Equal(atc.CheckPlan{ResourceType: defaultResourceType.Name()}))
+		})
+	})
+
+	Describe("ScheduleResourceCheck", func() {
+		It("creates an interval check unless one is queued or was recently created", func() {
+			created, err := checkFactory.ScheduleResourceCheck(defaultResource.ID(), time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			created, err = checkFactory.ScheduleResourceCheck(defaultResource.ID(), 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+
+			check, found, err := checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(check.CreatedBy()).To(Equal(atc.CheckCreatedByInterval))
+
+			err = check.Finish(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			created, err = checkFactory.ScheduleResourceCheck(defaultResource.ID(), time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+
+			created, err = checkFactory.ScheduleResourceCheck(defaultResource.ID(), 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+	})
+
+	Describe("StartNextCheck", func() {
+		It("starts the pending checks in the order they were created", func() {
+			first, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			second, err := checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			started, found, err := checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(started.ID()).To(Equal(first.ID()))
+			Expect(started.Status()).To(Equal(atc.CheckStatusStarted))
+			Expect(started.StartTime()).ToNot(BeZero())
+
+			started, found, err = checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(started.ID()).To(Equal(second.ID()))
+
+			_, found, err = checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when another pipeline's resource shares the resource config scope", func() {
+		var (
+			otherPipeline db.Pipeline
+			otherResource db.Resource
+		)
+
+		BeforeEach(func() {
+			atc.EnableGlobalResources = true
+
+			var err error
+			otherPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "other-resource",
+						Type:   defaultResource.Type(),
+						Source: defaultResource.Source(),
+					},
+				},
+			}, db.ConfigVersion(0), false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			otherResource, found, err = otherPipeline.Resource("other-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			for _, resource := range []db.Resource{defaultResource, otherResource} {
+				_, err = resource.SetResourceConfig(resource.Source(), atc.VersionedResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			atc.EnableGlobalResources = false
+		})
+
+		It("schedules one interval check for both", func() {
+			created, err := checkFactory.ScheduleResourceCheck(defaultResource.ID(), time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			created, err = checkFactory.ScheduleResourceCheck(otherResource.ID(), time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		It("starts their pending manual and webhook checks together", func() {
+			first, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			second, err := checkFactory.CreateResourceCheck(otherResource.ID(), atc.CheckCreatedByWebhook, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			fromVersion, err := checkFactory.CreateResourceCheck(otherResource.ID(), atc.CheckCreatedByManual, atc.Version{"ref": "v1"})
+			Expect(err).ToNot(HaveOccurred())
+
+			started, found, err := checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(started.ID()).To(Equal(first.ID()))
+
+			err = started.Finish(errors.New("nope"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = second.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.Status()).To(Equal(atc.CheckStatusErrored))
+			Expect(second.CheckError()).To(MatchError("nope"))
+
+			_, err = fromVersion.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fromVersion.Status()).To(Equal(atc.CheckStatusPending))
+		})
+
+		It("starts their pending manual checks along with an interval check", func() {
+			created, err := checkFactory.ScheduleResourceCheck(defaultResource.ID(), time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			manual, err := checkFactory.CreateResourceCheck(otherResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			started, found, err := checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(started.CreatedBy()).To(Equal(atc.CheckCreatedByInterval))
+
+			_, err = manual.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manual.Status()).To(Equal(atc.CheckStatusStarted))
+		})
+
+		It("requeues the checks started along with a skipped check", func() {
+			first, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			second, err := checkFactory.CreateResourceCheck(otherResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			started, found, err := checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(started.ID()).To(Equal(first.ID()))
+
+			err = started.Skip()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = first.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.Status()).To(Equal(atc.CheckStatusSkipped))
+
+			_, err = second.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.Status()).To(Equal(atc.CheckStatusPending))
+		})
+
+		Context("when the source of one of them changes", func() {
+			BeforeEach(func() {
+				_, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "other-resource",
+							Type:   defaultResource.Type(),
+							Source: atc.Source{"some": "other-repository"},
+						},
+					},
+				}, otherPipeline.ConfigVersion(), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = otherResource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("no longer shares the resource config scope", func() {
+				Expect(otherResource.ResourceConfigScopeID()).To(BeZero())
+
+				created, err := checkFactory.ScheduleResourceCheck(defaultResource.ID(), time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				created, err = checkFactory.ScheduleResourceCheck(otherResource.ID(), time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Resources", func() {
+		It("returns the active resources of unpaused pipelines", func() {
+			resources, err := checkFactory.Resources()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(resources[0].ID()).To(Equal(defaultResource.ID()))
+
+			err = defaultPipeline.Pause("some-user", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			resources, err = checkFactory.Resources()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(BeEmpty())
+		})
+	})
+
+	Describe("CountRecentResourceChecks", func() {
+		It("counts the recently created checks of resources of the type", func() {
+			_, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			count, err := checkFactory.CountRecentResourceChecks(defaultResource.Type(), time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+
+			count, err = checkFactory.CountRecentResourceChecks("some-other-type", time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+	})
+
+	Describe("ResourceChecks", func() {
+		It("returns the latest checks of the resource, newest first", func() {
+			first, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			second, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByWebhook, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			checks, err := checkFactory.ResourceChecks(defaultResource.ID(), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checks).To(HaveLen(2))
+			Expect(checks[0].ID()).To(Equal(second.ID()))
+			Expect(checks[1].ID()).To(Equal(first.ID()))
+
+			checks, err = checkFactory.ResourceChecks(defaultResource.ID(), 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checks).To(HaveLen(1))
+
+			_, err = defaultResource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(defaultResource.LatestCheckID()).To(Equal(second.ID()))
+		})
+	})
+
+	Describe("Check", func() {
+		It("finds the check, with its outcome once finished", func() {
+			created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = created.Finish(errors.New("nope"))
+			Expect(err).ToNot(HaveOccurred())
+
+			check, found, err := checkFactory.Check(created.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(check.Status()).To(Equal(atc.CheckStatusErrored))
+			Expect(check.CheckError()).To(MatchError("nope"))
+			Expect(check.EndTime()).ToNot(BeZero())
+
+			pipeline, found, err := check.Pipeline()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.ID()).To(Equal(defaultPipeline.ID()))
+		})
+
+		It("does not find checks which don't exist", func() {
+			_, found, err := checkFactory.Check(12345)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
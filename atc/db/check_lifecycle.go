@@ -0,0 +1,88 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . CheckLifecycle
+
+type CheckLifecycle interface {
+	ErrorStaleChecks(time.Duration) error
+	RemoveExpiredChecks(recyclePeriod time.Duration, checksToRetain int) error
+	RemoveExpiredCheckEvents(logsToRetain int) error
+}
+
+type checkLifecycle struct {
+	conn Conn
+}
+
+func NewCheckLifecycle(conn Conn) CheckLifecycle {
+	return &checkLifecycle{
+		conn: conn,
+	}
+}
+
+// ErrorStaleChecks errors the checks which were started longer than the given
+// period ago and never finished, i.e. those of an ATC which went away while
+// running them, so that they are no longer waited on and their resources are
+// checked again.
+func (lifecycle *checkLifecycle) ErrorStaleChecks(stalePeriod time.Duration) error {
+	_, err := psql.Update("checks").
+		Set("status", atc.CheckStatusErrored).
+		Set("check_error", fmt.Sprintf("check did not finish within %s", stalePeriod)).
+		Set("end_time", sq.Expr("now()")).
+		Where(sq.Eq{"status": atc.CheckStatusStarted}).
+		Where(sq.Expr(fmt.Sprintf("now() - start_time > '%d seconds'::interval", int(stalePeriod.Seconds())))).
+		RunWith(lifecycle.conn).
+		Exec()
+
+	return err
+}
+
+// RemoveExpiredChecks removes the checks which finished longer than the given
+// period ago, apart from the latest checksToRetain of each resource and
+// resource type, so that their outcome and logs can still be seen.
+func (lifecycle *checkLifecycle) RemoveExpiredChecks(recyclePeriod time.Duration, checksToRetain int) error {
+	_, err := lifecycle.conn.Exec(`
+		DELETE FROM checks
+		WHERE now() - end_time > $1::interval
+		AND id NOT IN (
+			SELECT id
+			FROM (
+				SELECT id, row_number() OVER (
+					PARTITION BY resource_id, resource_type_id
+					ORDER BY id DESC
+				) AS position
+				FROM checks
+			) AS ranked
+			WHERE position <= $2
+		)
+	`, fmt.Sprintf("%d seconds", int(recyclePeriod.Seconds())), checksToRetain)
+
+	return err
+}
+
+// RemoveExpiredCheckEvents removes the logs of every check but the latest
+// logsToRetain of each resource and resource type.
+func (lifecycle *checkLifecycle) RemoveExpiredCheckEvents(logsToRetain int) error {
+	_, err := lifecycle.conn.Exec(`
+		DELETE FROM check_events
+		WHERE check_id IN (
+			SELECT id
+			FROM (
+				SELECT id, row_number() OVER (
+					PARTITION BY resource_id, resource_type_id
+					ORDER BY id DESC
+				) AS position
+				FROM checks
+			) AS ranked
+			WHERE position > $1
+		)
+	`, logsToRetain)
+
+	return err
+}
@@ -0,0 +1,85 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckLifecycle", func() {
+	var checkLifecycle db.CheckLifecycle
+
+	BeforeEach(func() {
+		checkLifecycle = db.NewCheckLifecycle(dbConn)
+	})
+
+	Describe("ErrorStaleChecks", func() {
+		It("errors the checks which were started over the stale period ago", func() {
+			created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = checkLifecycle.ErrorStaleChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = created.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.Status()).To(Equal(atc.CheckStatusStarted))
+
+			_, err = dbConn.Exec(`UPDATE checks SET start_time = now() - '2 hours'::interval WHERE id = $1`, created.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			err = checkLifecycle.ErrorStaleChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = created.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.Status()).To(Equal(atc.CheckStatusErrored))
+			Expect(created.CheckError()).To(MatchError("check did not finish within 1h0m0s"))
+		})
+	})
+
+	Describe("RemoveExpiredChecks", func() {
+		It("removes the expired checks apart from the latest of each resource and resource type", func() {
+			var checks []db.Check
+			for i := 0; i < 3; i++ {
+				check, err := checkFactory.CreateResourceCheck(defaultResource.ID(), atc.CheckCreatedByManual, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				checks = append(checks, check)
+			}
+
+			typeCheck, err := checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), atc.CheckCreatedByManual, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			checks = append(checks, typeCheck)
+
+			for _, check := range checks {
+				err = check.Finish(nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			_, err = dbConn.Exec(`UPDATE checks SET end_time = now() - '2 hours'::interval`)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = checkLifecycle.RemoveExpiredChecks(time.Hour, 2)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := checkFactory.Check(checks[0].ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			for _, check := range checks[1:] {
+				_, found, err := checkFactory.Check(check.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			}
+		})
+	})
+})
@@ -30,6 +30,7 @@ var (
 
 	dbConn                              db.Conn
 	buildFactory                        db.BuildFactory
+	checkFactory                        db.CheckFactory
 	volumeRepository                    db.VolumeRepository
 	containerRepository                 db.ContainerRepository
 	teamFactory                         db.TeamFactory
@@ -39,6 +40,7 @@ var (
 	resourceConfigFactory               db.ResourceConfigFactory
 	resourceCacheFactory                db.ResourceCacheFactory
 	taskCacheFactory                    db.TaskCacheFactory
+	taskOutputCacheFactory              db.TaskOutputCacheFactory
 	workerBaseResourceTypeFactory       db.WorkerBaseResourceTypeFactory
 	workerTaskCacheFactory              db.WorkerTaskCacheFactory
 	userFactory                         db.UserFactory
@@ -95,6 +97,7 @@ var _ = BeforeEach(func() {
 	lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), metric.LogLockAcquired, metric.LogLockReleased)
 
 	buildFactory = db.NewBuildFactory(dbConn, lockFactory, 5*time.Minute)
+	checkFactory = db.NewCheckFactory(dbConn, lockFactory)
 	volumeRepository = db.NewVolumeRepository(dbConn)
 	containerRepository = db.NewContainerRepository(dbConn)
 	teamFactory = db.NewTeamFactory(dbConn, lockFactory)
@@ -104,6 +107,7 @@ var _ = BeforeEach(func() {
 	resourceConfigFactory = db.NewResourceConfigFactory(dbConn, lockFactory)
 	resourceCacheFactory = db.NewResourceCacheFactory(dbConn, lockFactory)
 	taskCacheFactory = db.NewTaskCacheFactory(dbConn)
+	taskOutputCacheFactory = db.NewTaskOutputCacheFactory(dbConn)
 	workerBaseResourceTypeFactory = db.NewWorkerBaseResourceTypeFactory(dbConn)
 	workerTaskCacheFactory = db.NewWorkerTaskCacheFactory(dbConn)
 	userFactory = db.NewUserFactory(dbConn)
@@ -142,7 +146,7 @@ var _ = BeforeEach(func() {
 	otherWorker, err = workerFactory.SaveWorker(otherWorkerPayload, 0)
 	Expect(err).NotTo(HaveOccurred())
 
-	defaultPipeline, _, err = defaultTeam.SavePipeline("default-pipeline", atc.Config{
+	defaultPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, atc.Config{
 		Jobs: atc.JobConfigs{
 			{
 				Name: "some-job",
@@ -166,7 +170,7 @@ var _ = BeforeEach(func() {
 				},
 			},
 		},
-	}, db.ConfigVersion(0), false)
+	}, db.ConfigVersion(0), false, "")
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
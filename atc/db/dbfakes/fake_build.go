@@ -66,6 +66,30 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	AttemptsStub        func() (map[atc.PlanID][]atc.BuildAttempt, error)
+	attemptsMutex       sync.RWMutex
+	attemptsArgsForCall []struct {
+	}
+	attemptsReturns struct {
+		result1 map[atc.PlanID][]atc.BuildAttempt
+		result2 error
+	}
+	attemptsReturnsOnCall map[int]struct {
+		result1 map[atc.PlanID][]atc.BuildAttempt
+		result2 error
+	}
+	ConfiguredPipelinesStub        func() ([]db.Pipeline, error)
+	configuredPipelinesMutex       sync.RWMutex
+	configuredPipelinesArgsForCall []struct {
+	}
+	configuredPipelinesReturns struct {
+		result1 []db.Pipeline
+		result2 error
+	}
+	configuredPipelinesReturnsOnCall map[int]struct {
+		result1 []db.Pipeline
+		result2 error
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -279,6 +303,16 @@ type FakeBuild struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -359,6 +393,18 @@ type FakeBuild struct {
 		result2 []db.BuildOutput
 		result3 error
 	}
+	SaveAttemptStub        func(atc.PlanID, atc.BuildAttempt) error
+	saveAttemptMutex       sync.RWMutex
+	saveAttemptArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 atc.BuildAttempt
+	}
+	saveAttemptReturns struct {
+		result1 error
+	}
+	saveAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveEventStub        func(atc.Event) error
 	saveEventMutex       sync.RWMutex
 	saveEventArgsForCall []struct {
@@ -750,6 +796,116 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) Attempts() (map[atc.PlanID][]atc.BuildAttempt, error) {
+	fake.attemptsMutex.Lock()
+	ret, specificReturn := fake.attemptsReturnsOnCall[len(fake.attemptsArgsForCall)]
+	fake.attemptsArgsForCall = append(fake.attemptsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Attempts", []interface{}{})
+	fake.attemptsMutex.Unlock()
+	if fake.AttemptsStub != nil {
+		return fake.AttemptsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.attemptsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) AttemptsCallCount() int {
+	fake.attemptsMutex.RLock()
+	defer fake.attemptsMutex.RUnlock()
+	return len(fake.attemptsArgsForCall)
+}
+
+func (fake *FakeBuild) AttemptsCalls(stub func() (map[atc.PlanID][]atc.BuildAttempt, error)) {
+	fake.attemptsMutex.Lock()
+	defer fake.attemptsMutex.Unlock()
+	fake.AttemptsStub = stub
+}
+
+func (fake *FakeBuild) AttemptsReturns(result1 map[atc.PlanID][]atc.BuildAttempt, result2 error) {
+	fake.attemptsMutex.Lock()
+	defer fake.attemptsMutex.Unlock()
+	fake.AttemptsStub = nil
+	fake.attemptsReturns = struct {
+		result1 map[atc.PlanID][]atc.BuildAttempt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) AttemptsReturnsOnCall(i int, result1 map[atc.PlanID][]atc.BuildAttempt, result2 error) {
+	fake.attemptsMutex.Lock()
+	defer fake.attemptsMutex.Unlock()
+	fake.AttemptsStub = nil
+	if fake.attemptsReturnsOnCall == nil {
+		fake.attemptsReturnsOnCall = make(map[int]struct {
+			result1 map[atc.PlanID][]atc.BuildAttempt
+			result2 error
+		})
+	}
+	fake.attemptsReturnsOnCall[i] = struct {
+		result1 map[atc.PlanID][]atc.BuildAttempt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ConfiguredPipelines() ([]db.Pipeline, error) {
+	fake.configuredPipelinesMutex.Lock()
+	ret, specificReturn := fake.configuredPipelinesReturnsOnCall[len(fake.configuredPipelinesArgsForCall)]
+	fake.configuredPipelinesArgsForCall = append(fake.configuredPipelinesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConfiguredPipelines", []interface{}{})
+	fake.configuredPipelinesMutex.Unlock()
+	if fake.ConfiguredPipelinesStub != nil {
+		return fake.ConfiguredPipelinesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.configuredPipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ConfiguredPipelinesCallCount() int {
+	fake.configuredPipelinesMutex.RLock()
+	defer fake.configuredPipelinesMutex.RUnlock()
+	return len(fake.configuredPipelinesArgsForCall)
+}
+
+func (fake *FakeBuild) ConfiguredPipelinesCalls(stub func() ([]db.Pipeline, error)) {
+	fake.configuredPipelinesMutex.Lock()
+	defer fake.configuredPipelinesMutex.Unlock()
+	fake.ConfiguredPipelinesStub = stub
+}
+
+func (fake *FakeBuild) ConfiguredPipelinesReturns(result1 []db.Pipeline, result2 error) {
+	fake.configuredPipelinesMutex.Lock()
+	defer fake.configuredPipelinesMutex.Unlock()
+	fake.ConfiguredPipelinesStub = nil
+	fake.configuredPipelinesReturns = struct {
+		result1 []db.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ConfiguredPipelinesReturnsOnCall(i int, result1 []db.Pipeline, result2 error) {
+	fake.configuredPipelinesMutex.Lock()
+	defer fake.configuredPipelinesMutex.Unlock()
+	fake.ConfiguredPipelinesStub = nil
+	if fake.configuredPipelinesReturnsOnCall == nil {
+		fake.configuredPipelinesReturnsOnCall = make(map[int]struct {
+			result1 []db.Pipeline
+			result2 error
+		})
+	}
+	fake.configuredPipelinesReturnsOnCall[i] = struct {
+		result1 []db.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1829,6 +1985,58 @@ func (fake *FakeBuild) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeBuild) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -2208,6 +2416,67 @@ func (fake *FakeBuild) ResourcesReturnsOnCall(i int, result1 []db.BuildInput, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveAttempt(arg1 atc.PlanID, arg2 atc.BuildAttempt) error {
+	fake.saveAttemptMutex.Lock()
+	ret, specificReturn := fake.saveAttemptReturnsOnCall[len(fake.saveAttemptArgsForCall)]
+	fake.saveAttemptArgsForCall = append(fake.saveAttemptArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 atc.BuildAttempt
+	}{arg1, arg2})
+	fake.recordInvocation("SaveAttempt", []interface{}{arg1, arg2})
+	fake.saveAttemptMutex.Unlock()
+	if fake.SaveAttemptStub != nil {
+		return fake.SaveAttemptStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveAttemptReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveAttemptCallCount() int {
+	fake.saveAttemptMutex.RLock()
+	defer fake.saveAttemptMutex.RUnlock()
+	return len(fake.saveAttemptArgsForCall)
+}
+
+func (fake *FakeBuild) SaveAttemptCalls(stub func(atc.PlanID, atc.BuildAttempt) error) {
+	fake.saveAttemptMutex.Lock()
+	defer fake.saveAttemptMutex.Unlock()
+	fake.SaveAttemptStub = stub
+}
+
+func (fake *FakeBuild) SaveAttemptArgsForCall(i int) (atc.PlanID, atc.BuildAttempt) {
+	fake.saveAttemptMutex.RLock()
+	defer fake.saveAttemptMutex.RUnlock()
+	argsForCall := fake.saveAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveAttemptReturns(result1 error) {
+	fake.saveAttemptMutex.Lock()
+	defer fake.saveAttemptMutex.Unlock()
+	fake.SaveAttemptStub = nil
+	fake.saveAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveAttemptReturnsOnCall(i int, result1 error) {
+	fake.saveAttemptMutex.Lock()
+	defer fake.saveAttemptMutex.Unlock()
+	fake.SaveAttemptStub = nil
+	if fake.saveAttemptReturnsOnCall == nil {
+		fake.saveAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SaveEvent(arg1 atc.Event) error {
 	fake.saveEventMutex.Lock()
 	ret, specificReturn := fake.saveEventReturnsOnCall[len(fake.saveEventArgsForCall)]
@@ -2968,6 +3237,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.attemptsMutex.RLock()
+	defer fake.attemptsMutex.RUnlock()
+	fake.configuredPipelinesMutex.RLock()
+	defer fake.configuredPipelinesMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -3004,10 +3277,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
-	fake.pipelineMutex.RLock()
-	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	fake.pipelineMutex.RLock()
+	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.preparationMutex.RLock()
@@ -3022,6 +3297,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reloadMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
+	fake.saveAttemptMutex.RLock()
+	defer fake.saveAttemptMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
@@ -231,9 +231,11 @@ type FakeJob struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
-	PauseStub        func() error
+	PauseStub        func(string, string) error
 	pauseMutex       sync.RWMutex
 	pauseArgsForCall []struct {
+		arg1 string
+		arg2 string
 	}
 	pauseReturns struct {
 		result1 error
@@ -241,6 +243,16 @@ type FakeJob struct {
 	pauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PauseCommentStub        func() string
+	pauseCommentMutex       sync.RWMutex
+	pauseCommentArgsForCall []struct {
+	}
+	pauseCommentReturns struct {
+		result1 string
+	}
+	pauseCommentReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PausedStub        func() bool
 	pausedMutex       sync.RWMutex
 	pausedArgsForCall []struct {
@@ -251,6 +263,16 @@ type FakeJob struct {
 	pausedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PausedByStub        func() string
+	pausedByMutex       sync.RWMutex
+	pausedByArgsForCall []struct {
+	}
+	pausedByReturns struct {
+		result1 string
+	}
+	pausedByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PipelineIDStub        func() int
 	pipelineIDMutex       sync.RWMutex
 	pipelineIDArgsForCall []struct {
@@ -261,6 +283,16 @@ type FakeJob struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -1438,15 +1470,17 @@ func (fake *FakeJob) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeJob) Pause() error {
+func (fake *FakeJob) Pause(arg1 string, arg2 string) error {
 	fake.pauseMutex.Lock()
 	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
 	fake.pauseArgsForCall = append(fake.pauseArgsForCall, struct {
-	}{})
-	fake.recordInvocation("Pause", []interface{}{})
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Pause", []interface{}{arg1, arg2})
 	fake.pauseMutex.Unlock()
 	if fake.PauseStub != nil {
-		return fake.PauseStub()
+		return fake.PauseStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1461,12 +1495,19 @@ func (fake *FakeJob) PauseCallCount() int {
 	return len(fake.pauseArgsForCall)
 }
 
-func (fake *FakeJob) PauseCalls(stub func() error) {
+func (fake *FakeJob) PauseCalls(stub func(string, string) error) {
 	fake.pauseMutex.Lock()
 	defer fake.pauseMutex.Unlock()
 	fake.PauseStub = stub
 }
 
+func (fake *FakeJob) PauseArgsForCall(i int) (string, string) {
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	argsForCall := fake.pauseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeJob) PauseReturns(result1 error) {
 	fake.pauseMutex.Lock()
 	defer fake.pauseMutex.Unlock()
@@ -1490,6 +1531,58 @@ func (fake *FakeJob) PauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) PauseComment() string {
+	fake.pauseCommentMutex.Lock()
+	ret, specificReturn := fake.pauseCommentReturnsOnCall[len(fake.pauseCommentArgsForCall)]
+	fake.pauseCommentArgsForCall = append(fake.pauseCommentArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PauseComment", []interface{}{})
+	fake.pauseCommentMutex.Unlock()
+	if fake.PauseCommentStub != nil {
+		return fake.PauseCommentStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pauseCommentReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PauseCommentCallCount() int {
+	fake.pauseCommentMutex.RLock()
+	defer fake.pauseCommentMutex.RUnlock()
+	return len(fake.pauseCommentArgsForCall)
+}
+
+func (fake *FakeJob) PauseCommentCalls(stub func() string) {
+	fake.pauseCommentMutex.Lock()
+	defer fake.pauseCommentMutex.Unlock()
+	fake.PauseCommentStub = stub
+}
+
+func (fake *FakeJob) PauseCommentReturns(result1 string) {
+	fake.pauseCommentMutex.Lock()
+	defer fake.pauseCommentMutex.Unlock()
+	fake.PauseCommentStub = nil
+	fake.pauseCommentReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeJob) PauseCommentReturnsOnCall(i int, result1 string) {
+	fake.pauseCommentMutex.Lock()
+	defer fake.pauseCommentMutex.Unlock()
+	fake.PauseCommentStub = nil
+	if fake.pauseCommentReturnsOnCall == nil {
+		fake.pauseCommentReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pauseCommentReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeJob) Paused() bool {
 	fake.pausedMutex.Lock()
 	ret, specificReturn := fake.pausedReturnsOnCall[len(fake.pausedArgsForCall)]
@@ -1542,6 +1635,58 @@ func (fake *FakeJob) PausedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeJob) PausedBy() string {
+	fake.pausedByMutex.Lock()
+	ret, specificReturn := fake.pausedByReturnsOnCall[len(fake.pausedByArgsForCall)]
+	fake.pausedByArgsForCall = append(fake.pausedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PausedBy", []interface{}{})
+	fake.pausedByMutex.Unlock()
+	if fake.PausedByStub != nil {
+		return fake.PausedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pausedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PausedByCallCount() int {
+	fake.pausedByMutex.RLock()
+	defer fake.pausedByMutex.RUnlock()
+	return len(fake.pausedByArgsForCall)
+}
+
+func (fake *FakeJob) PausedByCalls(stub func() string) {
+	fake.pausedByMutex.Lock()
+	defer fake.pausedByMutex.Unlock()
+	fake.PausedByStub = stub
+}
+
+func (fake *FakeJob) PausedByReturns(result1 string) {
+	fake.pausedByMutex.Lock()
+	defer fake.pausedByMutex.Unlock()
+	fake.PausedByStub = nil
+	fake.pausedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeJob) PausedByReturnsOnCall(i int, result1 string) {
+	fake.pausedByMutex.Lock()
+	defer fake.pausedByMutex.Unlock()
+	fake.PausedByStub = nil
+	if fake.pausedByReturnsOnCall == nil {
+		fake.pausedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pausedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeJob) PipelineID() int {
 	fake.pipelineIDMutex.Lock()
 	ret, specificReturn := fake.pipelineIDReturnsOnCall[len(fake.pipelineIDArgsForCall)]
@@ -1594,6 +1739,58 @@ func (fake *FakeJob) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeJob) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -2300,12 +2497,18 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.iDMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.pauseCommentMutex.RLock()
+	defer fake.pauseCommentMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
+	fake.pausedByMutex.RLock()
+	defer fake.pausedByMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.publicMutex.RLock()
@@ -7,9 +7,11 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/concourse/concourse/vars"
 )
 
 type FakePipeline struct {
@@ -29,6 +31,26 @@ type FakePipeline struct {
 		result2 bool
 		result3 error
 	}
+	ArchiveStub        func() error
+	archiveMutex       sync.RWMutex
+	archiveArgsForCall []struct {
+	}
+	archiveReturns struct {
+		result1 error
+	}
+	archiveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ArchivedStub        func() bool
+	archivedMutex       sync.RWMutex
+	archivedArgsForCall []struct {
+	}
+	archivedReturns struct {
+		result1 bool
+	}
+	archivedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	BuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -94,6 +116,18 @@ type FakePipeline struct {
 	configVersionReturnsOnCall map[int]struct {
 		result1 db.ConfigVersion
 	}
+	ConfigVersionsStub        func() ([]db.PipelineConfigVersion, error)
+	configVersionsMutex       sync.RWMutex
+	configVersionsArgsForCall []struct {
+	}
+	configVersionsReturns struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
+	configVersionsReturnsOnCall map[int]struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
 	CreateOneOffBuildStub        func() (db.Build, error)
 	createOneOffBuildMutex       sync.RWMutex
 	createOneOffBuildArgsForCall []struct {
@@ -152,6 +186,16 @@ type FakePipeline struct {
 	destroyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayStub        func() *atc.DisplayConfig
+	displayMutex       sync.RWMutex
+	displayArgsForCall []struct {
+	}
+	displayReturns struct {
+		result1 *atc.DisplayConfig
+	}
+	displayReturnsOnCall map[int]struct {
+		result1 *atc.DisplayConfig
+	}
 	ExposeStub        func() error
 	exposeMutex       sync.RWMutex
 	exposeArgsForCall []struct {
@@ -162,6 +206,31 @@ type FakePipeline struct {
 	exposeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FindConfigVersionStub        func(db.ConfigVersion) (db.PipelineConfigVersion, bool, error)
+	findConfigVersionMutex       sync.RWMutex
+	findConfigVersionArgsForCall []struct {
+		arg1 db.ConfigVersion
+	}
+	findConfigVersionReturns struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	findConfigVersionReturnsOnCall map[int]struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	FreezeWindowsStub        func() atc.FreezeWindowConfigs
+	freezeWindowsMutex       sync.RWMutex
+	freezeWindowsArgsForCall []struct {
+	}
+	freezeWindowsReturns struct {
+		result1 atc.FreezeWindowConfigs
+	}
+	freezeWindowsReturnsOnCall map[int]struct {
+		result1 atc.FreezeWindowConfigs
+	}
 	GetAllPendingBuildsStub        func() (map[string][]db.Build, error)
 	getAllPendingBuildsMutex       sync.RWMutex
 	getAllPendingBuildsArgsForCall []struct {
@@ -232,6 +301,16 @@ type FakePipeline struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InstanceVarsStub        func() atc.InstanceVars
+	instanceVarsMutex       sync.RWMutex
+	instanceVarsArgsForCall []struct {
+	}
+	instanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	instanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	JobStub        func(string) (db.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -281,9 +360,31 @@ type FakePipeline struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
-	PauseStub        func() error
+	ParentBuildIDStub        func() int
+	parentBuildIDMutex       sync.RWMutex
+	parentBuildIDArgsForCall []struct {
+	}
+	parentBuildIDReturns struct {
+		result1 int
+	}
+	parentBuildIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ParentJobIDStub        func() int
+	parentJobIDMutex       sync.RWMutex
+	parentJobIDArgsForCall []struct {
+	}
+	parentJobIDReturns struct {
+		result1 int
+	}
+	parentJobIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	PauseStub        func(string, string) error
 	pauseMutex       sync.RWMutex
 	pauseArgsForCall []struct {
+		arg1 string
+		arg2 string
 	}
 	pauseReturns struct {
 		result1 error
@@ -291,6 +392,16 @@ type FakePipeline struct {
 	pauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PauseCommentStub        func() string
+	pauseCommentMutex       sync.RWMutex
+	pauseCommentArgsForCall []struct {
+	}
+	pauseCommentReturns struct {
+		result1 string
+	}
+	pauseCommentReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PausedStub        func() bool
 	pausedMutex       sync.RWMutex
 	pausedArgsForCall []struct {
@@ -301,6 +412,16 @@ type FakePipeline struct {
 	pausedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PausedByStub        func() string
+	pausedByMutex       sync.RWMutex
+	pausedByArgsForCall []struct {
+	}
+	pausedByReturns struct {
+		result1 string
+	}
+	pausedByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PublicStub        func() bool
 	publicMutex       sync.RWMutex
 	publicArgsForCall []struct {
@@ -463,6 +584,39 @@ type FakePipeline struct {
 	unpauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VarSourcesStub        func() atc.VarSourceConfigs
+	varSourcesMutex       sync.RWMutex
+	varSourcesArgsForCall []struct {
+	}
+	varSourcesReturns struct {
+		result1 atc.VarSourceConfigs
+	}
+	varSourcesReturnsOnCall map[int]struct {
+		result1 atc.VarSourceConfigs
+	}
+	VariablesStub        func(lager.Logger, creds.Secrets, creds.VarSourcePool) vars.Variables
+	variablesMutex       sync.RWMutex
+	variablesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 creds.Secrets
+		arg3 creds.VarSourcePool
+	}
+	variablesReturns struct {
+		result1 vars.Variables
+	}
+	variablesReturnsOnCall map[int]struct {
+		result1 vars.Variables
+	}
+	VarsStub        func() map[string]interface{}
+	varsMutex       sync.RWMutex
+	varsArgsForCall []struct {
+	}
+	varsReturns struct {
+		result1 map[string]interface{}
+	}
+	varsReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -534,6 +688,110 @@ func (fake *FakePipeline) AcquireSchedulingLockReturnsOnCall(i int, result1 lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakePipeline) Archive() error {
+	fake.archiveMutex.Lock()
+	ret, specificReturn := fake.archiveReturnsOnCall[len(fake.archiveArgsForCall)]
+	fake.archiveArgsForCall = append(fake.archiveArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Archive", []interface{}{})
+	fake.archiveMutex.Unlock()
+	if fake.ArchiveStub != nil {
+		return fake.ArchiveStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.archiveReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ArchiveCallCount() int {
+	fake.archiveMutex.RLock()
+	defer fake.archiveMutex.RUnlock()
+	return len(fake.archiveArgsForCall)
+}
+
+func (fake *FakePipeline) ArchiveCalls(stub func() error) {
+	fake.archiveMutex.Lock()
+	defer fake.archiveMutex.Unlock()
+	fake.ArchiveStub = stub
+}
+
+func (fake *FakePipeline) ArchiveReturns(result1 error) {
+	fake.archiveMutex.Lock()
+	defer fake.archiveMutex.Unlock()
+	fake.ArchiveStub = nil
+	fake.archiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) ArchiveReturnsOnCall(i int, result1 error) {
+	fake.archiveMutex.Lock()
+	defer fake.archiveMutex.Unlock()
+	fake.ArchiveStub = nil
+	if fake.archiveReturnsOnCall == nil {
+		fake.archiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.archiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) Archived() bool {
+	fake.archivedMutex.Lock()
+	ret, specificReturn := fake.archivedReturnsOnCall[len(fake.archivedArgsForCall)]
+	fake.archivedArgsForCall = append(fake.archivedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Archived", []interface{}{})
+	fake.archivedMutex.Unlock()
+	if fake.ArchivedStub != nil {
+		return fake.ArchivedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.archivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ArchivedCallCount() int {
+	fake.archivedMutex.RLock()
+	defer fake.archivedMutex.RUnlock()
+	return len(fake.archivedArgsForCall)
+}
+
+func (fake *FakePipeline) ArchivedCalls(stub func() bool) {
+	fake.archivedMutex.Lock()
+	defer fake.archivedMutex.Unlock()
+	fake.ArchivedStub = stub
+}
+
+func (fake *FakePipeline) ArchivedReturns(result1 bool) {
+	fake.archivedMutex.Lock()
+	defer fake.archivedMutex.Unlock()
+	fake.ArchivedStub = nil
+	fake.archivedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePipeline) ArchivedReturnsOnCall(i int, result1 bool) {
+	fake.archivedMutex.Lock()
+	defer fake.archivedMutex.Unlock()
+	fake.ArchivedStub = nil
+	if fake.archivedReturnsOnCall == nil {
+		fake.archivedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.archivedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakePipeline) Builds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -836,6 +1094,61 @@ func (fake *FakePipeline) ConfigVersionReturnsOnCall(i int, result1 db.ConfigVer
 	}{result1}
 }
 
+func (fake *FakePipeline) ConfigVersions() ([]db.PipelineConfigVersion, error) {
+	fake.configVersionsMutex.Lock()
+	ret, specificReturn := fake.configVersionsReturnsOnCall[len(fake.configVersionsArgsForCall)]
+	fake.configVersionsArgsForCall = append(fake.configVersionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConfigVersions", []interface{}{})
+	fake.configVersionsMutex.Unlock()
+	if fake.ConfigVersionsStub != nil {
+		return fake.ConfigVersionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.configVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ConfigVersionsCallCount() int {
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
+	return len(fake.configVersionsArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigVersionsCalls(stub func() ([]db.PipelineConfigVersion, error)) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = stub
+}
+
+func (fake *FakePipeline) ConfigVersionsReturns(result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = nil
+	fake.configVersionsReturns = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigVersionsReturnsOnCall(i int, result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = nil
+	if fake.configVersionsReturnsOnCall == nil {
+		fake.configVersionsReturnsOnCall = make(map[int]struct {
+			result1 []db.PipelineConfigVersion
+			result2 error
+		})
+	}
+	fake.configVersionsReturnsOnCall[i] = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) CreateOneOffBuild() (db.Build, error) {
 	fake.createOneOffBuildMutex.Lock()
 	ret, specificReturn := fake.createOneOffBuildReturnsOnCall[len(fake.createOneOffBuildArgsForCall)]
@@ -1126,6 +1439,58 @@ func (fake *FakePipeline) DestroyReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Display() *atc.DisplayConfig {
+	fake.displayMutex.Lock()
+	ret, specificReturn := fake.displayReturnsOnCall[len(fake.displayArgsForCall)]
+	fake.displayArgsForCall = append(fake.displayArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Display", []interface{}{})
+	fake.displayMutex.Unlock()
+	if fake.DisplayStub != nil {
+		return fake.DisplayStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.displayReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) DisplayCallCount() int {
+	fake.displayMutex.RLock()
+	defer fake.displayMutex.RUnlock()
+	return len(fake.displayArgsForCall)
+}
+
+func (fake *FakePipeline) DisplayCalls(stub func() *atc.DisplayConfig) {
+	fake.displayMutex.Lock()
+	defer fake.displayMutex.Unlock()
+	fake.DisplayStub = stub
+}
+
+func (fake *FakePipeline) DisplayReturns(result1 *atc.DisplayConfig) {
+	fake.displayMutex.Lock()
+	defer fake.displayMutex.Unlock()
+	fake.DisplayStub = nil
+	fake.displayReturns = struct {
+		result1 *atc.DisplayConfig
+	}{result1}
+}
+
+func (fake *FakePipeline) DisplayReturnsOnCall(i int, result1 *atc.DisplayConfig) {
+	fake.displayMutex.Lock()
+	defer fake.displayMutex.Unlock()
+	fake.DisplayStub = nil
+	if fake.displayReturnsOnCall == nil {
+		fake.displayReturnsOnCall = make(map[int]struct {
+			result1 *atc.DisplayConfig
+		})
+	}
+	fake.displayReturnsOnCall[i] = struct {
+		result1 *atc.DisplayConfig
+	}{result1}
+}
+
 func (fake *FakePipeline) Expose() error {
 	fake.exposeMutex.Lock()
 	ret, specificReturn := fake.exposeReturnsOnCall[len(fake.exposeArgsForCall)]
@@ -1178,6 +1543,124 @@ func (fake *FakePipeline) ExposeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) FindConfigVersion(arg1 db.ConfigVersion) (db.PipelineConfigVersion, bool, error) {
+	fake.findConfigVersionMutex.Lock()
+	ret, specificReturn := fake.findConfigVersionReturnsOnCall[len(fake.findConfigVersionArgsForCall)]
+	fake.findConfigVersionArgsForCall = append(fake.findConfigVersionArgsForCall, struct {
+		arg1 db.ConfigVersion
+	}{arg1})
+	fake.recordInvocation("FindConfigVersion", []interface{}{arg1})
+	fake.findConfigVersionMutex.Unlock()
+	if fake.FindConfigVersionStub != nil {
+		return fake.FindConfigVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findConfigVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) FindConfigVersionCallCount() int {
+	fake.findConfigVersionMutex.RLock()
+	defer fake.findConfigVersionMutex.RUnlock()
+	return len(fake.findConfigVersionArgsForCall)
+}
+
+func (fake *FakePipeline) FindConfigVersionCalls(stub func(db.ConfigVersion) (db.PipelineConfigVersion, bool, error)) {
+	fake.findConfigVersionMutex.Lock()
+	defer fake.findConfigVersionMutex.Unlock()
+	fake.FindConfigVersionStub = stub
+}
+
+func (fake *FakePipeline) FindConfigVersionArgsForCall(i int) db.ConfigVersion {
+	fake.findConfigVersionMutex.RLock()
+	defer fake.findConfigVersionMutex.RUnlock()
+	argsForCall := fake.findConfigVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) FindConfigVersionReturns(result1 db.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.findConfigVersionMutex.Lock()
+	defer fake.findConfigVersionMutex.Unlock()
+	fake.FindConfigVersionStub = nil
+	fake.findConfigVersionReturns = struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) FindConfigVersionReturnsOnCall(i int, result1 db.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.findConfigVersionMutex.Lock()
+	defer fake.findConfigVersionMutex.Unlock()
+	fake.FindConfigVersionStub = nil
+	if fake.findConfigVersionReturnsOnCall == nil {
+		fake.findConfigVersionReturnsOnCall = make(map[int]struct {
+			result1 db.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findConfigVersionReturnsOnCall[i] = struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) FreezeWindows() atc.FreezeWindowConfigs {
+	fake.freezeWindowsMutex.Lock()
+	ret, specificReturn := fake.freezeWindowsReturnsOnCall[len(fake.freezeWindowsArgsForCall)]
+	fake.freezeWindowsArgsForCall = append(fake.freezeWindowsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FreezeWindows", []interface{}{})
+	fake.freezeWindowsMutex.Unlock()
+	if fake.FreezeWindowsStub != nil {
+		return fake.FreezeWindowsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.freezeWindowsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) FreezeWindowsCallCount() int {
+	fake.freezeWindowsMutex.RLock()
+	defer fake.freezeWindowsMutex.RUnlock()
+	return len(fake.freezeWindowsArgsForCall)
+}
+
+func (fake *FakePipeline) FreezeWindowsCalls(stub func() atc.FreezeWindowConfigs) {
+	fake.freezeWindowsMutex.Lock()
+	defer fake.freezeWindowsMutex.Unlock()
+	fake.FreezeWindowsStub = stub
+}
+
+func (fake *FakePipeline) FreezeWindowsReturns(result1 atc.FreezeWindowConfigs) {
+	fake.freezeWindowsMutex.Lock()
+	defer fake.freezeWindowsMutex.Unlock()
+	fake.FreezeWindowsStub = nil
+	fake.freezeWindowsReturns = struct {
+		result1 atc.FreezeWindowConfigs
+	}{result1}
+}
+
+func (fake *FakePipeline) FreezeWindowsReturnsOnCall(i int, result1 atc.FreezeWindowConfigs) {
+	fake.freezeWindowsMutex.Lock()
+	defer fake.freezeWindowsMutex.Unlock()
+	fake.FreezeWindowsStub = nil
+	if fake.freezeWindowsReturnsOnCall == nil {
+		fake.freezeWindowsReturnsOnCall = make(map[int]struct {
+			result1 atc.FreezeWindowConfigs
+		})
+	}
+	fake.freezeWindowsReturnsOnCall[i] = struct {
+		result1 atc.FreezeWindowConfigs
+	}{result1}
+}
+
 func (fake *FakePipeline) GetAllPendingBuilds() (map[string][]db.Build, error) {
 	fake.getAllPendingBuildsMutex.Lock()
 	ret, specificReturn := fake.getAllPendingBuildsReturnsOnCall[len(fake.getAllPendingBuildsArgsForCall)]
@@ -1517,6 +2000,58 @@ func (fake *FakePipeline) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakePipeline) InstanceVars() atc.InstanceVars {
+	fake.instanceVarsMutex.Lock()
+	ret, specificReturn := fake.instanceVarsReturnsOnCall[len(fake.instanceVarsArgsForCall)]
+	fake.instanceVarsArgsForCall = append(fake.instanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InstanceVars", []interface{}{})
+	fake.instanceVarsMutex.Unlock()
+	if fake.InstanceVarsStub != nil {
+		return fake.InstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.instanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) InstanceVarsCallCount() int {
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
+	return len(fake.instanceVarsArgsForCall)
+}
+
+func (fake *FakePipeline) InstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = stub
+}
+
+func (fake *FakePipeline) InstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	fake.instanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakePipeline) InstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	if fake.instanceVarsReturnsOnCall == nil {
+		fake.instanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.instanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakePipeline) Job(arg1 string) (db.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -1745,15 +2280,121 @@ func (fake *FakePipeline) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakePipeline) Pause() error {
+func (fake *FakePipeline) ParentBuildID() int {
+	fake.parentBuildIDMutex.Lock()
+	ret, specificReturn := fake.parentBuildIDReturnsOnCall[len(fake.parentBuildIDArgsForCall)]
+	fake.parentBuildIDArgsForCall = append(fake.parentBuildIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ParentBuildID", []interface{}{})
+	fake.parentBuildIDMutex.Unlock()
+	if fake.ParentBuildIDStub != nil {
+		return fake.ParentBuildIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.parentBuildIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ParentBuildIDCallCount() int {
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
+	return len(fake.parentBuildIDArgsForCall)
+}
+
+func (fake *FakePipeline) ParentBuildIDCalls(stub func() int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = stub
+}
+
+func (fake *FakePipeline) ParentBuildIDReturns(result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	fake.parentBuildIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) ParentBuildIDReturnsOnCall(i int, result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	if fake.parentBuildIDReturnsOnCall == nil {
+		fake.parentBuildIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.parentBuildIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) ParentJobID() int {
+	fake.parentJobIDMutex.Lock()
+	ret, specificReturn := fake.parentJobIDReturnsOnCall[len(fake.parentJobIDArgsForCall)]
+	fake.parentJobIDArgsForCall = append(fake.parentJobIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ParentJobID", []interface{}{})
+	fake.parentJobIDMutex.Unlock()
+	if fake.ParentJobIDStub != nil {
+		return fake.ParentJobIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.parentJobIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ParentJobIDCallCount() int {
+	fake.parentJobIDMutex.RLock()
+	defer fake.parentJobIDMutex.RUnlock()
+	return len(fake.parentJobIDArgsForCall)
+}
+
+func (fake *FakePipeline) ParentJobIDCalls(stub func() int) {
+	fake.parentJobIDMutex.Lock()
+	defer fake.parentJobIDMutex.Unlock()
+	fake.ParentJobIDStub = stub
+}
+
+func (fake *FakePipeline) ParentJobIDReturns(result1 int) {
+	fake.parentJobIDMutex.Lock()
+	defer fake.parentJobIDMutex.Unlock()
+	fake.ParentJobIDStub = nil
+	fake.parentJobIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) ParentJobIDReturnsOnCall(i int, result1 int) {
+	fake.parentJobIDMutex.Lock()
+	defer fake.parentJobIDMutex.Unlock()
+	fake.ParentJobIDStub = nil
+	if fake.parentJobIDReturnsOnCall == nil {
+		fake.parentJobIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.parentJobIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) Pause(arg1 string, arg2 string) error {
 	fake.pauseMutex.Lock()
 	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
 	fake.pauseArgsForCall = append(fake.pauseArgsForCall, struct {
-	}{})
-	fake.recordInvocation("Pause", []interface{}{})
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Pause", []interface{}{arg1, arg2})
 	fake.pauseMutex.Unlock()
 	if fake.PauseStub != nil {
-		return fake.PauseStub()
+		return fake.PauseStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1768,12 +2409,19 @@ func (fake *FakePipeline) PauseCallCount() int {
 	return len(fake.pauseArgsForCall)
 }
 
-func (fake *FakePipeline) PauseCalls(stub func() error) {
+func (fake *FakePipeline) PauseCalls(stub func(string, string) error) {
 	fake.pauseMutex.Lock()
 	defer fake.pauseMutex.Unlock()
 	fake.PauseStub = stub
 }
 
+func (fake *FakePipeline) PauseArgsForCall(i int) (string, string) {
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	argsForCall := fake.pauseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakePipeline) PauseReturns(result1 error) {
 	fake.pauseMutex.Lock()
 	defer fake.pauseMutex.Unlock()
@@ -1797,6 +2445,58 @@ func (fake *FakePipeline) PauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) PauseComment() string {
+	fake.pauseCommentMutex.Lock()
+	ret, specificReturn := fake.pauseCommentReturnsOnCall[len(fake.pauseCommentArgsForCall)]
+	fake.pauseCommentArgsForCall = append(fake.pauseCommentArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PauseComment", []interface{}{})
+	fake.pauseCommentMutex.Unlock()
+	if fake.PauseCommentStub != nil {
+		return fake.PauseCommentStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pauseCommentReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) PauseCommentCallCount() int {
+	fake.pauseCommentMutex.RLock()
+	defer fake.pauseCommentMutex.RUnlock()
+	return len(fake.pauseCommentArgsForCall)
+}
+
+func (fake *FakePipeline) PauseCommentCalls(stub func() string) {
+	fake.pauseCommentMutex.Lock()
+	defer fake.pauseCommentMutex.Unlock()
+	fake.PauseCommentStub = stub
+}
+
+func (fake *FakePipeline) PauseCommentReturns(result1 string) {
+	fake.pauseCommentMutex.Lock()
+	defer fake.pauseCommentMutex.Unlock()
+	fake.PauseCommentStub = nil
+	fake.pauseCommentReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePipeline) PauseCommentReturnsOnCall(i int, result1 string) {
+	fake.pauseCommentMutex.Lock()
+	defer fake.pauseCommentMutex.Unlock()
+	fake.PauseCommentStub = nil
+	if fake.pauseCommentReturnsOnCall == nil {
+		fake.pauseCommentReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pauseCommentReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakePipeline) Paused() bool {
 	fake.pausedMutex.Lock()
 	ret, specificReturn := fake.pausedReturnsOnCall[len(fake.pausedArgsForCall)]
@@ -1849,6 +2549,58 @@ func (fake *FakePipeline) PausedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakePipeline) PausedBy() string {
+	fake.pausedByMutex.Lock()
+	ret, specificReturn := fake.pausedByReturnsOnCall[len(fake.pausedByArgsForCall)]
+	fake.pausedByArgsForCall = append(fake.pausedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PausedBy", []interface{}{})
+	fake.pausedByMutex.Unlock()
+	if fake.PausedByStub != nil {
+		return fake.PausedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pausedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) PausedByCallCount() int {
+	fake.pausedByMutex.RLock()
+	defer fake.pausedByMutex.RUnlock()
+	return len(fake.pausedByArgsForCall)
+}
+
+func (fake *FakePipeline) PausedByCalls(stub func() string) {
+	fake.pausedByMutex.Lock()
+	defer fake.pausedByMutex.Unlock()
+	fake.PausedByStub = stub
+}
+
+func (fake *FakePipeline) PausedByReturns(result1 string) {
+	fake.pausedByMutex.Lock()
+	defer fake.pausedByMutex.Unlock()
+	fake.PausedByStub = nil
+	fake.pausedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePipeline) PausedByReturnsOnCall(i int, result1 string) {
+	fake.pausedByMutex.Lock()
+	defer fake.pausedByMutex.Unlock()
+	fake.PausedByStub = nil
+	if fake.pausedByReturnsOnCall == nil {
+		fake.pausedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pausedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakePipeline) Public() bool {
 	fake.publicMutex.Lock()
 	ret, specificReturn := fake.publicReturnsOnCall[len(fake.publicArgsForCall)]
@@ -2612,11 +3364,181 @@ func (fake *FakePipeline) UnpauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) VarSources() atc.VarSourceConfigs {
+	fake.varSourcesMutex.Lock()
+	ret, specificReturn := fake.varSourcesReturnsOnCall[len(fake.varSourcesArgsForCall)]
+	fake.varSourcesArgsForCall = append(fake.varSourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VarSources", []interface{}{})
+	fake.varSourcesMutex.Unlock()
+	if fake.VarSourcesStub != nil {
+		return fake.VarSourcesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.varSourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) VarSourcesCallCount() int {
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
+	return len(fake.varSourcesArgsForCall)
+}
+
+func (fake *FakePipeline) VarSourcesCalls(stub func() atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = stub
+}
+
+func (fake *FakePipeline) VarSourcesReturns(result1 atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	fake.varSourcesReturns = struct {
+		result1 atc.VarSourceConfigs
+	}{result1}
+}
+
+func (fake *FakePipeline) VarSourcesReturnsOnCall(i int, result1 atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	if fake.varSourcesReturnsOnCall == nil {
+		fake.varSourcesReturnsOnCall = make(map[int]struct {
+			result1 atc.VarSourceConfigs
+		})
+	}
+	fake.varSourcesReturnsOnCall[i] = struct {
+		result1 atc.VarSourceConfigs
+	}{result1}
+}
+
+func (fake *FakePipeline) Variables(arg1 lager.Logger, arg2 creds.Secrets, arg3 creds.VarSourcePool) vars.Variables {
+	fake.variablesMutex.Lock()
+	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
+	fake.variablesArgsForCall = append(fake.variablesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 creds.Secrets
+		arg3 creds.VarSourcePool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Variables", []interface{}{arg1, arg2, arg3})
+	fake.variablesMutex.Unlock()
+	if fake.VariablesStub != nil {
+		return fake.VariablesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.variablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) VariablesCallCount() int {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	return len(fake.variablesArgsForCall)
+}
+
+func (fake *FakePipeline) VariablesCalls(stub func(lager.Logger, creds.Secrets, creds.VarSourcePool) vars.Variables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = stub
+}
+
+func (fake *FakePipeline) VariablesArgsForCall(i int) (lager.Logger, creds.Secrets, creds.VarSourcePool) {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	argsForCall := fake.variablesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePipeline) VariablesReturns(result1 vars.Variables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	fake.variablesReturns = struct {
+		result1 vars.Variables
+	}{result1}
+}
+
+func (fake *FakePipeline) VariablesReturnsOnCall(i int, result1 vars.Variables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	if fake.variablesReturnsOnCall == nil {
+		fake.variablesReturnsOnCall = make(map[int]struct {
+			result1 vars.Variables
+		})
+	}
+	fake.variablesReturnsOnCall[i] = struct {
+		result1 vars.Variables
+	}{result1}
+}
+
+func (fake *FakePipeline) Vars() map[string]interface{} {
+	fake.varsMutex.Lock()
+	ret, specificReturn := fake.varsReturnsOnCall[len(fake.varsArgsForCall)]
+	fake.varsArgsForCall = append(fake.varsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Vars", []interface{}{})
+	fake.varsMutex.Unlock()
+	if fake.VarsStub != nil {
+		return fake.VarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.varsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) VarsCallCount() int {
+	fake.varsMutex.RLock()
+	defer fake.varsMutex.RUnlock()
+	return len(fake.varsArgsForCall)
+}
+
+func (fake *FakePipeline) VarsCalls(stub func() map[string]interface{}) {
+	fake.varsMutex.Lock()
+	defer fake.varsMutex.Unlock()
+	fake.VarsStub = stub
+}
+
+func (fake *FakePipeline) VarsReturns(result1 map[string]interface{}) {
+	fake.varsMutex.Lock()
+	defer fake.varsMutex.Unlock()
+	fake.VarsStub = nil
+	fake.varsReturns = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
+func (fake *FakePipeline) VarsReturnsOnCall(i int, result1 map[string]interface{}) {
+	fake.varsMutex.Lock()
+	defer fake.varsMutex.Unlock()
+	fake.VarsStub = nil
+	if fake.varsReturnsOnCall == nil {
+		fake.varsReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+		})
+	}
+	fake.varsReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
 func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.acquireSchedulingLockMutex.RLock()
 	defer fake.acquireSchedulingLockMutex.RUnlock()
+	fake.archiveMutex.RLock()
+	defer fake.archiveMutex.RUnlock()
+	fake.archivedMutex.RLock()
+	defer fake.archivedMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithTimeMutex.RLock()
@@ -2627,6 +3549,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.checkPausedMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
@@ -2637,8 +3561,14 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.deleteBuildEventsByBuildIDsMutex.RUnlock()
 	fake.destroyMutex.RLock()
 	defer fake.destroyMutex.RUnlock()
+	fake.displayMutex.RLock()
+	defer fake.displayMutex.RUnlock()
 	fake.exposeMutex.RLock()
 	defer fake.exposeMutex.RUnlock()
+	fake.findConfigVersionMutex.RLock()
+	defer fake.findConfigVersionMutex.RUnlock()
+	fake.freezeWindowsMutex.RLock()
+	defer fake.freezeWindowsMutex.RUnlock()
 	fake.getAllPendingBuildsMutex.RLock()
 	defer fake.getAllPendingBuildsMutex.RUnlock()
 	fake.getBuildsWithVersionAsInputMutex.RLock()
@@ -2651,6 +3581,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.hideMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobsMutex.RLock()
@@ -2659,10 +3591,18 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.loadVersionsDBMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
+	fake.parentJobIDMutex.RLock()
+	defer fake.parentJobIDMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
+	fake.pauseCommentMutex.RLock()
+	defer fake.pauseCommentMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
+	fake.pausedByMutex.RLock()
+	defer fake.pausedByMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -2689,6 +3629,12 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	fake.varsMutex.RLock()
+	defer fake.varsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -40,6 +40,16 @@ type FakeResource struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CheckIntervalStub        func() time.Duration
+	checkIntervalMutex       sync.RWMutex
+	checkIntervalArgsForCall []struct {
+	}
+	checkIntervalReturns struct {
+		result1 time.Duration
+	}
+	checkIntervalReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	CheckSetupErrorStub        func() error
 	checkSetupErrorMutex       sync.RWMutex
 	checkSetupErrorArgsForCall []struct {
@@ -70,6 +80,16 @@ type FakeResource struct {
 	configPinnedVersionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	ConsecutiveCheckErrorsStub        func() int
+	consecutiveCheckErrorsMutex       sync.RWMutex
+	consecutiveCheckErrorsArgsForCall []struct {
+	}
+	consecutiveCheckErrorsReturns struct {
+		result1 int
+	}
+	consecutiveCheckErrorsReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CurrentPinnedVersionStub        func() atc.Version
 	currentPinnedVersionMutex       sync.RWMutex
 	currentPinnedVersionArgsForCall []struct {
@@ -142,6 +162,16 @@ type FakeResource struct {
 	lastCheckStartTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LatestCheckIDStub        func() int
+	latestCheckIDMutex       sync.RWMutex
+	latestCheckIDArgsForCall []struct {
+	}
+	latestCheckIDReturns struct {
+		result1 int
+	}
+	latestCheckIDReturnsOnCall map[int]struct {
+		result1 int
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -152,16 +182,6 @@ type FakeResource struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
-	NotifyScanStub        func() error
-	notifyScanMutex       sync.RWMutex
-	notifyScanArgsForCall []struct {
-	}
-	notifyScanReturns struct {
-		result1 error
-	}
-	notifyScanReturnsOnCall map[int]struct {
-		result1 error
-	}
 	PinCommentStub        func() string
 	pinCommentMutex       sync.RWMutex
 	pinCommentArgsForCall []struct {
@@ -193,6 +213,16 @@ type FakeResource struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -394,6 +424,16 @@ type FakeResource struct {
 		result3 bool
 		result4 error
 	}
+	WebhookFilterStub        func() *atc.WebhookFilter
+	webhookFilterMutex       sync.RWMutex
+	webhookFilterArgsForCall []struct {
+	}
+	webhookFilterReturns struct {
+		result1 *atc.WebhookFilter
+	}
+	webhookFilterReturnsOnCall map[int]struct {
+		result1 *atc.WebhookFilter
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -564,6 +604,58 @@ func (fake *FakeResource) CheckEveryReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) CheckInterval() time.Duration {
+	fake.checkIntervalMutex.Lock()
+	ret, specificReturn := fake.checkIntervalReturnsOnCall[len(fake.checkIntervalArgsForCall)]
+	fake.checkIntervalArgsForCall = append(fake.checkIntervalArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckInterval", []interface{}{})
+	fake.checkIntervalMutex.Unlock()
+	if fake.CheckIntervalStub != nil {
+		return fake.CheckIntervalStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkIntervalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CheckIntervalCallCount() int {
+	fake.checkIntervalMutex.RLock()
+	defer fake.checkIntervalMutex.RUnlock()
+	return len(fake.checkIntervalArgsForCall)
+}
+
+func (fake *FakeResource) CheckIntervalCalls(stub func() time.Duration) {
+	fake.checkIntervalMutex.Lock()
+	defer fake.checkIntervalMutex.Unlock()
+	fake.CheckIntervalStub = stub
+}
+
+func (fake *FakeResource) CheckIntervalReturns(result1 time.Duration) {
+	fake.checkIntervalMutex.Lock()
+	defer fake.checkIntervalMutex.Unlock()
+	fake.CheckIntervalStub = nil
+	fake.checkIntervalReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeResource) CheckIntervalReturnsOnCall(i int, result1 time.Duration) {
+	fake.checkIntervalMutex.Lock()
+	defer fake.checkIntervalMutex.Unlock()
+	fake.CheckIntervalStub = nil
+	if fake.checkIntervalReturnsOnCall == nil {
+		fake.checkIntervalReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.checkIntervalReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeResource) CheckSetupError() error {
 	fake.checkSetupErrorMutex.Lock()
 	ret, specificReturn := fake.checkSetupErrorReturnsOnCall[len(fake.checkSetupErrorArgsForCall)]
@@ -720,6 +812,58 @@ func (fake *FakeResource) ConfigPinnedVersionReturnsOnCall(i int, result1 atc.Ve
 	}{result1}
 }
 
+func (fake *FakeResource) ConsecutiveCheckErrors() int {
+	fake.consecutiveCheckErrorsMutex.Lock()
+	ret, specificReturn := fake.consecutiveCheckErrorsReturnsOnCall[len(fake.consecutiveCheckErrorsArgsForCall)]
+	fake.consecutiveCheckErrorsArgsForCall = append(fake.consecutiveCheckErrorsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConsecutiveCheckErrors", []interface{}{})
+	fake.consecutiveCheckErrorsMutex.Unlock()
+	if fake.ConsecutiveCheckErrorsStub != nil {
+		return fake.ConsecutiveCheckErrorsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.consecutiveCheckErrorsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ConsecutiveCheckErrorsCallCount() int {
+	fake.consecutiveCheckErrorsMutex.RLock()
+	defer fake.consecutiveCheckErrorsMutex.RUnlock()
+	return len(fake.consecutiveCheckErrorsArgsForCall)
+}
+
+func (fake *FakeResource) ConsecutiveCheckErrorsCalls(stub func() int) {
+	fake.consecutiveCheckErrorsMutex.Lock()
+	defer fake.consecutiveCheckErrorsMutex.Unlock()
+	fake.ConsecutiveCheckErrorsStub = stub
+}
+
+func (fake *FakeResource) ConsecutiveCheckErrorsReturns(result1 int) {
+	fake.consecutiveCheckErrorsMutex.Lock()
+	defer fake.consecutiveCheckErrorsMutex.Unlock()
+	fake.ConsecutiveCheckErrorsStub = nil
+	fake.consecutiveCheckErrorsReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) ConsecutiveCheckErrorsReturnsOnCall(i int, result1 int) {
+	fake.consecutiveCheckErrorsMutex.Lock()
+	defer fake.consecutiveCheckErrorsMutex.Unlock()
+	fake.ConsecutiveCheckErrorsStub = nil
+	if fake.consecutiveCheckErrorsReturnsOnCall == nil {
+		fake.consecutiveCheckErrorsReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.consecutiveCheckErrorsReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) CurrentPinnedVersion() atc.Version {
 	fake.currentPinnedVersionMutex.Lock()
 	ret, specificReturn := fake.currentPinnedVersionReturnsOnCall[len(fake.currentPinnedVersionArgsForCall)]
@@ -1100,6 +1244,58 @@ func (fake *FakeResource) LastCheckStartTimeReturnsOnCall(i int, result1 time.Ti
 	}{result1}
 }
 
+func (fake *FakeResource) LatestCheckID() int {
+	fake.latestCheckIDMutex.Lock()
+	ret, specificReturn := fake.latestCheckIDReturnsOnCall[len(fake.latestCheckIDArgsForCall)]
+	fake.latestCheckIDArgsForCall = append(fake.latestCheckIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LatestCheckID", []interface{}{})
+	fake.latestCheckIDMutex.Unlock()
+	if fake.LatestCheckIDStub != nil {
+		return fake.LatestCheckIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.latestCheckIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) LatestCheckIDCallCount() int {
+	fake.latestCheckIDMutex.RLock()
+	defer fake.latestCheckIDMutex.RUnlock()
+	return len(fake.latestCheckIDArgsForCall)
+}
+
+func (fake *FakeResource) LatestCheckIDCalls(stub func() int) {
+	fake.latestCheckIDMutex.Lock()
+	defer fake.latestCheckIDMutex.Unlock()
+	fake.LatestCheckIDStub = stub
+}
+
+func (fake *FakeResource) LatestCheckIDReturns(result1 int) {
+	fake.latestCheckIDMutex.Lock()
+	defer fake.latestCheckIDMutex.Unlock()
+	fake.LatestCheckIDStub = nil
+	fake.latestCheckIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) LatestCheckIDReturnsOnCall(i int, result1 int) {
+	fake.latestCheckIDMutex.Lock()
+	defer fake.latestCheckIDMutex.Unlock()
+	fake.LatestCheckIDStub = nil
+	if fake.latestCheckIDReturnsOnCall == nil {
+		fake.latestCheckIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.latestCheckIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1152,58 +1348,6 @@ func (fake *FakeResource) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeResource) NotifyScan() error {
-	fake.notifyScanMutex.Lock()
-	ret, specificReturn := fake.notifyScanReturnsOnCall[len(fake.notifyScanArgsForCall)]
-	fake.notifyScanArgsForCall = append(fake.notifyScanArgsForCall, struct {
-	}{})
-	fake.recordInvocation("NotifyScan", []interface{}{})
-	fake.notifyScanMutex.Unlock()
-	if fake.NotifyScanStub != nil {
-		return fake.NotifyScanStub()
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	fakeReturns := fake.notifyScanReturns
-	return fakeReturns.result1
-}
-
-func (fake *FakeResource) NotifyScanCallCount() int {
-	fake.notifyScanMutex.RLock()
-	defer fake.notifyScanMutex.RUnlock()
-	return len(fake.notifyScanArgsForCall)
-}
-
-func (fake *FakeResource) NotifyScanCalls(stub func() error) {
-	fake.notifyScanMutex.Lock()
-	defer fake.notifyScanMutex.Unlock()
-	fake.NotifyScanStub = stub
-}
-
-func (fake *FakeResource) NotifyScanReturns(result1 error) {
-	fake.notifyScanMutex.Lock()
-	defer fake.notifyScanMutex.Unlock()
-	fake.NotifyScanStub = nil
-	fake.notifyScanReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeResource) NotifyScanReturnsOnCall(i int, result1 error) {
-	fake.notifyScanMutex.Lock()
-	defer fake.notifyScanMutex.Unlock()
-	fake.NotifyScanStub = nil
-	if fake.notifyScanReturnsOnCall == nil {
-		fake.notifyScanReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.notifyScanReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
 func (fake *FakeResource) PinComment() string {
 	fake.pinCommentMutex.Lock()
 	ret, specificReturn := fake.pinCommentReturnsOnCall[len(fake.pinCommentArgsForCall)]
@@ -1368,6 +1512,58 @@ func (fake *FakeResource) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeResource) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeResource) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeResource) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeResource) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeResource) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -2341,6 +2537,58 @@ func (fake *FakeResource) VersionsReturnsOnCall(i int, result1 []atc.ResourceVer
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeResource) WebhookFilter() *atc.WebhookFilter {
+	fake.webhookFilterMutex.Lock()
+	ret, specificReturn := fake.webhookFilterReturnsOnCall[len(fake.webhookFilterArgsForCall)]
+	fake.webhookFilterArgsForCall = append(fake.webhookFilterArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WebhookFilter", []interface{}{})
+	fake.webhookFilterMutex.Unlock()
+	if fake.WebhookFilterStub != nil {
+		return fake.WebhookFilterStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookFilterReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookFilterCallCount() int {
+	fake.webhookFilterMutex.RLock()
+	defer fake.webhookFilterMutex.RUnlock()
+	return len(fake.webhookFilterArgsForCall)
+}
+
+func (fake *FakeResource) WebhookFilterCalls(stub func() *atc.WebhookFilter) {
+	fake.webhookFilterMutex.Lock()
+	defer fake.webhookFilterMutex.Unlock()
+	fake.WebhookFilterStub = stub
+}
+
+func (fake *FakeResource) WebhookFilterReturns(result1 *atc.WebhookFilter) {
+	fake.webhookFilterMutex.Lock()
+	defer fake.webhookFilterMutex.Unlock()
+	fake.WebhookFilterStub = nil
+	fake.webhookFilterReturns = struct {
+		result1 *atc.WebhookFilter
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookFilterReturnsOnCall(i int, result1 *atc.WebhookFilter) {
+	fake.webhookFilterMutex.Lock()
+	defer fake.webhookFilterMutex.Unlock()
+	fake.WebhookFilterStub = nil
+	if fake.webhookFilterReturnsOnCall == nil {
+		fake.webhookFilterReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookFilter
+		})
+	}
+	fake.webhookFilterReturnsOnCall[i] = struct {
+		result1 *atc.WebhookFilter
+	}{result1}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -2402,12 +2650,16 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkIntervalMutex.RLock()
+	defer fake.checkIntervalMutex.RUnlock()
 	fake.checkSetupErrorMutex.RLock()
 	defer fake.checkSetupErrorMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
 	defer fake.checkTimeoutMutex.RUnlock()
 	fake.configPinnedVersionMutex.RLock()
 	defer fake.configPinnedVersionMutex.RUnlock()
+	fake.consecutiveCheckErrorsMutex.RLock()
+	defer fake.consecutiveCheckErrorsMutex.RUnlock()
 	fake.currentPinnedVersionMutex.RLock()
 	defer fake.currentPinnedVersionMutex.RUnlock()
 	fake.disableVersionMutex.RLock()
@@ -2422,16 +2674,18 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.lastCheckEndTimeMutex.RUnlock()
 	fake.lastCheckStartTimeMutex.RLock()
 	defer fake.lastCheckStartTimeMutex.RUnlock()
+	fake.latestCheckIDMutex.RLock()
+	defer fake.latestCheckIDMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
-	fake.notifyScanMutex.RLock()
-	defer fake.notifyScanMutex.RUnlock()
 	fake.pinCommentMutex.RLock()
 	defer fake.pinCommentMutex.RUnlock()
 	fake.pinVersionMutex.RLock()
 	defer fake.pinVersionMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.publicMutex.RLock()
@@ -2466,6 +2720,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.updateMetadataMutex.RUnlock()
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
+	fake.webhookFilterMutex.RLock()
+	defer fake.webhookFilterMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -108,12 +109,14 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FindCheckContainersStub        func(string, string, creds.Secrets) ([]db.Container, map[int]time.Time, error)
+	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
-		arg1 string
-		arg2 string
-		arg3 creds.Secrets
+		arg1 lager.Logger
+		arg2 atc.PipelineRef
+		arg3 string
+		arg4 creds.Secrets
+		arg5 creds.VarSourcePool
 	}
 	findCheckContainersReturns struct {
 		result1 []db.Container
@@ -271,10 +274,10 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PipelineStub        func(string) (db.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 db.Pipeline
@@ -336,13 +339,14 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
+		arg5 string
 	}
 	savePipelineReturns struct {
 		result1 db.Pipeline
@@ -354,6 +358,26 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	SavePipelineFromBuildStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, int, int, string) (db.Pipeline, bool, error)
+	savePipelineFromBuildMutex       sync.RWMutex
+	savePipelineFromBuildArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 int
+		arg5 int
+		arg6 string
+	}
+	savePipelineFromBuildReturns struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	savePipelineFromBuildReturnsOnCall map[int]struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
 	SaveWorkerStub        func(atc.Worker, time.Duration) (db.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -379,6 +403,19 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidatePassedJobsStub        func(atc.Config) ([]string, error)
+	validatePassedJobsMutex       sync.RWMutex
+	validatePassedJobsArgsForCall []struct {
+		arg1 atc.Config
+	}
+	validatePassedJobsReturns struct {
+		result1 []string
+		result2 error
+	}
+	validatePassedJobsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	VisiblePipelinesStub        func() ([]db.Pipeline, error)
 	visiblePipelinesMutex       sync.RWMutex
 	visiblePipelinesArgsForCall []struct {
@@ -868,18 +905,20 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) FindCheckContainers(arg1 string, arg2 string, arg3 creds.Secrets) ([]db.Container, map[int]time.Time, error) {
+func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
 	fake.findCheckContainersArgsForCall = append(fake.findCheckContainersArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 creds.Secrets
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("FindCheckContainers", []interface{}{arg1, arg2, arg3})
+		arg1 lager.Logger
+		arg2 atc.PipelineRef
+		arg3 string
+		arg4 creds.Secrets
+		arg5 creds.VarSourcePool
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("FindCheckContainers", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.findCheckContainersMutex.Unlock()
 	if fake.FindCheckContainersStub != nil {
-		return fake.FindCheckContainersStub(arg1, arg2, arg3)
+		return fake.FindCheckContainersStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -894,17 +933,17 @@ func (fake *FakeTeam) FindCheckContainersCallCount() int {
 	return len(fake.findCheckContainersArgsForCall)
 }
 
-func (fake *FakeTeam) FindCheckContainersCalls(stub func(string, string, creds.Secrets) ([]db.Container, map[int]time.Time, error)) {
+func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
 	fake.findCheckContainersMutex.Lock()
 	defer fake.findCheckContainersMutex.Unlock()
 	fake.FindCheckContainersStub = stub
 }
 
-func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (string, string, creds.Secrets) {
+func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) {
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	argsForCall := fake.findCheckContainersArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) FindCheckContainersReturns(result1 []db.Container, result2 map[int]time.Time, result3 error) {
@@ -1625,11 +1664,11 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -1649,13 +1688,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (db.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -1927,19 +1966,20 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool, arg5 string) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.savePipelineMutex.Unlock()
 	if fake.SavePipelineStub != nil {
-		return fake.SavePipelineStub(arg1, arg2, arg3, arg4)
+		return fake.SavePipelineStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -1954,17 +1994,17 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
@@ -1996,6 +2036,77 @@ func (fake *FakeTeam) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) SavePipelineFromBuild(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 int, arg5 int, arg6 string) (db.Pipeline, bool, error) {
+	fake.savePipelineFromBuildMutex.Lock()
+	ret, specificReturn := fake.savePipelineFromBuildReturnsOnCall[len(fake.savePipelineFromBuildArgsForCall)]
+	fake.savePipelineFromBuildArgsForCall = append(fake.savePipelineFromBuildArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 int
+		arg5 int
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("SavePipelineFromBuild", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.savePipelineFromBuildMutex.Unlock()
+	if fake.SavePipelineFromBuildStub != nil {
+		return fake.SavePipelineFromBuildStub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.savePipelineFromBuildReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SavePipelineFromBuildCallCount() int {
+	fake.savePipelineFromBuildMutex.RLock()
+	defer fake.savePipelineFromBuildMutex.RUnlock()
+	return len(fake.savePipelineFromBuildArgsForCall)
+}
+
+func (fake *FakeTeam) SavePipelineFromBuildCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, int, int, string) (db.Pipeline, bool, error)) {
+	fake.savePipelineFromBuildMutex.Lock()
+	defer fake.savePipelineFromBuildMutex.Unlock()
+	fake.SavePipelineFromBuildStub = stub
+}
+
+func (fake *FakeTeam) SavePipelineFromBuildArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, int, int, string) {
+	fake.savePipelineFromBuildMutex.RLock()
+	defer fake.savePipelineFromBuildMutex.RUnlock()
+	argsForCall := fake.savePipelineFromBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeTeam) SavePipelineFromBuildReturns(result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineFromBuildMutex.Lock()
+	defer fake.savePipelineFromBuildMutex.Unlock()
+	fake.SavePipelineFromBuildStub = nil
+	fake.savePipelineFromBuildReturns = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SavePipelineFromBuildReturnsOnCall(i int, result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineFromBuildMutex.Lock()
+	defer fake.savePipelineFromBuildMutex.Unlock()
+	fake.SavePipelineFromBuildStub = nil
+	if fake.savePipelineFromBuildReturnsOnCall == nil {
+		fake.savePipelineFromBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.savePipelineFromBuildReturnsOnCall[i] = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) SaveWorker(arg1 atc.Worker, arg2 time.Duration) (db.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -2120,6 +2231,69 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) ValidatePassedJobs(arg1 atc.Config) ([]string, error) {
+	fake.validatePassedJobsMutex.Lock()
+	ret, specificReturn := fake.validatePassedJobsReturnsOnCall[len(fake.validatePassedJobsArgsForCall)]
+	fake.validatePassedJobsArgsForCall = append(fake.validatePassedJobsArgsForCall, struct {
+		arg1 atc.Config
+	}{arg1})
+	fake.recordInvocation("ValidatePassedJobs", []interface{}{arg1})
+	fake.validatePassedJobsMutex.Unlock()
+	if fake.ValidatePassedJobsStub != nil {
+		return fake.ValidatePassedJobsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.validatePassedJobsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ValidatePassedJobsCallCount() int {
+	fake.validatePassedJobsMutex.RLock()
+	defer fake.validatePassedJobsMutex.RUnlock()
+	return len(fake.validatePassedJobsArgsForCall)
+}
+
+func (fake *FakeTeam) ValidatePassedJobsCalls(stub func(atc.Config) ([]string, error)) {
+	fake.validatePassedJobsMutex.Lock()
+	defer fake.validatePassedJobsMutex.Unlock()
+	fake.ValidatePassedJobsStub = stub
+}
+
+func (fake *FakeTeam) ValidatePassedJobsArgsForCall(i int) atc.Config {
+	fake.validatePassedJobsMutex.RLock()
+	defer fake.validatePassedJobsMutex.RUnlock()
+	argsForCall := fake.validatePassedJobsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ValidatePassedJobsReturns(result1 []string, result2 error) {
+	fake.validatePassedJobsMutex.Lock()
+	defer fake.validatePassedJobsMutex.Unlock()
+	fake.ValidatePassedJobsStub = nil
+	fake.validatePassedJobsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ValidatePassedJobsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.validatePassedJobsMutex.Lock()
+	defer fake.validatePassedJobsMutex.Unlock()
+	fake.ValidatePassedJobsStub = nil
+	if fake.validatePassedJobsReturnsOnCall == nil {
+		fake.validatePassedJobsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.validatePassedJobsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) VisiblePipelines() ([]db.Pipeline, error) {
 	fake.visiblePipelinesMutex.Lock()
 	ret, specificReturn := fake.visiblePipelinesReturnsOnCall[len(fake.visiblePipelinesArgsForCall)]
@@ -2283,12 +2457,16 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.publicPipelinesMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
+	fake.savePipelineFromBuildMutex.RLock()
+	defer fake.savePipelineFromBuildMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.validatePassedJobsMutex.RLock()
+	defer fake.validatePassedJobsMutex.RUnlock()
 	fake.visiblePipelinesMutex.RLock()
 	defer fake.visiblePipelinesMutex.RUnlock()
 	fake.workersMutex.RLock()
@@ -42,6 +42,20 @@ type FakeWorker struct {
 	activeVolumesReturnsOnCall map[int]struct {
 		result1 int
 	}
+	AllocatedStub        func() (uint64, uint64, error)
+	allocatedMutex       sync.RWMutex
+	allocatedArgsForCall []struct {
+	}
+	allocatedReturns struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
+	allocatedReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
 	BaggageclaimURLStub        func() *string
 	baggageclaimURLMutex       sync.RWMutex
 	baggageclaimURLArgsForCall []struct {
@@ -52,6 +66,16 @@ type FakeWorker struct {
 	baggageclaimURLReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	CapacityStub        func() atc.ContainerLimits
+	capacityMutex       sync.RWMutex
+	capacityArgsForCall []struct {
+	}
+	capacityReturns struct {
+		result1 atc.ContainerLimits
+	}
+	capacityReturnsOnCall map[int]struct {
+		result1 atc.ContainerLimits
+	}
 	CertsPathStub        func() *string
 	certsPathMutex       sync.RWMutex
 	certsPathArgsForCall []struct {
@@ -161,6 +185,16 @@ type FakeWorker struct {
 	hTTPSProxyURLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	HermeticStub        func() bool
+	hermeticMutex       sync.RWMutex
+	hermeticArgsForCall []struct {
+	}
+	hermeticReturns struct {
+		result1 bool
+	}
+	hermeticReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IncreaseActiveTasksStub        func() error
 	increaseActiveTasksMutex       sync.RWMutex
 	increaseActiveTasksArgsForCall []struct {
@@ -490,6 +524,64 @@ func (fake *FakeWorker) ActiveVolumesReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Allocated() (uint64, uint64, error) {
+	fake.allocatedMutex.Lock()
+	ret, specificReturn := fake.allocatedReturnsOnCall[len(fake.allocatedArgsForCall)]
+	fake.allocatedArgsForCall = append(fake.allocatedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Allocated", []interface{}{})
+	fake.allocatedMutex.Unlock()
+	if fake.AllocatedStub != nil {
+		return fake.AllocatedStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.allocatedReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) AllocatedCallCount() int {
+	fake.allocatedMutex.RLock()
+	defer fake.allocatedMutex.RUnlock()
+	return len(fake.allocatedArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatedCalls(stub func() (uint64, uint64, error)) {
+	fake.allocatedMutex.Lock()
+	defer fake.allocatedMutex.Unlock()
+	fake.AllocatedStub = stub
+}
+
+func (fake *FakeWorker) AllocatedReturns(result1 uint64, result2 uint64, result3 error) {
+	fake.allocatedMutex.Lock()
+	defer fake.allocatedMutex.Unlock()
+	fake.AllocatedStub = nil
+	fake.allocatedReturns = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) AllocatedReturnsOnCall(i int, result1 uint64, result2 uint64, result3 error) {
+	fake.allocatedMutex.Lock()
+	defer fake.allocatedMutex.Unlock()
+	fake.AllocatedStub = nil
+	if fake.allocatedReturnsOnCall == nil {
+		fake.allocatedReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.allocatedReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) BaggageclaimURL() *string {
 	fake.baggageclaimURLMutex.Lock()
 	ret, specificReturn := fake.baggageclaimURLReturnsOnCall[len(fake.baggageclaimURLArgsForCall)]
@@ -542,6 +634,58 @@ func (fake *FakeWorker) BaggageclaimURLReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Capacity() atc.ContainerLimits {
+	fake.capacityMutex.Lock()
+	ret, specificReturn := fake.capacityReturnsOnCall[len(fake.capacityArgsForCall)]
+	fake.capacityArgsForCall = append(fake.capacityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Capacity", []interface{}{})
+	fake.capacityMutex.Unlock()
+	if fake.CapacityStub != nil {
+		return fake.CapacityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.capacityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CapacityCallCount() int {
+	fake.capacityMutex.RLock()
+	defer fake.capacityMutex.RUnlock()
+	return len(fake.capacityArgsForCall)
+}
+
+func (fake *FakeWorker) CapacityCalls(stub func() atc.ContainerLimits) {
+	fake.capacityMutex.Lock()
+	defer fake.capacityMutex.Unlock()
+	fake.CapacityStub = stub
+}
+
+func (fake *FakeWorker) CapacityReturns(result1 atc.ContainerLimits) {
+	fake.capacityMutex.Lock()
+	defer fake.capacityMutex.Unlock()
+	fake.CapacityStub = nil
+	fake.capacityReturns = struct {
+		result1 atc.ContainerLimits
+	}{result1}
+}
+
+func (fake *FakeWorker) CapacityReturnsOnCall(i int, result1 atc.ContainerLimits) {
+	fake.capacityMutex.Lock()
+	defer fake.capacityMutex.Unlock()
+	fake.CapacityStub = nil
+	if fake.capacityReturnsOnCall == nil {
+		fake.capacityReturnsOnCall = make(map[int]struct {
+			result1 atc.ContainerLimits
+		})
+	}
+	fake.capacityReturnsOnCall[i] = struct {
+		result1 atc.ContainerLimits
+	}{result1}
+}
+
 func (fake *FakeWorker) CertsPath() *string {
 	fake.certsPathMutex.Lock()
 	ret, specificReturn := fake.certsPathReturnsOnCall[len(fake.certsPathArgsForCall)]
@@ -1088,6 +1232,58 @@ func (fake *FakeWorker) HTTPSProxyURLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Hermetic() bool {
+	fake.hermeticMutex.Lock()
+	ret, specificReturn := fake.hermeticReturnsOnCall[len(fake.hermeticArgsForCall)]
+	fake.hermeticArgsForCall = append(fake.hermeticArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Hermetic", []interface{}{})
+	fake.hermeticMutex.Unlock()
+	if fake.HermeticStub != nil {
+		return fake.HermeticStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.hermeticReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) HermeticCallCount() int {
+	fake.hermeticMutex.RLock()
+	defer fake.hermeticMutex.RUnlock()
+	return len(fake.hermeticArgsForCall)
+}
+
+func (fake *FakeWorker) HermeticCalls(stub func() bool) {
+	fake.hermeticMutex.Lock()
+	defer fake.hermeticMutex.Unlock()
+	fake.HermeticStub = stub
+}
+
+func (fake *FakeWorker) HermeticReturns(result1 bool) {
+	fake.hermeticMutex.Lock()
+	defer fake.hermeticMutex.Unlock()
+	fake.HermeticStub = nil
+	fake.hermeticReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) HermeticReturnsOnCall(i int, result1 bool) {
+	fake.hermeticMutex.Lock()
+	defer fake.hermeticMutex.Unlock()
+	fake.HermeticStub = nil
+	if fake.hermeticReturnsOnCall == nil {
+		fake.hermeticReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.hermeticReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) IncreaseActiveTasks() error {
 	fake.increaseActiveTasksMutex.Lock()
 	ret, specificReturn := fake.increaseActiveTasksReturnsOnCall[len(fake.increaseActiveTasksArgsForCall)]
@@ -1938,8 +2134,12 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeTasksMutex.RUnlock()
 	fake.activeVolumesMutex.RLock()
 	defer fake.activeVolumesMutex.RUnlock()
+	fake.allocatedMutex.RLock()
+	defer fake.allocatedMutex.RUnlock()
 	fake.baggageclaimURLMutex.RLock()
 	defer fake.baggageclaimURLMutex.RUnlock()
+	fake.capacityMutex.RLock()
+	defer fake.capacityMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
 	fake.createContainerMutex.RLock()
@@ -1960,6 +2160,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPProxyURLMutex.RUnlock()
 	fake.hTTPSProxyURLMutex.RLock()
 	defer fake.hTTPSProxyURLMutex.RUnlock()
+	fake.hermeticMutex.RLock()
+	defer fake.hermeticMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
 	fake.landMutex.RLock()
@@ -12,6 +12,9 @@ import (
 var ErrEndOfBuildEventStream = errors.New("end of build event stream")
 var ErrBuildEventStreamClosed = errors.New("build event stream closed")
 
+var ErrEndOfCheckEventStream = errors.New("end of check event stream")
+var ErrCheckEventStreamClosed = errors.New("check event stream closed")
+
 //go:generate counterfeiter . EventSource
 
 type EventSource interface {
@@ -25,12 +28,82 @@ func newBuildEventSource(
 	conn Conn,
 	notifier Notifier,
 	from uint,
-) *buildEventSource {
+) *eventSource {
+	return newEventSource(
+		eventStream{
+			table:  table,
+			column: "build_id",
+			id:     buildID,
+			completed: func() (bool, error) {
+				var completed bool
+				err := conn.QueryRow(`
+					SELECT builds.completed
+					FROM builds
+					WHERE builds.id = $1
+				`, buildID).Scan(&completed)
+				return completed, err
+			},
+			endErr:    ErrEndOfBuildEventStream,
+			closedErr: ErrBuildEventStreamClosed,
+		},
+		conn,
+		notifier,
+		from,
+	)
+}
+
+func newCheckEventSource(
+	checkID int,
+	conn Conn,
+	notifier Notifier,
+	from uint,
+) *eventSource {
+	return newEventSource(
+		eventStream{
+			table:  "check_events",
+			column: "check_id",
+			id:     checkID,
+			completed: func() (bool, error) {
+				var status atc.CheckStatus
+				err := conn.QueryRow(`
+					SELECT checks.status
+					FROM checks
+					WHERE checks.id = $1
+				`, checkID).Scan(&status)
+				return status != atc.CheckStatusPending && status != atc.CheckStatusStarted, err
+			},
+			endErr:    ErrEndOfCheckEventStream,
+			closedErr: ErrCheckEventStreamClosed,
+		},
+		conn,
+		notifier,
+		from,
+	)
+}
+
+// eventStream describes where the events of a build or a check are stored,
+// and how to tell that no more of them are coming.
+type eventStream struct {
+	table  string
+	column string
+	id     int
+
+	completed func() (bool, error)
+
+	endErr    error
+	closedErr error
+}
+
+func newEventSource(
+	stream eventStream,
+	conn Conn,
+	notifier Notifier,
+	from uint,
+) *eventSource {
 	wg := new(sync.WaitGroup)
 
-	source := &buildEventSource{
-		buildID: buildID,
-		table:   table,
+	source := &eventSource{
+		stream: stream,
 
 		conn: conn,
 
@@ -47,9 +120,8 @@ func newBuildEventSource(
 	return source
 }
 
-type buildEventSource struct {
-	buildID int
-	table   string
+type eventSource struct {
+	stream eventStream
 
 	conn     Conn
 	notifier Notifier
@@ -60,7 +132,7 @@ type buildEventSource struct {
 	wg     *sync.WaitGroup
 }
 
-func (source *buildEventSource) Next() (event.Envelope, error) {
+func (source *eventSource) Next() (event.Envelope, error) {
 	e, ok := <-source.events
 	if !ok {
 		return event.Envelope{}, source.err
@@ -69,7 +141,7 @@ func (source *buildEventSource) Next() (event.Envelope, error) {
 	return e, nil
 }
 
-func (source *buildEventSource) Close() error {
+func (source *eventSource) Close() error {
 	select {
 	case <-source.stop:
 		return nil
@@ -82,7 +154,7 @@ func (source *buildEventSource) Close() error {
 	return source.notifier.Close()
 }
 
-func (source *buildEventSource) collectEvents(cursor uint) {
+func (source *eventSource) collectEvents(cursor uint) {
 	defer source.wg.Done()
 
 	var batchSize = cap(source.events)
@@ -90,19 +162,13 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 	for {
 		select {
 		case <-source.stop:
-			source.err = ErrBuildEventStreamClosed
+			source.err = source.stream.closedErr
 			close(source.events)
 			return
 		default:
 		}
 
-		completed := false
-
-		err := source.conn.QueryRow(`
-			SELECT builds.completed
-			FROM builds
-			WHERE builds.id = $1
-		`, source.buildID).Scan(&completed)
+		completed, err := source.stream.completed()
 		if err != nil {
 			source.err = err
 			close(source.events)
@@ -111,12 +177,12 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 
 		rows, err := source.conn.Query(`
 			SELECT type, version, payload
-			FROM `+source.table+`
-			WHERE build_id = $1
+			FROM `+source.stream.table+`
+			WHERE `+source.stream.column+` = $1
 			ORDER BY event_id ASC
 			OFFSET $2
 			LIMIT $3
-		`, source.buildID, cursor, batchSize)
+		`, source.stream.id, cursor, batchSize)
 		if err != nil {
 			source.err = err
 			close(source.events)
@@ -153,7 +219,7 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 			case <-source.stop:
 				_ = rows.Close()
 
-				source.err = ErrBuildEventStreamClosed
+				source.err = source.stream.closedErr
 				close(source.events)
 				return
 			}
@@ -165,7 +231,7 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		}
 
 		if completed {
-			source.err = ErrEndOfBuildEventStream
+			source.err = source.stream.endErr
 			close(source.events)
 			return
 		}
@@ -173,7 +239,7 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		select {
 		case <-source.notifier.Notify():
 		case <-source.stop:
-			source.err = ErrBuildEventStreamClosed
+			source.err = source.stream.closedErr
 			close(source.events)
 			return
 		}
@@ -19,9 +19,12 @@ type Job interface {
 	ID() int
 	Name() string
 	Paused() bool
+	PausedBy() string
+	PauseComment() string
 	FirstLoggedBuildID() int
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	Config() atc.JobConfig
@@ -30,7 +33,7 @@ type Job interface {
 
 	Reload() (bool, error)
 
-	Pause() error
+	Pause(pausedBy string, comment string) error
 	Unpause() error
 
 	CreateBuild() (Build, error)
@@ -58,7 +61,7 @@ type Job interface {
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.paused_by", "j.pause_comment", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -74,17 +77,20 @@ func (e FirstLoggedBuildIDDecreasedError) Error() string {
 }
 
 type job struct {
-	id                 int
-	name               string
-	paused             bool
-	firstLoggedBuildID int
-	pipelineID         int
-	pipelineName       string
-	teamID             int
-	teamName           string
-	config             atc.JobConfig
-	tags               []string
-	hasNewInputs       bool
+	id                   int
+	name                 string
+	paused               bool
+	pausedBy             string
+	pauseComment         string
+	firstLoggedBuildID   int
+	pipelineID           int
+	pipelineName         string
+	pipelineInstanceVars atc.InstanceVars
+	teamID               int
+	teamName             string
+	config               atc.JobConfig
+	tags                 []string
+	hasNewInputs         bool
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -124,18 +130,21 @@ func (jobs Jobs) Configs() atc.JobConfigs {
 	return configs
 }
 
-func (j *job) ID() int                 { return j.id }
-func (j *job) Name() string            { return j.name }
-func (j *job) Paused() bool            { return j.paused }
-func (j *job) FirstLoggedBuildID() int { return j.firstLoggedBuildID }
-func (j *job) PipelineID() int         { return j.pipelineID }
-func (j *job) PipelineName() string    { return j.pipelineName }
-func (j *job) TeamID() int             { return j.teamID }
-func (j *job) TeamName() string        { return j.teamName }
-func (j *job) Config() atc.JobConfig   { return j.config }
-func (j *job) Tags() []string          { return j.tags }
-func (j *job) Public() bool            { return j.Config().Public }
-func (j *job) HasNewInputs() bool      { return j.hasNewInputs }
+func (j *job) ID() int                                { return j.id }
+func (j *job) Name() string                           { return j.name }
+func (j *job) Paused() bool                           { return j.paused }
+func (j *job) PausedBy() string                       { return j.pausedBy }
+func (j *job) PauseComment() string                   { return j.pauseComment }
+func (j *job) FirstLoggedBuildID() int                { return j.firstLoggedBuildID }
+func (j *job) PipelineID() int                        { return j.pipelineID }
+func (j *job) PipelineName() string                   { return j.pipelineName }
+func (j *job) PipelineInstanceVars() atc.InstanceVars { return j.pipelineInstanceVars }
+func (j *job) TeamID() int                            { return j.teamID }
+func (j *job) TeamName() string                       { return j.teamName }
+func (j *job) Config() atc.JobConfig                  { return j.config }
+func (j *job) Tags() []string                         { return j.tags }
+func (j *job) Public() bool                           { return j.Config().Public }
+func (j *job) HasNewInputs() bool                     { return j.hasNewInputs }
 
 func (j *job) Reload() (bool, error) {
 	row := jobsQuery.Where(sq.Eq{"j.id": j.id}).
@@ -153,12 +162,14 @@ func (j *job) Reload() (bool, error) {
 	return true, nil
 }
 
-func (j *job) Pause() error {
-	return j.updatePausedJob(true)
+// Pause stops the job from being scheduled, recording who paused it and why.
+// Either may be empty.
+func (j *job) Pause(pausedBy string, comment string) error {
+	return j.updatePausedJob(true, pausedBy, comment)
 }
 
 func (j *job) Unpause() error {
-	return j.updatePausedJob(false)
+	return j.updatePausedJob(false, "", "")
 }
 
 func (j *job) FinishedAndNextBuild() (Build, Build, error) {
@@ -622,9 +633,11 @@ func (j *job) updateSerialGroups(serialGroups []string) error {
 	return tx.Commit()
 }
 
-func (j *job) updatePausedJob(pause bool) error {
+func (j *job) updatePausedJob(pause bool, pausedBy string, comment string) error {
 	result, err := psql.Update("jobs").
 		Set("paused", pause).
+		Set("paused_by", nullIfEmpty(pausedBy)).
+		Set("pause_comment", nullIfEmpty(comment)).
 		Where(sq.Eq{"id": j.id}).
 		RunWith(j.conn).
 		Exec()
@@ -826,15 +839,27 @@ func (j *job) finishedBuild() (Build, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		configBlob []byte
-		nonce      sql.NullString
+		configBlob             []byte
+		nonce                  sql.NullString
+		pausedBy, pauseComment sql.NullString
+		pipelineInstanceVars   sql.NullString
 	)
 
-	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs)
+	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &pausedBy, &pauseComment, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs)
 	if err != nil {
 		return err
 	}
 
+	j.pausedBy = pausedBy.String
+	j.pauseComment = pauseComment.String
+
+	if pipelineInstanceVars.Valid {
+		err = json.Unmarshal([]byte(pipelineInstanceVars.String), &j.pipelineInstanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	es := j.conn.EncryptionStrategy()
 
 	var noncense *string
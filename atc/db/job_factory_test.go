@@ -16,19 +16,19 @@ var _ = Describe("Job Factory", func() {
 		otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 		Expect(err).NotTo(HaveOccurred())
 
-		publicPipeline, _, err := otherTeam.SavePipeline("public-pipeline", atc.Config{
+		publicPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{Name: "public-pipeline-job"},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(publicPipeline.Expose()).To(Succeed())
 
-		_, _, err = otherTeam.SavePipeline("private-pipeline", atc.Config{
+		_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{Name: "private-pipeline-job"},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -23,7 +23,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -86,7 +86,7 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -128,7 +128,7 @@ var _ = Describe("Job", func() {
 		})
 
 		It("can be paused", func() {
-			err := job.Pause()
+			err := job.Pause("", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			found, err := job.Reload()
@@ -138,8 +138,23 @@ var _ = Describe("Job", func() {
 			Expect(job.Paused()).To(BeTrue())
 		})
 
+		It("records who paused it and why", func() {
+			err := job.Pause("some-user", "waiting on a fix upstream")
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(job.PausedBy()).To(Equal("some-user"))
+			Expect(job.PauseComment()).To(Equal("waiting on a fix upstream"))
+		})
+
 		It("can be unpaused", func() {
-			err := job.Unpause()
+			err := job.Pause("some-user", "waiting on a fix upstream")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = job.Unpause()
 			Expect(err).NotTo(HaveOccurred())
 
 			found, err := job.Reload()
@@ -147,6 +162,8 @@ var _ = Describe("Job", func() {
 			Expect(found).To(BeTrue())
 
 			Expect(job.Paused()).To(BeFalse())
+			Expect(job.PausedBy()).To(BeEmpty())
+			Expect(job.PauseComment()).To(BeEmpty())
 		})
 	})
 
@@ -157,11 +174,11 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -387,7 +404,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -669,7 +686,7 @@ var _ = Describe("Job", func() {
 			Expect(found).To(BeTrue())
 			Expect(build.ID()).To(Equal(buildOne.ID()))
 
-			err = job1.Pause()
+			err = job1.Pause("", "")
 			Expect(err).NotTo(HaveOccurred())
 
 			build, found, err = job1.GetNextPendingBuildBySerialGroup([]string{"serial-group"})
@@ -788,7 +805,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -968,7 +985,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1120,7 +1137,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
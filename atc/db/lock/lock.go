@@ -21,6 +21,7 @@ const (
 	LockTypeContainerCreating
 	LockTypeDatabaseMigration
 	LockTypeActiveTasks
+	LockTypeCheckCreating
 )
 
 var ErrLostLock = errors.New("lock was lost while held, possibly due to connection breakage")
@@ -53,6 +54,10 @@ func NewActiveTasksLockID() LockID {
 	return LockID{LockTypeActiveTasks}
 }
 
+func NewCheckCreatingLockID(target string) LockID {
+	return LockID{LockTypeCheckCreating, lockIDFromString(target)}
+}
+
 //go:generate counterfeiter . LockFactory
 
 type LockFactory interface {
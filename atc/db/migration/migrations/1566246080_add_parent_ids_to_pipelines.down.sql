@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE pipelines
+    DROP COLUMN parent_job_id,
+    DROP COLUMN parent_build_id;
+COMMIT;
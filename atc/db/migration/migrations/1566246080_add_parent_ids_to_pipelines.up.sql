@@ -0,0 +1,15 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN parent_job_id integer,
+    ADD COLUMN parent_build_id integer;
+
+  ALTER TABLE pipelines
+    ADD CONSTRAINT pipelines_parent_job_id_fkey FOREIGN KEY (parent_job_id) REFERENCES jobs(id) ON DELETE SET NULL;
+
+  ALTER TABLE pipelines
+    ADD CONSTRAINT pipelines_parent_build_id_fkey FOREIGN KEY (parent_build_id) REFERENCES builds(id) ON DELETE SET NULL;
+
+  CREATE INDEX pipelines_parent_job_id_idx ON pipelines (parent_job_id);
+
+  CREATE INDEX pipelines_parent_build_id_idx ON pipelines (parent_build_id);
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+  CREATE TABLE build_attempts (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    plan_id text NOT NULL,
+    attempt integer NOT NULL,
+    status text NOT NULL,
+    error text NOT NULL DEFAULT '',
+    start_time timestamp with time zone NOT NULL,
+    end_time timestamp with time zone NOT NULL,
+    PRIMARY KEY (build_id, plan_id, attempt)
+  );
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE task_output_caches;
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+  CREATE TABLE task_output_caches (
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    step_name text NOT NULL,
+    cache_key text NOT NULL,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    outputs jsonb NOT NULL,
+    PRIMARY KEY (job_id, step_name)
+  );
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers DROP COLUMN hermetic;
+COMMIT;
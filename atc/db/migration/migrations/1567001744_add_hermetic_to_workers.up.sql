@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers ADD COLUMN hermetic boolean NOT NULL DEFAULT false;
+COMMIT;
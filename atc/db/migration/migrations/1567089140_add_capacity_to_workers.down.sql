@@ -0,0 +1,9 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN cpu_capacity,
+    DROP COLUMN memory_capacity;
+
+  ALTER TABLE containers
+    DROP COLUMN meta_cpu_limit,
+    DROP COLUMN meta_memory_limit;
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN cpu_capacity bigint,
+    ADD COLUMN memory_capacity bigint;
+
+  ALTER TABLE containers
+    ADD COLUMN meta_cpu_limit bigint DEFAULT 0 NOT NULL,
+    ADD COLUMN meta_memory_limit bigint DEFAULT 0 NOT NULL;
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+  -- instanced pipelines can't be told apart without their instance vars, so
+  -- rather than deleting them the migration refuses to run until they have
+  -- been destroyed
+  DO $$
+  BEGIN
+    IF EXISTS (SELECT 1 FROM pipelines WHERE instance_vars IS NOT NULL) THEN
+      RAISE EXCEPTION 'cannot migrate down while instanced pipelines exist, destroy them first';
+    END IF;
+  END;
+  $$;
+
+  DROP INDEX pipelines_name_team_id_instance_vars;
+
+  ALTER TABLE pipelines
+    ADD CONSTRAINT pipelines_name_team_id UNIQUE (name, team_id);
+
+  ALTER TABLE pipelines
+    DROP COLUMN instance_vars;
+COMMIT;
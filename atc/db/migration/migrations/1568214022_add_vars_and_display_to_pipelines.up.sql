@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN vars json,
+    ADD COLUMN display json;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE jobs_passed_jobs;
+COMMIT;
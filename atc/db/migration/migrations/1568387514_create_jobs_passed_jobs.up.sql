@@ -0,0 +1,15 @@
+BEGIN;
+  CREATE TABLE jobs_passed_jobs (
+    job_id integer NOT NULL,
+    passed_job_id integer NOT NULL,
+    PRIMARY KEY (job_id, passed_job_id)
+  );
+
+  CREATE INDEX jobs_passed_jobs_passed_job_id ON jobs_passed_jobs USING btree (passed_job_id);
+
+  ALTER TABLE ONLY jobs_passed_jobs
+    ADD CONSTRAINT jobs_passed_jobs_job_id_fkey FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE;
+
+  ALTER TABLE ONLY jobs_passed_jobs
+    ADD CONSTRAINT jobs_passed_jobs_passed_job_id_fkey FOREIGN KEY (passed_job_id) REFERENCES jobs(id) ON DELETE CASCADE;
+COMMIT;
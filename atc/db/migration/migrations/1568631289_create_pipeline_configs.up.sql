@@ -0,0 +1,16 @@
+BEGIN;
+  CREATE TABLE pipeline_configs (
+    id serial PRIMARY KEY,
+    pipeline_id integer NOT NULL,
+    version bigint NOT NULL,
+    config text NOT NULL,
+    nonce text,
+    created_by text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+  );
+
+  CREATE UNIQUE INDEX pipeline_configs_pipeline_id_version_uniq ON pipeline_configs (pipeline_id, version);
+
+  ALTER TABLE ONLY pipeline_configs
+    ADD CONSTRAINT pipeline_configs_pipeline_id_fkey FOREIGN KEY (pipeline_id) REFERENCES pipelines(id) ON DELETE CASCADE;
+COMMIT;
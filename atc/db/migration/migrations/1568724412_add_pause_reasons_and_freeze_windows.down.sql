@@ -0,0 +1,10 @@
+BEGIN;
+  ALTER TABLE jobs
+    DROP COLUMN paused_by,
+    DROP COLUMN pause_comment;
+
+  ALTER TABLE pipelines
+    DROP COLUMN paused_by,
+    DROP COLUMN pause_comment,
+    DROP COLUMN freeze_windows;
+COMMIT;
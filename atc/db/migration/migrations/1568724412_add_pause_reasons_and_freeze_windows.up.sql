@@ -0,0 +1,10 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN paused_by text,
+    ADD COLUMN pause_comment text,
+    ADD COLUMN freeze_windows json;
+
+  ALTER TABLE jobs
+    ADD COLUMN paused_by text,
+    ADD COLUMN pause_comment text;
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+  CREATE TABLE checks (
+    id bigserial PRIMARY KEY,
+    team_id integer NOT NULL,
+    pipeline_id integer NOT NULL,
+    resource_id integer,
+    resource_type_id integer,
+    status text NOT NULL,
+    created_by text NOT NULL,
+    plan json NOT NULL,
+    check_error text,
+    create_time timestamp with time zone DEFAULT now() NOT NULL,
+    start_time timestamp with time zone,
+    end_time timestamp with time zone
+  );
+
+  CREATE INDEX checks_status_idx ON checks (status);
+  CREATE INDEX checks_resource_id_idx ON checks (resource_id);
+  CREATE INDEX checks_resource_type_id_idx ON checks (resource_type_id);
+
+  ALTER TABLE ONLY checks
+    ADD CONSTRAINT checks_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    ADD CONSTRAINT checks_pipeline_id_fkey FOREIGN KEY (pipeline_id) REFERENCES pipelines(id) ON DELETE CASCADE,
+    ADD CONSTRAINT checks_resource_id_fkey FOREIGN KEY (resource_id) REFERENCES resources(id) ON DELETE CASCADE,
+    ADD CONSTRAINT checks_resource_type_id_fkey FOREIGN KEY (resource_type_id) REFERENCES resource_types(id) ON DELETE CASCADE;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE check_events;
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+  CREATE TABLE check_events (
+    check_id bigint NOT NULL,
+    event_id integer NOT NULL,
+    type text NOT NULL,
+    version text NOT NULL,
+    payload text NOT NULL
+  );
+
+  CREATE UNIQUE INDEX check_events_check_id_event_id ON check_events (check_id, event_id);
+
+  ALTER TABLE ONLY check_events
+    ADD CONSTRAINT check_events_check_id_fkey FOREIGN KEY (check_id) REFERENCES checks(id) ON DELETE CASCADE;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE resource_config_scopes
+    DROP COLUMN consecutive_check_errors,
+    DROP COLUMN check_interval;
+COMMIT;
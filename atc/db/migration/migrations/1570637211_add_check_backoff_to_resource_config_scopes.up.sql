@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE resource_config_scopes
+    ADD COLUMN consecutive_check_errors integer NOT NULL DEFAULT 0,
+    ADD COLUMN check_interval bigint;
+COMMIT;
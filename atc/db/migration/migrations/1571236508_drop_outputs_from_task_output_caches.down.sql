@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE task_output_caches
+    ADD COLUMN outputs jsonb NOT NULL DEFAULT '{}';
+COMMIT;
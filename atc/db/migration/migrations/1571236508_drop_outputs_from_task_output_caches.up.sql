@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE task_output_caches
+    DROP COLUMN outputs;
+COMMIT;
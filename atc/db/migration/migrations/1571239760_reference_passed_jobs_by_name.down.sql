@@ -0,0 +1,30 @@
+BEGIN;
+  ALTER TABLE jobs_passed_jobs
+    ADD COLUMN passed_job_id integer;
+
+  UPDATE jobs_passed_jobs jpj
+  SET passed_job_id = pj.id
+  FROM jobs j, pipelines jp, pipelines p, jobs pj
+  WHERE j.id = jpj.job_id
+    AND jp.id = j.pipeline_id
+    AND p.team_id = jp.team_id
+    AND p.name = jpj.passed_pipeline_name
+    AND p.instance_vars IS NULL
+    AND pj.pipeline_id = p.id
+    AND pj.name = jpj.passed_job_name;
+
+  DELETE FROM jobs_passed_jobs
+  WHERE passed_job_id IS NULL;
+
+  ALTER TABLE jobs_passed_jobs
+    DROP CONSTRAINT jobs_passed_jobs_pkey,
+    DROP COLUMN passed_pipeline_name,
+    DROP COLUMN passed_job_name,
+    ALTER COLUMN passed_job_id SET NOT NULL,
+    ADD PRIMARY KEY (job_id, passed_job_id);
+
+  CREATE INDEX jobs_passed_jobs_passed_job_id ON jobs_passed_jobs USING btree (passed_job_id);
+
+  ALTER TABLE ONLY jobs_passed_jobs
+    ADD CONSTRAINT jobs_passed_jobs_passed_job_id_fkey FOREIGN KEY (passed_job_id) REFERENCES jobs(id) ON DELETE CASCADE;
+COMMIT;
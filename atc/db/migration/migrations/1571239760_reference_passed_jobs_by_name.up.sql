@@ -0,0 +1,19 @@
+BEGIN;
+  ALTER TABLE jobs_passed_jobs
+    ADD COLUMN passed_pipeline_name text,
+    ADD COLUMN passed_job_name text;
+
+  UPDATE jobs_passed_jobs jpj
+  SET passed_pipeline_name = p.name,
+      passed_job_name = pj.name
+  FROM jobs pj, pipelines p
+  WHERE pj.id = jpj.passed_job_id
+    AND p.id = pj.pipeline_id;
+
+  ALTER TABLE jobs_passed_jobs
+    DROP CONSTRAINT jobs_passed_jobs_pkey,
+    DROP COLUMN passed_job_id,
+    ALTER COLUMN passed_pipeline_name SET NOT NULL,
+    ALTER COLUMN passed_job_name SET NOT NULL,
+    ADD PRIMARY KEY (job_id, passed_pipeline_name, passed_job_name);
+COMMIT;
@@ -127,6 +127,8 @@ var encryptedColumns = []encryptedColumn{
 	{"resource_types", "config", "id"},
 	{"builds", "private_plan", "id"},
 	{"cert_cache", "cert", "domain"},
+	{"pipelines", "var_sources", "id"},
+	{"pipeline_configs", "config", "id"},
 }
 
 func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,11 +12,17 @@ import (
 	"code.cloudfoundry.org/lager"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/algorithm"
+	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/vars"
+	"github.com/ghodss/yaml"
 )
 
+var ErrSetByNewerBuild = errors.New("pipeline set by a newer build")
+
 type ErrResourceNotFound struct {
 	Name string
 }
@@ -31,19 +38,41 @@ type Cause struct {
 	BuildID           int `json:"build_id"`
 }
 
+// PipelineConfigVersion is a config that a pipeline has been saved with.
+type PipelineConfigVersion struct {
+	Version   ConfigVersion
+	Config    atc.Config
+	CreatedBy string
+	CreatedAt time.Time
+}
+
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
+	VarSources() atc.VarSourceConfigs
+	Vars() map[string]interface{}
+	Display() *atc.DisplayConfig
 	ConfigVersion() ConfigVersion
+	ConfigVersions() ([]PipelineConfigVersion, error)
+	FindConfigVersion(version ConfigVersion) (PipelineConfigVersion, bool, error)
 	Public() bool
 	Paused() bool
+	PausedBy() string
+	PauseComment() string
+	FreezeWindows() atc.FreezeWindowConfigs
+	Archived() bool
+	ParentJobID() int
+	ParentBuildID() int
 
 	CheckPaused() (bool, error)
 	Reload() (bool, error)
 
+	Variables(lager.Logger, creds.Secrets, creds.VarSourcePool) vars.Variables
+
 	Causality(versionedResourceID int) ([]Cause, error)
 	ResourceVersion(resourceConfigVersionID int) (atc.ResourceVersion, bool, error)
 
@@ -78,9 +107,11 @@ type Pipeline interface {
 	Expose() error
 	Hide() error
 
-	Pause() error
+	Pause(pausedBy string, comment string) error
 	Unpause() error
 
+	Archive() error
+
 	Destroy() error
 	Rename(string) error
 }
@@ -88,15 +119,26 @@ type Pipeline interface {
 type pipeline struct {
 	id            int
 	name          string
+	instanceVars  atc.InstanceVars
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
+	varSources    atc.VarSourceConfigs
+	vars          map[string]interface{}
+	display       *atc.DisplayConfig
 	configVersion ConfigVersion
 	paused        bool
+	pausedBy      string
+	pauseComment  string
+	freezeWindows atc.FreezeWindowConfigs
 	public        bool
+	archived      bool
+	parentJobID   int
+	parentBuildID int
 
-	cacheIndex int
-	versionsDB *algorithm.VersionsDB
+	cacheIndex           int
+	passedPipelinesIndex string
+	versionsDB           *algorithm.VersionsDB
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -108,12 +150,23 @@ type ConfigVersion int
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
+		p.var_sources,
+		p.nonce,
+		p.vars,
+		p.display,
 		p.version,
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.paused_by,
+		p.pause_comment,
+		p.freeze_windows,
+		p.public,
+		p.archived,
+		p.parent_job_id,
+		p.parent_build_id
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -125,46 +178,72 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                      { return p.id }
-func (p *pipeline) Name() string                 { return p.name }
-func (p *pipeline) TeamID() int                  { return p.teamID }
-func (p *pipeline) TeamName() string             { return p.teamName }
-func (p *pipeline) Groups() atc.GroupConfigs     { return p.groups }
-func (p *pipeline) ConfigVersion() ConfigVersion { return p.configVersion }
-func (p *pipeline) Public() bool                 { return p.public }
-func (p *pipeline) Paused() bool                 { return p.paused }
-
-// IMPORTANT: This method is broken with the new resource config versions changes
+func (p *pipeline) ID() int                          { return p.id }
+func (p *pipeline) Name() string                     { return p.name }
+func (p *pipeline) InstanceVars() atc.InstanceVars   { return p.instanceVars }
+func (p *pipeline) TeamID() int                      { return p.teamID }
+func (p *pipeline) TeamName() string                 { return p.teamName }
+func (p *pipeline) Groups() atc.GroupConfigs         { return p.groups }
+func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
+func (p *pipeline) Vars() map[string]interface{}     { return p.vars }
+func (p *pipeline) Display() *atc.DisplayConfig      { return p.display }
+func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
+func (p *pipeline) Public() bool                     { return p.public }
+func (p *pipeline) Paused() bool                     { return p.paused }
+func (p *pipeline) PausedBy() string                 { return p.pausedBy }
+func (p *pipeline) PauseComment() string             { return p.pauseComment }
+func (p *pipeline) Archived() bool                   { return p.archived }
+func (p *pipeline) ParentJobID() int                 { return p.parentJobID }
+func (p *pipeline) ParentBuildID() int               { return p.parentBuildID }
+
+func (p *pipeline) FreezeWindows() atc.FreezeWindowConfigs { return p.freezeWindows }
+
+// Causality follows a resource config version through the builds that used
+// it and the versions they produced, across all of the team's pipelines whose
+// resources share the version's resource config.
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
 	rows, err := p.conn.Query(`
-		WITH RECURSIVE causality(versioned_resource_id, build_id) AS (
-				SELECT bi.versioned_resource_id, bi.build_id
-				FROM build_inputs bi
-				WHERE bi.versioned_resource_id = $1
+		WITH RECURSIVE causality(resource_config_version_id, build_id) AS (
+				SELECT v.id, i.build_id
+				FROM resource_config_versions v
+				INNER JOIN resource_config_scopes s ON s.id = v.resource_config_scope_id
+				INNER JOIN resources r ON r.resource_config_id = s.resource_config_id
+				INNER JOIN pipelines p ON p.id = r.pipeline_id
+				INNER JOIN build_resource_config_version_inputs i ON i.resource_id = r.id AND i.version_md5 = v.version_md5
+				WHERE v.id = $1
+				AND p.team_id = $2
 			UNION
-				SELECT bi.versioned_resource_id, bi.build_id
+				SELECT ov.id, i.build_id
 				FROM causality t
-				INNER JOIN build_outputs bo ON bo.build_id = t.build_id
-				INNER JOIN build_inputs bi ON bi.versioned_resource_id = bo.versioned_resource_id
-				INNER JOIN builds b ON b.id = bi.build_id
+				INNER JOIN build_resource_config_version_outputs o ON o.build_id = t.build_id
+				INNER JOIN resources orr ON orr.id = o.resource_id
+				INNER JOIN resource_config_versions ov ON ov.version_md5 = o.version_md5 AND ov.resource_config_scope_id = orr.resource_config_scope_id
+				INNER JOIN resources ir ON ir.resource_config_id = orr.resource_config_id
+				INNER JOIN pipelines ip ON ip.id = ir.pipeline_id
+				INNER JOIN build_resource_config_version_inputs i ON i.resource_id = ir.id AND i.version_md5 = o.version_md5
+				INNER JOIN builds b ON b.id = i.build_id
+				WHERE ip.team_id = $2
 				AND NOT EXISTS (
 					SELECT 1
-					FROM build_outputs obo
-					INNER JOIN builds ob ON ob.id = obo.build_id
-					WHERE obo.build_id < bi.build_id
+					FROM build_resource_config_version_inputs oi
+					INNER JOIN builds ob ON ob.id = oi.build_id
+					WHERE oi.build_id < i.build_id
 					AND ob.job_id = b.job_id
-					AND obo.versioned_resource_id = bi.versioned_resource_id
+					AND oi.resource_id = i.resource_id
+					AND oi.version_md5 = i.version_md5
 				)
 		)
-		SELECT c.versioned_resource_id, c.build_id
+		SELECT c.resource_config_version_id, c.build_id
 		FROM causality c
 		INNER JOIN builds b ON b.id = c.build_id
-		ORDER BY b.start_time ASC, c.versioned_resource_id ASC
-	`, versionedResourceID)
+		ORDER BY b.start_time ASC, c.resource_config_version_id ASC
+	`, versionedResourceID, p.teamID)
 	if err != nil {
 		return nil, err
 	}
 
+	defer Close(rows)
+
 	var causality []Cause
 	for rows.Next() {
 		var vrID, buildID int
@@ -182,6 +261,92 @@ func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
 	return causality, nil
 }
 
+// ConfigVersions returns every config version the pipeline has been saved
+// with, the latest first. Only their metadata is loaded, leaving the configs
+// themselves empty, as listing them need not decrypt every config.
+func (p *pipeline) ConfigVersions() ([]PipelineConfigVersion, error) {
+	rows, err := psql.Select("c.version, c.created_by, c.created_at").
+		From("pipeline_configs c").
+		Where(sq.Eq{"c.pipeline_id": p.id}).
+		OrderBy("c.version DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	versions := []PipelineConfigVersion{}
+	for rows.Next() {
+		var version PipelineConfigVersion
+		var createdBy sql.NullString
+
+		err = rows.Scan(&version.Version, &createdBy, &version.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		version.CreatedBy = createdBy.String
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func (p *pipeline) FindConfigVersion(version ConfigVersion) (PipelineConfigVersion, bool, error) {
+	row := psql.Select("c.version, c.config, c.nonce, c.created_by, c.created_at").
+		From("pipeline_configs c").
+		Where(sq.Eq{
+			"c.pipeline_id": p.id,
+			"c.version":     version,
+		}).
+		RunWith(p.conn).
+		QueryRow()
+
+	configVersion, err := scanPipelineConfigVersion(p.conn.EncryptionStrategy(), row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PipelineConfigVersion{}, false, nil
+		}
+
+		return PipelineConfigVersion{}, false, err
+	}
+
+	return configVersion, true, nil
+}
+
+func scanPipelineConfigVersion(es encryption.Strategy, row scannable) (PipelineConfigVersion, error) {
+	var configVersion PipelineConfigVersion
+	var configBlob string
+	var nonce, createdBy sql.NullString
+
+	err := row.Scan(&configVersion.Version, &configBlob, &nonce, &createdBy, &configVersion.CreatedAt)
+	if err != nil {
+		return PipelineConfigVersion{}, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := es.Decrypt(configBlob, noncense)
+	if err != nil {
+		return PipelineConfigVersion{}, err
+	}
+
+	err = yaml.Unmarshal(decryptedConfig, &configVersion.Config)
+	if err != nil {
+		return PipelineConfigVersion{}, err
+	}
+
+	configVersion.CreatedBy = createdBy.String
+
+	return configVersion, nil
+}
+
 func (p *pipeline) CheckPaused() (bool, error) {
 	var paused bool
 
@@ -214,6 +379,17 @@ func (p *pipeline) Reload() (bool, error) {
 	return true, nil
 }
 
+// Variables returns the vars available to the pipeline: those of the
+// cluster-wide credential manager, those of the pipeline's var sources, and
+// finally the pipeline's own static vars.
+func (p *pipeline) Variables(logger lager.Logger, secrets creds.Secrets, varSourcePool creds.VarSourcePool) vars.Variables {
+	return vars.NewMultiVars([]vars.Variables{
+		creds.NewVariables(secrets, p.teamName, p.name),
+		creds.NewVarSourceVariables(logger, varSourcePool, p.teamName, p.name, p.varSources),
+		vars.StaticVariables(p.vars),
+	})
+}
+
 func (p *pipeline) CreateJobBuild(jobName string) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
@@ -593,9 +769,13 @@ func (p *pipeline) Dashboard() (Dashboard, error) {
 	return dashboard, nil
 }
 
-func (p *pipeline) Pause() error {
+// Pause stops the pipeline's jobs from being scheduled, recording who paused
+// it and why. Either may be empty.
+func (p *pipeline) Pause(pausedBy string, comment string) error {
 	_, err := psql.Update("pipelines").
 		Set("paused", true).
+		Set("paused_by", nullIfEmpty(pausedBy)).
+		Set("pause_comment", nullIfEmpty(comment)).
 		Where(sq.Eq{
 			"id": p.id,
 		}).
@@ -608,6 +788,8 @@ func (p *pipeline) Pause() error {
 func (p *pipeline) Unpause() error {
 	_, err := psql.Update("pipelines").
 		Set("paused", false).
+		Set("paused_by", nil).
+		Set("pause_comment", nil).
 		Where(sq.Eq{
 			"id": p.id,
 		}).
@@ -617,6 +799,63 @@ func (p *pipeline) Unpause() error {
 	return err
 }
 
+// Archive permanently pauses the pipeline while keeping its build history
+// around. The resource configs of its resources and resource types are
+// released so that they can be garbage collected. Saving the pipeline's config
+// again un-archives it.
+func (p *pipeline) Archive() error {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("pipelines").
+		Set("archived", true).
+		Set("paused", true).
+		Where(sq.Eq{
+			"id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("resources").
+		Set("resource_config_id", nil).
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("resource_types").
+		Set("resource_config_id", nil).
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	p.archived = true
+	p.paused = true
+
+	return nil
+}
+
 func (p *pipeline) Hide() error {
 	_, err := psql.Update("pipelines").
 		Set("public", false).
@@ -665,18 +904,33 @@ func (p *pipeline) Destroy() error {
 }
 
 func (p *pipeline) LoadVersionsDB() (*algorithm.VersionsDB, error) {
+	// the versions DB also holds the builds of jobs in other pipelines that
+	// this pipeline's inputs are passed through, so it is stale whenever
+	// any of their pipelines change too
 	var cacheIndex int
-	err := psql.Select("cache_index").
-		From("pipelines").
-		Where(sq.Eq{"id": p.id}).
-		RunWith(p.conn).
-		QueryRow().
-		Scan(&cacheIndex)
+	var passedPipelinesIndex string
+	err := p.conn.QueryRow(`
+		SELECT p.cache_index, COALESCE((
+			SELECT string_agg(pp.id || ':' || pp.cache_index, ',' ORDER BY pp.id)
+			FROM pipelines pp
+			WHERE pp.team_id = p.team_id
+			AND pp.instance_vars IS NULL
+			AND pp.name IN (
+				SELECT jpj.passed_pipeline_name
+				FROM jobs_passed_jobs jpj
+				JOIN jobs j ON j.id = jpj.job_id
+				WHERE j.pipeline_id = p.id
+				AND j.active = true
+			)
+		), '')
+		FROM pipelines p
+		WHERE p.id = $1
+	`, p.id).Scan(&cacheIndex, &passedPipelinesIndex)
 	if err != nil {
 		return nil, err
 	}
 
-	if p.versionsDB != nil && p.cacheIndex == cacheIndex {
+	if p.versionsDB != nil && p.cacheIndex == cacheIndex && p.passedPipelinesIndex == passedPipelinesIndex {
 		return p.versionsDB, nil
 	}
 
@@ -840,12 +1094,104 @@ func (p *pipeline) LoadVersionsDB() (*algorithm.VersionsDB, error) {
 		db.ResourceIDs[name] = id
 	}
 
+	err = p.loadPassedJobsOfOtherPipelines(db)
+	if err != nil {
+		return nil, err
+	}
+
 	p.versionsDB = db
 	p.cacheIndex = cacheIndex
+	p.passedPipelinesIndex = passedPipelinesIndex
 
 	return db, nil
 }
 
+// loadPassedJobsOfOtherPipelines adds the jobs of other pipelines that this
+// pipeline's inputs are passed through to the versions DB, keyed by
+// pipeline/job, along with the versions their successful builds used or
+// produced. The versions are matched to this pipeline's resources with the
+// same resource config. The jobs are looked up by name, so that they are
+// found again when their pipeline is destroyed and set again.
+func (p *pipeline) loadPassedJobsOfOtherPipelines(db *algorithm.VersionsDB) error {
+	rows, err := psql.Select("DISTINCT pp.name, pj.name, pj.id").
+		From("jobs_passed_jobs jpj").
+		Join("jobs j ON j.id = jpj.job_id").
+		Join("pipelines pp ON pp.name = jpj.passed_pipeline_name").
+		Join("jobs pj ON pj.pipeline_id = pp.id AND pj.name = jpj.passed_job_name").
+		Where(sq.Eq{
+			"j.pipeline_id":    p.id,
+			"j.active":         true,
+			"pp.team_id":       p.teamID,
+			"pp.instance_vars": nil,
+			"pj.active":        true,
+		}).
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	jobIDs := []int{}
+	for rows.Next() {
+		var pipelineName, jobName string
+		var id int
+		err = rows.Scan(&pipelineName, &jobName, &id)
+		if err != nil {
+			return err
+		}
+
+		db.JobIDs[atc.PassedJob{Pipeline: pipelineName, Job: jobName}.String()] = id
+		jobIDs = append(jobIDs, id)
+	}
+
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	// inputs of successful builds are implicit outputs
+	for _, table := range []string{
+		"build_resource_config_version_outputs",
+		"build_resource_config_version_inputs",
+	} {
+		rows, err := psql.Select("DISTINCT v.id, v.check_order, r.id, o.build_id, b.job_id").
+			From(table + " o").
+			Join("builds b ON b.id = o.build_id").
+			Join("resources pr ON pr.id = o.resource_id").
+			Join("resources r ON r.resource_config_id = pr.resource_config_id").
+			Join("resource_config_versions v ON v.version_md5 = o.version_md5 AND v.resource_config_scope_id = r.resource_config_scope_id").
+			Where(sq.Expr("(r.id, v.version_md5) NOT IN (SELECT resource_id, version_md5 from resource_disabled_versions)")).
+			Where(sq.NotEq{
+				"v.check_order": 0,
+			}).
+			Where(sq.Eq{
+				"b.status":      BuildStatusSucceeded,
+				"b.job_id":      jobIDs,
+				"r.pipeline_id": p.id,
+			}).
+			RunWith(p.conn).
+			Query()
+		if err != nil {
+			return err
+		}
+
+		defer Close(rows)
+
+		for rows.Next() {
+			var output algorithm.BuildOutput
+			err = rows.Scan(&output.VersionID, &output.CheckOrder, &output.ResourceID, &output.BuildID, &output.JobID)
+			if err != nil {
+				return err
+			}
+
+			db.BuildOutputs = append(db.BuildOutputs, output)
+		}
+	}
+
+	return nil
+}
+
 func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 	if len(buildIDs) == 0 {
 		return nil
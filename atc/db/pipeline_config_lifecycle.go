@@ -0,0 +1,43 @@
+package db
+
+//go:generate counterfeiter . PipelineConfigLifecycle
+
+type PipelineConfigLifecycle interface {
+	RemoveExpiredPipelineConfigs(configsToRetain int) error
+}
+
+type pipelineConfigLifecycle struct {
+	conn Conn
+}
+
+func NewPipelineConfigLifecycle(conn Conn) PipelineConfigLifecycle {
+	return &pipelineConfigLifecycle{
+		conn: conn,
+	}
+}
+
+// RemoveExpiredPipelineConfigs removes every config each pipeline has been
+// saved with but the latest configsToRetain, which can still be listed and
+// rolled back to. A configsToRetain of 0 keeps every config.
+func (lifecycle *pipelineConfigLifecycle) RemoveExpiredPipelineConfigs(configsToRetain int) error {
+	if configsToRetain <= 0 {
+		return nil
+	}
+
+	_, err := lifecycle.conn.Exec(`
+		DELETE FROM pipeline_configs
+		WHERE id IN (
+			SELECT id
+			FROM (
+				SELECT id, row_number() OVER (
+					PARTITION BY pipeline_id
+					ORDER BY version DESC
+				) AS position
+				FROM pipeline_configs
+			) AS ranked
+			WHERE position > $1
+		)
+	`, configsToRetain)
+
+	return err
+}
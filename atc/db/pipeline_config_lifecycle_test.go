@@ -0,0 +1,54 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineConfigLifecycle", func() {
+	var (
+		pipelineConfigLifecycle db.PipelineConfigLifecycle
+		savedVersions           []db.ConfigVersion
+	)
+
+	BeforeEach(func() {
+		pipelineConfigLifecycle = db.NewPipelineConfigLifecycle(dbConn)
+
+		current, found, err := defaultPipeline.FindConfigVersion(defaultPipeline.ConfigVersion())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		pipeline := defaultPipeline
+		savedVersions = []db.ConfigVersion{pipeline.ConfigVersion()}
+		for i := 0; i < 2; i++ {
+			pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, current.Config, pipeline.ConfigVersion(), false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			savedVersions = append(savedVersions, pipeline.ConfigVersion())
+		}
+	})
+
+	Describe("RemoveExpiredPipelineConfigs", func() {
+		It("removes every config of the pipeline apart from the latest ones", func() {
+			err := pipelineConfigLifecycle.RemoveExpiredPipelineConfigs(2)
+			Expect(err).ToNot(HaveOccurred())
+
+			configVersions, err := defaultPipeline.ConfigVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configVersions).To(HaveLen(2))
+			Expect(configVersions[0].Version).To(Equal(savedVersions[2]))
+			Expect(configVersions[1].Version).To(Equal(savedVersions[1]))
+		})
+
+		It("keeps every config when none are to be retained", func() {
+			err := pipelineConfigLifecycle.RemoveExpiredPipelineConfigs(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			configVersions, err := defaultPipeline.ConfigVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configVersions).To(HaveLen(3))
+		})
+	})
+})
@@ -27,7 +27,7 @@ func NewPipelineFactory(conn Conn, lockFactory lock.LockFactory) PipelineFactory
 func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, error) {
 	rows, err := pipelinesQuery.
 		Where(sq.Eq{"t.name": teamNames}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "p.id ASC").
 		RunWith(f.conn).
 		Query()
 	if err != nil {
@@ -42,7 +42,7 @@ func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, erro
 	rows, err = pipelinesQuery.
 		Where(sq.NotEq{"t.name": teamNames}).
 		Where(sq.Eq{"public": true}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "p.id ASC").
 		RunWith(f.conn).
 		Query()
 	if err != nil {
@@ -59,7 +59,7 @@ func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, erro
 
 func (f *pipelineFactory) AllPipelines() ([]Pipeline, error) {
 	rows, err := pipelinesQuery.
-		OrderBy("ordering").
+		OrderBy("ordering", "p.id").
 		RunWith(f.conn).
 		Query()
 	if err != nil {
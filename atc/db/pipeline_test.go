@@ -117,7 +117,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), false)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -158,7 +158,7 @@ var _ = Describe("Pipeline", func() {
 
 		Context("when the pipeline is paused", func() {
 			BeforeEach(func() {
-				Expect(pipeline.Pause()).To(Succeed())
+				Expect(pipeline.Pause("", "")).To(Succeed())
 			})
 
 			It("returns the pipeline is paused", func() {
@@ -169,7 +169,7 @@ var _ = Describe("Pipeline", func() {
 
 	Describe("Pause", func() {
 		JustBeforeEach(func() {
-			Expect(pipeline.Pause()).To(Succeed())
+			Expect(pipeline.Pause("", "")).To(Succeed())
 
 			found, err := pipeline.Reload()
 			Expect(err).ToNot(HaveOccurred())
@@ -185,6 +185,17 @@ var _ = Describe("Pipeline", func() {
 				Expect(pipeline.Paused()).To(BeTrue())
 			})
 		})
+
+		Context("when a reason is given", func() {
+			BeforeEach(func() {
+				Expect(pipeline.Pause("some-user", "upgrading the database")).To(Succeed())
+			})
+
+			It("records who paused the pipeline and why", func() {
+				Expect(pipeline.PausedBy()).To(Equal("some-user"))
+				Expect(pipeline.PauseComment()).To(Equal("upgrading the database"))
+			})
+		})
 	})
 
 	Describe("Unpause", func() {
@@ -198,12 +209,95 @@ var _ = Describe("Pipeline", func() {
 
 		Context("when the pipeline is paused", func() {
 			BeforeEach(func() {
-				Expect(pipeline.Pause()).To(Succeed())
+				Expect(pipeline.Pause("some-user", "upgrading the database")).To(Succeed())
 			})
 
 			It("unpauses the pipeline", func() {
 				Expect(pipeline.Paused()).To(BeFalse())
 			})
+
+			It("clears the reason it was paused", func() {
+				Expect(pipeline.PausedBy()).To(BeEmpty())
+				Expect(pipeline.PauseComment()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Archive", func() {
+		var resource db.Resource
+		var resourceConfigID int
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceConfigScope, err := resource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			resourceConfigID = resourceConfigScope.ResourceConfig().ID()
+
+			_, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pipeline.Unpause()).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			Expect(pipeline.Archive()).To(Succeed())
+
+			found, err := pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("archives the pipeline", func() {
+			Expect(pipeline.Archived()).To(BeTrue())
+		})
+
+		It("pauses the pipeline", func() {
+			Expect(pipeline.Paused()).To(BeTrue())
+		})
+
+		It("keeps the pipeline's builds", func() {
+			builds, _, err := pipeline.Builds(db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+		})
+
+		It("releases the resource configs of its resources", func() {
+			found, err := resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resource.ResourceConfigID()).To(BeZero())
+
+			Expect(resourceConfigFactory.CleanUnreferencedConfigs()).To(Succeed())
+
+			var exists bool
+			err = dbConn.QueryRow("SELECT EXISTS (SELECT 1 FROM resource_configs WHERE id = $1)", resourceConfigID).Scan(&exists)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		Context("when the pipeline's config is saved again", func() {
+			JustBeforeEach(func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("un-archives the pipeline", func() {
+				Expect(pipeline.Archived()).To(BeFalse())
+			})
+
+			It("leaves the pipeline paused", func() {
+				Expect(pipeline.Paused()).To(BeTrue())
+			})
 		})
 	})
 
@@ -213,13 +307,99 @@ var _ = Describe("Pipeline", func() {
 		})
 
 		It("renames the pipeline", func() {
-			pipeline, found, err := team.Pipeline("oopsies")
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "oopsies"})
 			Expect(pipeline.Name()).To(Equal("oopsies"))
 			Expect(found).To(BeTrue())
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
 
+	Describe("SavePipelineFromBuild", func() {
+		var (
+			parentBuild db.Build
+			savedConfig atc.Config
+			saveErr     error
+		)
+
+		BeforeEach(func() {
+			var err error
+			parentBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			savedConfig = pipelineConfig
+			savedConfig.Groups = atc.GroupConfigs{
+				{Name: "set-by-build", Jobs: []string{"job-name"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			_, _, saveErr = team.SavePipelineFromBuild(
+				atc.PipelineRef{Name: "fake-pipeline"},
+				savedConfig,
+				pipeline.ConfigVersion(),
+				job.ID(),
+				parentBuild.ID(),
+				"some-user",
+			)
+		})
+
+		It("records the job and build which set the pipeline", func() {
+			Expect(saveErr).ToNot(HaveOccurred())
+
+			found, err := pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(pipeline.ParentJobID()).To(Equal(job.ID()))
+			Expect(pipeline.ParentBuildID()).To(Equal(parentBuild.ID()))
+			Expect(pipeline.Groups()).To(Equal(savedConfig.Groups))
+		})
+
+		It("is listed as configured by the build", func() {
+			pipelines, err := parentBuild.ConfiguredPipelines()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelines).To(HaveLen(1))
+			Expect(pipelines[0].Name()).To(Equal("fake-pipeline"))
+		})
+
+		Context("when the pipeline was set by a newer build", func() {
+			var newerBuild db.Build
+
+			BeforeEach(func() {
+				var err error
+				newerBuild, err = job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = team.SavePipelineFromBuild(
+					atc.PipelineRef{Name: "fake-pipeline"},
+					pipelineConfig,
+					pipeline.ConfigVersion(),
+					job.ID(),
+					newerBuild.ID(),
+					"some-user",
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("returns ErrSetByNewerBuild", func() {
+				Expect(saveErr).To(Equal(db.ErrSetByNewerBuild))
+			})
+
+			It("leaves the pipeline as the newer build set it", func() {
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(pipeline.ParentBuildID()).To(Equal(newerBuild.ID()))
+				Expect(pipeline.Groups()).To(Equal(pipelineConfig.Groups))
+			})
+		})
+	})
+
 	Describe("Resource Config Versions", func() {
 		resourceName := "some-resource"
 		otherResourceName := "some-other-resource"
@@ -386,10 +566,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, false)
+			dbPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name"}, pipelineConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, false)
+			otherDBPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline-name"}, otherPipelineConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = dbPipeline.Resource(resourceName)
@@ -957,7 +1137,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipelineDB, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -1152,7 +1332,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 
-			_, found, err = team.Pipeline(pipeline.Name())
+			_, found, err = team.Pipeline(atc.PipelineRef{Name: pipeline.Name()})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -1198,7 +1378,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline-name"}, otherPipelineConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1410,6 +1590,295 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("VersionsDB with inputs passed through jobs of other pipelines", func() {
+		var (
+			downstreamPipeline db.Pipeline
+			upstreamJob        db.Job
+			upstreamBuild      db.Build
+			downstreamResource db.Resource
+			downstreamVersion  db.ResourceConfigVersion
+		)
+
+		BeforeEach(func() {
+			var err error
+			var found bool
+			downstreamPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "downstream-pipeline"}, atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "downstream-resource",
+						Type:   "some-type",
+						Source: atc.Source{"some": "source"},
+					},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "downstream-job",
+						Plan: atc.PlanSequence{
+							{
+								Get:    "downstream-resource",
+								Passed: []string{"fake-pipeline/job-name"},
+							},
+						},
+					},
+				},
+			}, 0, false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			upstreamJob = job
+
+			upstreamBuild, err = upstreamJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			upstreamResource, _, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+
+			upstreamScope, err := upstreamResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = upstreamScope.SaveVersions([]atc.Version{{"version": "1"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			downstreamResource, _, err = downstreamPipeline.Resource("downstream-resource")
+			Expect(err).ToNot(HaveOccurred())
+
+			downstreamScope, err := downstreamResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = downstreamScope.SaveVersions([]atc.Version{{"version": "1"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			downstreamVersion, found, err = downstreamScope.FindVersion(atc.Version{"version": "1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = upstreamBuild.SaveOutput("some-type", atc.Source{"some": "source"}, atc.VersionedResourceTypes{}, atc.Version{"version": "1"}, nil, "some-resource", "some-resource")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("includes the jobs of other pipelines keyed by pipeline/job", func() {
+			versionsDB, err := downstreamPipeline.LoadVersionsDB()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versionsDB.JobIDs).To(HaveKeyWithValue("fake-pipeline/job-name", upstreamJob.ID()))
+		})
+
+		It("does not include their builds until they succeed", func() {
+			versionsDB, err := downstreamPipeline.LoadVersionsDB()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versionsDB.BuildOutputs).To(BeEmpty())
+		})
+
+		Context("when the build of the other pipeline's job succeeds", func() {
+			var versionsDB *algorithm.VersionsDB
+
+			BeforeEach(func() {
+				var err error
+				versionsDB, err = downstreamPipeline.LoadVersionsDB()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = upstreamBuild.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("invalidates the cached VersionsDB", func() {
+				cachedVersionsDB, err := downstreamPipeline.LoadVersionsDB()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versionsDB != cachedVersionsDB).To(BeTrue(), "Expected VersionsDB to be different objects")
+			})
+
+			It("includes its outputs as versions of the resources with the same config", func() {
+				versionsDB, err := downstreamPipeline.LoadVersionsDB()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versionsDB.BuildOutputs).To(ConsistOf(algorithm.BuildOutput{
+					ResourceVersion: algorithm.ResourceVersion{
+						VersionID:  downstreamVersion.ID(),
+						ResourceID: downstreamResource.ID(),
+						CheckOrder: downstreamVersion.CheckOrder(),
+					},
+					BuildID: upstreamBuild.ID(),
+					JobID:   upstreamJob.ID(),
+				}))
+			})
+		})
+
+		Context("when the other pipeline is destroyed and set again", func() {
+			var recreatedJob db.Job
+
+			BeforeEach(func() {
+				_, err := downstreamPipeline.LoadVersionsDB()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = pipeline.Destroy()
+				Expect(err).ToNot(HaveOccurred())
+
+				recreatedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				recreatedJob, found, err = recreatedPipeline.Job("job-name")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("includes the job of the new pipeline", func() {
+				versionsDB, err := downstreamPipeline.LoadVersionsDB()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versionsDB.JobIDs).To(HaveKeyWithValue("fake-pipeline/job-name", recreatedJob.ID()))
+				Expect(recreatedJob.ID()).ToNot(Equal(upstreamJob.ID()))
+			})
+		})
+
+		Context("when the job no longer passes through the other pipeline", func() {
+			BeforeEach(func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: "downstream-pipeline"}, atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "downstream-resource",
+							Type:   "some-type",
+							Source: atc.Source{"some": "source"},
+						},
+					},
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							Plan: atc.PlanSequence{
+								{
+									Get: "downstream-resource",
+								},
+							},
+						},
+					},
+				}, downstreamPipeline.ConfigVersion(), false, "")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("no longer includes its jobs", func() {
+				versionsDB, err := downstreamPipeline.LoadVersionsDB()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versionsDB.JobIDs).ToNot(HaveKey("fake-pipeline/job-name"))
+			})
+		})
+	})
+
+	Describe("Causality", func() {
+		It("follows the versions produced by builds into other pipelines", func() {
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "some-other-resource",
+						Type:   "some-type",
+						Source: atc.Source{"some": "other-source"},
+					},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "other-job",
+						Plan: atc.PlanSequence{
+							{
+								Get:    "some-other-resource",
+								Passed: []string{"fake-pipeline/job-name"},
+							},
+						},
+					},
+				},
+			}, 0, false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			resource, _, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+
+			scope, err := resource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = scope.SaveVersions([]atc.Version{{"version": "1"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			inputVersion, found, err := scope.FindVersion(atc.Version{"version": "1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.UseInputs([]db.BuildInput{{Name: "some-input", Version: atc.Version{"version": "1"}, ResourceID: resource.ID()}})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.SaveOutput("some-type", atc.Source{"some": "other-source"}, atc.VersionedResourceTypes{}, atc.Version{"version": "2"}, nil, "some-other-resource", "some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+
+			otherResource, _, err := otherPipeline.Resource("some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+
+			otherScope, err := otherResource.SetResourceConfig(atc.Source{"some": "other-source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = otherScope.SaveVersions([]atc.Version{{"version": "2"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			otherJob, found, err := otherPipeline.Job("other-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			otherBuild, err := otherJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = otherBuild.UseInputs([]db.BuildInput{{Name: "some-other-resource", Version: atc.Version{"version": "2"}, ResourceID: otherResource.ID()}})
+			Expect(err).ToNot(HaveOccurred())
+
+			causality, err := pipeline.Causality(inputVersion.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causality).To(HaveLen(2))
+			Expect(causality[0]).To(Equal(db.Cause{ResourceVersionID: inputVersion.ID(), BuildID: build.ID()}))
+			Expect(causality[1].BuildID).To(Equal(otherBuild.ID()))
+		})
+	})
+
+	Describe("ConfigVersions", func() {
+		var updatedConfig atc.Config
+
+		BeforeEach(func() {
+			updatedConfig = pipelineConfig
+			updatedConfig.Jobs = append(atc.JobConfigs{}, pipelineConfig.Jobs...)
+			updatedConfig.Jobs[1].Serial = false
+
+			var err error
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, updatedConfig, pipeline.ConfigVersion(), false, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns every saved config version, the latest first, without the configs", func() {
+			configVersions, err := pipeline.ConfigVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configVersions).To(HaveLen(2))
+
+			Expect(configVersions[0].Version).To(Equal(pipeline.ConfigVersion()))
+			Expect(configVersions[0].Config).To(Equal(atc.Config{}))
+			Expect(configVersions[0].CreatedBy).To(Equal("some-user"))
+			Expect(configVersions[0].CreatedAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+			Expect(configVersions[1].Version).To(BeNumerically("<", pipeline.ConfigVersion()))
+			Expect(configVersions[1].Config).To(Equal(atc.Config{}))
+			Expect(configVersions[1].CreatedBy).To(BeEmpty())
+		})
+
+		Describe("FindConfigVersion", func() {
+			It("finds a saved config by its version", func() {
+				configVersions, err := pipeline.ConfigVersions()
+				Expect(err).ToNot(HaveOccurred())
+
+				configVersion, found, err := pipeline.FindConfigVersion(configVersions[1].Version)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(configVersion.Config).To(Equal(pipelineConfig))
+			})
+
+			It("does not find versions the pipeline was never saved with", func() {
+				_, found, err := pipeline.FindConfigVersion(pipeline.ConfigVersion() + 100)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Dashboard", func() {
 		It("returns a Dashboard object with a DashboardJob corresponding to each configured job", func() {
 			job, found, err := pipeline.Job("job-name")
@@ -2159,7 +2628,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -2179,7 +2648,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -6,3 +6,12 @@ const pqUniqueViolationErrCode = "unique_violation"
 const pqFKeyViolationErrCode = "foreign_key_violation"
 
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+// nullIfEmpty stores an empty string as NULL.
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -22,6 +22,7 @@ type Resource interface {
 	Public() bool
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
 	TeamName() string
 	Type() string
 	Source() atc.Source
@@ -32,7 +33,11 @@ type Resource interface {
 	Tags() atc.Tags
 	CheckSetupError() error
 	CheckError() error
+	ConsecutiveCheckErrors() int
+	CheckInterval() time.Duration
+	LatestCheckID() int
 	WebhookToken() string
+	WebhookFilter() *atc.WebhookFilter
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	PinComment() string
@@ -56,12 +61,11 @@ type Resource interface {
 
 	SetResourceConfig(atc.Source, atc.VersionedResourceTypes) (ResourceConfigScope, error)
 	SetCheckSetupError(error) error
-	NotifyScan() error
 
 	Reload() (bool, error)
 }
 
-var resourcesQuery = psql.Select("r.id, r.name, r.type, r.config, r.check_error, rs.last_check_start_time, rs.last_check_end_time, r.pipeline_id, r.nonce, r.resource_config_id, r.resource_config_scope_id, p.name, t.name, rs.check_error, rp.version, rp.comment_text").
+var resourcesQuery = psql.Select("r.id, r.name, r.type, r.config, r.check_error, rs.last_check_start_time, rs.last_check_end_time, r.pipeline_id, r.nonce, r.resource_config_id, r.resource_config_scope_id, p.name, p.instance_vars, t.name, rs.check_error, rp.version, rp.comment_text, rs.consecutive_check_errors, rs.check_interval, (SELECT max(c.id) FROM checks c WHERE c.resource_id = r.id)").
 	From("resources r").
 	Join("pipelines p ON p.id = r.pipeline_id").
 	Join("teams t ON t.id = p.team_id").
@@ -75,6 +79,7 @@ type resource struct {
 	public                bool
 	pipelineID            int
 	pipelineName          string
+	pipelineInstanceVars  atc.InstanceVars
 	teamName              string
 	type_                 string
 	source                atc.Source
@@ -85,7 +90,11 @@ type resource struct {
 	tags                  atc.Tags
 	checkSetupError       error
 	checkError            error
+	checkErrorCount       int
+	checkInterval         time.Duration
+	latestCheckID         int
 	webhookToken          string
+	webhookFilter         *atc.WebhookFilter
 	configPinnedVersion   atc.Version
 	apiPinnedVersion      atc.Version
 	pinComment            string
@@ -122,43 +131,50 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 
 	for _, r := range resources {
 		configs = append(configs, atc.ResourceConfig{
-			Name:         r.Name(),
-			Public:       r.Public(),
-			WebhookToken: r.WebhookToken(),
-			Type:         r.Type(),
-			Source:       r.Source(),
-			CheckEvery:   r.CheckEvery(),
-			Tags:         r.Tags(),
-			Version:      r.ConfigPinnedVersion(),
-			Icon:         r.Icon(),
+			Name:          r.Name(),
+			Public:        r.Public(),
+			WebhookToken:  r.WebhookToken(),
+			WebhookFilter: r.WebhookFilter(),
+			Type:          r.Type(),
+			Source:        r.Source(),
+			CheckEvery:    r.CheckEvery(),
+			Tags:          r.Tags(),
+			Version:       r.ConfigPinnedVersion(),
+			Icon:          r.Icon(),
 		})
 	}
 
 	return configs
 }
 
-func (r *resource) ID() int                          { return r.id }
-func (r *resource) Name() string                     { return r.name }
-func (r *resource) Public() bool                     { return r.public }
-func (r *resource) PipelineID() int                  { return r.pipelineID }
-func (r *resource) PipelineName() string             { return r.pipelineName }
-func (r *resource) TeamName() string                 { return r.teamName }
-func (r *resource) Type() string                     { return r.type_ }
-func (r *resource) Source() atc.Source               { return r.source }
-func (r *resource) CheckEvery() string               { return r.checkEvery }
-func (r *resource) CheckTimeout() string             { return r.checkTimeout }
-func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTime }
-func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
-func (r *resource) Tags() atc.Tags                   { return r.tags }
-func (r *resource) CheckSetupError() error           { return r.checkSetupError }
-func (r *resource) CheckError() error                { return r.checkError }
-func (r *resource) WebhookToken() string             { return r.webhookToken }
-func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
-func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
-func (r *resource) PinComment() string               { return r.pinComment }
-func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
-func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
-func (r *resource) Icon() string                     { return r.icon }
+func (r *resource) ID() int                                { return r.id }
+func (r *resource) Name() string                           { return r.name }
+func (r *resource) Public() bool                           { return r.public }
+func (r *resource) PipelineID() int                        { return r.pipelineID }
+func (r *resource) PipelineName() string                   { return r.pipelineName }
+func (r *resource) PipelineInstanceVars() atc.InstanceVars { return r.pipelineInstanceVars }
+func (r *resource) TeamName() string                       { return r.teamName }
+func (r *resource) Type() string                           { return r.type_ }
+func (r *resource) Source() atc.Source                     { return r.source }
+func (r *resource) CheckEvery() string                     { return r.checkEvery }
+func (r *resource) CheckTimeout() string                   { return r.checkTimeout }
+func (r *resource) LastCheckStartTime() time.Time          { return r.lastCheckStartTime }
+func (r *resource) LastCheckEndTime() time.Time            { return r.lastCheckEndTime }
+func (r *resource) Tags() atc.Tags                         { return r.tags }
+func (r *resource) CheckSetupError() error                 { return r.checkSetupError }
+func (r *resource) CheckError() error                      { return r.checkError }
+func (r *resource) ConsecutiveCheckErrors() int            { return r.checkErrorCount }
+func (r *resource) CheckInterval() time.Duration           { return r.checkInterval }
+func (r *resource) LatestCheckID() int                     { return r.latestCheckID }
+func (r *resource) WebhookToken() string                   { return r.webhookToken }
+func (r *resource) ConfigPinnedVersion() atc.Version       { return r.configPinnedVersion }
+func (r *resource) APIPinnedVersion() atc.Version          { return r.apiPinnedVersion }
+func (r *resource) PinComment() string                     { return r.pinComment }
+func (r *resource) ResourceConfigID() int                  { return r.resourceConfigID }
+func (r *resource) ResourceConfigScopeID() int             { return r.resourceConfigScopeID }
+func (r *resource) Icon() string                           { return r.icon }
+
+func (r *resource) WebhookFilter() *atc.WebhookFilter { return r.webhookFilter }
 
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
@@ -639,24 +655,32 @@ func (r *resource) toggleVersion(rcvID int, enable bool) error {
 	return tx.Commit()
 }
 
-func (r *resource) NotifyScan() error {
-	return r.conn.Bus().Notify(fmt.Sprintf("resource_scan_%d", r.id))
-}
-
 func scanResource(r *resource, row scannable) error {
 	var (
 		configBlob                                                                  []byte
 		checkErr, rcsCheckErr, nonce, rcID, rcScopeID, apiPinnedVersion, pinComment sql.NullString
+		pipelineInstanceVars                                                        sql.NullString
 		lastCheckStartTime, lastCheckEndTime                                        pq.NullTime
+		consecutiveCheckErrors, checkInterval, latestCheckID                        sql.NullInt64
 	)
 
-	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &r.teamName, &rcsCheckErr, &apiPinnedVersion, &pinComment)
+	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamName, &rcsCheckErr, &apiPinnedVersion, &pinComment, &consecutiveCheckErrors, &checkInterval, &latestCheckID)
 	if err != nil {
 		return err
 	}
 
 	r.lastCheckStartTime = lastCheckStartTime.Time
 	r.lastCheckEndTime = lastCheckEndTime.Time
+	r.checkErrorCount = int(consecutiveCheckErrors.Int64)
+	r.checkInterval = time.Duration(checkInterval.Int64)
+	r.latestCheckID = int(latestCheckID.Int64)
+
+	if pipelineInstanceVars.Valid {
+		err = json.Unmarshal([]byte(pipelineInstanceVars.String), &r.pipelineInstanceVars)
+		if err != nil {
+			return err
+		}
+	}
 
 	es := r.conn.EncryptionStrategy()
 
@@ -682,6 +706,7 @@ func scanResource(r *resource, row scannable) error {
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
 	r.webhookToken = config.WebhookToken
+	r.webhookFilter = config.WebhookFilter
 	r.configPinnedVersion = config.Version
 	r.icon = config.Icon
 
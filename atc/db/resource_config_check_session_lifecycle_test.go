@@ -76,7 +76,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 
 			It("removes check sessions for inactive resources", func() {
 				By("removing the default resource from the pipeline config")
-				_, _, err := defaultTeam.SavePipeline("default-pipeline", atc.Config{
+				_, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -92,7 +92,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 							},
 						},
 					},
-				}, defaultPipeline.ConfigVersion(), false)
+				}, defaultPipeline.ConfigVersion(), false, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				By("cleaning up inactive sessions")
@@ -107,7 +107,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 
 			It("removes check sessions for resources in paused pipelines", func() {
 				By("pausing the pipeline")
-				Expect(defaultPipeline.Pause()).To(Succeed())
+				Expect(defaultPipeline.Pause("", "")).To(Succeed())
 
 				By("cleaning up inactive sessions")
 				Expect(lifecycle.CleanInactiveResourceConfigCheckSessions()).To(Succeed())
@@ -173,7 +173,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 
 			It("removes check sessions for inactive resource types", func() {
 				By("removing the default resource from the pipeline config")
-				_, _, err := defaultTeam.SavePipeline("default-pipeline", atc.Config{
+				_, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -189,7 +189,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 						},
 					},
 					ResourceTypes: atc.ResourceTypes{},
-				}, defaultPipeline.ConfigVersion(), false)
+				}, defaultPipeline.ConfigVersion(), false, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				By("cleaning up inactive sessions")
@@ -204,7 +204,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 
 			It("removes check sessions for resource types in paused pipelines", func() {
 				By("pausing the pipeline")
-				Expect(defaultPipeline.Pause()).To(Succeed())
+				Expect(defaultPipeline.Pause("", "")).To(Succeed())
 
 				By("cleaning up inactive sessions")
 				Expect(lifecycle.CleanInactiveResourceConfigCheckSessions()).To(Succeed())
@@ -164,12 +164,14 @@ func (r *resourceConfigScope) SetCheckError(cause error) error {
 	if cause == nil {
 		_, err = psql.Update("resource_config_scopes").
 			Set("check_error", nil).
+			Set("consecutive_check_errors", 0).
 			Where(sq.Eq{"id": r.id}).
 			RunWith(r.conn).
 			Exec()
 	} else {
 		_, err = psql.Update("resource_config_scopes").
 			Set("check_error", cause.Error()).
+			Set("consecutive_check_errors", sq.Expr("consecutive_check_errors + 1")).
 			Where(sq.Eq{"id": r.id}).
 			RunWith(r.conn).
 			Exec()
@@ -198,17 +200,17 @@ func (r *resourceConfigScope) UpdateLastCheckStartTime(
 
 	defer Rollback(tx)
 
-	params := []interface{}{r.id}
+	params := []interface{}{r.id, int64(interval)}
 
 	condition := ""
 	if !immediate {
-		condition = "AND now() - last_check_start_time > ($2 || ' SECONDS')::INTERVAL"
+		condition = "AND now() - last_check_start_time > ($3 || ' SECONDS')::INTERVAL"
 		params = append(params, interval.Seconds())
 	}
 
 	updated, err := checkIfRowsUpdated(tx, `
 			UPDATE resource_config_scopes
-			SET last_check_start_time = now()
+			SET last_check_start_time = now(), check_interval = $2
 			WHERE id = $1
 		`+condition, params...)
 	if err != nil {
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"errors"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -25,7 +26,7 @@ var _ = Describe("Resource Config Scope", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(setupTx.Commit()).To(Succeed())
 
-		pipeline, _, err := defaultTeam.SavePipeline("scope-pipeline", atc.Config{
+		pipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "scope-pipeline"}, atc.Config{
 			Resources: atc.ResourceConfigs{
 				{
 					Name: "some-resource",
@@ -35,7 +36,7 @@ var _ = Describe("Resource Config Scope", func() {
 					},
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		resource, found, err := pipeline.Resource("some-resource")
@@ -213,6 +214,15 @@ var _ = Describe("Resource Config Scope", func() {
 				Expect(updated).To(BeTrue())
 			})
 
+			It("records the interval it was checked on", func() {
+				_, err := resourceConfigScope.UpdateLastCheckStartTime(4*time.Minute, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = someResource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(someResource.CheckInterval()).To(Equal(4 * time.Minute))
+			})
+
 			Context("when immediate", func() {
 				It("should update the last checked", func() {
 					updated, err := resourceConfigScope.UpdateLastCheckStartTime(1*time.Second, true)
@@ -261,6 +271,47 @@ var _ = Describe("Resource Config Scope", func() {
 		})
 	})
 
+	Describe("SetCheckError", func() {
+		var someResource db.Resource
+
+		BeforeEach(func() {
+			var err error
+			var found bool
+
+			someResource, found, err = defaultPipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceScope, err = someResource.SetResourceConfig(
+				someResource.Source(),
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("counts the errors in a row", func() {
+			Expect(resourceScope.SetCheckError(errors.New("nope"))).To(Succeed())
+			Expect(resourceScope.SetCheckError(errors.New("nope again"))).To(Succeed())
+
+			_, err := someResource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(someResource.CheckError()).To(MatchError("nope again"))
+			Expect(someResource.ConsecutiveCheckErrors()).To(Equal(2))
+		})
+
+		Context("when the check succeeds", func() {
+			It("clears the error and the count", func() {
+				Expect(resourceScope.SetCheckError(errors.New("nope"))).To(Succeed())
+				Expect(resourceScope.SetCheckError(nil)).To(Succeed())
+
+				_, err := someResource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(someResource.CheckError()).To(BeNil())
+				Expect(someResource.ConsecutiveCheckErrors()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("UpdateLastCheckEndTime", func() {
 		var (
 			someResource        db.Resource
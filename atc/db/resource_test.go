@@ -21,7 +21,7 @@ var _ = Describe("Resource", func() {
 		)
 
 		pipeline, created, err = defaultTeam.SavePipeline(
-			"pipeline-with-resources",
+			atc.PipelineRef{Name: "pipeline-with-resources"},
 			atc.Config{
 				Resources: atc.ResourceConfigs{
 					{
@@ -53,6 +53,7 @@ var _ = Describe("Resource", func() {
 			},
 			0,
 			false,
+			"",
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -236,10 +237,11 @@ var _ = Describe("Resource", func() {
 			}
 
 			pipeline, created, err = defaultTeam.SavePipeline(
-				"pipeline-with-same-resources",
+				atc.PipelineRef{Name: "pipeline-with-same-resources"},
 				config,
 				0,
 				false,
+				"",
 			)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
@@ -456,10 +458,11 @@ var _ = Describe("Resource", func() {
 					BeforeEach(func() {
 						config.Resources[2].Source = atc.Source{"some": "other-repo"}
 						newPipeline, _, err := defaultTeam.SavePipeline(
-							"pipeline-with-same-resources",
+							atc.PipelineRef{Name: "pipeline-with-same-resources"},
 							config,
 							pipeline.ConfigVersion(),
 							false,
+							"",
 						)
 						Expect(err).ToNot(HaveOccurred())
 
@@ -488,10 +491,11 @@ var _ = Describe("Resource", func() {
 					BeforeEach(func() {
 						config.ResourceTypes[0].UniqueVersionHistory = false
 						newPipeline, _, err := defaultTeam.SavePipeline(
-							"pipeline-with-same-resources",
+							atc.PipelineRef{Name: "pipeline-with-same-resources"},
 							config,
 							pipeline.ConfigVersion(),
 							false,
+							"",
 						)
 						Expect(err).ToNot(HaveOccurred())
 
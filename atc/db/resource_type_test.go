@@ -19,7 +19,7 @@ var _ = Describe("ResourceType", func() {
 		)
 
 		pipeline, created, err = defaultTeam.SavePipeline(
-			"pipeline-with-types",
+			atc.PipelineRef{Name: "pipeline-with-types"},
 			atc.Config{
 				ResourceTypes: atc.ResourceTypes{
 					{
@@ -49,6 +49,7 @@ var _ = Describe("ResourceType", func() {
 			},
 			0,
 			false,
+			"",
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -107,7 +108,7 @@ var _ = Describe("ResourceType", func() {
 				)
 
 				pipeline, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+					atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						ResourceTypes: atc.ResourceTypes{
 							{
@@ -119,6 +120,7 @@ var _ = Describe("ResourceType", func() {
 					},
 					pipeline.ConfigVersion(),
 					false,
+					"",
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
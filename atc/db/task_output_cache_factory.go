@@ -0,0 +1,147 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// taskOutputCachePathPrefix prefixes the task cache paths of cached task
+// outputs, keeping them apart from the task's own caches.
+const taskOutputCachePathPrefix = "outputs:"
+
+// TaskOutputCachePath returns the task cache path under which a volume
+// holding the given output of a task step with the given cache key is kept.
+func TaskOutputCachePath(key string, output string) string {
+	return taskOutputCachePathPrefix + key + "/" + output
+}
+
+// TaskOutputCache records the outputs of the most recent successful run of a
+// task step, along with the cache key they were produced for.
+type TaskOutputCache struct {
+	Key       string
+	BuildID   int
+	BuildName string
+
+	// Outputs maps the name of each of the task's outputs to the handle of
+	// the task cache volume holding it. It is only set by Find, as the
+	// volumes are looked up through their task caches.
+	Outputs map[string]string
+}
+
+//go:generate counterfeiter . TaskOutputCacheFactory
+
+type TaskOutputCacheFactory interface {
+	Find(jobID int, stepName string, key string) (TaskOutputCache, bool, error)
+	Save(jobID int, stepName string, cache TaskOutputCache) error
+}
+
+type taskOutputCacheFactory struct {
+	conn Conn
+}
+
+func NewTaskOutputCacheFactory(conn Conn) TaskOutputCacheFactory {
+	return &taskOutputCacheFactory{
+		conn: conn,
+	}
+}
+
+// Find returns the outputs cached for the step if they were produced for the
+// given key. Outputs whose task cache volume is gone are left out.
+func (f *taskOutputCacheFactory) Find(jobID int, stepName string, key string) (TaskOutputCache, bool, error) {
+	var cache TaskOutputCache
+	err := psql.Select("c.cache_key", "c.build_id", "b.name").
+		From("task_output_caches c").
+		Join("builds b ON b.id = c.build_id").
+		Where(sq.Eq{
+			"c.job_id":    jobID,
+			"c.step_name": stepName,
+			"c.cache_key": key,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&cache.Key, &cache.BuildID, &cache.BuildName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TaskOutputCache{}, false, nil
+		}
+
+		return TaskOutputCache{}, false, err
+	}
+
+	pathPrefix := TaskOutputCachePath(key, "")
+
+	rows, err := psql.Select("tc.path", "v.handle").
+		From("volumes v").
+		Join("worker_task_caches wtc ON wtc.id = v.worker_task_cache_id").
+		Join("task_caches tc ON tc.id = wtc.task_cache_id").
+		Where(sq.Eq{
+			"tc.job_id":    jobID,
+			"tc.step_name": stepName,
+			"v.state":      VolumeStateCreated,
+		}).
+		Where(sq.Like{"tc.path": pathPrefix + "%"}).
+		OrderBy("v.id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return TaskOutputCache{}, false, err
+	}
+
+	defer Close(rows)
+
+	cache.Outputs = map[string]string{}
+	for rows.Next() {
+		var path, handle string
+		err = rows.Scan(&path, &handle)
+		if err != nil {
+			return TaskOutputCache{}, false, err
+		}
+
+		cache.Outputs[strings.TrimPrefix(path, pathPrefix)] = handle
+	}
+
+	return cache, true, nil
+}
+
+// Save records the key of the step's most recent successful run, whose
+// outputs must already be initialized as task caches under
+// TaskOutputCachePath. The task caches holding the outputs of any other key
+// are removed so that their volumes can be garbage-collected.
+func (f *taskOutputCacheFactory) Save(jobID int, stepName string, cache TaskOutputCache) error {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("task_output_caches").
+		Columns("job_id", "step_name", "cache_key", "build_id").
+		Values(jobID, stepName, cache.Key, cache.BuildID).
+		Suffix(`
+			ON CONFLICT (job_id, step_name) DO UPDATE SET
+				cache_key = EXCLUDED.cache_key,
+				build_id = EXCLUDED.build_id
+		`).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+	_, err = psql.Delete("task_caches").
+		Where(sq.Eq{
+			"job_id":    jobID,
+			"step_name": stepName,
+		}).
+		Where(sq.Like{"path": taskOutputCachePathPrefix + "%"}).
+		Where(sq.NotLike{"path": taskOutputCachePathPrefix + cache.Key + "/%"}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
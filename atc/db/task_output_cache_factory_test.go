@@ -0,0 +1,156 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskOutputCacheFactory", func() {
+	var (
+		build db.Build
+		cache db.TaskOutputCache
+	)
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultJob.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		cache = db.TaskOutputCache{
+			Key:     "some-key",
+			BuildID: build.ID(),
+		}
+	})
+
+	initializeOutputVolume := func(key string, output string) db.CreatedVolume {
+		creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{})
+		Expect(err).ToNot(HaveOccurred())
+
+		creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-path")
+		Expect(err).ToNot(HaveOccurred())
+
+		volume, err := creatingVolume.Created()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = volume.InitializeTaskCache(defaultJob.ID(), "some-step", db.TaskOutputCachePath(key, output))
+		Expect(err).ToNot(HaveOccurred())
+
+		return volume
+	}
+
+	Describe("Find", func() {
+		Context("when no outputs were saved", func() {
+			It("returns not found", func() {
+				_, found, err := taskOutputCacheFactory.Find(defaultJob.ID(), "some-step", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when outputs were saved", func() {
+			var volume db.CreatedVolume
+
+			BeforeEach(func() {
+				volume = initializeOutputVolume("some-key", "some-output")
+
+				err := taskOutputCacheFactory.Save(defaultJob.ID(), "some-step", cache)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the volumes of their task caches along with the name of the build that produced them", func() {
+				saved, found, err := taskOutputCacheFactory.Find(defaultJob.ID(), "some-step", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				cache.BuildName = build.Name()
+				cache.Outputs = map[string]string{"some-output": volume.Handle()}
+				Expect(saved).To(Equal(cache))
+			})
+
+			Context("when another volume is initialized as the output's task cache", func() {
+				var otherVolume db.CreatedVolume
+
+				BeforeEach(func() {
+					otherVolume = initializeOutputVolume("some-key", "some-output")
+				})
+
+				It("returns the other volume", func() {
+					saved, found, err := taskOutputCacheFactory.Find(defaultJob.ID(), "some-step", "some-key")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(saved.Outputs).To(Equal(map[string]string{"some-output": otherVolume.Handle()}))
+				})
+			})
+
+			It("does not return them for another key", func() {
+				_, found, err := taskOutputCacheFactory.Find(defaultJob.ID(), "some-step", "some-other-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("does not return them for another step", func() {
+				_, found, err := taskOutputCacheFactory.Find(defaultJob.ID(), "some-other-step", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Save", func() {
+		BeforeEach(func() {
+			err := taskOutputCacheFactory.Save(defaultJob.ID(), "some-step", cache)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when outputs are saved for another key", func() {
+			var (
+				oldTaskCache   db.UsedTaskCache
+				otherTaskCache db.UsedTaskCache
+				otherHandle    string
+			)
+
+			BeforeEach(func() {
+				var err error
+				oldTaskCache, err = taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-step", db.TaskOutputCachePath("some-key", "some-output"))
+				Expect(err).ToNot(HaveOccurred())
+
+				otherTaskCache, err = taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-step", "some-path")
+				Expect(err).ToNot(HaveOccurred())
+
+				otherVolume := initializeOutputVolume("some-other-key", "some-output")
+				otherHandle = otherVolume.Handle()
+
+				err = taskOutputCacheFactory.Save(defaultJob.ID(), "some-step", db.TaskOutputCache{
+					Key:     "some-other-key",
+					BuildID: build.ID(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("replaces the saved outputs", func() {
+				_, found, err := taskOutputCacheFactory.Find(defaultJob.ID(), "some-step", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				saved, found, err := taskOutputCacheFactory.Find(defaultJob.ID(), "some-step", "some-other-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(saved.Outputs).To(Equal(map[string]string{"some-output": otherHandle}))
+			})
+
+			It("removes the task caches of the old outputs", func() {
+				_, found, err := taskCacheFactory.Find(defaultJob.ID(), "some-step", oldTaskCache.Path())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("keeps the task's own caches", func() {
+				_, found, err := taskCacheFactory.Find(defaultJob.ID(), "some-step", otherTaskCache.Path())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
+})
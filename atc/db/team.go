@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"code.cloudfoundry.org/lager"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
@@ -17,6 +19,16 @@ import (
 
 var ErrConfigComparisonFailed = errors.New("comparison with existing config failed during save")
 
+// InvalidPassedJobsError is returned when saving a pipeline whose inputs are
+// passed through jobs of other pipelines which can't be found in the team.
+type InvalidPassedJobsError struct {
+	Errors []string
+}
+
+func (err InvalidPassedJobsError) Error() string {
+	return "invalid passed jobs:\n" + strings.Join(err.Errors, "\n")
+}
+
 //go:generate counterfeiter . Team
 
 type Team interface {
@@ -30,13 +42,25 @@ type Team interface {
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
+		createdBy string,
+	) (Pipeline, bool, error)
+
+	SavePipelineFromBuild(
+		pipelineRef atc.PipelineRef,
+		config atc.Config,
+		from ConfigVersion,
+		jobID int,
+		buildID int,
+		createdBy string,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	ValidatePassedJobs(config atc.Config) ([]string, error)
+
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	VisiblePipelines() ([]Pipeline, error)
@@ -58,7 +82,7 @@ type Team interface {
 	IsContainerWithinTeam(string, bool) (bool, error)
 
 	FindContainerByHandle(string) (Container, bool, error)
-	FindCheckContainers(string, string, creds.Secrets) ([]Container, map[int]time.Time, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 	FindWorkerForContainer(handle string) (Worker, bool, error)
@@ -338,16 +362,106 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
+	createdBy string,
+) (Pipeline, bool, error) {
+	return t.savePipeline(pipelineRef, config, from, initiallyPaused, createdBy, nil)
+}
+
+// pipelineParent is the job and build whose set_pipeline step configured a
+// pipeline.
+type pipelineParent struct {
+	jobID   int
+	buildID int
+}
+
+// SavePipelineFromBuild saves a pipeline configured by a set_pipeline step of
+// the given job's build, recording the job and build as its parent. A build
+// may not take over a pipeline which was last set by a newer build, in which
+// case ErrSetByNewerBuild is returned and the pipeline is left untouched.
+func (t *team) SavePipelineFromBuild(
+	pipelineRef atc.PipelineRef,
+	config atc.Config,
+	from ConfigVersion,
+	jobID int,
+	buildID int,
+	createdBy string,
+) (Pipeline, bool, error) {
+	if jobID <= 0 || buildID <= 0 {
+		return nil, false, errors.New("job and build id cannot be negative or zero-value")
+	}
+
+	return t.savePipeline(pipelineRef, config, from, false, createdBy, &pipelineParent{
+		jobID:   jobID,
+		buildID: buildID,
+	})
+}
+
+func (t *team) savePipeline(
+	pipelineRef atc.PipelineRef,
+	config atc.Config,
+	from ConfigVersion,
+	initiallyPaused bool,
+	createdBy string,
+	parent *pipelineParent,
 ) (Pipeline, bool, error) {
 	groupsPayload, err := json.Marshal(config.Groups)
 	if err != nil {
 		return nil, false, err
 	}
 
+	var varSourcesPayload *string
+	var nonce *string
+	if len(config.VarSources) != 0 {
+		varSourcesJSON, err := json.Marshal(config.VarSources)
+		if err != nil {
+			return nil, false, err
+		}
+
+		encryptedPayload, noncense, err := t.conn.EncryptionStrategy().Encrypt(varSourcesJSON)
+		if err != nil {
+			return nil, false, err
+		}
+
+		varSourcesPayload = &encryptedPayload
+		nonce = noncense
+	}
+
+	var varsPayload []byte
+	if len(config.Vars) != 0 {
+		varsPayload, err = json.Marshal(config.Vars)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	var displayPayload []byte
+	if config.Display != nil {
+		displayPayload, err = json.Marshal(config.Display)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	var freezeWindowsPayload []byte
+	if len(config.FreezeWindows) != 0 {
+		freezeWindowsPayload, err = json.Marshal(config.FreezeWindows)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	var instanceVarsPayload []byte
+	if len(pipelineRef.InstanceVars) != 0 {
+		instanceVarsPayload, err = json.Marshal(pipelineRef.InstanceVars)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	jobGroups := make(map[string][]string)
 	for _, group := range config.Groups {
 		for _, job := range group.Jobs {
@@ -356,7 +470,6 @@ func (t *team) SavePipeline(
 	}
 
 	var created bool
-	var existingConfig int
 
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -365,27 +478,78 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
-	err = tx.QueryRow(`
-		SELECT COUNT(1)
-		FROM pipelines
-		WHERE name = $1
-	  AND team_id = $2
-	`, pipelineName, t.id).Scan(&existingConfig)
+	errorMessages, err := t.validatePassedJobs(tx, config)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(errorMessages) > 0 {
+		return nil, false, InvalidPassedJobsError{Errors: errorMessages}
+	}
+
+	// the existing pipeline is locked so that concurrent builds setting it
+	// are compared against each other's parent build
+	refEq, err := pipelineRefEq("", pipelineRef)
 	if err != nil {
 		return nil, false, err
 	}
 
+	existing := true
+	var existingParentBuildID sql.NullInt64
+	err = psql.Select("parent_build_id").
+		From("pipelines").
+		Where(sq.Eq{"team_id": t.id}).
+		Where(refEq).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingParentBuildID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, false, err
+		}
+
+		existing = false
+	}
+
+	if parent != nil && existingParentBuildID.Valid && int(existingParentBuildID.Int64) > parent.buildID {
+		return nil, false, ErrSetByNewerBuild
+	}
+
+	parentIDs := map[string]interface{}{}
+	if parent != nil {
+		parentIDs["parent_job_id"] = parent.jobID
+		parentIDs["parent_build_id"] = parent.buildID
+	}
+
 	var pipelineID int
-	if existingConfig == 0 {
+	if !existing {
+		// new instances are ordered along with the other instances sharing
+		// their name
+		values := map[string]interface{}{
+			"name":           pipelineRef.Name,
+			"instance_vars":  instanceVarsPayload,
+			"groups":         groupsPayload,
+			"var_sources":    varSourcesPayload,
+			"nonce":          nonce,
+			"vars":           varsPayload,
+			"display":        displayPayload,
+			"freeze_windows": freezeWindowsPayload,
+			"version":        sq.Expr("nextval('config_version_seq')"),
+			"ordering": sq.Expr(`COALESCE(
+				(SELECT MIN(ordering) FROM pipelines WHERE team_id = ? AND name = ?),
+				currval('pipelines_id_seq')
+			)`, t.id, pipelineRef.Name),
+			"paused":  initiallyPaused,
+			"team_id": t.id,
+		}
+
+		for column, id := range parentIDs {
+			values[column] = id
+		}
+
 		err = psql.Insert("pipelines").
-			SetMap(map[string]interface{}{
-				"name":     pipelineName,
-				"groups":   groupsPayload,
-				"version":  sq.Expr("nextval('config_version_seq')"),
-				"ordering": sq.Expr("currval('pipelines_id_seq')"),
-				"paused":   initiallyPaused,
-				"team_id":  t.id,
-			}).
+			SetMap(values).
 			Suffix("RETURNING id").
 			RunWith(tx).
 			QueryRow().Scan(&pipelineID)
@@ -397,12 +561,19 @@ func (t *team) SavePipeline(
 	} else {
 		update := psql.Update("pipelines").
 			Set("groups", groupsPayload).
+			Set("var_sources", varSourcesPayload).
+			Set("nonce", nonce).
+			Set("vars", varsPayload).
+			Set("display", displayPayload).
+			Set("freeze_windows", freezeWindowsPayload).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
+			Set("archived", false).
+			SetMap(parentIDs).
 			Where(sq.Eq{
-				"name":    pipelineName,
 				"version": from,
 				"team_id": t.id,
 			}).
+			Where(refEq).
 			Suffix("RETURNING id")
 
 		err = update.RunWith(tx).QueryRow().Scan(&pipelineID)
@@ -426,6 +597,18 @@ func (t *team) SavePipeline(
 			return nil, false, err
 		}
 
+		_, err = tx.Exec(`
+      DELETE FROM jobs_passed_jobs
+      WHERE job_id in (
+        SELECT j.id
+        FROM jobs j
+        WHERE j.pipeline_id = $1
+      )
+		`, pipelineID)
+		if err != nil {
+			return nil, false, err
+		}
+
 		_, err = tx.Exec(`
 			UPDATE jobs
 			SET active = false
@@ -495,6 +678,20 @@ func (t *team) SavePipeline(
 				return nil, false, err
 			}
 		}
+
+		for _, input := range job.Inputs() {
+			for _, name := range input.Passed {
+				passedJob := atc.ParsePassedJob(name)
+				if !passedJob.IsExternal() {
+					continue
+				}
+
+				err = t.registerPassedJob(tx, job.Name, passedJob, pipelineID)
+				if err != nil {
+					return nil, false, err
+				}
+			}
+		}
 	}
 
 	err = removeUnusedWorkerTaskCaches(tx, pipelineID, config.Jobs)
@@ -515,6 +712,11 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	err = t.savePipelineConfig(tx, pipelineID, pipeline.ConfigVersion(), config, createdBy)
+	if err != nil {
+		return nil, false, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, false, err
@@ -523,16 +725,19 @@ func (t *team) SavePipeline(
 	return pipeline, created, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	refEq, err := pipelineRefEq("p.", pipelineRef)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
-			Where(sq.Eq{
-				"p.team_id": t.id,
-				"p.name":    pipelineName,
-			}).
+			Where(sq.Eq{"p.team_id": t.id}).
+			Where(refEq).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -551,7 +756,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy("ordering", "p.id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -572,7 +777,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "p.id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -590,7 +795,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err := pipelinesQuery.
 		Where(sq.Eq{"team_id": t.id}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "p.id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -605,7 +810,7 @@ func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err = pipelinesQuery.
 		Where(sq.NotEq{"team_id": t.id}).
 		Where(sq.Eq{"public": true}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "p.id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -797,8 +1002,8 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
-func (t *team) FindCheckContainers(pipelineName string, resourceName string, secretManager creds.Secrets) ([]Container, map[int]time.Time, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -819,7 +1024,7 @@ func (t *team) FindCheckContainers(pipelineName string, resourceName string, sec
 		return nil, nil, err
 	}
 
-	variables := creds.NewVariables(secretManager, t.name, pipeline.Name())
+	variables := pipeline.Variables(logger, secretManager, varSourcePool)
 
 	versionedResourceTypes := pipelineResourceTypes.Deserialize()
 
@@ -1043,6 +1248,119 @@ func (t *team) registerSerialGroup(tx Tx, jobName, serialGroup string, pipelineI
 	return swallowUniqueViolation(err)
 }
 
+// ValidatePassedJobs returns an error message for every input of the config
+// which is passed through a job of another pipeline that can't be found in the
+// team, as saving the config would do.
+func (t *team) ValidatePassedJobs(config atc.Config) ([]string, error) {
+	return t.validatePassedJobs(t.conn, config)
+}
+
+// validatePassedJobs checks that the jobs of other pipelines named in passed
+// constraints exist within the team. Passed jobs are referenced by pipeline
+// name only, so they can't be jobs of an instanced pipeline.
+func (t *team) validatePassedJobs(runner sq.BaseRunner, config atc.Config) ([]string, error) {
+	errorMessages := []string{}
+
+	for _, job := range config.Jobs {
+		for _, input := range job.Inputs() {
+			for _, name := range input.Passed {
+				passedJob := atc.ParsePassedJob(name)
+				if !passedJob.IsExternal() {
+					continue
+				}
+
+				identifier := fmt.Sprintf("jobs.%s.get.%s.passed", job.Name, input.Name)
+
+				// a pipeline without instance vars takes precedence over
+				// instances sharing its name
+				var instanced, jobFound bool
+				err := psql.Select("p.instance_vars IS NOT NULL").
+					Column(sq.Expr("EXISTS (SELECT 1 FROM jobs j WHERE j.pipeline_id = p.id AND j.name = ? AND j.active)", passedJob.Job)).
+					From("pipelines p").
+					Where(sq.Eq{
+						"p.team_id": t.id,
+						"p.name":    passedJob.Pipeline,
+					}).
+					OrderBy("p.instance_vars IS NOT NULL").
+					Limit(1).
+					RunWith(runner).
+					QueryRow().
+					Scan(&instanced, &jobFound)
+				if err != nil {
+					if err == sql.ErrNoRows {
+						errorMessages = append(errorMessages, fmt.Sprintf("%s references an unknown pipeline ('%s')", identifier, passedJob.Pipeline))
+						continue
+					}
+
+					return nil, err
+				}
+
+				if instanced {
+					errorMessages = append(errorMessages, fmt.Sprintf("%s references an instanced pipeline ('%s'), which passed constraints cannot refer to", identifier, passedJob.Pipeline))
+					continue
+				}
+
+				if !jobFound {
+					errorMessages = append(errorMessages, fmt.Sprintf("%s references an unknown job ('%s')", identifier, name))
+				}
+			}
+		}
+	}
+
+	return errorMessages, nil
+}
+
+// registerPassedJob records that a job's inputs are passed through a job of
+// another pipeline in the team, so that the job's builds can be scheduled
+// from that job's builds. The job is referenced by name, as its pipeline may
+// be destroyed and set again after this pipeline is saved.
+func (t *team) registerPassedJob(tx Tx, jobName string, passedJob atc.PassedJob, pipelineID int) error {
+	_, err := tx.Exec(`
+    INSERT INTO jobs_passed_jobs (job_id, passed_pipeline_name, passed_job_name)
+    SELECT j.id, $3, $4
+    FROM jobs j
+    WHERE j.name = $1
+      AND j.pipeline_id = $2
+    ON CONFLICT DO NOTHING`,
+		jobName, pipelineID, passedJob.Pipeline, passedJob.Job,
+	)
+
+	return err
+}
+
+// savePipelineConfig keeps every saved version of a pipeline's config so that
+// it can be listed and rolled back to.
+func (t *team) savePipelineConfig(tx Tx, pipelineID int, version ConfigVersion, config atc.Config, createdBy string) error {
+	configPayload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	es := t.conn.EncryptionStrategy()
+	encryptedPayload, nonce, err := es.Encrypt(configPayload)
+	if err != nil {
+		return err
+	}
+
+	var createdByPayload *string
+	if createdBy != "" {
+		createdByPayload = &createdBy
+	}
+
+	_, err = psql.Insert("pipeline_configs").
+		SetMap(map[string]interface{}{
+			"pipeline_id": pipelineID,
+			"version":     version,
+			"config":      encryptedPayload,
+			"nonce":       nonce,
+			"created_by":  createdByPayload,
+		}).
+		RunWith(tx).
+		Exec()
+
+	return err
+}
+
 func (t *team) saveResource(tx Tx, resource atc.ResourceConfig, pipelineID int) error {
 	configPayload, err := json.Marshal(resource)
 	if err != nil {
@@ -1055,6 +1373,11 @@ func (t *team) saveResource(tx Tx, resource atc.ResourceConfig, pipelineID int)
 		return err
 	}
 
+	changed, err := t.sourceChanged(tx, "resources", resource.Name, pipelineID, resource.Type, resource.Source)
+	if err != nil {
+		return err
+	}
+
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE resources
 		SET config = $3, active = true, nonce = $4, type = $5
@@ -1064,6 +1387,18 @@ func (t *team) saveResource(tx Tx, resource atc.ResourceConfig, pipelineID int)
 		return err
 	}
 
+	if changed {
+		_, err = psql.Update("resources").
+			Set("resource_config_id", nil).
+			Set("resource_config_scope_id", nil).
+			Where(sq.Eq{"name": resource.Name, "pipeline_id": pipelineID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
 	if resource.Version != nil {
 		resourceIDQuery := `
 				resource_pins.resource_id =
@@ -1103,6 +1438,11 @@ func (t *team) saveResourceType(tx Tx, resourceType atc.ResourceType, pipelineID
 		return err
 	}
 
+	changed, err := t.sourceChanged(tx, "resource_types", resourceType.Name, pipelineID, resourceType.Type, resourceType.Source)
+	if err != nil {
+		return err
+	}
+
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE resource_types
 		SET config = $3, type = $4, active = true, nonce = $5
@@ -1112,6 +1452,29 @@ func (t *team) saveResourceType(tx Tx, resourceType atc.ResourceType, pipelineID
 		return err
 	}
 
+	if changed {
+		_, err = psql.Update("resource_types").
+			Set("resource_config_id", nil).
+			Where(sq.Eq{"name": resourceType.Name, "pipeline_id": pipelineID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+
+		// the resources of the type are checked using a different resource
+		// config from now on
+		_, err = psql.Update("resources").
+			Set("resource_config_id", nil).
+			Set("resource_config_scope_id", nil).
+			Where(sq.Eq{"type": resourceType.Name, "pipeline_id": pipelineID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
 	if updated {
 		return nil
 	}
@@ -1124,6 +1487,65 @@ func (t *team) saveResourceType(tx Tx, resourceType atc.ResourceType, pipelineID
 	return swallowUniqueViolation(err)
 }
 
+// sourceChanged reports whether the existing resource or resource type of the
+// given table has a different type or source than the one being saved, in
+// which case the resource config it was last checked with no longer applies.
+func (t *team) sourceChanged(tx Tx, table string, name string, pipelineID int, type_ string, source atc.Source) (bool, error) {
+	var (
+		configBlob []byte
+		nonce      sql.NullString
+	)
+
+	err := psql.Select("config", "nonce").
+		From(table).
+		Where(sq.Eq{"name": name, "pipeline_id": pipelineID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&configBlob, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := t.conn.EncryptionStrategy().Decrypt(string(configBlob), noncense)
+	if err != nil {
+		return false, err
+	}
+
+	var existing struct {
+		Type   string     `json:"type"`
+		Source atc.Source `json:"source"`
+	}
+
+	err = json.Unmarshal(decryptedConfig, &existing)
+	if err != nil {
+		return false, err
+	}
+
+	if existing.Type != type_ {
+		return true, nil
+	}
+
+	existingSource, err := json.Marshal(existing.Source)
+	if err != nil {
+		return false, err
+	}
+
+	newSource, err := json.Marshal(source)
+	if err != nil {
+		return false, err
+	}
+
+	return string(existingSource) != string(newSource), nil
+}
+
 func checkIfRowsUpdated(tx Tx, query string, params ...interface{}) (bool, error) {
 	result, err := tx.Exec(query, params...)
 	if err != nil {
@@ -1178,13 +1600,49 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 	return creating, created, nil
 }
 
+// pipelineRefEq matches the pipeline identified by the ref. Pipelines which
+// are not instanced have no instance vars.
+func pipelineRefEq(prefix string, ref atc.PipelineRef) (sq.Sqlizer, error) {
+	if len(ref.InstanceVars) == 0 {
+		return sq.Eq{
+			prefix + "name":          ref.Name,
+			prefix + "instance_vars": nil,
+		}, nil
+	}
+
+	instanceVars, err := json.Marshal(ref.InstanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return sq.And{
+		sq.Eq{prefix + "name": ref.Name},
+		sq.Expr(prefix+"instance_vars = ?::jsonb", string(instanceVars)),
+	}, nil
+}
+
 func scanPipeline(p *pipeline, scan scannable) error {
-	var groups sql.NullString
-	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	var instanceVars, groups, varSources, nonce, pipelineVars, display, freezeWindows sql.NullString
+	var pausedBy, pauseComment sql.NullString
+	var parentJobID, parentBuildID sql.NullInt64
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &varSources, &nonce, &pipelineVars, &display, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &pausedBy, &pauseComment, &freezeWindows, &p.public, &p.archived, &parentJobID, &parentBuildID)
 	if err != nil {
 		return err
 	}
 
+	p.pausedBy = pausedBy.String
+	p.pauseComment = pauseComment.String
+
+	p.parentJobID = int(parentJobID.Int64)
+	p.parentBuildID = int(parentBuildID.Int64)
+
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -1195,6 +1653,51 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		p.groups = pipelineGroups
 	}
 
+	p.vars = nil
+	if pipelineVars.Valid {
+		err = json.Unmarshal([]byte(pipelineVars.String), &p.vars)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.display = nil
+	if display.Valid {
+		err = json.Unmarshal([]byte(display.String), &p.display)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.freezeWindows = nil
+	if freezeWindows.Valid {
+		err = json.Unmarshal([]byte(freezeWindows.String), &p.freezeWindows)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.varSources = nil
+	if varSources.Valid {
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedVarSources, err := p.conn.EncryptionStrategy().Decrypt(varSources.String, noncense)
+		if err != nil {
+			return err
+		}
+
+		var pipelineVarSources atc.VarSourceConfigs
+		err = json.Unmarshal(decryptedVarSources, &pipelineVarSources)
+		if err != nil {
+			return err
+		}
+
+		p.varSources = pipelineVarSources
+	}
+
 	return nil
 }
 
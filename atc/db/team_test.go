@@ -40,11 +40,11 @@ var _ = Describe("Team", func() {
 		var otherTeamPipeline db.Pipeline
 
 		BeforeEach(func() {
-			otherTeamPipeline, _, err = otherTeam.SavePipeline("fake-pipeline", atc.Config{
+			otherTeamPipeline, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = otherTeam.Delete()
@@ -512,7 +512,7 @@ var _ = Describe("Team", func() {
 					otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 					Expect(err).NotTo(HaveOccurred())
 
-					otherPipeline, _, err := otherTeam.SavePipeline("other-pipeline", atc.Config{
+					otherPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
@@ -536,7 +536,7 @@ var _ = Describe("Team", func() {
 								},
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).NotTo(HaveOccurred())
 
 					otherResource, found, err := otherPipeline.Resource("some-resource")
@@ -854,24 +854,45 @@ var _ = Describe("Team", func() {
 		Context("when the team has configured pipelines", func() {
 			BeforeEach(func() {
 				var err error
-				pipeline1, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
 			It("returns the pipelines", func() {
 				Expect(pipelines).To(Equal([]db.Pipeline{pipeline1, pipeline2}))
 			})
+
+			Context("when an instance of a pipeline is configured later", func() {
+				var instance db.Pipeline
+
+				BeforeEach(func() {
+					var err error
+					instance, _, err = team.SavePipeline(atc.PipelineRef{
+						Name:         "fake-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "release-1.2"},
+					}, atc.Config{
+						Jobs: atc.JobConfigs{
+							{Name: "job-name"},
+						},
+					}, db.ConfigVersion(1), false, "")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("groups it with the pipelines sharing its name", func() {
+					Expect(pipelines).To(Equal([]db.Pipeline{pipeline1, instance, pipeline2}))
+				})
+			})
 		})
 		Context("when the team has no configured pipelines", func() {
 			It("returns no pipelines", func() {
@@ -895,18 +916,18 @@ var _ = Describe("Team", func() {
 		Context("when the team has configured pipelines", func() {
 			BeforeEach(func() {
 				var err error
-				_, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -944,18 +965,18 @@ var _ = Describe("Team", func() {
 		Context("when the team has configured pipelines", func() {
 			BeforeEach(func() {
 				var err error
-				pipeline1, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err = otherTeam.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pipeline2.Expose()).To(Succeed())
@@ -969,11 +990,11 @@ var _ = Describe("Team", func() {
 			Context("when the other team has a private pipeline", func() {
 				BeforeEach(func() {
 					var err error
-					_, _, err = otherTeam.SavePipeline("fake-pipeline-three", atc.Config{
+					_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-three"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{Name: "job-fake-again"},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -998,14 +1019,14 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, false)
+			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name-a"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name-b"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, false)
+			otherPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-name-a"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, false)
+			otherPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-name-b"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1161,7 +1182,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1312,7 +1333,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1418,7 +1439,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1670,37 +1691,282 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
+		Context("when saving an instance of the pipeline", func() {
+			var (
+				pipeline    db.Pipeline
+				instanceRef atc.PipelineRef
+			)
+
+			BeforeEach(func() {
+				var err error
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				instanceRef = atc.PipelineRef{
+					Name:         pipelineName,
+					InstanceVars: atc.InstanceVars{"branch": "release-1.2"},
+				}
+			})
+
+			It("creates a separate pipeline identified by its instance vars", func() {
+				instance, created, err := team.SavePipeline(instanceRef, otherConfig, 0, false, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+				Expect(instance.ID()).ToNot(Equal(pipeline.ID()))
+				Expect(instance.Name()).To(Equal(pipelineName))
+				Expect(instance.InstanceVars()).To(Equal(instanceRef.InstanceVars))
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.InstanceVars()).To(BeNil())
+
+				_, found, err = pipeline.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("finds the instance by its instance vars", func() {
+				instance, _, err := team.SavePipeline(instanceRef, otherConfig, 0, false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				foundPipeline, found, err := team.Pipeline(instanceRef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundPipeline.ID()).To(Equal(instance.ID()))
+
+				foundPipeline, found, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundPipeline.ID()).To(Equal(pipeline.ID()))
+
+				_, found, err = team.Pipeline(atc.PipelineRef{
+					Name:         pipelineName,
+					InstanceVars: atc.InstanceVars{"branch": "release-1.3"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("updates the instance when it is saved again", func() {
+				instance, _, err := team.SavePipeline(instanceRef, otherConfig, 0, false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				updated, created, err := team.SavePipeline(instanceRef, config, instance.ConfigVersion(), false, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(updated.ID()).To(Equal(instance.ID()))
+			})
+		})
+
+		Context("when an input is passed through a job of another pipeline", func() {
+			var downstreamConfig atc.Config
+
+			BeforeEach(func() {
+				downstreamConfig = atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-other-resource",
+							Type:   "some-type",
+							Source: atc.Source{"source-config": "some-value"},
+						},
+					},
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							Plan: atc.PlanSequence{
+								{
+									Get:    "some-other-resource",
+									Passed: []string{"upstream-pipeline/some-other-job"},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			Context("when the job exists", func() {
+				BeforeEach(func() {
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, otherConfig, 0, false, "")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("saves the pipeline", func() {
+					_, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, downstreamConfig, 0, false, "")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(created).To(BeTrue())
+				})
+
+				It("reports no errors when validating the config", func() {
+					errorMessages, err := team.ValidatePassedJobs(downstreamConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
+			Context("when the job does not exist", func() {
+				BeforeEach(func() {
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, config, 0, false, "")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not save the pipeline", func() {
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, downstreamConfig, 0, false, "")
+					Expect(err).To(Equal(db.InvalidPassedJobsError{
+						Errors: []string{"jobs.downstream-job.get.some-other-resource.passed references an unknown job ('upstream-pipeline/some-other-job')"},
+					}))
+
+					_, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+
+			Context("when the pipeline does not exist", func() {
+				It("does not save the pipeline", func() {
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, downstreamConfig, 0, false, "")
+					Expect(err).To(Equal(db.InvalidPassedJobsError{
+						Errors: []string{"jobs.downstream-job.get.some-other-resource.passed references an unknown pipeline ('upstream-pipeline')"},
+					}))
+				})
+
+				It("does not save the pipeline when it is set by a build either", func() {
+					_, _, err := team.SavePipelineFromBuild(atc.PipelineRef{Name: pipelineName}, downstreamConfig, 0, 1, 1, "")
+					Expect(err).To(Equal(db.InvalidPassedJobsError{
+						Errors: []string{"jobs.downstream-job.get.some-other-resource.passed references an unknown pipeline ('upstream-pipeline')"},
+					}))
+				})
+
+				It("reports the error when validating the config", func() {
+					errorMessages, err := team.ValidatePassedJobs(downstreamConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(errorMessages).To(Equal([]string{
+						"jobs.downstream-job.get.some-other-resource.passed references an unknown pipeline ('upstream-pipeline')",
+					}))
+				})
+			})
+
+			Context("when the pipeline only has instances", func() {
+				BeforeEach(func() {
+					_, _, err := team.SavePipeline(atc.PipelineRef{
+						Name:         "upstream-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "release-1.2"},
+					}, otherConfig, 0, false, "")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not save the pipeline", func() {
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, downstreamConfig, 0, false, "")
+					Expect(err).To(Equal(db.InvalidPassedJobsError{
+						Errors: []string{"jobs.downstream-job.get.some-other-resource.passed references an instanced pipeline ('upstream-pipeline'), which passed constraints cannot refer to"},
+					}))
+				})
+			})
+		})
+
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(pipeline.TeamID()).To(Equal(team.ID()))
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
 			Expect(pipeline.Paused()).To(BeTrue())
 		})
 
+		It("saves the vars and display", func() {
+			config.Vars = map[string]interface{}{
+				"branch": "master",
+				"nested": map[string]interface{}{"key": "value"},
+			}
+			config.Display = &atc.DisplayConfig{
+				BackgroundImage: "https://example.com/image.png",
+			}
+
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.Vars()).To(Equal(config.Vars))
+			Expect(pipeline.Display()).To(Equal(config.Display))
+		})
+
+		It("saves the freeze windows", func() {
+			config.FreezeWindows = atc.FreezeWindowConfigs{
+				{
+					Name:     "weekend",
+					Start:    "0 18 * * 5",
+					Duration: "62h",
+					Location: "Europe/London",
+					Jobs:     []string{"some-job"},
+				},
+			}
+
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(savedPipeline.FreezeWindows()).To(Equal(config.FreezeWindows))
+
+			config.FreezeWindows = nil
+
+			savedPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, savedPipeline.ConfigVersion(), false, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(savedPipeline.FreezeWindows()).To(BeEmpty())
+		})
+
+		It("saves the var sources", func() {
+			config.VarSources = atc.VarSourceConfigs{
+				{
+					Name: "some-var-source",
+					Type: "vault",
+					Config: map[string]interface{}{
+						"url": "https://vault.example.com",
+					},
+				},
+			}
+
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(savedPipeline.VarSources()).To(Equal(config.VarSources))
+
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.VarSources()).To(Equal(config.VarSources))
+
+			config.VarSources = nil
+
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err = pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.VarSources()).To(BeEmpty())
+		})
+
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -1708,7 +1974,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1721,14 +1987,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1741,7 +2007,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("clears out api pinned version when resaving a pinned version on the pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1784,7 +2050,7 @@ var _ = Describe("Team", func() {
 				"version": "v2",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1795,7 +2061,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("does not clear the api pinned version when resaving pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1834,7 +2100,7 @@ var _ = Describe("Team", func() {
 			Expect(reloaded).To(BeTrue())
 			Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "v1"}))
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1844,12 +2110,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-resource")
@@ -1858,7 +2124,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1871,14 +2137,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1891,12 +2157,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1905,7 +2171,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -1915,12 +2181,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1930,12 +2196,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -1984,7 +2250,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -1996,7 +2262,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[1].Name = "new-other-job"
 				config.Jobs[1].OldName = "new-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("new-job")
@@ -2007,7 +2273,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with job name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Jobs[0].Name = "new-job"
@@ -2016,31 +2282,31 @@ var _ = Describe("Team", func() {
 				config.Jobs[1].Name = "some-job"
 				config.Jobs[1].OldName = "new-job"
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 			})
 
 			Context("when new job name is in database but is inactive", func() {
 				It("should successfully update job name", func() {
-					pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+					pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs = config.Jobs[:len(config.Jobs)-1]
 
-					_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+					_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs[0].Name = "new-job"
 					config.Jobs[0].OldName = "some-job"
 
-					_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion()+1, false)
+					_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion()+1, false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
 
 		It("removes task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2056,7 +2322,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2065,7 +2331,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2091,7 +2357,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2099,11 +2365,70 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeFalse())
 		})
 
+		It("removes the cached outputs of tasks that no longer skip when unchanged", func() {
+			config.Jobs = []atc.JobConfig{
+				{
+					Name: "some-job",
+					Plan: atc.PlanSequence{
+						{
+							Task:            "some-task",
+							TaskConfigPath:  "some/config/path.yml",
+							SkipIfUnchanged: true,
+						},
+					},
+				},
+			}
+
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			job, found, err := pipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = taskCacheFactory.FindOrCreate(job.ID(), "some-task", "some-path")
+			Expect(err).ToNot(HaveOccurred())
+
+			outputPath := db.TaskOutputCachePath("some-key", "some-output")
+			_, err = taskCacheFactory.FindOrCreate(job.ID(), "some-task", outputPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = taskOutputCacheFactory.Save(job.ID(), "some-task", db.TaskOutputCache{Key: "some-key", BuildID: build.ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err = taskOutputCacheFactory.Find(job.ID(), "some-task", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			config.Jobs[0].Plan[0].SkipIfUnchanged = false
+
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err = taskOutputCacheFactory.Find(job.ID(), "some-task", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
 		It("should not remove task caches in other pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline("other-pipeline", config, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2140,7 +2465,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2153,7 +2478,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -2184,7 +2509,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2195,7 +2520,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2215,7 +2540,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineName, otherConfig, savedPipeline.ConfigVersion(), false)
+			savedPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, savedPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -2226,46 +2551,46 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
 
 		Context("updating an existing pipeline", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err = team.Pipeline(pipelineName)
+				pipeline, found, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), true, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err = team.Pipeline(pipelineName)
+				pipeline, found, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
@@ -2276,12 +2601,12 @@ var _ = Describe("Team", func() {
 			pipelineName := "a-pipeline-name"
 			otherPipelineName := "an-other-pipeline-name"
 
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineName, otherConfig, 0, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(pipeline.Name()).To(Equal(pipelineName))
@@ -2299,7 +2624,7 @@ var _ = Describe("Team", func() {
 				Jobs:          jobs.Configs(),
 			}, config)
 
-			otherPipeline, found, err := team.Pipeline(otherPipelineName)
+			otherPipeline, found, err := team.Pipeline(atc.PipelineRef{Name: otherPipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(otherPipeline.Name()).To(Equal(otherPipelineName))
@@ -2324,10 +2649,10 @@ var _ = Describe("Team", func() {
 			otherPipelineName := "an-other-pipeline-name"
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineName, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -2397,22 +2722,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion(), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion(), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -2469,7 +2794,7 @@ var _ = Describe("Team", func() {
 
 			pipelineName := "a-pipeline-name"
 
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceTypes, err := pipeline.ResourceTypes()
@@ -2487,27 +2812,27 @@ var _ = Describe("Team", func() {
 
 		Context("when there are multiple teams", func() {
 			It("can allow pipelines with the same name across teams", func() {
-				teamPipeline, _, err := team.SavePipeline("steve", config, 0, true)
+				teamPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "steve"}, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(teamPipeline.Paused()).To(BeTrue())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline("steve", otherConfig, 0, true)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(otherTeamPipeline.Paused()).To(BeTrue())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				teamPipeline, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), false)
+				teamPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, teamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline("steve", config, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "steve"}, config, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, otherTeamPipeline.ConfigVersion(), true, "")
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -2516,6 +2841,7 @@ var _ = Describe("Team", func() {
 	Describe("FindCheckContainers", func() {
 		var (
 			fakeSecretManager *credsfakes.FakeSecrets
+			fakeVarSourcePool *credsfakes.FakeVarSourcePool
 		)
 
 		expiries := db.ContainerOwnerExpiries{
@@ -2526,6 +2852,8 @@ var _ = Describe("Team", func() {
 		BeforeEach(func() {
 			fakeSecretManager = new(credsfakes.FakeSecrets)
 			fakeSecretManager.GetReturns("", nil, false, nil)
+
+			fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 		})
 
 		Context("when pipeline exists", func() {
@@ -2553,7 +2881,7 @@ var _ = Describe("Team", func() {
 					})
 
 					It("returns check container for resource", func() {
-						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers("default-pipeline", "some-resource", fakeSecretManager)
+						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(containers).To(HaveLen(1))
 						Expect(containers[0].ID()).To(Equal(resourceContainer.ID()))
@@ -2571,7 +2899,7 @@ var _ = Describe("Team", func() {
 						)
 
 						BeforeEach(func() {
-							otherPipeline, _, err = defaultTeam.SavePipeline("other-pipeline", atc.Config{
+							otherPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -2581,7 +2909,7 @@ var _ = Describe("Team", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), false)
+							}, db.ConfigVersion(0), false, "")
 							Expect(err).NotTo(HaveOccurred())
 
 							otherResource, found, err = otherPipeline.Resource("some-resource")
@@ -2602,7 +2930,7 @@ var _ = Describe("Team", func() {
 						})
 
 						It("returns the same check container", func() {
-							containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers("other-pipeline", "some-resource", fakeSecretManager)
+							containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "other-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 							Expect(err).ToNot(HaveOccurred())
 							Expect(containers).To(HaveLen(1))
 							Expect(containers[0].ID()).To(Equal(otherResourceContainer.ID()))
@@ -2615,7 +2943,7 @@ var _ = Describe("Team", func() {
 
 				Context("when check container does not exist", func() {
 					It("returns empty list", func() {
-						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers("default-pipeline", "some-resource", fakeSecretManager)
+						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(containers).To(BeEmpty())
 						Expect(checkContainersExpiresAt).To(BeEmpty())
@@ -2625,7 +2953,7 @@ var _ = Describe("Team", func() {
 
 			Context("when resource does not exist", func() {
 				It("returns empty list", func() {
-					containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers("default-pipeline", "non-existent-resource", fakeSecretManager)
+					containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "non-existent-resource", fakeSecretManager, fakeVarSourcePool)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(containers).To(BeEmpty())
 					Expect(checkContainersExpiresAt).To(BeEmpty())
@@ -2635,7 +2963,7 @@ var _ = Describe("Team", func() {
 
 		Context("when pipeline does not exist", func() {
 			It("returns empty list", func() {
-				containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers("non-existent-pipeline", "some-resource", fakeSecretManager)
+				containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "non-existent-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(containers).To(BeEmpty())
 				Expect(checkContainersExpiresAt).To(BeEmpty())
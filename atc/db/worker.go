@@ -59,6 +59,9 @@ type Worker interface {
 	StartTime() time.Time
 	ExpiresAt() time.Time
 	Ephemeral() bool
+	Hermetic() bool
+	Capacity() atc.ContainerLimits
+	Allocated() (cpu uint64, memory uint64, err error)
 
 	Reload() (bool, error)
 
@@ -98,6 +101,8 @@ type worker struct {
 	expiresAt        time.Time
 	certsPath        *string
 	ephemeral        bool
+	hermetic         bool
+	capacity         atc.ContainerLimits
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -118,6 +123,8 @@ func (worker *worker) Tags() []string                          { return worker.t
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
+func (worker *worker) Hermetic() bool                          { return worker.hermetic }
+func (worker *worker) Capacity() atc.ContainerLimits           { return worker.capacity }
 
 func (worker *worker) StartTime() time.Time { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
@@ -368,6 +375,26 @@ func (worker *worker) ActiveTasks() (int, error) {
 	return worker.activeTasks, nil
 }
 
+// Allocated returns the total CPU shares and bytes of memory that the
+// worker's creating and created containers are limited to.
+func (worker *worker) Allocated() (uint64, uint64, error) {
+	var cpu, memory uint64
+	err := psql.Select("COALESCE(SUM(meta_cpu_limit), 0)", "COALESCE(SUM(meta_memory_limit), 0)").
+		From("containers").
+		Where(sq.Eq{
+			"worker_name": worker.name,
+			"state":       []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+		}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&cpu, &memory)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return cpu, memory, nil
+}
+
 func (worker *worker) IncreaseActiveTasks() error {
 	result, err := psql.Update("workers").
 		Set("active_tasks", sq.Expr("active_tasks+1")).
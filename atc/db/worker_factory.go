@@ -54,7 +54,10 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.hermetic,
+		w.cpu_capacity,
+		w.memory_capacity
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -140,6 +143,9 @@ func scanWorker(worker *worker, row scannable) error {
 		startTime     pq.NullTime
 		expiresAt     pq.NullTime
 		ephemeral     sql.NullBool
+
+		cpuCapacity    sql.NullInt64
+		memoryCapacity sql.NullInt64
 	)
 
 	err := row.Scan(
@@ -162,6 +168,9 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&worker.hermetic,
+		&cpuCapacity,
+		&memoryCapacity,
 	)
 	if err != nil {
 		return err
@@ -215,6 +224,16 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	if cpuCapacity.Valid {
+		cpu := uint64(cpuCapacity.Int64)
+		worker.capacity.CPU = &cpu
+	}
+
+	if memoryCapacity.Valid {
+		memory := uint64(memoryCapacity.Int64)
+		worker.capacity.Memory = &memory
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
@@ -394,6 +413,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		workerVersion = &atcWorker.Version
 	}
 
+	var capacity atc.ContainerLimits
+	if atcWorker.Capacity != nil {
+		capacity = *atcWorker.Capacity
+	}
+
 	values := []interface{}{
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
@@ -411,6 +435,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		string(workerState),
 		teamID,
 		atcWorker.Ephemeral,
+		atcWorker.Hermetic,
+		capacity.CPU,
+		capacity.Memory,
 	}
 
 	conflictValues := values
@@ -442,6 +469,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"state",
 			"team_id",
 			"ephemeral",
+			"hermetic",
+			"cpu_capacity",
+			"memory_capacity",
 		).
 		Values(append([]interface{}{
 			sq.Expr(expires),
@@ -466,7 +496,10 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				hermetic = ?,
+				cpu_capacity = ?,
+				memory_capacity = ?
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -509,6 +542,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		teamID:           workerTeamID,
 		startTime:        time.Unix(atcWorker.StartTime, 0),
 		ephemeral:        atcWorker.Ephemeral,
+		hermetic:         atcWorker.Hermetic,
+		capacity:         capacity,
 		conn:             conn,
 	}
 
@@ -224,14 +224,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: false,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -256,14 +256,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: true,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -471,14 +471,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: false,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -503,14 +503,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: true,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
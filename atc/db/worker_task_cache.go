@@ -81,10 +81,15 @@ func (workerTaskCache WorkerTaskCache) find(runner sq.Runner) (*UsedWorkerTaskCa
 
 func removeUnusedWorkerTaskCaches(tx Tx, pipelineID int, jobConfigs []atc.JobConfig) error {
 	steps := make(map[string][]string)
+	cachedSteps := make(map[string][]string)
 	for _, jobConfig := range jobConfigs {
 		for _, jobConfigPlan := range jobConfig.Plan {
 			if jobConfigPlan.Task != "" {
 				steps[jobConfig.Name] = append(steps[jobConfig.Name], jobConfigPlan.Task)
+
+				if jobConfigPlan.SkipIfUnchanged {
+					cachedSteps[jobConfig.Name] = append(cachedSteps[jobConfig.Name], jobConfigPlan.Task)
+				}
 			}
 		}
 	}
@@ -106,6 +111,51 @@ func removeUnusedWorkerTaskCaches(tx Tx, pipelineID int, jobConfigs []atc.JobCon
 		Where(sq.Eq{"j.pipeline_id": pipelineID}).
 		RunWith(tx).
 		Exec()
+	if err != nil {
+		return err
+	}
+
+	return removeUnusedTaskOutputCaches(tx, pipelineID, cachedSteps)
+}
+
+// removeUnusedTaskOutputCaches removes the cached outputs of the task steps
+// which no longer skip their runs when unchanged, along with the task caches
+// holding them.
+func removeUnusedTaskOutputCaches(tx Tx, pipelineID int, cachedSteps map[string][]string) error {
+	jobNames := []string{}
+	query := sq.Or{}
+	for jobName, stepNames := range cachedSteps {
+		jobNames = append(jobNames, jobName)
+		query = append(query, sq.And{sq.Eq{"j.name": jobName}, sq.NotEq{"tc.step_name": stepNames}})
+	}
+
+	_, err := psql.Delete("task_caches tc USING jobs j").
+		Where(
+			sq.Or{
+				query,
+				sq.NotEq{"j.name": jobNames},
+				sq.Eq{"j.active": false},
+			}).
+		Where(sq.Like{"tc.path": taskOutputCachePathPrefix + "%"}).
+		Where(sq.Expr("j.id = tc.job_id")).
+		Where(sq.Eq{"j.pipeline_id": pipelineID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("task_output_caches tc USING jobs j").
+		Where(
+			sq.Or{
+				query,
+				sq.NotEq{"j.name": jobNames},
+				sq.Eq{"j.active": false},
+			}).
+		Where(sq.Expr("j.id = tc.job_id")).
+		Where(sq.Eq{"j.pipeline_id": pipelineID}).
+		RunWith(tx).
+		Exec()
 
 	return err
 }
@@ -432,4 +432,75 @@ var _ = Describe("Worker", func() {
 			})
 		})
 	})
+
+	Describe("Capacity and allocation", func() {
+		var cpu, memory uint64
+
+		BeforeEach(func() {
+			cpu = 2048
+			memory = 4096
+
+			atcWorker.Capacity = &atc.ContainerLimits{CPU: &cpu, Memory: &memory}
+
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has the capacity the worker registered with", func() {
+			Expect(worker.Capacity()).To(Equal(atc.ContainerLimits{CPU: &cpu, Memory: &memory}))
+
+			foundWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(foundWorker.Capacity()).To(Equal(atc.ContainerLimits{CPU: &cpu, Memory: &memory}))
+		})
+
+		Context("when the worker has no containers", func() {
+			It("has nothing allocated", func() {
+				allocatedCPU, allocatedMemory, err := worker.Allocated()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allocatedCPU).To(BeZero())
+				Expect(allocatedMemory).To(BeZero())
+			})
+		})
+
+		Context("when the worker has containers with limits", func() {
+			BeforeEach(func() {
+				resourceConfig, err := resourceConfigFactory.FindOrCreateResourceConfig(
+					"some-resource-type",
+					atc.Source{"some": "source"},
+					atc.VersionedResourceTypes{},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				expiries := ContainerOwnerExpiries{
+					Min: 5 * time.Minute,
+					Max: 1 * time.Hour,
+				}
+
+				_, err = worker.CreateContainer(
+					NewResourceConfigCheckSessionContainerOwner(resourceConfig, expiries),
+					ContainerMetadata{Type: "check", CPULimit: 512, MemoryLimit: 1024},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = worker.CreateContainer(
+					NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()),
+					ContainerMetadata{Type: "task", MemoryLimit: 2048},
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("has the sum of their limits allocated", func() {
+				allocatedCPU, allocatedMemory, err := worker.Allocated()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allocatedCPU).To(Equal(uint64(512)))
+				Expect(allocatedMemory).To(Equal(uint64(3072)))
+			})
+		})
+	})
 })
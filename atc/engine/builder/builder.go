@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
 )
 
 const supportedSchema = "exec.v2"
@@ -19,6 +20,8 @@ type StepFactory interface {
 	GetStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) exec.Step
 	PutStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) exec.Step
 	TaskStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.TaskDelegate, lock.LockFactory) exec.Step
+	SetPipelineStep(atc.Plan, exec.StepMetadata, exec.SetPipelineDelegate) exec.Step
+	LoadVarStep(atc.Plan, exec.StepMetadata, exec.LoadVarDelegate) exec.Step
 	ArtifactInputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 	ArtifactOutputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 }
@@ -26,10 +29,14 @@ type StepFactory interface {
 //go:generate counterfeiter . DelegateFactory
 
 type DelegateFactory interface {
-	GetDelegate(db.Build, atc.PlanID) exec.GetDelegate
-	PutDelegate(db.Build, atc.PlanID) exec.PutDelegate
-	TaskDelegate(db.Build, atc.PlanID) exec.TaskDelegate
-	BuildStepDelegate(db.Build, atc.PlanID) exec.BuildStepDelegate
+	GetDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.GetDelegate
+	PutDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.PutDelegate
+	TaskDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.TaskDelegate
+	SetPipelineDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.SetPipelineDelegate
+	LoadVarDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.LoadVarDelegate
+	IfDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.IfDelegate
+	RetryDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.RetryDelegate
+	BuildStepDelegate(db.Build, atc.PlanID, *vars.BuildVariables) exec.BuildStepDelegate
 }
 
 func NewStepBuilder(
@@ -53,7 +60,7 @@ type stepBuilder struct {
 	lockFactory     lock.LockFactory
 }
 
-func (builder *stepBuilder) BuildStep(build db.Build) (exec.Step, error) {
+func (builder *stepBuilder) BuildStep(build db.Build, buildVars *vars.BuildVariables) (exec.Step, error) {
 
 	if build == nil {
 		return exec.IdentityStep{}, errors.New("Must provide a build")
@@ -63,185 +70,249 @@ func (builder *stepBuilder) BuildStep(build db.Build) (exec.Step, error) {
 		return exec.IdentityStep{}, errors.New("Schema not supported")
 	}
 
-	return builder.buildStep(build, build.PrivatePlan()), nil
+	return builder.buildStep(build, build.PrivatePlan(), buildVars), nil
 }
 
-func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	if plan.Aggregate != nil {
-		return builder.buildAggregateStep(build, plan)
+		return builder.buildAggregateStep(build, plan, buildVars)
 	}
 
 	if plan.InParallel != nil {
-		return builder.buildParallelStep(build, plan)
+		return builder.buildParallelStep(build, plan, buildVars)
 	}
 
 	if plan.Do != nil {
-		return builder.buildDoStep(build, plan)
+		return builder.buildDoStep(build, plan, buildVars)
 	}
 
 	if plan.Timeout != nil {
-		return builder.buildTimeoutStep(build, plan)
+		return builder.buildTimeoutStep(build, plan, buildVars)
 	}
 
 	if plan.Try != nil {
-		return builder.buildTryStep(build, plan)
+		return builder.buildTryStep(build, plan, buildVars)
+	}
+
+	if plan.If != nil {
+		return builder.buildIfStep(build, plan, buildVars)
 	}
 
 	if plan.OnAbort != nil {
-		return builder.buildOnAbortStep(build, plan)
+		return builder.buildOnAbortStep(build, plan, buildVars)
 	}
 
 	if plan.OnError != nil {
-		return builder.buildOnErrorStep(build, plan)
+		return builder.buildOnErrorStep(build, plan, buildVars)
 	}
 
 	if plan.OnSuccess != nil {
-		return builder.buildOnSuccessStep(build, plan)
+		return builder.buildOnSuccessStep(build, plan, buildVars)
 	}
 
 	if plan.OnFailure != nil {
-		return builder.buildOnFailureStep(build, plan)
+		return builder.buildOnFailureStep(build, plan, buildVars)
+	}
+
+	if plan.OnRetry != nil {
+		return builder.buildOnRetryStep(build, plan, buildVars)
 	}
 
 	if plan.Ensure != nil {
-		return builder.buildEnsureStep(build, plan)
+		return builder.buildEnsureStep(build, plan, buildVars)
 	}
 
 	if plan.Task != nil {
-		return builder.buildTaskStep(build, plan)
+		return builder.buildTaskStep(build, plan, buildVars)
 	}
 
 	if plan.Get != nil {
-		return builder.buildGetStep(build, plan)
+		return builder.buildGetStep(build, plan, buildVars)
 	}
 
 	if plan.Put != nil {
-		return builder.buildPutStep(build, plan)
+		return builder.buildPutStep(build, plan, buildVars)
+	}
+
+	if plan.SetPipeline != nil {
+		return builder.buildSetPipelineStep(build, plan, buildVars)
+	}
+
+	if plan.LoadVar != nil {
+		return builder.buildLoadVarStep(build, plan, buildVars)
 	}
 
 	if plan.Retry != nil {
-		return builder.buildRetryStep(build, plan)
+		return builder.buildRetryStep(build, plan, buildVars)
+	}
+
+	if plan.Across != nil {
+		return builder.buildAcrossStep(build, plan, buildVars)
 	}
 
 	if plan.ArtifactInput != nil {
-		return builder.buildArtifactInputStep(build, plan)
+		return builder.buildArtifactInputStep(build, plan, buildVars)
 	}
 
 	if plan.ArtifactOutput != nil {
-		return builder.buildArtifactOutputStep(build, plan)
+		return builder.buildArtifactOutputStep(build, plan, buildVars)
 	}
 
 	return exec.IdentityStep{}
 }
 
-func (builder *stepBuilder) buildAggregateStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildAggregateStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	agg := exec.AggregateStep{}
 
 	for _, innerPlan := range *plan.Aggregate {
 		innerPlan.Attempts = plan.Attempts
-		step := builder.buildStep(build, innerPlan)
+		step := builder.buildStep(build, innerPlan, buildVars)
 		agg = append(agg, step)
 	}
 
 	return agg
 }
 
-func (builder *stepBuilder) buildParallelStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildParallelStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	var steps []exec.Step
 
 	for _, innerPlan := range plan.InParallel.Steps {
 		innerPlan.Attempts = plan.Attempts
-		step := builder.buildStep(build, innerPlan)
+		step := builder.buildStep(build, innerPlan, buildVars)
 		steps = append(steps, step)
 	}
 
 	return exec.InParallel(steps, plan.InParallel.Limit, plan.InParallel.FailFast)
 }
 
-func (builder *stepBuilder) buildDoStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildDoStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	var step exec.Step = exec.IdentityStep{}
 
 	for i := len(*plan.Do) - 1; i >= 0; i-- {
 		innerPlan := (*plan.Do)[i]
 		innerPlan.Attempts = plan.Attempts
-		previous := builder.buildStep(build, innerPlan)
+		previous := builder.buildStep(build, innerPlan, buildVars)
 		step = exec.OnSuccess(previous, step)
 	}
 
 	return step
 }
 
-func (builder *stepBuilder) buildTimeoutStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildTimeoutStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	innerPlan := plan.Timeout.Step
 	innerPlan.Attempts = plan.Attempts
-	step := builder.buildStep(build, innerPlan)
+	step := builder.buildStep(build, innerPlan, buildVars)
 	return exec.Timeout(step, plan.Timeout.Duration)
 }
 
-func (builder *stepBuilder) buildTryStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildTryStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	innerPlan := plan.Try.Step
 	innerPlan.Attempts = plan.Attempts
-	step := builder.buildStep(build, innerPlan)
+	step := builder.buildStep(build, innerPlan, buildVars)
 	return exec.Try(step)
 }
 
-func (builder *stepBuilder) buildOnAbortStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildIfStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
+	innerPlan := plan.If.Step
+	innerPlan.Attempts = plan.Attempts
+	step := builder.buildStep(build, innerPlan, buildVars)
+
+	stepMetadata := builder.stepMetadata(
+		build,
+		builder.externalURL,
+	)
+
+	return exec.If(
+		step,
+		*plan.If,
+		stepMetadata,
+		builder.delegateFactory.IfDelegate(build, plan.ID, buildVars),
+	)
+}
+
+func (builder *stepBuilder) buildOnAbortStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	plan.OnAbort.Step.Attempts = plan.Attempts
-	step := builder.buildStep(build, plan.OnAbort.Step)
+	step := builder.buildStep(build, plan.OnAbort.Step, buildVars)
 	plan.OnAbort.Next.Attempts = plan.Attempts
-	next := builder.buildStep(build, plan.OnAbort.Next)
+	next := builder.buildStep(build, plan.OnAbort.Next, buildVars)
 	return exec.OnAbort(step, next)
 }
 
-func (builder *stepBuilder) buildOnErrorStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildOnErrorStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	plan.OnError.Step.Attempts = plan.Attempts
-	step := builder.buildStep(build, plan.OnError.Step)
+	step := builder.buildStep(build, plan.OnError.Step, buildVars)
 	plan.OnError.Next.Attempts = plan.Attempts
-	next := builder.buildStep(build, plan.OnError.Next)
+	next := builder.buildStep(build, plan.OnError.Next, buildVars)
 	return exec.OnError(step, next)
 }
 
-func (builder *stepBuilder) buildOnSuccessStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildOnSuccessStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	plan.OnSuccess.Step.Attempts = plan.Attempts
-	step := builder.buildStep(build, plan.OnSuccess.Step)
+	step := builder.buildStep(build, plan.OnSuccess.Step, buildVars)
 	plan.OnSuccess.Next.Attempts = plan.Attempts
-	next := builder.buildStep(build, plan.OnSuccess.Next)
+	next := builder.buildStep(build, plan.OnSuccess.Next, buildVars)
 	return exec.OnSuccess(step, next)
 }
 
-func (builder *stepBuilder) buildOnFailureStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildOnFailureStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	plan.OnFailure.Step.Attempts = plan.Attempts
-	step := builder.buildStep(build, plan.OnFailure.Step)
+	step := builder.buildStep(build, plan.OnFailure.Step, buildVars)
 	plan.OnFailure.Next.Attempts = plan.Attempts
-	next := builder.buildStep(build, plan.OnFailure.Next)
+	next := builder.buildStep(build, plan.OnFailure.Next, buildVars)
 	return exec.OnFailure(step, next)
 }
 
-func (builder *stepBuilder) buildEnsureStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildOnRetryStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
+	plan.OnRetry.Step.Attempts = plan.Attempts
+	step := builder.buildStep(build, plan.OnRetry.Step, buildVars)
+	plan.OnRetry.Next.Attempts = plan.Attempts
+	next := builder.buildStep(build, plan.OnRetry.Next, buildVars)
+	return exec.OnRetry(step, next)
+}
+
+func (builder *stepBuilder) buildEnsureStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	plan.Ensure.Step.Attempts = plan.Attempts
-	step := builder.buildStep(build, plan.Ensure.Step)
+	step := builder.buildStep(build, plan.Ensure.Step, buildVars)
 	plan.Ensure.Next.Attempts = plan.Attempts
-	next := builder.buildStep(build, plan.Ensure.Next)
+	next := builder.buildStep(build, plan.Ensure.Next, buildVars)
 	return exec.Ensure(step, next)
 }
 
-func (builder *stepBuilder) buildRetryStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildRetryStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 	steps := []exec.Step{}
 
-	for index, innerPlan := range *plan.Retry {
+	for index, innerPlan := range plan.Retry.Attempts {
 		innerPlan.Attempts = append(plan.Attempts, index+1)
 
-		step := builder.buildStep(build, innerPlan)
+		step := builder.buildStep(build, innerPlan, buildVars)
 		steps = append(steps, step)
 	}
 
-	return exec.Retry(steps...)
+	return exec.Retry(
+		*plan.Retry,
+		builder.delegateFactory.RetryDelegate(build, plan.ID, buildVars),
+		steps...,
+	)
 }
 
-func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildAcrossStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
+	steps := []exec.Step{}
+
+	for _, scopedPlan := range plan.Across.Steps {
+		innerPlan := scopedPlan.Step
+		innerPlan.Attempts = plan.Attempts
+
+		step := builder.buildStep(build, innerPlan, buildVars)
+		steps = append(steps, step)
+	}
+
+	return exec.Across(plan.Across.Vars, steps, plan.Across.FailFast)
+}
+
+func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	containerMetadata := builder.containerMetadata(
 		build,
@@ -259,11 +330,11 @@ func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan) exec.Ste
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.GetDelegate(build, plan.ID),
+		builder.delegateFactory.GetDelegate(build, plan.ID, buildVars),
 	)
 }
 
-func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	containerMetadata := builder.containerMetadata(
 		build,
@@ -281,11 +352,11 @@ func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan) exec.Ste
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.PutDelegate(build, plan.ID),
+		builder.delegateFactory.PutDelegate(build, plan.ID, buildVars),
 	)
 }
 
-func (builder *stepBuilder) buildTaskStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildTaskStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	containerMetadata := builder.containerMetadata(
 		build,
@@ -303,26 +374,54 @@ func (builder *stepBuilder) buildTaskStep(build db.Build, plan atc.Plan) exec.St
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.TaskDelegate(build, plan.ID),
+		builder.delegateFactory.TaskDelegate(build, plan.ID, buildVars),
 		builder.lockFactory,
 	)
 }
 
-func (builder *stepBuilder) buildArtifactInputStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildSetPipelineStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
+
+	stepMetadata := builder.stepMetadata(
+		build,
+		builder.externalURL,
+	)
+
+	return builder.stepFactory.SetPipelineStep(
+		plan,
+		stepMetadata,
+		builder.delegateFactory.SetPipelineDelegate(build, plan.ID, buildVars),
+	)
+}
+
+func (builder *stepBuilder) buildLoadVarStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
+
+	stepMetadata := builder.stepMetadata(
+		build,
+		builder.externalURL,
+	)
+
+	return builder.stepFactory.LoadVarStep(
+		plan,
+		stepMetadata,
+		builder.delegateFactory.LoadVarDelegate(build, plan.ID, buildVars),
+	)
+}
+
+func (builder *stepBuilder) buildArtifactInputStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	return builder.stepFactory.ArtifactInputStep(
 		plan,
 		build,
-		builder.delegateFactory.BuildStepDelegate(build, plan.ID),
+		builder.delegateFactory.BuildStepDelegate(build, plan.ID, buildVars),
 	)
 }
 
-func (builder *stepBuilder) buildArtifactOutputStep(build db.Build, plan atc.Plan) exec.Step {
+func (builder *stepBuilder) buildArtifactOutputStep(build db.Build, plan atc.Plan, buildVars *vars.BuildVariables) exec.Step {
 
 	return builder.stepFactory.ArtifactOutputStep(
 		plan,
 		build,
-		builder.delegateFactory.BuildStepDelegate(build, plan.ID),
+		builder.delegateFactory.BuildStepDelegate(build, plan.ID, buildVars),
 	)
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/engine/builder/builderfakes"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
 type StepBuilder interface {
-	BuildStep(db.Build) (exec.Step, error)
+	BuildStep(db.Build, *vars.BuildVariables) (exec.Step, error)
 }
 
 var _ = Describe("Builder", func() {
@@ -32,6 +33,7 @@ var _ = Describe("Builder", func() {
 
 			planFactory atc.PlanFactory
 			stepBuilder StepBuilder
+			buildVars   *vars.BuildVariables
 		)
 
 		BeforeEach(func() {
@@ -47,11 +49,12 @@ var _ = Describe("Builder", func() {
 			)
 
 			planFactory = atc.NewPlanFactory(123)
+			buildVars = vars.NewBuildVariables(nil)
 		})
 
 		Context("with no build", func() {
 			JustBeforeEach(func() {
-				_, err = stepBuilder.BuildStep(nil)
+				_, err = stepBuilder.BuildStep(nil, buildVars)
 			})
 
 			It("errors", func() {
@@ -94,7 +97,7 @@ var _ = Describe("Builder", func() {
 			JustBeforeEach(func() {
 				fakeBuild.PrivatePlanReturns(expectedPlan)
 
-				_, err = stepBuilder.BuildStep(fakeBuild)
+				_, err = stepBuilder.BuildStep(fakeBuild, buildVars)
 			})
 
 			Context("when the build has the wrong schema", func() {
@@ -288,8 +291,10 @@ var _ = Describe("Builder", func() {
 						})
 
 						retryPlanTwo = planFactory.NewPlan(atc.RetryPlan{
-							taskPlan,
-							taskPlan,
+							Attempts: []atc.Plan{
+								taskPlan,
+								taskPlan,
+							},
 						})
 
 						aggregatePlan = planFactory.NewPlan(atc.AggregatePlan{retryPlanTwo})
@@ -308,14 +313,26 @@ var _ = Describe("Builder", func() {
 						})
 
 						expectedPlan = planFactory.NewPlan(atc.RetryPlan{
-							getPlan,
-							timeoutPlan,
-							getPlan,
+							Attempts: []atc.Plan{
+								getPlan,
+								timeoutPlan,
+								getPlan,
+							},
 						})
 					})
 
 					It("constructs the retry correctly", func() {
-						Expect(*expectedPlan.Retry).To(HaveLen(3))
+						Expect(expectedPlan.Retry.Attempts).To(HaveLen(3))
+					})
+
+					It("constructs a retry delegate for each retry", func() {
+						Expect(fakeDelegateFactory.RetryDelegateCallCount()).To(Equal(2))
+
+						_, planID, _ := fakeDelegateFactory.RetryDelegateArgsForCall(0)
+						Expect(planID).To(Equal(retryPlanTwo.ID))
+
+						_, planID, _ = fakeDelegateFactory.RetryDelegateArgsForCall(1)
+						Expect(planID).To(Equal(expectedPlan.ID))
 					})
 
 					It("constructs the first get correctly", func() {
@@ -357,7 +374,7 @@ var _ = Describe("Builder", func() {
 					})
 
 					It("constructs nested retries correctly", func() {
-						Expect(*retryPlanTwo.Retry).To(HaveLen(2))
+						Expect(retryPlanTwo.Retry.Attempts).To(HaveLen(2))
 					})
 
 					It("constructs nested steps correctly", func() {
@@ -441,7 +458,9 @@ var _ = Describe("Builder", func() {
 						})
 
 						expectedPlan = planFactory.NewPlan(atc.RetryPlan{
-							ensurePlan,
+							Attempts: []atc.Plan{
+								ensurePlan,
+							},
 						})
 					})
 
@@ -461,6 +480,51 @@ var _ = Describe("Builder", func() {
 					})
 				})
 
+				Context("with a plan where attempts have on_retry hooks", func() {
+					var (
+						attemptPlan atc.Plan
+						hookPlan    atc.Plan
+					)
+
+					BeforeEach(func() {
+						attemptPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name:       "some-task",
+							ConfigPath: "some-config-path",
+						})
+
+						hookPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name:       "some-hook",
+							ConfigPath: "some-hook-config-path",
+						})
+
+						expectedPlan = planFactory.NewPlan(atc.RetryPlan{
+							Attempts: []atc.Plan{
+								planFactory.NewPlan(atc.OnRetryPlan{
+									Step: attemptPlan,
+									Next: hookPlan,
+								}),
+								attemptPlan,
+							},
+						})
+					})
+
+					It("builds the hooks with the attempt they belong to", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(3))
+
+						plan, _, containerMetadata, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+						Expect(plan.Task.Name).To(Equal("some-task"))
+						Expect(containerMetadata.Attempt).To(Equal("1"))
+
+						plan, _, containerMetadata, _, _ = fakeStepFactory.TaskStepArgsForCall(1)
+						Expect(plan.Task.Name).To(Equal("some-hook"))
+						Expect(containerMetadata.Attempt).To(Equal("1"))
+
+						plan, _, containerMetadata, _, _ = fakeStepFactory.TaskStepArgsForCall(2)
+						Expect(plan.Task.Name).To(Equal("some-task"))
+						Expect(containerMetadata.Attempt).To(Equal("2"))
+					})
+				})
+
 				Context("with a basic plan", func() {
 
 					Context("that contains inputs", func() {
@@ -719,6 +783,136 @@ var _ = Describe("Builder", func() {
 					})
 				})
 
+				Context("running across steps", func() {
+					var (
+						firstTaskPlan  atc.Plan
+						secondTaskPlan atc.Plan
+					)
+
+					BeforeEach(func() {
+						firstTaskPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name: "some-task-a",
+						})
+
+						secondTaskPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name: "some-task-b",
+						})
+
+						expectedPlan = planFactory.NewPlan(atc.AcrossPlan{
+							Vars: []atc.AcrossVar{
+								{Var: "some-var", Values: []interface{}{"a", "b"}},
+							},
+							Steps: []atc.VarScopedPlan{
+								{Step: firstTaskPlan, Values: []interface{}{"a"}},
+								{Step: secondTaskPlan, Values: []interface{}{"b"}},
+							},
+						})
+					})
+
+					It("constructs a step for every combination", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(2))
+
+						plan, _, containerMetadata, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+						Expect(plan).To(Equal(firstTaskPlan))
+						Expect(containerMetadata.StepName).To(Equal("some-task-a"))
+
+						plan, _, containerMetadata, _, _ = fakeStepFactory.TaskStepArgsForCall(1)
+						Expect(plan).To(Equal(secondTaskPlan))
+						Expect(containerMetadata.StepName).To(Equal("some-task-b"))
+					})
+				})
+
+				Context("running set_pipeline steps", func() {
+					var setPipelinePlan atc.Plan
+
+					BeforeEach(func() {
+						setPipelinePlan = planFactory.NewPlan(atc.SetPipelinePlan{
+							Name: "some-pipeline",
+							File: "some-input/pipeline.yml",
+						})
+
+						expectedPlan = setPipelinePlan
+					})
+
+					It("constructs the step correctly", func() {
+						Expect(fakeStepFactory.SetPipelineStepCallCount()).To(Equal(1))
+						plan, stepMetadata, _ := fakeStepFactory.SetPipelineStepArgsForCall(0)
+						Expect(plan).To(Equal(setPipelinePlan))
+						Expect(stepMetadata).To(Equal(expectedMetadata))
+					})
+
+					It("constructs the delegate for the build and plan", func() {
+						Expect(fakeDelegateFactory.SetPipelineDelegateCallCount()).To(Equal(1))
+						build, planID, actualBuildVars := fakeDelegateFactory.SetPipelineDelegateArgsForCall(0)
+						Expect(build).To(Equal(fakeBuild))
+						Expect(planID).To(Equal(setPipelinePlan.ID))
+						Expect(actualBuildVars).To(BeIdenticalTo(buildVars))
+					})
+				})
+
+				Context("running load_var steps", func() {
+					var loadVarPlan atc.Plan
+
+					BeforeEach(func() {
+						loadVarPlan = planFactory.NewPlan(atc.LoadVarPlan{
+							Name: "some-var",
+							File: "some-input/version",
+						})
+
+						expectedPlan = loadVarPlan
+					})
+
+					It("constructs the step correctly", func() {
+						Expect(fakeStepFactory.LoadVarStepCallCount()).To(Equal(1))
+						plan, stepMetadata, _ := fakeStepFactory.LoadVarStepArgsForCall(0)
+						Expect(plan).To(Equal(loadVarPlan))
+						Expect(stepMetadata).To(Equal(expectedMetadata))
+					})
+
+					It("constructs the delegate with the build's vars", func() {
+						Expect(fakeDelegateFactory.LoadVarDelegateCallCount()).To(Equal(1))
+						build, planID, actualBuildVars := fakeDelegateFactory.LoadVarDelegateArgsForCall(0)
+						Expect(build).To(Equal(fakeBuild))
+						Expect(planID).To(Equal(loadVarPlan.ID))
+						Expect(actualBuildVars).To(BeIdenticalTo(buildVars))
+					})
+				})
+
+				Context("running conditional steps", func() {
+					var (
+						ifPlan   atc.Plan
+						taskPlan atc.Plan
+					)
+
+					BeforeEach(func() {
+						taskPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name: "some-task",
+						})
+
+						ifPlan = planFactory.NewPlan(atc.IfPlan{
+							Condition: `build.job_name == "some-job"`,
+							Step:      taskPlan,
+						})
+
+						expectedPlan = ifPlan
+					})
+
+					It("constructs the nested step", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(1))
+						plan, stepMetadata, _, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+						Expect(plan).To(Equal(taskPlan))
+						Expect(stepMetadata).To(Equal(expectedMetadata))
+					})
+
+					It("constructs the delegate for the if plan", func() {
+						Expect(fakeDelegateFactory.IfDelegateCallCount()).To(Equal(1))
+						build, planID, actualBuildVars := fakeDelegateFactory.IfDelegateArgsForCall(0)
+						Expect(build).To(Equal(fakeBuild))
+						Expect(planID).To(Equal(ifPlan.ID))
+						Expect(actualBuildVars).To(BeIdenticalTo(buildVars))
+					})
+				})
+
 				Context("running try steps", func() {
 					var inputPlan atc.Plan
 
@@ -8,14 +8,16 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
 )
 
 type FakeDelegateFactory struct {
-	BuildStepDelegateStub        func(db.Build, atc.PlanID) exec.BuildStepDelegate
+	BuildStepDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.BuildStepDelegate
 	buildStepDelegateMutex       sync.RWMutex
 	buildStepDelegateArgsForCall []struct {
 		arg1 db.Build
 		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
 	}
 	buildStepDelegateReturns struct {
 		result1 exec.BuildStepDelegate
@@ -23,11 +25,12 @@ type FakeDelegateFactory struct {
 	buildStepDelegateReturnsOnCall map[int]struct {
 		result1 exec.BuildStepDelegate
 	}
-	GetDelegateStub        func(db.Build, atc.PlanID) exec.GetDelegate
+	GetDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.GetDelegate
 	getDelegateMutex       sync.RWMutex
 	getDelegateArgsForCall []struct {
 		arg1 db.Build
 		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
 	}
 	getDelegateReturns struct {
 		result1 exec.GetDelegate
@@ -35,11 +38,38 @@ type FakeDelegateFactory struct {
 	getDelegateReturnsOnCall map[int]struct {
 		result1 exec.GetDelegate
 	}
-	PutDelegateStub        func(db.Build, atc.PlanID) exec.PutDelegate
+	IfDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.IfDelegate
+	ifDelegateMutex       sync.RWMutex
+	ifDelegateArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}
+	ifDelegateReturns struct {
+		result1 exec.IfDelegate
+	}
+	ifDelegateReturnsOnCall map[int]struct {
+		result1 exec.IfDelegate
+	}
+	LoadVarDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.LoadVarDelegate
+	loadVarDelegateMutex       sync.RWMutex
+	loadVarDelegateArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}
+	loadVarDelegateReturns struct {
+		result1 exec.LoadVarDelegate
+	}
+	loadVarDelegateReturnsOnCall map[int]struct {
+		result1 exec.LoadVarDelegate
+	}
+	PutDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.PutDelegate
 	putDelegateMutex       sync.RWMutex
 	putDelegateArgsForCall []struct {
 		arg1 db.Build
 		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
 	}
 	putDelegateReturns struct {
 		result1 exec.PutDelegate
@@ -47,11 +77,38 @@ type FakeDelegateFactory struct {
 	putDelegateReturnsOnCall map[int]struct {
 		result1 exec.PutDelegate
 	}
-	TaskDelegateStub        func(db.Build, atc.PlanID) exec.TaskDelegate
+	RetryDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.RetryDelegate
+	retryDelegateMutex       sync.RWMutex
+	retryDelegateArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}
+	retryDelegateReturns struct {
+		result1 exec.RetryDelegate
+	}
+	retryDelegateReturnsOnCall map[int]struct {
+		result1 exec.RetryDelegate
+	}
+	SetPipelineDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.SetPipelineDelegate
+	setPipelineDelegateMutex       sync.RWMutex
+	setPipelineDelegateArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}
+	setPipelineDelegateReturns struct {
+		result1 exec.SetPipelineDelegate
+	}
+	setPipelineDelegateReturnsOnCall map[int]struct {
+		result1 exec.SetPipelineDelegate
+	}
+	TaskDelegateStub        func(db.Build, atc.PlanID, *vars.BuildVariables) exec.TaskDelegate
 	taskDelegateMutex       sync.RWMutex
 	taskDelegateArgsForCall []struct {
 		arg1 db.Build
 		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
 	}
 	taskDelegateReturns struct {
 		result1 exec.TaskDelegate
@@ -63,17 +120,18 @@ type FakeDelegateFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDelegateFactory) BuildStepDelegate(arg1 db.Build, arg2 atc.PlanID) exec.BuildStepDelegate {
+func (fake *FakeDelegateFactory) BuildStepDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.BuildStepDelegate {
 	fake.buildStepDelegateMutex.Lock()
 	ret, specificReturn := fake.buildStepDelegateReturnsOnCall[len(fake.buildStepDelegateArgsForCall)]
 	fake.buildStepDelegateArgsForCall = append(fake.buildStepDelegateArgsForCall, struct {
 		arg1 db.Build
 		arg2 atc.PlanID
-	}{arg1, arg2})
-	fake.recordInvocation("BuildStepDelegate", []interface{}{arg1, arg2})
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("BuildStepDelegate", []interface{}{arg1, arg2, arg3})
 	fake.buildStepDelegateMutex.Unlock()
 	if fake.BuildStepDelegateStub != nil {
-		return fake.BuildStepDelegateStub(arg1, arg2)
+		return fake.BuildStepDelegateStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -88,17 +146,17 @@ func (fake *FakeDelegateFactory) BuildStepDelegateCallCount() int {
 	return len(fake.buildStepDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) BuildStepDelegateCalls(stub func(db.Build, atc.PlanID) exec.BuildStepDelegate) {
+func (fake *FakeDelegateFactory) BuildStepDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.BuildStepDelegate) {
 	fake.buildStepDelegateMutex.Lock()
 	defer fake.buildStepDelegateMutex.Unlock()
 	fake.BuildStepDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) BuildStepDelegateArgsForCall(i int) (db.Build, atc.PlanID) {
+func (fake *FakeDelegateFactory) BuildStepDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	argsForCall := fake.buildStepDelegateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeDelegateFactory) BuildStepDelegateReturns(result1 exec.BuildStepDelegate) {
@@ -124,17 +182,18 @@ func (fake *FakeDelegateFactory) BuildStepDelegateReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
-func (fake *FakeDelegateFactory) GetDelegate(arg1 db.Build, arg2 atc.PlanID) exec.GetDelegate {
+func (fake *FakeDelegateFactory) GetDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.GetDelegate {
 	fake.getDelegateMutex.Lock()
 	ret, specificReturn := fake.getDelegateReturnsOnCall[len(fake.getDelegateArgsForCall)]
 	fake.getDelegateArgsForCall = append(fake.getDelegateArgsForCall, struct {
 		arg1 db.Build
 		arg2 atc.PlanID
-	}{arg1, arg2})
-	fake.recordInvocation("GetDelegate", []interface{}{arg1, arg2})
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetDelegate", []interface{}{arg1, arg2, arg3})
 	fake.getDelegateMutex.Unlock()
 	if fake.GetDelegateStub != nil {
-		return fake.GetDelegateStub(arg1, arg2)
+		return fake.GetDelegateStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -149,17 +208,17 @@ func (fake *FakeDelegateFactory) GetDelegateCallCount() int {
 	return len(fake.getDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) GetDelegateCalls(stub func(db.Build, atc.PlanID) exec.GetDelegate) {
+func (fake *FakeDelegateFactory) GetDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.GetDelegate) {
 	fake.getDelegateMutex.Lock()
 	defer fake.getDelegateMutex.Unlock()
 	fake.GetDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) GetDelegateArgsForCall(i int) (db.Build, atc.PlanID) {
+func (fake *FakeDelegateFactory) GetDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
 	fake.getDelegateMutex.RLock()
 	defer fake.getDelegateMutex.RUnlock()
 	argsForCall := fake.getDelegateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeDelegateFactory) GetDelegateReturns(result1 exec.GetDelegate) {
@@ -185,17 +244,142 @@ func (fake *FakeDelegateFactory) GetDelegateReturnsOnCall(i int, result1 exec.Ge
 	}{result1}
 }
 
-func (fake *FakeDelegateFactory) PutDelegate(arg1 db.Build, arg2 atc.PlanID) exec.PutDelegate {
+func (fake *FakeDelegateFactory) IfDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.IfDelegate {
+	fake.ifDelegateMutex.Lock()
+	ret, specificReturn := fake.ifDelegateReturnsOnCall[len(fake.ifDelegateArgsForCall)]
+	fake.ifDelegateArgsForCall = append(fake.ifDelegateArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("IfDelegate", []interface{}{arg1, arg2, arg3})
+	fake.ifDelegateMutex.Unlock()
+	if fake.IfDelegateStub != nil {
+		return fake.IfDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.ifDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDelegateFactory) IfDelegateCallCount() int {
+	fake.ifDelegateMutex.RLock()
+	defer fake.ifDelegateMutex.RUnlock()
+	return len(fake.ifDelegateArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) IfDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.IfDelegate) {
+	fake.ifDelegateMutex.Lock()
+	defer fake.ifDelegateMutex.Unlock()
+	fake.IfDelegateStub = stub
+}
+
+func (fake *FakeDelegateFactory) IfDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
+	fake.ifDelegateMutex.RLock()
+	defer fake.ifDelegateMutex.RUnlock()
+	argsForCall := fake.ifDelegateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDelegateFactory) IfDelegateReturns(result1 exec.IfDelegate) {
+	fake.ifDelegateMutex.Lock()
+	defer fake.ifDelegateMutex.Unlock()
+	fake.IfDelegateStub = nil
+	fake.ifDelegateReturns = struct {
+		result1 exec.IfDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) IfDelegateReturnsOnCall(i int, result1 exec.IfDelegate) {
+	fake.ifDelegateMutex.Lock()
+	defer fake.ifDelegateMutex.Unlock()
+	fake.IfDelegateStub = nil
+	if fake.ifDelegateReturnsOnCall == nil {
+		fake.ifDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.IfDelegate
+		})
+	}
+	fake.ifDelegateReturnsOnCall[i] = struct {
+		result1 exec.IfDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) LoadVarDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.LoadVarDelegate {
+	fake.loadVarDelegateMutex.Lock()
+	ret, specificReturn := fake.loadVarDelegateReturnsOnCall[len(fake.loadVarDelegateArgsForCall)]
+	fake.loadVarDelegateArgsForCall = append(fake.loadVarDelegateArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("LoadVarDelegate", []interface{}{arg1, arg2, arg3})
+	fake.loadVarDelegateMutex.Unlock()
+	if fake.LoadVarDelegateStub != nil {
+		return fake.LoadVarDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.loadVarDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDelegateFactory) LoadVarDelegateCallCount() int {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	return len(fake.loadVarDelegateArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) LoadVarDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = stub
+}
+
+func (fake *FakeDelegateFactory) LoadVarDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	argsForCall := fake.loadVarDelegateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDelegateFactory) LoadVarDelegateReturns(result1 exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = nil
+	fake.loadVarDelegateReturns = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) LoadVarDelegateReturnsOnCall(i int, result1 exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = nil
+	if fake.loadVarDelegateReturnsOnCall == nil {
+		fake.loadVarDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.LoadVarDelegate
+		})
+	}
+	fake.loadVarDelegateReturnsOnCall[i] = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) PutDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.PutDelegate {
 	fake.putDelegateMutex.Lock()
 	ret, specificReturn := fake.putDelegateReturnsOnCall[len(fake.putDelegateArgsForCall)]
 	fake.putDelegateArgsForCall = append(fake.putDelegateArgsForCall, struct {
 		arg1 db.Build
 		arg2 atc.PlanID
-	}{arg1, arg2})
-	fake.recordInvocation("PutDelegate", []interface{}{arg1, arg2})
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PutDelegate", []interface{}{arg1, arg2, arg3})
 	fake.putDelegateMutex.Unlock()
 	if fake.PutDelegateStub != nil {
-		return fake.PutDelegateStub(arg1, arg2)
+		return fake.PutDelegateStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -210,17 +394,17 @@ func (fake *FakeDelegateFactory) PutDelegateCallCount() int {
 	return len(fake.putDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) PutDelegateCalls(stub func(db.Build, atc.PlanID) exec.PutDelegate) {
+func (fake *FakeDelegateFactory) PutDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.PutDelegate) {
 	fake.putDelegateMutex.Lock()
 	defer fake.putDelegateMutex.Unlock()
 	fake.PutDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) PutDelegateArgsForCall(i int) (db.Build, atc.PlanID) {
+func (fake *FakeDelegateFactory) PutDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
 	fake.putDelegateMutex.RLock()
 	defer fake.putDelegateMutex.RUnlock()
 	argsForCall := fake.putDelegateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeDelegateFactory) PutDelegateReturns(result1 exec.PutDelegate) {
@@ -246,17 +430,142 @@ func (fake *FakeDelegateFactory) PutDelegateReturnsOnCall(i int, result1 exec.Pu
 	}{result1}
 }
 
-func (fake *FakeDelegateFactory) TaskDelegate(arg1 db.Build, arg2 atc.PlanID) exec.TaskDelegate {
+func (fake *FakeDelegateFactory) RetryDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.RetryDelegate {
+	fake.retryDelegateMutex.Lock()
+	ret, specificReturn := fake.retryDelegateReturnsOnCall[len(fake.retryDelegateArgsForCall)]
+	fake.retryDelegateArgsForCall = append(fake.retryDelegateArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RetryDelegate", []interface{}{arg1, arg2, arg3})
+	fake.retryDelegateMutex.Unlock()
+	if fake.RetryDelegateStub != nil {
+		return fake.RetryDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.retryDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateCallCount() int {
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	return len(fake.retryDelegateArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.RetryDelegate) {
+	fake.retryDelegateMutex.Lock()
+	defer fake.retryDelegateMutex.Unlock()
+	fake.RetryDelegateStub = stub
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	argsForCall := fake.retryDelegateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateReturns(result1 exec.RetryDelegate) {
+	fake.retryDelegateMutex.Lock()
+	defer fake.retryDelegateMutex.Unlock()
+	fake.RetryDelegateStub = nil
+	fake.retryDelegateReturns = struct {
+		result1 exec.RetryDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) RetryDelegateReturnsOnCall(i int, result1 exec.RetryDelegate) {
+	fake.retryDelegateMutex.Lock()
+	defer fake.retryDelegateMutex.Unlock()
+	fake.RetryDelegateStub = nil
+	if fake.retryDelegateReturnsOnCall == nil {
+		fake.retryDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.RetryDelegate
+		})
+	}
+	fake.retryDelegateReturnsOnCall[i] = struct {
+		result1 exec.RetryDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) SetPipelineDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.SetPipelineDelegate {
+	fake.setPipelineDelegateMutex.Lock()
+	ret, specificReturn := fake.setPipelineDelegateReturnsOnCall[len(fake.setPipelineDelegateArgsForCall)]
+	fake.setPipelineDelegateArgsForCall = append(fake.setPipelineDelegateArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetPipelineDelegate", []interface{}{arg1, arg2, arg3})
+	fake.setPipelineDelegateMutex.Unlock()
+	if fake.SetPipelineDelegateStub != nil {
+		return fake.SetPipelineDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDelegateFactory) SetPipelineDelegateCallCount() int {
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
+	return len(fake.setPipelineDelegateArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) SetPipelineDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = stub
+}
+
+func (fake *FakeDelegateFactory) SetPipelineDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
+	argsForCall := fake.setPipelineDelegateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDelegateFactory) SetPipelineDelegateReturns(result1 exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = nil
+	fake.setPipelineDelegateReturns = struct {
+		result1 exec.SetPipelineDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) SetPipelineDelegateReturnsOnCall(i int, result1 exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = nil
+	if fake.setPipelineDelegateReturnsOnCall == nil {
+		fake.setPipelineDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.SetPipelineDelegate
+		})
+	}
+	fake.setPipelineDelegateReturnsOnCall[i] = struct {
+		result1 exec.SetPipelineDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) TaskDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 *vars.BuildVariables) exec.TaskDelegate {
 	fake.taskDelegateMutex.Lock()
 	ret, specificReturn := fake.taskDelegateReturnsOnCall[len(fake.taskDelegateArgsForCall)]
 	fake.taskDelegateArgsForCall = append(fake.taskDelegateArgsForCall, struct {
 		arg1 db.Build
 		arg2 atc.PlanID
-	}{arg1, arg2})
-	fake.recordInvocation("TaskDelegate", []interface{}{arg1, arg2})
+		arg3 *vars.BuildVariables
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("TaskDelegate", []interface{}{arg1, arg2, arg3})
 	fake.taskDelegateMutex.Unlock()
 	if fake.TaskDelegateStub != nil {
-		return fake.TaskDelegateStub(arg1, arg2)
+		return fake.TaskDelegateStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -271,17 +580,17 @@ func (fake *FakeDelegateFactory) TaskDelegateCallCount() int {
 	return len(fake.taskDelegateArgsForCall)
 }
 
-func (fake *FakeDelegateFactory) TaskDelegateCalls(stub func(db.Build, atc.PlanID) exec.TaskDelegate) {
+func (fake *FakeDelegateFactory) TaskDelegateCalls(stub func(db.Build, atc.PlanID, *vars.BuildVariables) exec.TaskDelegate) {
 	fake.taskDelegateMutex.Lock()
 	defer fake.taskDelegateMutex.Unlock()
 	fake.TaskDelegateStub = stub
 }
 
-func (fake *FakeDelegateFactory) TaskDelegateArgsForCall(i int) (db.Build, atc.PlanID) {
+func (fake *FakeDelegateFactory) TaskDelegateArgsForCall(i int) (db.Build, atc.PlanID, *vars.BuildVariables) {
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
 	argsForCall := fake.taskDelegateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeDelegateFactory) TaskDelegateReturns(result1 exec.TaskDelegate) {
@@ -314,8 +623,16 @@ func (fake *FakeDelegateFactory) Invocations() map[string][][]interface{} {
 	defer fake.buildStepDelegateMutex.RUnlock()
 	fake.getDelegateMutex.RLock()
 	defer fake.getDelegateMutex.RUnlock()
+	fake.ifDelegateMutex.RLock()
+	defer fake.ifDelegateMutex.RUnlock()
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
 	fake.putDelegateMutex.RLock()
 	defer fake.putDelegateMutex.RUnlock()
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
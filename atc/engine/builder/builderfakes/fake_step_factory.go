@@ -52,6 +52,19 @@ type FakeStepFactory struct {
 	getStepReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	LoadVarStepStub        func(atc.Plan, exec.StepMetadata, exec.LoadVarDelegate) exec.Step
+	loadVarStepMutex       sync.RWMutex
+	loadVarStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.LoadVarDelegate
+	}
+	loadVarStepReturns struct {
+		result1 exec.Step
+	}
+	loadVarStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	PutStepStub        func(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) exec.Step
 	putStepMutex       sync.RWMutex
 	putStepArgsForCall []struct {
@@ -66,6 +79,19 @@ type FakeStepFactory struct {
 	putStepReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	SetPipelineStepStub        func(atc.Plan, exec.StepMetadata, exec.SetPipelineDelegate) exec.Step
+	setPipelineStepMutex       sync.RWMutex
+	setPipelineStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.SetPipelineDelegate
+	}
+	setPipelineStepReturns struct {
+		result1 exec.Step
+	}
+	setPipelineStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	TaskStepStub        func(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.TaskDelegate, lock.LockFactory) exec.Step
 	taskStepMutex       sync.RWMutex
 	taskStepArgsForCall []struct {
@@ -272,6 +298,68 @@ func (fake *FakeStepFactory) GetStepReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeStepFactory) LoadVarStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 exec.LoadVarDelegate) exec.Step {
+	fake.loadVarStepMutex.Lock()
+	ret, specificReturn := fake.loadVarStepReturnsOnCall[len(fake.loadVarStepArgsForCall)]
+	fake.loadVarStepArgsForCall = append(fake.loadVarStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.LoadVarDelegate
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("LoadVarStep", []interface{}{arg1, arg2, arg3})
+	fake.loadVarStepMutex.Unlock()
+	if fake.LoadVarStepStub != nil {
+		return fake.LoadVarStepStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.loadVarStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStepFactory) LoadVarStepCallCount() int {
+	fake.loadVarStepMutex.RLock()
+	defer fake.loadVarStepMutex.RUnlock()
+	return len(fake.loadVarStepArgsForCall)
+}
+
+func (fake *FakeStepFactory) LoadVarStepCalls(stub func(atc.Plan, exec.StepMetadata, exec.LoadVarDelegate) exec.Step) {
+	fake.loadVarStepMutex.Lock()
+	defer fake.loadVarStepMutex.Unlock()
+	fake.LoadVarStepStub = stub
+}
+
+func (fake *FakeStepFactory) LoadVarStepArgsForCall(i int) (atc.Plan, exec.StepMetadata, exec.LoadVarDelegate) {
+	fake.loadVarStepMutex.RLock()
+	defer fake.loadVarStepMutex.RUnlock()
+	argsForCall := fake.loadVarStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStepFactory) LoadVarStepReturns(result1 exec.Step) {
+	fake.loadVarStepMutex.Lock()
+	defer fake.loadVarStepMutex.Unlock()
+	fake.LoadVarStepStub = nil
+	fake.loadVarStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeStepFactory) LoadVarStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.loadVarStepMutex.Lock()
+	defer fake.loadVarStepMutex.Unlock()
+	fake.LoadVarStepStub = nil
+	if fake.loadVarStepReturnsOnCall == nil {
+		fake.loadVarStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.loadVarStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeStepFactory) PutStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 db.ContainerMetadata, arg4 exec.PutDelegate) exec.Step {
 	fake.putStepMutex.Lock()
 	ret, specificReturn := fake.putStepReturnsOnCall[len(fake.putStepArgsForCall)]
@@ -335,6 +423,68 @@ func (fake *FakeStepFactory) PutStepReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeStepFactory) SetPipelineStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 exec.SetPipelineDelegate) exec.Step {
+	fake.setPipelineStepMutex.Lock()
+	ret, specificReturn := fake.setPipelineStepReturnsOnCall[len(fake.setPipelineStepArgsForCall)]
+	fake.setPipelineStepArgsForCall = append(fake.setPipelineStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 exec.SetPipelineDelegate
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetPipelineStep", []interface{}{arg1, arg2, arg3})
+	fake.setPipelineStepMutex.Unlock()
+	if fake.SetPipelineStepStub != nil {
+		return fake.SetPipelineStepStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStepFactory) SetPipelineStepCallCount() int {
+	fake.setPipelineStepMutex.RLock()
+	defer fake.setPipelineStepMutex.RUnlock()
+	return len(fake.setPipelineStepArgsForCall)
+}
+
+func (fake *FakeStepFactory) SetPipelineStepCalls(stub func(atc.Plan, exec.StepMetadata, exec.SetPipelineDelegate) exec.Step) {
+	fake.setPipelineStepMutex.Lock()
+	defer fake.setPipelineStepMutex.Unlock()
+	fake.SetPipelineStepStub = stub
+}
+
+func (fake *FakeStepFactory) SetPipelineStepArgsForCall(i int) (atc.Plan, exec.StepMetadata, exec.SetPipelineDelegate) {
+	fake.setPipelineStepMutex.RLock()
+	defer fake.setPipelineStepMutex.RUnlock()
+	argsForCall := fake.setPipelineStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStepFactory) SetPipelineStepReturns(result1 exec.Step) {
+	fake.setPipelineStepMutex.Lock()
+	defer fake.setPipelineStepMutex.Unlock()
+	fake.SetPipelineStepStub = nil
+	fake.setPipelineStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeStepFactory) SetPipelineStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.setPipelineStepMutex.Lock()
+	defer fake.setPipelineStepMutex.Unlock()
+	fake.SetPipelineStepStub = nil
+	if fake.setPipelineStepReturnsOnCall == nil {
+		fake.setPipelineStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.setPipelineStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeStepFactory) TaskStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 db.ContainerMetadata, arg4 exec.TaskDelegate, arg5 lock.LockFactory) exec.Step {
 	fake.taskStepMutex.Lock()
 	ret, specificReturn := fake.taskStepReturnsOnCall[len(fake.taskStepArgsForCall)]
@@ -408,8 +558,12 @@ func (fake *FakeStepFactory) Invocations() map[string][][]interface{} {
 	defer fake.artifactOutputStepMutex.RUnlock()
 	fake.getStepMutex.RLock()
 	defer fake.getStepMutex.RUnlock()
+	fake.loadVarStepMutex.RLock()
+	defer fake.loadVarStepMutex.RUnlock()
 	fake.putStepMutex.RLock()
 	defer fake.putStepMutex.RUnlock()
+	fake.setPipelineStepMutex.RLock()
+	defer fake.setPipelineStepMutex.RUnlock()
 	fake.taskStepMutex.RLock()
 	defer fake.taskStepMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
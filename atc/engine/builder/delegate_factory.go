@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
 )
 
 func NewDelegateFactory() *delegateFactory {
@@ -19,25 +20,41 @@ func NewDelegateFactory() *delegateFactory {
 
 type delegateFactory struct{}
 
-func (delegate *delegateFactory) GetDelegate(build db.Build, planID atc.PlanID) exec.GetDelegate {
-	return NewGetDelegate(build, planID, clock.NewClock())
+func (delegate *delegateFactory) GetDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.GetDelegate {
+	return NewGetDelegate(build, planID, buildVars, clock.NewClock())
 }
 
-func (delegate *delegateFactory) PutDelegate(build db.Build, planID atc.PlanID) exec.PutDelegate {
-	return NewPutDelegate(build, planID, clock.NewClock())
+func (delegate *delegateFactory) PutDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.PutDelegate {
+	return NewPutDelegate(build, planID, buildVars, clock.NewClock())
 }
 
-func (delegate *delegateFactory) TaskDelegate(build db.Build, planID atc.PlanID) exec.TaskDelegate {
-	return NewTaskDelegate(build, planID, clock.NewClock())
+func (delegate *delegateFactory) TaskDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.TaskDelegate {
+	return NewTaskDelegate(build, planID, buildVars, clock.NewClock())
 }
 
-func (delegate *delegateFactory) BuildStepDelegate(build db.Build, planID atc.PlanID) exec.BuildStepDelegate {
-	return NewBuildStepDelegate(build, planID, clock.NewClock())
+func (delegate *delegateFactory) SetPipelineDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.SetPipelineDelegate {
+	return NewSetPipelineDelegate(build, planID, buildVars, clock.NewClock())
 }
 
-func NewGetDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.GetDelegate {
+func (delegate *delegateFactory) LoadVarDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.LoadVarDelegate {
+	return NewLoadVarDelegate(build, planID, buildVars, clock.NewClock())
+}
+
+func (delegate *delegateFactory) IfDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.IfDelegate {
+	return NewIfDelegate(build, planID, clock.NewClock())
+}
+
+func (delegate *delegateFactory) RetryDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.RetryDelegate {
+	return NewRetryDelegate(build, planID, buildVars, clock.NewClock())
+}
+
+func (delegate *delegateFactory) BuildStepDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.BuildStepDelegate {
+	return NewBuildStepDelegate(build, planID, buildVars, clock.NewClock())
+}
+
+func NewGetDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.GetDelegate {
 	return &getDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, buildVars, clock),
 
 		eventOrigin: event.Origin{ID: event.OriginID(planID)},
 		build:       build,
@@ -133,9 +150,9 @@ func (d *getDelegate) UpdateVersion(log lager.Logger, plan atc.GetPlan, info exe
 	}
 }
 
-func NewPutDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.PutDelegate {
+func NewPutDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.PutDelegate {
 	return &putDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, buildVars, clock),
 
 		eventOrigin: event.Origin{ID: event.OriginID(planID)},
 		build:       build,
@@ -216,12 +233,13 @@ func (d *putDelegate) SaveOutput(log lager.Logger, plan atc.PutPlan, source atc.
 	}
 }
 
-func NewTaskDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.TaskDelegate {
+func NewTaskDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.TaskDelegate {
 	return &taskDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, buildVars, clock),
 
 		eventOrigin: event.Origin{ID: event.OriginID(planID)},
 		build:       build,
+		clock:       clock,
 	}
 }
 
@@ -230,6 +248,7 @@ type taskDelegate struct {
 
 	build       db.Build
 	eventOrigin event.Origin
+	clock       clock.Clock
 }
 
 func (d *taskDelegate) Initializing(logger lager.Logger, taskConfig atc.TaskConfig) {
@@ -274,22 +293,241 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
+func (d *taskDelegate) Cached(logger lager.Logger, cache db.TaskOutputCache) {
+	err := d.build.SaveEvent(event.TaskCached{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Key:       cache.Key,
+		BuildID:   cache.BuildID,
+		BuildName: cache.BuildName,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-task-cached-event", err)
+		return
+	}
+
+	logger.Info("cached", lager.Data{"build-id": cache.BuildID})
+}
+
+func NewSetPipelineDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.SetPipelineDelegate {
+	return &setPipelineDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, buildVars, clock),
+
+		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		build:       build,
+		clock:       clock,
+	}
+}
+
+type setPipelineDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func (d *setPipelineDelegate) Initializing(logger lager.Logger) {
+	err := d.build.SaveEvent(event.Initialize{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-initialize-event", err)
+		return
+	}
+
+	logger.Info("initializing")
+}
+
+func (d *setPipelineDelegate) Starting(logger lager.Logger) {
+	err := d.build.SaveEvent(event.Start{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-start-event", err)
+		return
+	}
+
+	logger.Debug("starting")
+}
+
+func (d *setPipelineDelegate) Finished(logger lager.Logger, succeeded bool) {
+	err := d.build.SaveEvent(event.Finish{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Succeeded: succeeded,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{"succeeded": succeeded})
+}
+
+func NewLoadVarDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.LoadVarDelegate {
+	return &loadVarDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, buildVars, clock),
+
+		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		build:       build,
+		clock:       clock,
+	}
+}
+
+type loadVarDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func (d *loadVarDelegate) Initializing(logger lager.Logger) {
+	err := d.build.SaveEvent(event.Initialize{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-initialize-event", err)
+		return
+	}
+
+	logger.Info("initializing")
+}
+
+func (d *loadVarDelegate) Starting(logger lager.Logger) {
+	err := d.build.SaveEvent(event.Start{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-start-event", err)
+		return
+	}
+
+	logger.Debug("starting")
+}
+
+func (d *loadVarDelegate) Finished(logger lager.Logger, succeeded bool) {
+	err := d.build.SaveEvent(event.Finish{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Succeeded: succeeded,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{"succeeded": succeeded})
+}
+
+func NewIfDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.IfDelegate {
+	return &ifDelegate{
+		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		build:       build,
+		clock:       clock,
+	}
+}
+
+type ifDelegate struct {
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func (d *ifDelegate) Skipped(logger lager.Logger, condition string) {
+	err := d.build.SaveEvent(event.Skipped{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Condition: condition,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-skipped-event", err)
+		return
+	}
+
+	logger.Info("skipped", lager.Data{"condition": condition})
+}
+
+func NewRetryDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.RetryDelegate {
+	return &retryDelegate{
+		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		build:       build,
+		buildVars:   buildVars,
+		clock:       clock,
+	}
+}
+
+type retryDelegate struct {
+	build       db.Build
+	eventOrigin event.Origin
+	buildVars   *vars.BuildVariables
+	clock       clock.Clock
+}
+
+func (d *retryDelegate) AttemptFinished(logger lager.Logger, attempt atc.BuildAttempt) {
+	attempt.Error = d.buildVars.Redact(attempt.Error)
+
+	err := d.build.SaveAttempt(atc.PlanID(d.eventOrigin.ID), attempt)
+	if err != nil {
+		logger.Error("failed-to-save-attempt", err)
+		return
+	}
+
+	err = d.build.SaveEvent(event.FinishAttempt{
+		Origin:    d.eventOrigin,
+		Time:      attempt.EndTime,
+		Attempt:   attempt.Attempt,
+		Status:    attempt.Status,
+		Error:     attempt.Error,
+		StartTime: attempt.StartTime,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-attempt-event", err)
+		return
+	}
+
+	logger.Info("attempt-finished", lager.Data{"attempt": attempt.Attempt, "status": attempt.Status})
+}
+
+func (d *retryDelegate) Retrying(logger lager.Logger, attempt int, wait time.Duration) {
+	err := d.build.SaveEvent(event.Retrying{
+		Origin:  d.eventOrigin,
+		Time:    d.clock.Now().Unix(),
+		Attempt: attempt,
+		Wait:    wait.String(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-retrying-event", err)
+		return
+	}
+
+	logger.Info("retrying", lager.Data{"attempt": attempt, "wait": wait.String()})
+}
+
 func NewBuildStepDelegate(
 	build db.Build,
 	planID atc.PlanID,
+	buildVars *vars.BuildVariables,
 	clock clock.Clock,
 ) *buildStepDelegate {
 	return &buildStepDelegate{
-		build:  build,
-		planID: planID,
-		clock:  clock,
+		build:     build,
+		planID:    planID,
+		buildVars: buildVars,
+		clock:     clock,
 	}
 }
 
 type buildStepDelegate struct {
-	build  db.Build
-	planID atc.PlanID
-	clock  clock.Clock
+	build     db.Build
+	planID    atc.PlanID
+	buildVars *vars.BuildVariables
+	clock     clock.Clock
 }
 
 func (delegate *buildStepDelegate) ImageVersionDetermined(resourceCache db.UsedResourceCache) error {
@@ -303,6 +541,7 @@ func (delegate *buildStepDelegate) Stdout() io.Writer {
 			Source: event.OriginSourceStdout,
 			ID:     event.OriginID(delegate.planID),
 		},
+		delegate.buildVars,
 		delegate.clock,
 	)
 }
@@ -314,13 +553,14 @@ func (delegate *buildStepDelegate) Stderr() io.Writer {
 			Source: event.OriginSourceStderr,
 			ID:     event.OriginID(delegate.planID),
 		},
+		delegate.buildVars,
 		delegate.clock,
 	)
 }
 
 func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string) {
 	err := delegate.build.SaveEvent(event.Error{
-		Message: message,
+		Message: delegate.buildVars.Redact(message),
 		Origin: event.Origin{
 			ID: event.OriginID(delegate.planID),
 		},
@@ -331,19 +571,21 @@ func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
-func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.Writer {
+func newDBEventWriter(build db.Build, origin event.Origin, buildVars *vars.BuildVariables, clock clock.Clock) io.Writer {
 	return &dbEventWriter{
-		build:  build,
-		origin: origin,
-		clock:  clock,
+		build:     build,
+		origin:    origin,
+		buildVars: buildVars,
+		clock:     clock,
 	}
 }
 
 type dbEventWriter struct {
-	build    db.Build
-	origin   event.Origin
-	clock    clock.Clock
-	dangling []byte
+	build     db.Build
+	origin    event.Origin
+	buildVars *vars.BuildVariables
+	clock     clock.Clock
+	dangling  []byte
 }
 
 func (writer *dbEventWriter) Write(data []byte) (int, error) {
@@ -359,7 +601,7 @@ func (writer *dbEventWriter) Write(data []byte) (int, error) {
 
 	err := writer.build.SaveEvent(event.Log{
 		Time:    writer.clock.Now().Unix(),
-		Payload: string(text),
+		Payload: writer.buildVars.Redact(string(text)),
 		Origin:  writer.origin,
 	})
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -26,6 +27,7 @@ var _ = Describe("DelegateFactory", func() {
 		fakePipeline *dbfakes.FakePipeline
 		fakeResource *dbfakes.FakeResource
 		fakeClock    *fakeclock.FakeClock
+		buildVars    *vars.BuildVariables
 	)
 
 	BeforeEach(func() {
@@ -35,6 +37,7 @@ var _ = Describe("DelegateFactory", func() {
 		fakePipeline = new(dbfakes.FakePipeline)
 		fakeResource = new(dbfakes.FakeResource)
 		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
+		buildVars = vars.NewBuildVariables(nil)
 	})
 
 	Describe("GetDelegate", func() {
@@ -50,7 +53,7 @@ var _ = Describe("DelegateFactory", func() {
 				Metadata: []atc.MetadataField{{Name: "baz", Value: "shmaz"}},
 			}
 
-			delegate = builder.NewGetDelegate(fakeBuild, "some-plan-id", fakeClock)
+			delegate = builder.NewGetDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
 		})
 
 		Describe("Finished", func() {
@@ -160,7 +163,7 @@ var _ = Describe("DelegateFactory", func() {
 				Metadata: []atc.MetadataField{{Name: "baz", Value: "shmaz"}},
 			}
 
-			delegate = builder.NewPutDelegate(fakeBuild, "some-plan-id", fakeClock)
+			delegate = builder.NewPutDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
 		})
 
 		Describe("Finished", func() {
@@ -219,7 +222,7 @@ var _ = Describe("DelegateFactory", func() {
 		)
 
 		BeforeEach(func() {
-			delegate = builder.NewTaskDelegate(fakeBuild, "some-plan-id", fakeClock)
+			delegate = builder.NewTaskDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
 		})
 
 		Describe("Initializing", func() {
@@ -257,6 +260,219 @@ var _ = Describe("DelegateFactory", func() {
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
 		})
+
+		Describe("Cached", func() {
+			JustBeforeEach(func() {
+				delegate.Cached(logger, db.TaskOutputCache{
+					Key:       "some-key",
+					BuildID:   42,
+					BuildName: "7",
+				})
+			})
+
+			It("saves an event naming the build that produced the outputs", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				e := fakeBuild.SaveEventArgsForCall(0)
+				Expect(e.EventType()).To(Equal(atc.EventType("task-cached")))
+				Expect(e.(event.TaskCached).Key).To(Equal("some-key"))
+				Expect(e.(event.TaskCached).BuildID).To(Equal(42))
+				Expect(e.(event.TaskCached).BuildName).To(Equal("7"))
+				Expect(e.(event.TaskCached).Time).To(Equal(int64(123456789)))
+			})
+		})
+	})
+
+	Describe("SetPipelineDelegate", func() {
+		var (
+			delegate exec.SetPipelineDelegate
+		)
+
+		BeforeEach(func() {
+			delegate = builder.NewSetPipelineDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
+		})
+
+		Describe("Initializing", func() {
+			JustBeforeEach(func() {
+				delegate.Initializing(logger)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Initialize{
+					Origin: event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:   123456789,
+				}))
+			})
+		})
+
+		Describe("Starting", func() {
+			JustBeforeEach(func() {
+				delegate.Starting(logger)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Start{
+					Origin: event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:   123456789,
+				}))
+			})
+		})
+
+		Describe("Finished", func() {
+			JustBeforeEach(func() {
+				delegate.Finished(logger, true)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Finish{
+					Origin:    event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:      123456789,
+					Succeeded: true,
+				}))
+			})
+		})
+	})
+
+	Describe("LoadVarDelegate", func() {
+		var (
+			delegate exec.LoadVarDelegate
+		)
+
+		BeforeEach(func() {
+			delegate = builder.NewLoadVarDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
+		})
+
+		Describe("Finished", func() {
+			JustBeforeEach(func() {
+				delegate.Finished(logger, false)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Finish{
+					Origin:    event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:      123456789,
+					Succeeded: false,
+				}))
+			})
+		})
+	})
+
+	Describe("IfDelegate", func() {
+		var (
+			delegate exec.IfDelegate
+		)
+
+		BeforeEach(func() {
+			delegate = builder.NewIfDelegate(fakeBuild, "some-plan-id", fakeClock)
+		})
+
+		Describe("Skipped", func() {
+			JustBeforeEach(func() {
+				delegate.Skipped(logger, `build.job_name == "some-job"`)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Skipped{
+					Origin:    event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:      123456789,
+					Condition: `build.job_name == "some-job"`,
+				}))
+			})
+		})
+	})
+
+	Describe("RetryDelegate", func() {
+		var (
+			delegate exec.RetryDelegate
+		)
+
+		BeforeEach(func() {
+			delegate = builder.NewRetryDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
+		})
+
+		Describe("Retrying", func() {
+			JustBeforeEach(func() {
+				delegate.Retrying(logger, 2, 1500*time.Millisecond)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Retrying{
+					Origin:  event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:    123456789,
+					Attempt: 2,
+					Wait:    "1.5s",
+				}))
+			})
+		})
+
+		Describe("AttemptFinished", func() {
+			var attempt atc.BuildAttempt
+
+			BeforeEach(func() {
+				attempt = atc.BuildAttempt{
+					Attempt:   1,
+					Status:    atc.StatusErrored,
+					Error:     "nope",
+					StartTime: 123456700,
+					EndTime:   123456789,
+				}
+			})
+
+			JustBeforeEach(func() {
+				delegate.AttemptFinished(logger, attempt)
+			})
+
+			It("saves the attempt", func() {
+				Expect(fakeBuild.SaveAttemptCallCount()).To(Equal(1))
+
+				planID, savedAttempt := fakeBuild.SaveAttemptArgsForCall(0)
+				Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+				Expect(savedAttempt).To(Equal(attempt))
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.FinishAttempt{
+					Origin:    event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:      123456789,
+					Attempt:   1,
+					Status:    atc.StatusErrored,
+					Error:     "nope",
+					StartTime: 123456700,
+				}))
+			})
+
+			Context("when the error contains a redacted var", func() {
+				BeforeEach(func() {
+					attempt.Error = "failed with some-secret"
+					buildVars.AddLocalVar("some-var", "some-secret", true)
+				})
+
+				It("redacts the var's value from the saved attempt", func() {
+					_, savedAttempt := fakeBuild.SaveAttemptArgsForCall(0)
+					Expect(savedAttempt.Error).To(Equal("failed with ((redacted))"))
+				})
+
+				It("redacts the var's value from the event", func() {
+					Expect(fakeBuild.SaveEventArgsForCall(0).(event.FinishAttempt).Error).To(Equal("failed with ((redacted))"))
+				})
+			})
+
+			Context("when saving the attempt fails", func() {
+				BeforeEach(func() {
+					fakeBuild.SaveAttemptReturns(errors.New("nope"))
+				})
+
+				It("does not save an event", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(BeZero())
+				})
+			})
+		})
 	})
 
 	Describe("BuildStepDelegate", func() {
@@ -265,7 +481,7 @@ var _ = Describe("DelegateFactory", func() {
 		)
 
 		BeforeEach(func() {
-			delegate = builder.NewBuildStepDelegate(fakeBuild, "some-plan-id", fakeClock)
+			delegate = builder.NewBuildStepDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
 		})
 
 		Describe("ImageVersionDetermined", func() {
@@ -324,6 +540,24 @@ var _ = Describe("DelegateFactory", func() {
 					})
 				})
 
+				Context("when the output contains a redacted var", func() {
+					BeforeEach(func() {
+						buildVars.AddLocalVar("some-var", "ell", true)
+					})
+
+					It("redacts the var's value from the log event", func() {
+						Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+						Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+							Time:    123456789,
+							Payload: "h((redacted))o",
+							Origin: event.Origin{
+								Source: event.OriginSourceStdout,
+								ID:     "some-plan-id",
+							},
+						}))
+					})
+				})
+
 				Context("when saving the event succeeds", func() {
 					disaster := errors.New("nope")
 
@@ -414,6 +648,22 @@ var _ = Describe("DelegateFactory", func() {
 				})
 			})
 
+			Context("when the message contains a redacted var", func() {
+				BeforeEach(func() {
+					buildVars.AddLocalVar("some-var", "error", true)
+				})
+
+				It("redacts the var's value from the error event", func() {
+					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Error{
+						Time:    123456789,
+						Message: "fake ((redacted)) message",
+						Origin: event.Origin{
+							ID: "some-plan-id",
+						},
+					}))
+				})
+			})
+
 			Context("when saving the event fails", func() {
 				disaster := errors.New("nope")
 
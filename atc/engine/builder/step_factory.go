@@ -15,15 +15,17 @@ import (
 )
 
 type stepFactory struct {
-	pool                  worker.Pool
-	client                worker.Client
-	resourceFetcher       resource.Fetcher
-	resourceCacheFactory  db.ResourceCacheFactory
-	resourceConfigFactory db.ResourceConfigFactory
-	secretManager         creds.Secrets
-	defaultLimits         atc.ContainerLimits
-	strategy              worker.ContainerPlacementStrategy
-	resourceFactory       resource.ResourceFactory
+	pool                   worker.Pool
+	client                 worker.Client
+	resourceFetcher        resource.Fetcher
+	resourceCacheFactory   db.ResourceCacheFactory
+	resourceConfigFactory  db.ResourceConfigFactory
+	secretManager          creds.Secrets
+	defaultLimits          atc.ContainerLimits
+	strategy               worker.ContainerPlacementStrategy
+	resourceFactory        resource.ResourceFactory
+	teamFactory            db.TeamFactory
+	taskOutputCacheFactory db.TaskOutputCacheFactory
 }
 
 func NewStepFactory(
@@ -36,17 +38,21 @@ func NewStepFactory(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	resourceFactory resource.ResourceFactory,
+	teamFactory db.TeamFactory,
+	taskOutputCacheFactory db.TaskOutputCacheFactory,
 ) *stepFactory {
 	return &stepFactory{
-		pool:                  pool,
-		client:                client,
-		resourceFetcher:       resourceFetcher,
-		resourceCacheFactory:  resourceCacheFactory,
-		resourceConfigFactory: resourceConfigFactory,
-		secretManager:         secretManager,
-		defaultLimits:         defaultLimits,
-		strategy:              strategy,
-		resourceFactory:       resourceFactory,
+		pool:                   pool,
+		client:                 client,
+		resourceFetcher:        resourceFetcher,
+		resourceCacheFactory:   resourceCacheFactory,
+		resourceConfigFactory:  resourceConfigFactory,
+		secretManager:          secretManager,
+		defaultLimits:          defaultLimits,
+		strategy:               strategy,
+		resourceFactory:        resourceFactory,
+		teamFactory:            teamFactory,
+		taskOutputCacheFactory: taskOutputCacheFactory,
 	}
 }
 
@@ -117,6 +123,7 @@ func (factory *stepFactory) TaskStep(
 		factory.secretManager,
 		factory.strategy,
 		factory.client,
+		factory.taskOutputCacheFactory,
 		delegate,
 		lockFactory,
 	)
@@ -124,6 +131,37 @@ func (factory *stepFactory) TaskStep(
 	return exec.LogError(taskStep, delegate)
 }
 
+func (factory *stepFactory) SetPipelineStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegate exec.SetPipelineDelegate,
+) exec.Step {
+	spStep := exec.NewSetPipelineStep(
+		plan.ID,
+		*plan.SetPipeline,
+		stepMetadata,
+		delegate,
+		factory.teamFactory,
+	)
+
+	return exec.LogError(spStep, delegate)
+}
+
+func (factory *stepFactory) LoadVarStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegate exec.LoadVarDelegate,
+) exec.Step {
+	lvStep := exec.NewLoadVarStep(
+		plan.ID,
+		*plan.LoadVar,
+		stepMetadata,
+		delegate,
+	)
+
+	return exec.LogError(lvStep, delegate)
+}
+
 func (factory *stepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
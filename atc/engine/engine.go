@@ -2,15 +2,19 @@ package engine
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/vars"
 )
 
 //go:generate counterfeiter . Engine
@@ -29,12 +33,13 @@ type Runnable interface {
 //go:generate counterfeiter . StepBuilder
 
 type StepBuilder interface {
-	BuildStep(db.Build) (exec.Step, error)
+	BuildStep(db.Build, *vars.BuildVariables) (exec.Step, error)
 }
 
-func NewEngine(builder StepBuilder) Engine {
+func NewEngine(builder StepBuilder, varSourcePool creds.VarSourcePool) Engine {
 	return &engine{
-		builder: builder,
+		builder:       builder,
+		varSourcePool: varSourcePool,
 
 		release:       make(chan bool),
 		trackedStates: new(sync.Map),
@@ -43,7 +48,8 @@ func NewEngine(builder StepBuilder) Engine {
 }
 
 type engine struct {
-	builder StepBuilder
+	builder       StepBuilder
+	varSourcePool creds.VarSourcePool
 
 	release       chan bool
 	trackedStates *sync.Map
@@ -71,6 +77,7 @@ func (engine *engine) NewBuild(build db.Build) Runnable {
 		cancel,
 		build,
 		engine.builder,
+		engine.varSourcePool,
 		engine.release,
 		engine.trackedStates,
 		engine.waitGroup,
@@ -82,6 +89,7 @@ func NewBuild(
 	cancel func(),
 	build db.Build,
 	builder StepBuilder,
+	varSourcePool creds.VarSourcePool,
 	release chan bool,
 	trackedStates *sync.Map,
 	waitGroup *sync.WaitGroup,
@@ -90,8 +98,9 @@ func NewBuild(
 		ctx:    ctx,
 		cancel: cancel,
 
-		build:   build,
-		builder: builder,
+		build:         build,
+		builder:       builder,
+		varSourcePool: varSourcePool,
 
 		release:       release,
 		trackedStates: trackedStates,
@@ -103,8 +112,9 @@ type engineBuild struct {
 	ctx    context.Context
 	cancel func()
 
-	build   db.Build
-	builder StepBuilder
+	build         db.Build
+	builder       StepBuilder
+	varSourcePool creds.VarSourcePool
 
 	release       chan bool
 	trackedStates *sync.Map
@@ -158,7 +168,18 @@ func (b *engineBuild) Run(logger lager.Logger) {
 
 	defer notifier.Close()
 
-	step, err := b.builder.BuildStep(b.build)
+	pipelineVars, err := b.pipelineVars(logger)
+	if err != nil {
+		logger.Error("failed-to-load-pipeline-vars", err)
+		b.saveError(logger, fmt.Sprintf("failed to load the pipeline's vars: %s", err))
+		b.finish(logger.Session("finish"), err, false)
+		return
+	}
+
+	state := b.runState(pipelineVars)
+	defer b.clearRunState()
+
+	step, err := b.builder.BuildStep(b.build, state.Variables())
 	if err != nil {
 		logger.Error("failed-to-build-step", err)
 		return
@@ -169,9 +190,6 @@ func (b *engineBuild) Run(logger lager.Logger) {
 
 	logger.Info("running")
 
-	state := b.runState()
-	defer b.clearRunState()
-
 	noleak := make(chan bool)
 	defer close(noleak)
 
@@ -218,6 +236,16 @@ func (b *engineBuild) finish(logger lager.Logger, err error, succeeded bool) {
 	}
 }
 
+func (b *engineBuild) saveError(logger lager.Logger, message string) {
+	err := b.build.SaveEvent(event.Error{
+		Message: message,
+		Time:    time.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-error-event", err)
+	}
+}
+
 func (b *engineBuild) saveStatus(logger lager.Logger, status atc.BuildStatus) {
 	if err := b.build.Finish(db.BuildStatus(status)); err != nil {
 		logger.Error("failed-to-finish-build", err)
@@ -259,8 +287,36 @@ func (b *engineBuild) trackFinished(logger lager.Logger) {
 	}
 }
 
-func (build *engineBuild) runState() exec.RunState {
-	existingState, _ := build.trackedStates.LoadOrStore(build.build.ID(), exec.NewRunState())
+// pipelineVars returns the vars of the build's pipeline, from its var sources
+// and its static vars, or nil for one-off builds.
+func (build *engineBuild) pipelineVars(logger lager.Logger) (vars.Variables, error) {
+	if build.build.PipelineID() == 0 {
+		return nil, nil
+	}
+
+	pipeline, found, err := build.build.Pipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return vars.NewMultiVars([]vars.Variables{
+		creds.NewVarSourceVariables(
+			logger,
+			build.varSourcePool,
+			pipeline.TeamName(),
+			pipeline.Name(),
+			pipeline.VarSources(),
+		),
+		vars.StaticVariables(pipeline.Vars()),
+	}), nil
+}
+
+func (build *engineBuild) runState(pipelineVars vars.Variables) exec.RunState {
+	existingState, _ := build.trackedStates.LoadOrStore(build.build.ID(), exec.NewRunState(pipelineVars))
 	return existingState.(exec.RunState)
 }
 
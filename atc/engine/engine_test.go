@@ -8,13 +8,17 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	. "github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -22,8 +26,9 @@ import (
 
 var _ = Describe("Engine", func() {
 	var (
-		fakeBuild       *dbfakes.FakeBuild
-		fakeStepBuilder *enginefakes.FakeStepBuilder
+		fakeBuild         *dbfakes.FakeBuild
+		fakeStepBuilder   *enginefakes.FakeStepBuilder
+		fakeVarSourcePool *credsfakes.FakeVarSourcePool
 	)
 
 	BeforeEach(func() {
@@ -31,6 +36,7 @@ var _ = Describe("Engine", func() {
 		fakeBuild.IDReturns(128)
 
 		fakeStepBuilder = new(enginefakes.FakeStepBuilder)
+		fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 	})
 
 	Describe("NewBuild", func() {
@@ -40,7 +46,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepBuilder)
+			engine = NewEngine(fakeStepBuilder, fakeVarSourcePool)
 		})
 
 		JustBeforeEach(func() {
@@ -77,6 +83,7 @@ var _ = Describe("Engine", func() {
 				func() { cancel <- true },
 				fakeBuild,
 				fakeStepBuilder,
+				fakeVarSourcePool,
 				release,
 				trackedStates,
 				waitGroup,
@@ -141,6 +148,81 @@ var _ = Describe("Engine", func() {
 								Expect(fakeNotifier.CloseCallCount()).To(Equal(1))
 							})
 
+							It("builds the step with the vars of the state it runs with", func() {
+								waitGroup.Wait()
+								Expect(fakeStepBuilder.BuildStepCallCount()).To(Equal(1))
+								_, buildVars := fakeStepBuilder.BuildStepArgsForCall(0)
+
+								Expect(fakeStep.RunCallCount()).To(Equal(1))
+								_, state := fakeStep.RunArgsForCall(0)
+								Expect(buildVars).To(BeIdenticalTo(state.Variables()))
+							})
+
+							Context("when the build's pipeline has var sources and static vars", func() {
+								BeforeEach(func() {
+									fakePipeline := new(dbfakes.FakePipeline)
+									fakePipeline.TeamNameReturns("some-team")
+									fakePipeline.NameReturns("some-pipeline")
+									fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+										{Name: "some-source", Type: "vault"},
+									})
+									fakePipeline.VarsReturns(map[string]interface{}{
+										"some-static-var": "some-static-value",
+									})
+
+									fakeBuild.PipelineIDReturns(42)
+									fakeBuild.PipelineReturns(fakePipeline, true, nil)
+
+									fakeSecrets := new(credsfakes.FakeSecrets)
+									fakeSecrets.GetReturns("some-value", nil, true, nil)
+									fakeVarSourcePool.FindOrCreateReturns(fakeSecrets, nil)
+								})
+
+								It("builds the step with vars from the var sources", func() {
+									waitGroup.Wait()
+									_, buildVars := fakeStepBuilder.BuildStepArgsForCall(0)
+
+									val, found, err := buildVars.Get(vars.VariableDefinition{Source: "some-source", Name: "some-var"})
+									Expect(err).ToNot(HaveOccurred())
+									Expect(found).To(BeTrue())
+									Expect(val).To(Equal("some-value"))
+
+									Expect(fakeVarSourcePool.FindOrCreateCallCount()).To(Equal(1))
+									_, _, _, varSource := fakeVarSourcePool.FindOrCreateArgsForCall(0)
+									Expect(varSource.Name).To(Equal("some-source"))
+								})
+
+								It("builds the step with the pipeline's static vars", func() {
+									waitGroup.Wait()
+									_, buildVars := fakeStepBuilder.BuildStepArgsForCall(0)
+
+									val, found, err := buildVars.Get(vars.VariableDefinition{Name: "some-static-var"})
+									Expect(err).ToNot(HaveOccurred())
+									Expect(found).To(BeTrue())
+									Expect(val).To(Equal("some-static-value"))
+								})
+							})
+
+							Context("when loading the build's pipeline fails", func() {
+								BeforeEach(func() {
+									fakeBuild.PipelineIDReturns(42)
+									fakeBuild.PipelineReturns(nil, false, errors.New("nope"))
+								})
+
+								It("does not build the step", func() {
+									waitGroup.Wait()
+									Expect(fakeStepBuilder.BuildStepCallCount()).To(Equal(0))
+								})
+
+								It("finishes the build as errored", func() {
+									waitGroup.Wait()
+									Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+									Expect(fakeBuild.SaveEventArgsForCall(0)).To(BeAssignableToTypeOf(event.Error{}))
+									Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+									Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
+								})
+							})
+
 							Context("when the build is released", func() {
 								BeforeEach(func() {
 									readyToRelease := make(chan bool)
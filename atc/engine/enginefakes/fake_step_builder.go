@@ -7,13 +7,15 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
 )
 
 type FakeStepBuilder struct {
-	BuildStepStub        func(db.Build) (exec.Step, error)
+	BuildStepStub        func(db.Build, *vars.BuildVariables) (exec.Step, error)
 	buildStepMutex       sync.RWMutex
 	buildStepArgsForCall []struct {
 		arg1 db.Build
+		arg2 *vars.BuildVariables
 	}
 	buildStepReturns struct {
 		result1 exec.Step
@@ -27,16 +29,17 @@ type FakeStepBuilder struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeStepBuilder) BuildStep(arg1 db.Build) (exec.Step, error) {
+func (fake *FakeStepBuilder) BuildStep(arg1 db.Build, arg2 *vars.BuildVariables) (exec.Step, error) {
 	fake.buildStepMutex.Lock()
 	ret, specificReturn := fake.buildStepReturnsOnCall[len(fake.buildStepArgsForCall)]
 	fake.buildStepArgsForCall = append(fake.buildStepArgsForCall, struct {
 		arg1 db.Build
-	}{arg1})
-	fake.recordInvocation("BuildStep", []interface{}{arg1})
+		arg2 *vars.BuildVariables
+	}{arg1, arg2})
+	fake.recordInvocation("BuildStep", []interface{}{arg1, arg2})
 	fake.buildStepMutex.Unlock()
 	if fake.BuildStepStub != nil {
-		return fake.BuildStepStub(arg1)
+		return fake.BuildStepStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -51,17 +54,17 @@ func (fake *FakeStepBuilder) BuildStepCallCount() int {
 	return len(fake.buildStepArgsForCall)
 }
 
-func (fake *FakeStepBuilder) BuildStepCalls(stub func(db.Build) (exec.Step, error)) {
+func (fake *FakeStepBuilder) BuildStepCalls(stub func(db.Build, *vars.BuildVariables) (exec.Step, error)) {
 	fake.buildStepMutex.Lock()
 	defer fake.buildStepMutex.Unlock()
 	fake.BuildStepStub = stub
 }
 
-func (fake *FakeStepBuilder) BuildStepArgsForCall(i int) db.Build {
+func (fake *FakeStepBuilder) BuildStepArgsForCall(i int) (db.Build, *vars.BuildVariables) {
 	fake.buildStepMutex.RLock()
 	defer fake.buildStepMutex.RUnlock()
 	argsForCall := fake.buildStepArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeStepBuilder) BuildStepReturns(result1 exec.Step, result2 error) {
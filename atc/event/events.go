@@ -20,6 +20,17 @@ type FinishTask struct {
 func (FinishTask) EventType() atc.EventType  { return EventTypeFinishTask }
 func (FinishTask) Version() atc.EventVersion { return "4.0" }
 
+type TaskCached struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	Key       string `json:"key"`
+	BuildID   int    `json:"build_id"`
+	BuildName string `json:"build_name"`
+}
+
+func (TaskCached) EventType() atc.EventType  { return EventTypeTaskCached }
+func (TaskCached) Version() atc.EventVersion { return "1.0" }
+
 type InitializeTask struct {
 	Time       int64      `json:"time"`
 	Origin     Origin     `json:"origin"`
@@ -164,3 +175,59 @@ type FinishPut struct {
 
 func (FinishPut) EventType() atc.EventType  { return EventTypeFinishPut }
 func (FinishPut) Version() atc.EventVersion { return "5.1" }
+
+type Initialize struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time,omitempty"`
+}
+
+func (Initialize) EventType() atc.EventType  { return EventTypeInitialize }
+func (Initialize) Version() atc.EventVersion { return "2.0" }
+
+type Start struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time,omitempty"`
+}
+
+func (Start) EventType() atc.EventType  { return EventTypeStart }
+func (Start) Version() atc.EventVersion { return "2.0" }
+
+type Finish struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Succeeded bool   `json:"succeeded"`
+}
+
+func (Finish) EventType() atc.EventType  { return EventTypeFinish }
+func (Finish) Version() atc.EventVersion { return "2.0" }
+
+type Skipped struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Condition string `json:"condition"`
+}
+
+func (Skipped) EventType() atc.EventType  { return EventTypeSkipped }
+func (Skipped) Version() atc.EventVersion { return "1.0" }
+
+type Retrying struct {
+	Origin  Origin `json:"origin"`
+	Time    int64  `json:"time"`
+	Attempt int    `json:"attempt"`
+	Wait    string `json:"wait"`
+}
+
+func (Retrying) EventType() atc.EventType  { return EventTypeRetrying }
+func (Retrying) Version() atc.EventVersion { return "1.0" }
+
+type FinishAttempt struct {
+	Origin    Origin          `json:"origin"`
+	Time      int64           `json:"time"`
+	Attempt   int             `json:"attempt"`
+	Status    atc.BuildStatus `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	StartTime int64           `json:"start_time"`
+}
+
+func (FinishAttempt) EventType() atc.EventType  { return EventTypeFinishAttempt }
+func (FinishAttempt) Version() atc.EventVersion { return "1.0" }
@@ -37,12 +37,19 @@ func init() {
 	RegisterEvent(InitializeTask{})
 	RegisterEvent(StartTask{})
 	RegisterEvent(FinishTask{})
+	RegisterEvent(TaskCached{})
 	RegisterEvent(InitializeGet{})
 	RegisterEvent(StartGet{})
 	RegisterEvent(FinishGet{})
 	RegisterEvent(InitializePut{})
 	RegisterEvent(StartPut{})
 	RegisterEvent(FinishPut{})
+	RegisterEvent(Initialize{})
+	RegisterEvent(Start{})
+	RegisterEvent(Finish{})
+	RegisterEvent(Skipped{})
+	RegisterEvent(Retrying{})
+	RegisterEvent(FinishAttempt{})
 	RegisterEvent(Status{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
@@ -18,6 +18,9 @@ const (
 	// task execution finished
 	EventTypeFinishTask atc.EventType = "finish-task"
 
+	// task was not run, as the outputs of a previous run were reused
+	EventTypeTaskCached atc.EventType = "task-cached"
+
 	// initialize getting something
 	EventTypeInitializeGet atc.EventType = "initialize-get"
 
@@ -36,6 +39,24 @@ const (
 	// finished putting something
 	EventTypeFinishPut atc.EventType = "finish-put"
 
+	// initialize a step which has no more specific event type
+	EventTypeInitialize atc.EventType = "initialize"
+
+	// started a step which has no more specific event type
+	EventTypeStart atc.EventType = "start"
+
+	// finished a step which has no more specific event type
+	EventTypeFinish atc.EventType = "finish"
+
+	// a step was not run because its condition did not hold
+	EventTypeSkipped atc.EventType = "skipped"
+
+	// a step is about to be retried, possibly after waiting
+	EventTypeRetrying atc.EventType = "retrying"
+
+	// finished an attempt of a step that may be retried
+	EventTypeFinishAttempt atc.EventType = "finish-attempt"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
 )
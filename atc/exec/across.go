@@ -0,0 +1,41 @@
+package exec
+
+import (
+	"github.com/concourse/concourse/atc"
+)
+
+// Across constructs a step which runs each of the given steps, one per
+// combination of the vars' values. The steps must be ordered the same way as
+// an atc.AcrossPlan's steps, i.e. with the last var varying the fastest.
+//
+// Combinations are run in parallel per var, limited by the var's
+// MaxInFlight. A MaxInFlight of 0 means the combinations for that var are run
+// one at a time.
+func Across(vars []atc.AcrossVar, steps []Step, failFast bool) Step {
+	if len(steps) == 0 {
+		return IdentityStep{}
+	}
+
+	if len(vars) == 0 {
+		return steps[0]
+	}
+
+	v := vars[0]
+	if len(v.Values) == 0 {
+		return IdentityStep{}
+	}
+
+	size := len(steps) / len(v.Values)
+
+	var groups []Step
+	for i := 0; i < len(v.Values); i++ {
+		groups = append(groups, Across(vars[1:], steps[i*size:(i+1)*size], failFast))
+	}
+
+	limit := v.MaxInFlight
+	if limit < 1 {
+		limit = 1
+	}
+
+	return InParallel(groups, limit, failFast)
+}
@@ -0,0 +1,157 @@
+package exec_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Across", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeSteps []*execfakes.FakeStep
+		vars      []atc.AcrossVar
+		failFast  bool
+
+		state *execfakes.FakeRunState
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeSteps = nil
+		for i := 0; i < 4; i++ {
+			fakeStep := new(execfakes.FakeStep)
+			fakeStep.SucceededReturns(true)
+			fakeSteps = append(fakeSteps, fakeStep)
+		}
+
+		vars = []atc.AcrossVar{
+			{Var: "a", Values: []interface{}{"a1", "a2"}},
+			{Var: "b", Values: []interface{}{"b1", "b2"}},
+		}
+
+		failFast = false
+
+		state = new(execfakes.FakeRunState)
+		state.NewLocalScopeReturns(state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		var steps []Step
+		for _, fakeStep := range fakeSteps {
+			steps = append(steps, fakeStep)
+		}
+
+		step = Across(vars, steps, failFast)
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("runs every combination", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+
+		for _, fakeStep := range fakeSteps {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+		}
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	Context("when no max_in_flight is configured", func() {
+		var (
+			lock    sync.Mutex
+			running int
+			maxSeen int
+		)
+
+		BeforeEach(func() {
+			running, maxSeen = 0, 0
+
+			for _, fakeStep := range fakeSteps {
+				fakeStep.RunStub = func(context.Context, RunState) error {
+					lock.Lock()
+					running++
+					if running > maxSeen {
+						maxSeen = running
+					}
+					lock.Unlock()
+
+					lock.Lock()
+					running--
+					lock.Unlock()
+
+					return nil
+				}
+			}
+		})
+
+		It("runs the combinations one at a time", func() {
+			Expect(maxSeen).To(Equal(1))
+		})
+	})
+
+	Context("when every var allows all of its values in flight", func() {
+		BeforeEach(func() {
+			vars[0].MaxInFlight = 2
+			vars[1].MaxInFlight = 2
+
+			wg := new(sync.WaitGroup)
+			wg.Add(len(fakeSteps))
+
+			for _, fakeStep := range fakeSteps {
+				fakeStep.RunStub = func(context.Context, RunState) error {
+					wg.Done()
+					wg.Wait()
+					return nil
+				}
+			}
+		})
+
+		It("runs all of the combinations concurrently", func() {
+			for _, fakeStep := range fakeSteps {
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			}
+		})
+	})
+
+	Context("when a combination fails", func() {
+		BeforeEach(func() {
+			fakeSteps[1].SucceededReturns(false)
+		})
+
+		It("fails", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		It("still runs the remaining combinations", func() {
+			Expect(fakeSteps[3].RunCallCount()).To(Equal(1))
+		})
+
+		Context("when fail fast is configured", func() {
+			BeforeEach(func() {
+				failFast = true
+			})
+
+			It("does not run the remaining combinations", func() {
+				Expect(fakeSteps[2].RunCallCount()).To(Equal(0))
+				Expect(fakeSteps[3].RunCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
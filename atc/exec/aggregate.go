@@ -16,13 +16,15 @@ type AggregateStep []Step
 // It will wait for all steps to exit, even if one step fails or errors. After
 // all steps finish, their errors (if any) will be aggregated and returned as a
 // single error.
+//
+// As with InParallelStep, each step runs in its own local scope.
 func (step AggregateStep) Run(ctx context.Context, state RunState) error {
 	errs := make(chan error, len(step))
 
 	for _, s := range step {
 		s := s
 		go func() {
-			errs <- s.Run(ctx, state)
+			errs <- s.Run(ctx, state.NewLocalScope())
 		}()
 	}
 
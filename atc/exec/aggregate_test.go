@@ -41,6 +41,11 @@ var _ = Describe("Aggregate", func() {
 		repo = artifact.NewRepository()
 		state = new(execfakes.FakeRunState)
 		state.ArtifactsReturns(repo)
+		state.NewLocalScopeStub = func() RunState {
+			localState := new(execfakes.FakeRunState)
+			localState.ArtifactsReturns(repo)
+			return localState
+		}
 	})
 
 	AfterEach(func() {
@@ -65,6 +70,16 @@ var _ = Describe("Aggregate", func() {
 		Expect(repo).To(Equal(repo))
 	})
 
+	It("runs each step in its own local scope", func() {
+		Expect(state.NewLocalScopeCallCount()).To(Equal(2))
+
+		_, stateA := fakeStepA.RunArgsForCall(0)
+		_, stateB := fakeStepB.RunArgsForCall(0)
+		Expect(stateA).ToNot(BeIdenticalTo(state))
+		Expect(stateB).ToNot(BeIdenticalTo(state))
+		Expect(stateA).ToNot(BeIdenticalTo(stateB))
+	})
+
 	Describe("executing each source", func() {
 		BeforeEach(func() {
 			wg := new(sync.WaitGroup)
@@ -0,0 +1,47 @@
+package exec
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/exec/artifact"
+)
+
+// readArtifactFile reads the file at the given path out of the
+// artifact.Repository.
+//
+// The path must be in the format SOURCE_NAME/FILE/PATH. The SOURCE_NAME will
+// be used to determine the ArtifactSource in the artifact.Repository to stream
+// the file out of.
+//
+// If the source name is missing, UnspecifiedArtifactSourceError is returned.
+// If the source name cannot be found, UnknownArtifactSourceError is returned.
+func readArtifactFile(logger lager.Logger, repo *artifact.Repository, path string) ([]byte, error) {
+	segs := strings.SplitN(path, "/", 2)
+	if len(segs) != 2 {
+		return nil, UnspecifiedArtifactSourceError{path}
+	}
+
+	sourceName := artifact.Name(segs[0])
+	filePath := segs[1]
+
+	source, found := repo.SourceFor(sourceName)
+	if !found {
+		return nil, UnknownArtifactSourceError{sourceName, path}
+	}
+
+	stream, err := source.StreamFile(logger, filePath)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, fmt.Errorf("file '%s/%s' not found", sourceName, filePath)
+		}
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return ioutil.ReadAll(stream)
+}
@@ -33,7 +33,7 @@ var _ = Describe("ArtifactOutputStep", func() {
 	BeforeEach(func() {
 		ctx, cancel = context.WithCancel(context.Background())
 
-		state = exec.NewRunState()
+		state = exec.NewRunState(nil)
 
 		delegate = new(execfakes.FakeBuildStepDelegate)
 		delegate.StdoutReturns(ioutil.Discard)
@@ -7,6 +7,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/vars"
 )
 
 type FakeRunState struct {
@@ -20,6 +21,16 @@ type FakeRunState struct {
 	artifactsReturnsOnCall map[int]struct {
 		result1 *artifact.Repository
 	}
+	NewLocalScopeStub        func() exec.RunState
+	newLocalScopeMutex       sync.RWMutex
+	newLocalScopeArgsForCall []struct {
+	}
+	newLocalScopeReturns struct {
+		result1 exec.RunState
+	}
+	newLocalScopeReturnsOnCall map[int]struct {
+		result1 exec.RunState
+	}
 	ResultStub        func(atc.PlanID, interface{}) bool
 	resultMutex       sync.RWMutex
 	resultArgsForCall []struct {
@@ -38,6 +49,16 @@ type FakeRunState struct {
 		arg1 atc.PlanID
 		arg2 interface{}
 	}
+	VariablesStub        func() *vars.BuildVariables
+	variablesMutex       sync.RWMutex
+	variablesArgsForCall []struct {
+	}
+	variablesReturns struct {
+		result1 *vars.BuildVariables
+	}
+	variablesReturnsOnCall map[int]struct {
+		result1 *vars.BuildVariables
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -94,6 +115,58 @@ func (fake *FakeRunState) ArtifactsReturnsOnCall(i int, result1 *artifact.Reposi
 	}{result1}
 }
 
+func (fake *FakeRunState) NewLocalScope() exec.RunState {
+	fake.newLocalScopeMutex.Lock()
+	ret, specificReturn := fake.newLocalScopeReturnsOnCall[len(fake.newLocalScopeArgsForCall)]
+	fake.newLocalScopeArgsForCall = append(fake.newLocalScopeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("NewLocalScope", []interface{}{})
+	fake.newLocalScopeMutex.Unlock()
+	if fake.NewLocalScopeStub != nil {
+		return fake.NewLocalScopeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.newLocalScopeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRunState) NewLocalScopeCallCount() int {
+	fake.newLocalScopeMutex.RLock()
+	defer fake.newLocalScopeMutex.RUnlock()
+	return len(fake.newLocalScopeArgsForCall)
+}
+
+func (fake *FakeRunState) NewLocalScopeCalls(stub func() exec.RunState) {
+	fake.newLocalScopeMutex.Lock()
+	defer fake.newLocalScopeMutex.Unlock()
+	fake.NewLocalScopeStub = stub
+}
+
+func (fake *FakeRunState) NewLocalScopeReturns(result1 exec.RunState) {
+	fake.newLocalScopeMutex.Lock()
+	defer fake.newLocalScopeMutex.Unlock()
+	fake.NewLocalScopeStub = nil
+	fake.newLocalScopeReturns = struct {
+		result1 exec.RunState
+	}{result1}
+}
+
+func (fake *FakeRunState) NewLocalScopeReturnsOnCall(i int, result1 exec.RunState) {
+	fake.newLocalScopeMutex.Lock()
+	defer fake.newLocalScopeMutex.Unlock()
+	fake.NewLocalScopeStub = nil
+	if fake.newLocalScopeReturnsOnCall == nil {
+		fake.newLocalScopeReturnsOnCall = make(map[int]struct {
+			result1 exec.RunState
+		})
+	}
+	fake.newLocalScopeReturnsOnCall[i] = struct {
+		result1 exec.RunState
+	}{result1}
+}
+
 func (fake *FakeRunState) Result(arg1 atc.PlanID, arg2 interface{}) bool {
 	fake.resultMutex.Lock()
 	ret, specificReturn := fake.resultReturnsOnCall[len(fake.resultArgsForCall)]
@@ -187,15 +260,71 @@ func (fake *FakeRunState) StoreResultArgsForCall(i int) (atc.PlanID, interface{}
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeRunState) Variables() *vars.BuildVariables {
+	fake.variablesMutex.Lock()
+	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
+	fake.variablesArgsForCall = append(fake.variablesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Variables", []interface{}{})
+	fake.variablesMutex.Unlock()
+	if fake.VariablesStub != nil {
+		return fake.VariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.variablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRunState) VariablesCallCount() int {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	return len(fake.variablesArgsForCall)
+}
+
+func (fake *FakeRunState) VariablesCalls(stub func() *vars.BuildVariables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = stub
+}
+
+func (fake *FakeRunState) VariablesReturns(result1 *vars.BuildVariables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	fake.variablesReturns = struct {
+		result1 *vars.BuildVariables
+	}{result1}
+}
+
+func (fake *FakeRunState) VariablesReturnsOnCall(i int, result1 *vars.BuildVariables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	if fake.variablesReturnsOnCall == nil {
+		fake.variablesReturnsOnCall = make(map[int]struct {
+			result1 *vars.BuildVariables
+		})
+	}
+	fake.variablesReturnsOnCall[i] = struct {
+		result1 *vars.BuildVariables
+	}{result1}
+}
+
 func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.newLocalScopeMutex.RLock()
+	defer fake.newLocalScopeMutex.RUnlock()
 	fake.resultMutex.RLock()
 	defer fake.resultMutex.RUnlock()
 	fake.storeResultMutex.RLock()
 	defer fake.storeResultMutex.RUnlock()
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
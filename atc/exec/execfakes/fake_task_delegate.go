@@ -12,6 +12,12 @@ import (
 )
 
 type FakeTaskDelegate struct {
+	CachedStub        func(lager.Logger, db.TaskOutputCache)
+	cachedMutex       sync.RWMutex
+	cachedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.TaskOutputCache
+	}
 	ErroredStub        func(lager.Logger, string)
 	erroredMutex       sync.RWMutex
 	erroredArgsForCall []struct {
@@ -71,6 +77,38 @@ type FakeTaskDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTaskDelegate) Cached(arg1 lager.Logger, arg2 db.TaskOutputCache) {
+	fake.cachedMutex.Lock()
+	fake.cachedArgsForCall = append(fake.cachedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.TaskOutputCache
+	}{arg1, arg2})
+	fake.recordInvocation("Cached", []interface{}{arg1, arg2})
+	fake.cachedMutex.Unlock()
+	if fake.CachedStub != nil {
+		fake.CachedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) CachedCallCount() int {
+	fake.cachedMutex.RLock()
+	defer fake.cachedMutex.RUnlock()
+	return len(fake.cachedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) CachedCalls(stub func(lager.Logger, db.TaskOutputCache)) {
+	fake.cachedMutex.Lock()
+	defer fake.cachedMutex.Unlock()
+	fake.CachedStub = stub
+}
+
+func (fake *FakeTaskDelegate) CachedArgsForCall(i int) (lager.Logger, db.TaskOutputCache) {
+	fake.cachedMutex.RLock()
+	defer fake.cachedMutex.RUnlock()
+	argsForCall := fake.cachedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) Errored(arg1 lager.Logger, arg2 string) {
 	fake.erroredMutex.Lock()
 	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
@@ -366,6 +404,8 @@ func (fake *FakeTaskDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cachedMutex.RLock()
+	defer fake.cachedMutex.RUnlock()
 	fake.erroredMutex.RLock()
 	defer fake.erroredMutex.RUnlock()
 	fake.finishedMutex.RLock()
@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/vars"
 )
 
 type ErrPipelineNotFound struct {
@@ -118,7 +119,10 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 
 	step.delegate.Initializing(logger)
 
-	variables := creds.NewVariables(step.secrets, step.metadata.TeamName, step.metadata.PipelineName)
+	variables := vars.NewMultiVars([]vars.Variables{
+		creds.NewVariables(step.secrets, step.metadata.TeamName, step.metadata.PipelineName),
+		state.Variables(),
+	})
 
 	source, err := creds.NewSource(variables, step.plan.Source).Evaluate()
 	if err != nil {
@@ -148,6 +152,10 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		Env:    step.metadata.Env(),
 	}
 
+	if step.plan.Limits != nil {
+		containerSpec.Limits = worker.ContainerLimits(*step.plan.Limits)
+	}
+
 	workerSpec := worker.WorkerSpec{
 		ResourceType:  step.plan.Type,
 		Tags:          step.plan.Tags,
@@ -22,6 +22,7 @@ import (
 	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/vars"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -85,6 +86,7 @@ var _ = Describe("GetStep", func() {
 
 		artifactRepository = artifact.NewRepository()
 		state = new(execfakes.FakeRunState)
+		state.VariablesReturns(vars.NewBuildVariables(nil))
 		state.ArtifactsReturns(artifactRepository)
 
 		fakeVersionedSource = new(resourcefakes.FakeVersionedSource)
@@ -171,6 +173,20 @@ var _ = Describe("GetStep", func() {
 		Expect(strategy).To(Equal(fakeStrategy))
 	})
 
+	Context("when the plan has container limits", func() {
+		BeforeEach(func() {
+			cpu := uint64(512)
+			memory := uint64(1024)
+			getPlan.Limits = &atc.ContainerLimits{CPU: &cpu, Memory: &memory}
+		})
+
+		It("limits the container", func() {
+			_, _, _, actualContainerSpec, _, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+			Expect(*actualContainerSpec.Limits.CPU).To(Equal(uint64(512)))
+			Expect(*actualContainerSpec.Limits.Memory).To(Equal(uint64(1024)))
+		})
+	})
+
 	Context("when find or choosing worker succeeds", func() {
 		BeforeEach(func() {
 			fakeWorker.NameReturns("some-worker")
@@ -0,0 +1,160 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+//go:generate counterfeiter . IfDelegate
+
+type IfDelegate interface {
+	Skipped(lager.Logger, string)
+}
+
+// IfStep only runs its step if its condition holds.
+type IfStep struct {
+	step     Step
+	plan     atc.IfPlan
+	metadata StepMetadata
+	delegate IfDelegate
+
+	skipped bool
+}
+
+// If constructs an IfStep.
+func If(step Step, plan atc.IfPlan, metadata StepMetadata, delegate IfDelegate) *IfStep {
+	return &IfStep{
+		step:     step,
+		plan:     plan,
+		metadata: metadata,
+		delegate: delegate,
+	}
+}
+
+// Run evaluates the condition against the build's metadata, the build-local
+// vars of the given state and the versions of the job's inputs.
+//
+// If the condition holds, the nested step is run and its error returned.
+// Otherwise the step is skipped, which is reported to the delegate.
+func (step *IfStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("if")
+
+	condition, err := atc.ParseCondition(step.plan.Condition)
+	if err != nil {
+		return err
+	}
+
+	holds, err := condition.Evaluate(conditionResolver{
+		metadata:      step.metadata,
+		variables:     state.Variables(),
+		inputVersions: step.plan.InputVersions,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to evaluate condition '%s': %s", step.plan.Condition, err)
+	}
+
+	if !holds {
+		step.skipped = true
+		step.delegate.Skipped(logger, step.plan.Condition)
+		return nil
+	}
+
+	return step.step.Run(ctx, state)
+}
+
+// Succeeded is true if the step was skipped, and otherwise returns the nested
+// step's Succeeded.
+func (step *IfStep) Succeeded() bool {
+	if step.skipped {
+		return true
+	}
+
+	return step.step.Succeeded()
+}
+
+type conditionResolver struct {
+	metadata      StepMetadata
+	variables     *vars.BuildVariables
+	inputVersions map[string]atc.Version
+}
+
+func (resolver conditionResolver) Resolve(ref []string) (interface{}, error) {
+	switch ref[0] {
+	case atc.ConditionRootBuild:
+		return resolver.build(ref[1])
+
+	case atc.ConditionRootVars:
+		val, found, err := resolver.variables.Get(vars.VariableDefinition{
+			Source: vars.LocalVarSource,
+			Name:   ref[1],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, fmt.Errorf("undefined var: %s", ref[1])
+		}
+
+		for _, field := range ref[2:] {
+			switch v := val.(type) {
+			case map[string]interface{}:
+				val, found = v[field]
+			case map[interface{}]interface{}:
+				val, found = v[field]
+			default:
+				found = false
+			}
+
+			if !found {
+				return nil, fmt.Errorf("var '%s' has no field '%s'", ref[1], field)
+			}
+		}
+
+		return val, nil
+
+	case atc.ConditionRootInputs:
+		version, found := resolver.inputVersions[ref[1]]
+		if !found {
+			return nil, fmt.Errorf("unknown input: %s", ref[1])
+		}
+
+		val, found := version[ref[2]]
+		if !found {
+			return nil, fmt.Errorf("version of input '%s' has no field '%s'", ref[1], ref[2])
+		}
+
+		return val, nil
+	}
+
+	return nil, fmt.Errorf("invalid reference: %v", ref)
+}
+
+func (resolver conditionResolver) build(field string) (interface{}, error) {
+	switch field {
+	case "id":
+		return strconv.Itoa(resolver.metadata.BuildID), nil
+	case "name":
+		return resolver.metadata.BuildName, nil
+	case "team_id":
+		return strconv.Itoa(resolver.metadata.TeamID), nil
+	case "team_name":
+		return resolver.metadata.TeamName, nil
+	case "job_id":
+		return strconv.Itoa(resolver.metadata.JobID), nil
+	case "job_name":
+		return resolver.metadata.JobName, nil
+	case "pipeline_id":
+		return strconv.Itoa(resolver.metadata.PipelineID), nil
+	case "pipeline_name":
+		return resolver.metadata.PipelineName, nil
+	}
+
+	return nil, fmt.Errorf("unknown build field: %s", field)
+}
@@ -0,0 +1,177 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("If Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep     *execfakes.FakeStep
+		fakeDelegate *execfakes.FakeIfDelegate
+
+		state RunState
+
+		plan atc.IfPlan
+
+		stepMetadata = StepMetadata{
+			BuildID:      42,
+			BuildName:    "some-build",
+			JobName:      "some-job",
+			PipelineName: "some-pipeline",
+			TeamName:     "some-team",
+		}
+
+		step    *IfStep
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeDelegate = new(execfakes.FakeIfDelegate)
+
+		state = NewRunState(nil)
+		state.Variables().AddLocalVar("image", map[string]interface{}{"tag": "some-tag"}, false)
+
+		plan = atc.IfPlan{
+			InputVersions: map[string]atc.Version{
+				"some-input": {"ref": "some-ref"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = If(fakeStep, plan, stepMetadata, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the condition holds", func() {
+		BeforeEach(func() {
+			plan.Condition = `build.job_name == "some-job" && build.id == 42 && inputs.some-input.ref == "some-ref" && vars.image.tag == "some-tag"`
+		})
+
+		It("runs the step with the same state", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+
+			_, runState := fakeStep.RunArgsForCall(0)
+			Expect(runState).To(Equal(state))
+		})
+
+		It("does not report the step as skipped", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(BeZero())
+		})
+
+		Context("when the step succeeds", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(true)
+			})
+
+			It("succeeds", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when the step fails", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(false)
+			})
+
+			It("fails", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when the step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the condition does not hold", func() {
+		BeforeEach(func() {
+			plan.Condition = `build.pipeline_name != "some-pipeline" || vars.image.tag == "other-tag"`
+		})
+
+		It("does not run the step", func() {
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+
+		It("reports the step as skipped", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(1))
+
+			_, condition := fakeDelegate.SkippedArgsForCall(0)
+			Expect(condition).To(Equal(plan.Condition))
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when the condition refers to an undefined var", func() {
+		BeforeEach(func() {
+			plan.Condition = `vars.bogus == "some-value"`
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError(`failed to evaluate condition 'vars.bogus == "some-value"': undefined var: bogus`))
+		})
+
+		It("does not run the step", func() {
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the condition refers to a missing field of a var", func() {
+		BeforeEach(func() {
+			plan.Condition = `vars.image.bogus == "some-value"`
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError(`failed to evaluate condition 'vars.image.bogus == "some-value"': var 'image' has no field 'bogus'`))
+		})
+	})
+
+	Context("when the condition refers to an unknown input", func() {
+		BeforeEach(func() {
+			plan.Condition = `inputs.bogus.ref == "some-ref"`
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError(`failed to evaluate condition 'inputs.bogus.ref == "some-ref"': unknown input: bogus`))
+		})
+	})
+
+	Context("when the condition cannot be parsed", func() {
+		BeforeEach(func() {
+			plan.Condition = `build.job_name`
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("incomplete comparison: build.job_name"))
+		})
+	})
+})
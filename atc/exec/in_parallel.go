@@ -35,6 +35,9 @@ func InParallel(steps []Step, limit int, failFast bool) InParallelStep {
 // Cancelling a parallel step means that any outstanding steps will not be scheduled to run.
 // After all steps finish, their errors (if any) will be collected and returned as a
 // single error.
+//
+// Each step runs in its own local scope, so vars set by one step are not
+// visible to the others or to the steps which follow.
 func (step InParallelStep) Run(ctx context.Context, state RunState) error {
 	var (
 		errs          = make(chan error, len(step.steps))
@@ -58,7 +61,7 @@ func (step InParallelStep) Run(ctx context.Context, state RunState) error {
 				<-sem
 			}()
 
-			errs <- s.Run(runCtx, state)
+			errs <- s.Run(runCtx, state.NewLocalScope())
 			if !s.Succeeded() && step.failFast {
 				cancel()
 			}
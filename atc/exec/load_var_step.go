@@ -0,0 +1,149 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/ghodss/yaml"
+)
+
+//go:generate counterfeiter . LoadVarDelegate
+
+type LoadVarDelegate interface {
+	BuildStepDelegate
+
+	Initializing(lager.Logger)
+	Starting(lager.Logger)
+	Finished(lager.Logger, bool)
+}
+
+// LoadVarStep reads a file from an artifact produced earlier in the build and
+// registers its contents as a build-local var, which later steps can refer to
+// as ((.:name)).
+type LoadVarStep struct {
+	planID    atc.PlanID
+	plan      atc.LoadVarPlan
+	metadata  StepMetadata
+	delegate  LoadVarDelegate
+	succeeded bool
+}
+
+func NewLoadVarStep(
+	planID atc.PlanID,
+	plan atc.LoadVarPlan,
+	metadata StepMetadata,
+	delegate LoadVarDelegate,
+) *LoadVarStep {
+	return &LoadVarStep{
+		planID:   planID,
+		plan:     plan,
+		metadata: metadata,
+		delegate: delegate,
+	}
+}
+
+// Run reads the file out of the artifact.Repository and parses it according
+// to the plan's format.
+//
+// If no format is given, files ending in .json or .yml/.yaml are parsed
+// accordingly, and any other file is loaded as a string with surrounding
+// whitespace trimmed.
+//
+// Unless the plan sets reveal, the value is redacted from the build's output.
+func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("load-var-step", lager.Data{
+		"step-name": step.plan.Name,
+		"job-id":    step.metadata.JobID,
+	})
+
+	step.delegate.Initializing(logger)
+	step.delegate.Starting(logger)
+
+	fileBytes, err := readArtifactFile(logger, state.Artifacts(), step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	value, err := step.parse(fileBytes)
+	if err != nil {
+		return err
+	}
+
+	state.Variables().AddLocalVar(step.plan.Name, value, !step.plan.Reveal)
+
+	logger.Debug("loaded-var", lager.Data{"var": step.plan.Name})
+
+	step.succeeded = true
+	step.delegate.Finished(logger, true)
+
+	return nil
+}
+
+// Succeeded returns true if the var was loaded.
+func (step *LoadVarStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *LoadVarStep) parse(fileBytes []byte) (interface{}, error) {
+	format := step.plan.Format
+	if format == "" {
+		switch filepath.Ext(step.plan.File) {
+		case ".json":
+			format = "json"
+		case ".yml", ".yaml":
+			format = "yaml"
+		default:
+			format = "trim"
+		}
+	}
+
+	switch format {
+	case "json":
+		var value interface{}
+		decoder := useNumber(json.NewDecoder(bytes.NewReader(fileBytes)))
+		err := decoder.Decode(&value)
+		if err == nil && decoder.More() {
+			err = errors.New("unexpected data after the top-level value")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' as json: %s", step.plan.File, err)
+		}
+
+		return value, nil
+
+	case "yaml", "yml":
+		var value interface{}
+		err := yaml.Unmarshal(fileBytes, &value, useNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' as yaml: %s", step.plan.File, err)
+		}
+
+		return value, nil
+
+	case "trim":
+		return strings.TrimSpace(string(fileBytes)), nil
+
+	case "raw":
+		return string(fileBytes), nil
+	}
+
+	return nil, fmt.Errorf("unknown format: %s", format)
+}
+
+// useNumber decodes numbers as json.Number rather than float64, so that they
+// keep the form they're written in (e.g. 1.0) and large integers keep their
+// precision.
+func useNumber(decoder *json.Decoder) *json.Decoder {
+	decoder.UseNumber()
+	return decoder
+}
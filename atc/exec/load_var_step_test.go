@@ -0,0 +1,241 @@
+package exec_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("LoadVarStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate       *execfakes.FakeLoadVarDelegate
+		fakeArtifactSource *workerfakes.FakeArtifactSource
+
+		loadVarPlan *atc.LoadVarPlan
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:       123,
+			TeamName:     "some-team",
+			BuildID:      42,
+			BuildName:    "some-build",
+			JobID:        87,
+			JobName:      "some-job",
+			PipelineID:   4567,
+			PipelineName: "some-pipeline",
+		}
+
+		state exec.RunState
+		files map[string]string
+
+		step    *exec.LoadVarStep
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeDelegate = new(execfakes.FakeLoadVarDelegate)
+
+		files = map[string]string{
+			"version":      "1.2.3\n",
+			"image.json":   `{"repository":"some-repo","tag":"some-tag"}`,
+			"numbers.json": `{"version":1.0,"id":12345678901234567890}`,
+			"config.yml":   "some-key: some-value\n",
+			"numbers.yml":  "id: 12345678901234567890\n",
+			"invalid.json": "nope",
+		}
+
+		fakeArtifactSource = new(workerfakes.FakeArtifactSource)
+		fakeArtifactSource.StreamFileStub = func(_ lager.Logger, path string) (io.ReadCloser, error) {
+			content, found := files[path]
+			if !found {
+				return nil, baggageclaim.ErrFileNotFound
+			}
+
+			return gbytes.BufferWithBytes([]byte(content)), nil
+		}
+
+		state = exec.NewRunState(nil)
+		state.Artifacts().RegisterSource("some-input", fakeArtifactSource)
+
+		loadVarPlan = &atc.LoadVarPlan{
+			Name: "some-var",
+			File: "some-input/version",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewLoadVarStep(
+			atc.PlanID("some-plan-id"),
+			*loadVarPlan,
+			stepMetadata,
+			fakeDelegate,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	localVar := func() interface{} {
+		val, found, err := state.Variables().Get(vars.VariableDefinition{Source: ".", Name: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		return val
+	}
+
+	It("loads the trimmed file contents as a local var", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(localVar()).To(Equal("1.2.3"))
+	})
+
+	It("redacts the value", func() {
+		Expect(state.Variables().Redact("version 1.2.3")).To(Equal("version ((redacted))"))
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	It("emits initializing, starting and finished events", func() {
+		Expect(fakeDelegate.InitializingCallCount()).To(Equal(1))
+		Expect(fakeDelegate.StartingCallCount()).To(Equal(1))
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	Context("when reveal is set", func() {
+		BeforeEach(func() {
+			loadVarPlan.Reveal = true
+		})
+
+		It("does not redact the value", func() {
+			Expect(state.Variables().Redact("version 1.2.3")).To(Equal("version 1.2.3"))
+		})
+	})
+
+	Context("when the format is raw", func() {
+		BeforeEach(func() {
+			loadVarPlan.Format = "raw"
+		})
+
+		It("loads the file contents as-is", func() {
+			Expect(localVar()).To(Equal("1.2.3\n"))
+		})
+	})
+
+	Context("when the file is json", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-input/image.json"
+		})
+
+		It("parses the file", func() {
+			Expect(localVar()).To(Equal(map[string]interface{}{
+				"repository": "some-repo",
+				"tag":        "some-tag",
+			}))
+		})
+
+		Context("but the format is trim", func() {
+			BeforeEach(func() {
+				loadVarPlan.Format = "trim"
+			})
+
+			It("loads the file as a string", func() {
+				Expect(localVar()).To(Equal(`{"repository":"some-repo","tag":"some-tag"}`))
+			})
+		})
+	})
+
+	Context("when the json file has numbers", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-input/numbers.json"
+		})
+
+		It("keeps them as they are written", func() {
+			Expect(localVar()).To(Equal(map[string]interface{}{
+				"version": json.Number("1.0"),
+				"id":      json.Number("12345678901234567890"),
+			}))
+		})
+	})
+
+	Context("when the json file has data after its value", func() {
+		BeforeEach(func() {
+			files["image.json"] = `{"repository":"some-repo"} {"tag":"some-tag"}`
+			loadVarPlan.File = "some-input/image.json"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("failed to parse 'some-input/image.json' as json"))
+		})
+	})
+
+	Context("when the file is yaml", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-input/config.yml"
+		})
+
+		It("parses the file", func() {
+			Expect(localVar()).To(Equal(map[string]interface{}{
+				"some-key": "some-value",
+			}))
+		})
+
+		Context("with a large integer", func() {
+			BeforeEach(func() {
+				loadVarPlan.File = "some-input/numbers.yml"
+			})
+
+			It("keeps its precision", func() {
+				Expect(localVar()).To(Equal(map[string]interface{}{
+					"id": json.Number("12345678901234567890"),
+				}))
+			})
+		})
+	})
+
+	Context("when the file cannot be parsed", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-input/invalid.json"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("failed to parse 'some-input/invalid.json' as json"))
+		})
+
+		It("does not succeed", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		BeforeEach(func() {
+			loadVarPlan.File = "some-input/bogus"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("file 'some-input/bogus' not found"))
+		})
+	})
+})
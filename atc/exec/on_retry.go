@@ -0,0 +1,36 @@
+package exec
+
+import (
+	"context"
+)
+
+// OnRetryStep is an attempt of a RetryStep, along with a hook to run when the
+// attempt is about to be retried.
+type OnRetryStep struct {
+	step Step
+	hook Step
+}
+
+// OnRetry constructs an OnRetryStep.
+func OnRetry(step Step, hook Step) OnRetryStep {
+	return OnRetryStep{
+		step: step,
+		hook: hook,
+	}
+}
+
+// Run will call Run on the first step. The hook is not run; the RetryStep
+// which the attempt belongs to runs it with RunHook once it decides to retry.
+func (o OnRetryStep) Run(ctx context.Context, state RunState) error {
+	return o.step.Run(ctx, state)
+}
+
+// RunHook runs the hook, returning its error.
+func (o OnRetryStep) RunHook(ctx context.Context, state RunState) error {
+	return o.hook.Run(ctx, state)
+}
+
+// Succeeded is true if the first step completed successfully.
+func (o OnRetryStep) Succeeded() bool {
+	return o.step.Succeeded()
+}
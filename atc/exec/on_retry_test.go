@@ -0,0 +1,107 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("On Retry Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		step *execfakes.FakeStep
+		hook *execfakes.FakeStep
+
+		repo  *artifact.Repository
+		state *execfakes.FakeRunState
+
+		onRetryStep exec.OnRetryStep
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		step = &execfakes.FakeStep{}
+		hook = &execfakes.FakeStep{}
+
+		repo = artifact.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+
+		onRetryStep = exec.OnRetry(step, hook)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("Run", func() {
+		var stepErr error
+
+		JustBeforeEach(func() {
+			stepErr = onRetryStep.Run(ctx, state)
+		})
+
+		Context("when the step fails", func() {
+			BeforeEach(func() {
+				step.SucceededReturns(false)
+			})
+
+			It("does not run the hook", func() {
+				Expect(step.RunCallCount()).To(Equal(1))
+				Expect(hook.RunCallCount()).To(Equal(0))
+			})
+
+			It("fails", func() {
+				Expect(onRetryStep.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when the step errors", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				step.RunReturns(disaster)
+			})
+
+			It("returns the error without running the hook", func() {
+				Expect(stepErr).To(Equal(disaster))
+				Expect(hook.RunCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RunHook", func() {
+		var hookErr error
+
+		JustBeforeEach(func() {
+			hookErr = onRetryStep.RunHook(ctx, state)
+		})
+
+		It("runs the hook with the same state", func() {
+			Expect(hook.RunCallCount()).To(Equal(1))
+
+			_, runState := hook.RunArgsForCall(0)
+			Expect(runState).To(Equal(state))
+		})
+
+		Context("when the hook errors", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				hook.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(hookErr).To(Equal(disaster))
+			})
+		})
+	})
+})
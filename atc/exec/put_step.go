@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/vars"
 )
 
 //go:generate counterfeiter . PutDelegate
@@ -82,7 +83,10 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 
 	step.delegate.Initializing(logger)
 
-	variables := creds.NewVariables(step.secrets, step.metadata.TeamName, step.metadata.PipelineName)
+	variables := vars.NewMultiVars([]vars.Variables{
+		creds.NewVariables(step.secrets, step.metadata.TeamName, step.metadata.PipelineName),
+		state.Variables(),
+	})
 
 	source, err := creds.NewSource(variables, step.plan.Source).Evaluate()
 	if err != nil {
@@ -131,6 +135,10 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		Inputs: containerInputs,
 	}
 
+	if step.plan.Limits != nil {
+		containerSpec.Limits = worker.ContainerLimits(*step.plan.Limits)
+	}
+
 	workerSpec := worker.WorkerSpec{
 		ResourceType:  step.plan.Type,
 		Tags:          step.plan.Tags,
@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/vars"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -86,6 +87,7 @@ var _ = Describe("PutStep", func() {
 
 		repo = artifact.NewRepository()
 		state = new(execfakes.FakeRunState)
+		state.VariablesReturns(vars.NewBuildVariables(nil))
 		state.ArtifactsReturns(repo)
 
 		uninterpolatedResourceTypes := atc.VersionedResourceTypes{
@@ -235,6 +237,20 @@ var _ = Describe("PutStep", func() {
 				Expect(delegate).To(Equal(fakeDelegate))
 			})
 
+			Context("when the plan has container limits", func() {
+				BeforeEach(func() {
+					cpu := uint64(512)
+					memory := uint64(1024)
+					putPlan.Limits = &atc.ContainerLimits{CPU: &cpu, Memory: &memory}
+				})
+
+				It("limits the container", func() {
+					_, _, _, actualContainerSpec, _, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+					Expect(*actualContainerSpec.Limits.CPU).To(Equal(uint64(512)))
+					Expect(*actualContainerSpec.Limits.Memory).To(Equal(uint64(1024)))
+				})
+			})
+
 			Context("when the inputs are specified", func() {
 				BeforeEach(func() {
 					putPlan.Inputs = &atc.InputsConfig{
@@ -269,6 +285,21 @@ var _ = Describe("PutStep", func() {
 				Expect(putParams).To(Equal(atc.Params{"some-param": "some-value"}))
 			})
 
+			Context("when the params refer to a local var", func() {
+				BeforeEach(func() {
+					buildVars := vars.NewBuildVariables(nil)
+					buildVars.AddLocalVar("some-var", "some-local-value", true)
+					state.VariablesReturns(buildVars)
+
+					putPlan.Params = atc.Params{"some-param": "((.:some-var))"}
+				})
+
+				It("puts the resource with the local var interpolated", func() {
+					_, _, _, putParams := fakeResource.PutArgsForCall(0)
+					Expect(putParams).To(Equal(atc.Params{"some-param": "some-local-value"}))
+				})
+			})
+
 			It("puts the resource with the io config forwarded", func() {
 				Expect(fakeResource.PutCallCount()).To(Equal(1))
 
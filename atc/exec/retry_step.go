@@ -2,41 +2,129 @@ package exec
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 )
 
+const defaultBackoffFactor = 2
+
+//go:generate counterfeiter . RetryDelegate
+
+type RetryDelegate interface {
+	AttemptFinished(lager.Logger, atc.BuildAttempt)
+	Retrying(lager.Logger, int, time.Duration)
+}
+
 // RetryStep is a step that will run the steps in order until one of them
 // succeeds.
 type RetryStep struct {
 	Attempts    []Step
 	LastAttempt Step
+
+	plan     atc.RetryPlan
+	delegate RetryDelegate
 }
 
-func Retry(attempts ...Step) Step {
+func Retry(plan atc.RetryPlan, delegate RetryDelegate, attempts ...Step) Step {
 	return &RetryStep{
 		Attempts: attempts,
+
+		plan:     plan,
+		delegate: delegate,
 	}
 }
 
 // Run iterates through each step, stopping once a step succeeds. If all steps
 // fail, the RetryStep will fail.
+//
+// The outcome of each attempt is reported to the delegate. Before each retry,
+// the attempt's on_retry hook is run, if it has one, and the delegate is told
+// which attempt is next and how long the step will wait before running it, as
+// configured by the plan's backoff. If the hook errors, its error is returned
+// without retrying.
+//
+// If the plan only retries on errors, a failed attempt is not retried, and if
+// it only retries on failures, an errored attempt is not retried.
 func (step *RetryStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("retry")
+
+	backoff, err := newRetryBackoff(step.plan.Backoff)
+	if err != nil {
+		return err
+	}
+
 	var attemptErr error
 
-	for _, attempt := range step.Attempts {
+	for i, attempt := range step.Attempts {
+		if i > 0 {
+			wait := backoff.wait(i)
+
+			step.delegate.Retrying(logger, i+1, wait)
+
+			if wait > 0 {
+				timer := time.NewTimer(wait)
+
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				}
+			}
+		}
+
 		step.LastAttempt = attempt
 
+		startTime := time.Now()
+
 		attemptErr = attempt.Run(ctx, state)
+
+		outcome := atc.BuildAttempt{
+			Attempt:   i + 1,
+			StartTime: startTime.Unix(),
+			EndTime:   time.Now().Unix(),
+		}
+
 		if ctx.Err() != nil {
+			outcome.Status = atc.StatusAborted
+			step.delegate.AttemptFinished(logger, outcome)
 			return ctx.Err()
 		}
 
+		retry := false
 		if attemptErr != nil {
-			continue
+			outcome.Status = atc.StatusErrored
+			outcome.Error = attemptErr.Error()
+			retry = step.plan.On != atc.RetryOnFailure
+		} else if attempt.Succeeded() {
+			outcome.Status = atc.StatusSucceeded
+		} else {
+			outcome.Status = atc.StatusFailed
+			retry = step.plan.On != atc.RetryOnError
 		}
 
-		if attempt.Succeeded() {
+		step.delegate.AttemptFinished(logger, outcome)
+
+		if !retry || i == len(step.Attempts)-1 {
 			break
 		}
+
+		if hooked, ok := attempt.(OnRetryStep); ok {
+			err := hooked.RunHook(ctx, state)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return attemptErr
@@ -46,3 +134,67 @@ func (step *RetryStep) Run(ctx context.Context, state RunState) error {
 func (step *RetryStep) Succeeded() bool {
 	return step.LastAttempt.Succeeded()
 }
+
+type retryBackoff struct {
+	initial time.Duration
+	max     time.Duration
+	factor  float64
+	jitter  bool
+}
+
+func newRetryBackoff(config *atc.RetryBackoff) (retryBackoff, error) {
+	var backoff retryBackoff
+	if config == nil {
+		return backoff, nil
+	}
+
+	backoff.initial = time.Second
+	if config.Initial != "" {
+		initial, err := time.ParseDuration(config.Initial)
+		if err != nil {
+			return retryBackoff{}, fmt.Errorf("invalid backoff initial duration: %s", err)
+		}
+
+		backoff.initial = initial
+	}
+
+	if config.Max != "" {
+		max, err := time.ParseDuration(config.Max)
+		if err != nil {
+			return retryBackoff{}, fmt.Errorf("invalid backoff max duration: %s", err)
+		}
+
+		backoff.max = max
+	}
+
+	backoff.factor = config.Factor
+	if backoff.factor == 0 {
+		backoff.factor = defaultBackoffFactor
+	}
+
+	backoff.jitter = config.Jitter
+
+	return backoff, nil
+}
+
+// wait returns how long to wait before the given retry, starting from 1. With
+// jitter, a random duration between half and all of the wait is returned.
+func (backoff retryBackoff) wait(retry int) time.Duration {
+	wait := float64(backoff.initial) * math.Pow(backoff.factor, float64(retry-1))
+
+	if backoff.max > 0 && wait > float64(backoff.max) {
+		wait = float64(backoff.max)
+	}
+
+	duration := time.Duration(math.MaxInt64)
+	if wait < math.MaxInt64 {
+		duration = time.Duration(wait)
+	}
+
+	if backoff.jitter && duration > 1 {
+		half := duration / 2
+		duration = half + time.Duration(rand.Int63n(int64(duration-half)+1))
+	}
+
+	return duration
+}
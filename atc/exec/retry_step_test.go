@@ -3,7 +3,10 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -23,6 +26,9 @@ var _ = Describe("Retry Step", func() {
 		repo  *artifact.Repository
 		state *execfakes.FakeRunState
 
+		plan         atc.RetryPlan
+		fakeDelegate *execfakes.FakeRetryDelegate
+
 		step Step
 	)
 
@@ -37,7 +43,12 @@ var _ = Describe("Retry Step", func() {
 		state = new(execfakes.FakeRunState)
 		state.ArtifactsReturns(repo)
 
-		step = Retry(attempt1, attempt2, attempt3)
+		plan = atc.RetryPlan{}
+		fakeDelegate = new(execfakes.FakeRetryDelegate)
+	})
+
+	JustBeforeEach(func() {
+		step = Retry(plan, fakeDelegate, attempt1, attempt2, attempt3)
 	})
 
 	Context("when attempt 1 succeeds", func() {
@@ -171,6 +182,14 @@ var _ = Describe("Retry Step", func() {
 				Expect(attempt3.RunCallCount()).To(Equal(0))
 			})
 
+			It("reports the interrupted attempt as aborted", func() {
+				Expect(fakeDelegate.AttemptFinishedCallCount()).To(Equal(2))
+
+				_, outcome := fakeDelegate.AttemptFinishedArgsForCall(1)
+				Expect(outcome.Attempt).To(Equal(2))
+				Expect(outcome.Status).To(Equal(atc.StatusAborted))
+			})
+
 			Describe("Succeeded", func() {
 				It("delegates to attempt 2", func() {
 					// internal check for success within retry loop
@@ -249,6 +268,36 @@ var _ = Describe("Retry Step", func() {
 				Expect(attempt3.RunCallCount()).To(Equal(1))
 			})
 
+			It("reports the outcome of each attempt to the delegate", func() {
+				Expect(fakeDelegate.AttemptFinishedCallCount()).To(Equal(3))
+
+				_, outcome := fakeDelegate.AttemptFinishedArgsForCall(0)
+				Expect(outcome.Attempt).To(Equal(1))
+				Expect(outcome.Status).To(Equal(atc.StatusFailed))
+				Expect(outcome.StartTime).ToNot(BeZero())
+				Expect(outcome.EndTime).To(BeNumerically(">=", outcome.StartTime))
+
+				_, outcome = fakeDelegate.AttemptFinishedArgsForCall(1)
+				Expect(outcome.Attempt).To(Equal(2))
+				Expect(outcome.Status).To(Equal(atc.StatusFailed))
+
+				_, outcome = fakeDelegate.AttemptFinishedArgsForCall(2)
+				Expect(outcome.Attempt).To(Equal(3))
+				Expect(outcome.Status).To(Equal(atc.StatusSucceeded))
+			})
+
+			It("reports each retry to the delegate without waiting", func() {
+				Expect(fakeDelegate.RetryingCallCount()).To(Equal(2))
+
+				_, attempt, wait := fakeDelegate.RetryingArgsForCall(0)
+				Expect(attempt).To(Equal(2))
+				Expect(wait).To(BeZero())
+
+				_, attempt, wait = fakeDelegate.RetryingArgsForCall(1)
+				Expect(attempt).To(Equal(3))
+				Expect(wait).To(BeZero())
+			})
+
 			Describe("Succeeded", func() {
 				It("delegates to attempt 3", func() {
 					// internal check for success within retry loop
@@ -261,6 +310,235 @@ var _ = Describe("Retry Step", func() {
 					Expect(attempt3.SucceededCallCount()).To(Equal(2))
 				})
 			})
+
+			Context("with a backoff", func() {
+				BeforeEach(func() {
+					plan.Backoff = &atc.RetryBackoff{
+						Initial: "10ms",
+						Factor:  3,
+					}
+				})
+
+				It("waits longer before each retry", func() {
+					Expect(fakeDelegate.RetryingCallCount()).To(Equal(2))
+
+					_, attempt, wait := fakeDelegate.RetryingArgsForCall(0)
+					Expect(attempt).To(Equal(2))
+					Expect(wait).To(Equal(10 * time.Millisecond))
+
+					_, attempt, wait = fakeDelegate.RetryingArgsForCall(1)
+					Expect(attempt).To(Equal(3))
+					Expect(wait).To(Equal(30 * time.Millisecond))
+				})
+
+				Context("with a max", func() {
+					BeforeEach(func() {
+						plan.Backoff.Max = "20ms"
+					})
+
+					It("does not wait longer than the max", func() {
+						_, _, wait := fakeDelegate.RetryingArgsForCall(1)
+						Expect(wait).To(Equal(20 * time.Millisecond))
+					})
+				})
+
+				Context("with jitter", func() {
+					BeforeEach(func() {
+						plan.Backoff.Jitter = true
+					})
+
+					It("waits between half and all of the backoff", func() {
+						_, _, wait := fakeDelegate.RetryingArgsForCall(0)
+						Expect(wait).To(BeNumerically(">=", 5*time.Millisecond))
+						Expect(wait).To(BeNumerically("<=", 10*time.Millisecond))
+
+						_, _, wait = fakeDelegate.RetryingArgsForCall(1)
+						Expect(wait).To(BeNumerically(">=", 15*time.Millisecond))
+						Expect(wait).To(BeNumerically("<=", 30*time.Millisecond))
+					})
+				})
+
+				Context("with no initial duration or factor", func() {
+					BeforeEach(func() {
+						plan.Backoff = &atc.RetryBackoff{Max: "1ms"}
+					})
+
+					It("starts from a second and doubles, up to the max", func() {
+						_, _, wait := fakeDelegate.RetryingArgsForCall(1)
+						Expect(wait).To(Equal(time.Millisecond))
+					})
+				})
+
+				Context("when the build is aborted while waiting", func() {
+					BeforeEach(func() {
+						plan.Backoff.Initial = "1h"
+
+						fakeDelegate.RetryingStub = func(lager.Logger, int, time.Duration) {
+							cancel()
+						}
+					})
+
+					It("returns the context error without running the next attempt", func() {
+						Expect(stepErr).To(Equal(context.Canceled))
+
+						Expect(attempt1.RunCallCount()).To(Equal(1))
+						Expect(attempt2.RunCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the backoff has an invalid duration", func() {
+					BeforeEach(func() {
+						plan.Backoff.Initial = "bogus"
+					})
+
+					It("returns an error without running any attempts", func() {
+						Expect(stepErr).To(MatchError(ContainSubstring("invalid backoff initial duration")))
+						Expect(attempt1.RunCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when only retrying on errors", func() {
+				BeforeEach(func() {
+					plan.On = atc.RetryOnError
+				})
+
+				It("does not retry the failed attempt", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+
+					Expect(attempt1.RunCallCount()).To(Equal(1))
+					Expect(attempt2.RunCallCount()).To(Equal(0))
+
+					Expect(fakeDelegate.RetryingCallCount()).To(BeZero())
+				})
+
+				It("fails", func() {
+					Expect(step.Succeeded()).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Context("when attempt 1 errors", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			attempt1.RunReturns(disaster)
+			attempt2.SucceededReturns(true)
+		})
+
+		Describe("Run", func() {
+			var stepErr error
+
+			JustBeforeEach(func() {
+				stepErr = step.Run(ctx, state)
+			})
+
+			Context("when only retrying on errors", func() {
+				BeforeEach(func() {
+					plan.On = atc.RetryOnError
+				})
+
+				It("retries the errored attempt", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+
+					Expect(attempt1.RunCallCount()).To(Equal(1))
+					Expect(attempt2.RunCallCount()).To(Equal(1))
+				})
+			})
+
+			It("reports the error as the attempt's outcome", func() {
+				_, outcome := fakeDelegate.AttemptFinishedArgsForCall(0)
+				Expect(outcome.Attempt).To(Equal(1))
+				Expect(outcome.Status).To(Equal(atc.StatusErrored))
+				Expect(outcome.Error).To(Equal("nope"))
+			})
+
+			Context("when only retrying on failures", func() {
+				BeforeEach(func() {
+					plan.On = atc.RetryOnFailure
+				})
+
+				It("returns the error without retrying", func() {
+					Expect(stepErr).To(Equal(disaster))
+
+					Expect(attempt1.RunCallCount()).To(Equal(1))
+					Expect(attempt2.RunCallCount()).To(Equal(0))
+
+					Expect(fakeDelegate.RetryingCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Context("when the attempts have on_retry hooks", func() {
+		var (
+			hook1 *execfakes.FakeStep
+			hook2 *execfakes.FakeStep
+
+			stepErr error
+		)
+
+		BeforeEach(func() {
+			hook1 = new(execfakes.FakeStep)
+			hook2 = new(execfakes.FakeStep)
+		})
+
+		JustBeforeEach(func() {
+			step = Retry(
+				plan,
+				fakeDelegate,
+				OnRetry(attempt1, hook1),
+				OnRetry(attempt2, hook2),
+				attempt3,
+			)
+
+			stepErr = step.Run(ctx, state)
+		})
+
+		Context("when attempt 1 fails and attempt 2 succeeds", func() {
+			BeforeEach(func() {
+				attempt1.SucceededReturns(false)
+				attempt2.SucceededReturns(true)
+			})
+
+			It("runs the first attempt's hook before retrying", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+
+				Expect(hook1.RunCallCount()).To(Equal(1))
+				Expect(hook2.RunCallCount()).To(Equal(0))
+
+				_, runState := hook1.RunArgsForCall(0)
+				Expect(runState).To(Equal(state))
+
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when the hook errors", func() {
+			disaster := errors.New("hook failed")
+
+			BeforeEach(func() {
+				attempt1.SucceededReturns(false)
+				hook1.RunReturns(disaster)
+			})
+
+			It("returns the error without retrying", func() {
+				Expect(stepErr).To(Equal(disaster))
+
+				Expect(attempt2.RunCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when attempt 1 fails, but only errors are retried", func() {
+			BeforeEach(func() {
+				attempt1.SucceededReturns(false)
+				plan.On = atc.RetryOnError
+			})
+
+			It("does not run the hook", func() {
+				Expect(hook1.RunCallCount()).To(Equal(0))
+			})
 		})
 	})
 
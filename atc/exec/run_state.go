@@ -6,17 +6,22 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/vars"
 )
 
 type runState struct {
 	artifacts *artifact.Repository
 	results   *sync.Map
+	variables *vars.BuildVariables
 }
 
-func NewRunState() RunState {
+// NewRunState returns the state of a new build. pipelineVars provides the
+// vars of the build's pipeline, and may be nil.
+func NewRunState(pipelineVars vars.Variables) RunState {
 	return &runState{
 		artifacts: artifact.NewRepository(),
 		results:   &sync.Map{},
+		variables: vars.NewBuildVariables(pipelineVars),
 	}
 }
 
@@ -41,3 +46,15 @@ func (state *runState) Result(id atc.PlanID, to interface{}) bool {
 func (state *runState) StoreResult(id atc.PlanID, val interface{}) {
 	state.results.Store(id, val)
 }
+
+func (state *runState) Variables() *vars.BuildVariables {
+	return state.variables
+}
+
+func (state *runState) NewLocalScope() RunState {
+	return &runState{
+		artifacts: state.artifacts,
+		results:   state.results,
+		variables: state.variables.NewLocalScope(),
+	}
+}
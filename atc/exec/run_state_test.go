@@ -3,6 +3,7 @@ package exec_test
 import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -11,7 +12,44 @@ var _ = Describe("RunState", func() {
 	var state exec.RunState
 
 	BeforeEach(func() {
-		state = exec.NewRunState()
+		state = exec.NewRunState(nil)
+	})
+
+	Describe("NewLocalScope", func() {
+		var localState exec.RunState
+
+		BeforeEach(func() {
+			state.Variables().AddLocalVar("some-var", "some-value", false)
+
+			localState = state.NewLocalScope()
+		})
+
+		It("shares the artifacts of the parent", func() {
+			Expect(localState.Artifacts()).To(BeIdenticalTo(state.Artifacts()))
+		})
+
+		It("shares the results of the parent", func() {
+			localState.StoreResult("some-id", 123)
+
+			var result int
+			Expect(state.Result("some-id", &result)).To(BeTrue())
+			Expect(result).To(Equal(123))
+		})
+
+		It("can see the vars of the parent", func() {
+			val, found, err := localState.Variables().Get(vars.VariableDefinition{Source: ".", Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("some-value"))
+		})
+
+		It("does not leak vars to the parent", func() {
+			localState.Variables().AddLocalVar("other-var", "other-value", false)
+
+			_, found, err := state.Variables().Get(vars.VariableDefinition{Source: ".", Name: "other-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
 	})
 
 	Describe("Result", func() {
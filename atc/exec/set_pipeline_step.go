@@ -0,0 +1,174 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/vars"
+	"github.com/ghodss/yaml"
+)
+
+//go:generate counterfeiter . SetPipelineDelegate
+
+type SetPipelineDelegate interface {
+	BuildStepDelegate
+
+	Initializing(lager.Logger)
+	Starting(lager.Logger)
+	Finished(lager.Logger, bool)
+}
+
+// SetPipelineStep configures a pipeline within the build's team, using a
+// config file read from an artifact produced earlier in the build.
+type SetPipelineStep struct {
+	planID      atc.PlanID
+	plan        atc.SetPipelinePlan
+	metadata    StepMetadata
+	delegate    SetPipelineDelegate
+	teamFactory db.TeamFactory
+	succeeded   bool
+}
+
+func NewSetPipelineStep(
+	planID atc.PlanID,
+	plan atc.SetPipelinePlan,
+	metadata StepMetadata,
+	delegate SetPipelineDelegate,
+	teamFactory db.TeamFactory,
+) *SetPipelineStep {
+	return &SetPipelineStep{
+		planID:      planID,
+		plan:        plan,
+		metadata:    metadata,
+		delegate:    delegate,
+		teamFactory: teamFactory,
+	}
+}
+
+// Run reads the pipeline config and any var files out of the
+// artifact.Repository, interpolates the static vars into the config, and
+// saves the pipeline if it is valid.
+//
+// Vars which are not statically configured are left in place, so that they
+// are resolved through the credential manager when the pipeline runs.
+//
+// If the config is invalid, the validation errors are written to stderr and
+// the step fails. The pipeline then records the job and build which set it.
+func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("set-pipeline-step", lager.Data{
+		"step-name": step.plan.Name,
+		"job-id":    step.metadata.JobID,
+	})
+
+	step.delegate.Initializing(logger)
+
+	stdout := step.delegate.Stdout()
+	stderr := step.delegate.Stderr()
+
+	configBytes, err := readArtifactFile(logger, state.Artifacts(), step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	params := []vars.Variables{vars.StaticVariables(step.plan.Vars)}
+
+	// var files specified later take precedence over those specified earlier
+	for i := len(step.plan.VarFiles) - 1; i >= 0; i-- {
+		path := step.plan.VarFiles[i]
+
+		varsBytes, err := readArtifactFile(logger, state.Artifacts(), path)
+		if err != nil {
+			return err
+		}
+
+		var staticVars vars.StaticVariables
+		err = yaml.Unmarshal(varsBytes, &staticVars)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal var file '%s': %s", path, err)
+		}
+
+		params = append(params, staticVars)
+	}
+
+	configBytes, err = vars.NewTemplateResolver(configBytes, params).Resolve(false, false)
+	if err != nil {
+		return fmt.Errorf("failed to interpolate pipeline config: %s", err)
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(configBytes, &config)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal pipeline config '%s': %s", step.plan.File, err)
+	}
+
+	step.delegate.Starting(logger)
+
+	warnings, errorMessages := config.Validate()
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	}
+
+	if len(errorMessages) > 0 {
+		fmt.Fprintln(stderr, "invalid pipeline:")
+
+		for _, message := range errorMessages {
+			fmt.Fprintf(stderr, "- %s\n", message)
+		}
+
+		step.delegate.Finished(logger, false)
+		return nil
+	}
+
+	team := step.teamFactory.GetByID(step.metadata.TeamID)
+
+	pipelineRef := atc.PipelineRef{Name: step.plan.Name}
+
+	fromVersion := db.ConfigVersion(0)
+	pipeline, found, err := team.Pipeline(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		fromVersion = pipeline.ConfigVersion()
+	}
+
+	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
+
+	createdBy := fmt.Sprintf("%s/%s #%s", step.metadata.PipelineName, step.metadata.JobName, step.metadata.BuildName)
+
+	pipeline, _, err = team.SavePipelineFromBuild(pipelineRef, config, fromVersion, step.metadata.JobID, step.metadata.BuildID, createdBy)
+	if invalidErr, ok := err.(db.InvalidPassedJobsError); ok {
+		fmt.Fprintln(stderr, "invalid pipeline:")
+
+		for _, message := range invalidErr.Errors {
+			fmt.Fprintf(stderr, "- %s\n", message)
+		}
+
+		step.delegate.Finished(logger, false)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "done\n")
+
+	logger.Info("saved-pipeline", lager.Data{"team": step.metadata.TeamName, "pipeline": pipeline.Name()})
+
+	step.succeeded = true
+	step.delegate.Finished(logger, true)
+
+	return nil
+}
+
+// Succeeded returns true if the pipeline was valid and has been saved.
+func (step *SetPipelineStep) Succeeded() bool {
+	return step.succeeded
+}
@@ -0,0 +1,294 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("SetPipelineStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeTeamFactory    *dbfakes.FakeTeamFactory
+		fakeTeam           *dbfakes.FakeTeam
+		fakePipeline       *dbfakes.FakePipeline
+		fakeDelegate       *execfakes.FakeSetPipelineDelegate
+		fakeArtifactSource *workerfakes.FakeArtifactSource
+
+		setPipelinePlan *atc.SetPipelinePlan
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:       123,
+			TeamName:     "some-team",
+			BuildID:      42,
+			BuildName:    "some-build",
+			JobID:        87,
+			JobName:      "some-job",
+			PipelineID:   4567,
+			PipelineName: "some-pipeline",
+		}
+
+		repo  *artifact.Repository
+		state *execfakes.FakeRunState
+
+		files map[string]string
+
+		step    *exec.SetPipelineStep
+		stepErr error
+
+		stdoutBuf *gbytes.Buffer
+		stderrBuf *gbytes.Buffer
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.NameReturns("other-pipeline")
+		fakePipeline.ConfigVersionReturns(db.ConfigVersion(9))
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.PipelineReturns(nil, false, nil)
+		fakeTeam.SavePipelineFromBuildReturns(fakePipeline, true, nil)
+
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakeDelegate = new(execfakes.FakeSetPipelineDelegate)
+		stdoutBuf = gbytes.NewBuffer()
+		stderrBuf = gbytes.NewBuffer()
+		fakeDelegate.StdoutReturns(stdoutBuf)
+		fakeDelegate.StderrReturns(stderrBuf)
+
+		files = map[string]string{
+			"pipeline.yml": `
+resources:
+- name: some-resource
+  type: git
+  source: {uri: ((uri)), private_key: ((private-key))}
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource
+    trigger: ((trigger))
+`,
+			"vars.yml":       "uri: vars-uri\ntrigger: true\n",
+			"other-vars.yml": "uri: other-vars-uri\n",
+		}
+
+		fakeArtifactSource = new(workerfakes.FakeArtifactSource)
+		fakeArtifactSource.StreamFileStub = func(_ lager.Logger, path string) (io.ReadCloser, error) {
+			content, found := files[path]
+			if !found {
+				return nil, baggageclaim.ErrFileNotFound
+			}
+
+			return gbytes.BufferWithBytes([]byte(content)), nil
+		}
+
+		repo = artifact.NewRepository()
+		repo.RegisterSource("some-input", fakeArtifactSource)
+
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+
+		setPipelinePlan = &atc.SetPipelinePlan{
+			Name:     "other-pipeline",
+			File:     "some-input/pipeline.yml",
+			VarFiles: []string{"some-input/vars.yml", "some-input/other-vars.yml"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewSetPipelineStep(
+			atc.PlanID("some-plan-id"),
+			*setPipelinePlan,
+			stepMetadata,
+			fakeDelegate,
+			fakeTeamFactory,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("saves the pipeline within the build's team", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+
+		Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(123))
+
+		Expect(fakeTeam.SavePipelineFromBuildCallCount()).To(Equal(1))
+		pipelineRef, config, from, _, _, createdBy := fakeTeam.SavePipelineFromBuildArgsForCall(0)
+		Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "other-pipeline"}))
+		Expect(from).To(Equal(db.ConfigVersion(0)))
+		Expect(createdBy).To(Equal("some-pipeline/some-job #some-build"))
+
+		Expect(config.Resources).To(Equal(atc.ResourceConfigs{
+			{
+				Name: "some-resource",
+				Type: "git",
+				Source: atc.Source{
+					"uri":         "other-vars-uri",
+					"private_key": "((private-key))",
+				},
+			},
+		}))
+		Expect(config.Jobs[0].Plan[0].Trigger).To(BeTrue())
+	})
+
+	It("records the job and build which set the pipeline", func() {
+		Expect(fakeTeam.SavePipelineFromBuildCallCount()).To(Equal(1))
+		_, _, _, jobID, buildID, _ := fakeTeam.SavePipelineFromBuildArgsForCall(0)
+		Expect(jobID).To(Equal(87))
+		Expect(buildID).To(Equal(42))
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	It("emits initializing, starting and finished events", func() {
+		Expect(fakeDelegate.InitializingCallCount()).To(Equal(1))
+		Expect(fakeDelegate.StartingCallCount()).To(Equal(1))
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	Context("when vars are configured on the step", func() {
+		BeforeEach(func() {
+			setPipelinePlan.Vars = map[string]interface{}{"uri": "step-uri"}
+		})
+
+		It("takes precedence over the var files", func() {
+			_, config, _, _, _, _ := fakeTeam.SavePipelineFromBuildArgsForCall(0)
+			Expect(config.Resources[0].Source["uri"]).To(Equal("step-uri"))
+		})
+	})
+
+	Context("when the pipeline already exists", func() {
+		BeforeEach(func() {
+			fakeTeam.PipelineReturns(fakePipeline, true, nil)
+		})
+
+		It("saves the pipeline from its current config version", func() {
+			_, _, from, _, _, _ := fakeTeam.SavePipelineFromBuildArgsForCall(0)
+			Expect(from).To(Equal(db.ConfigVersion(9)))
+		})
+	})
+
+	Context("when the pipeline config is invalid", func() {
+		BeforeEach(func() {
+			files["pipeline.yml"] = `
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource
+`
+		})
+
+		It("does not error", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("writes the validation errors to stderr", func() {
+			Expect(stderrBuf).To(gbytes.Say("invalid pipeline:"))
+			Expect(stderrBuf).To(gbytes.Say("refers to a resource that does not exist"))
+		})
+
+		It("does not save the pipeline", func() {
+			Expect(fakeTeam.SavePipelineFromBuildCallCount()).To(BeZero())
+		})
+
+		It("fails", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the config file does not exist", func() {
+		BeforeEach(func() {
+			setPipelinePlan.File = "some-input/bogus.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("file 'some-input/bogus.yml' not found"))
+		})
+	})
+
+	Context("when the config file's artifact is unknown", func() {
+		BeforeEach(func() {
+			setPipelinePlan.File = "bogus-input/pipeline.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.UnknownArtifactSourceError{"bogus-input", "bogus-input/pipeline.yml"}))
+		})
+	})
+
+	Context("when saving the pipeline fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeTeam.SavePipelineFromBuildReturns(nil, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+
+		It("fails", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when an input is passed through a job of another pipeline which can't be found", func() {
+		BeforeEach(func() {
+			fakeTeam.SavePipelineFromBuildReturns(nil, false, db.InvalidPassedJobsError{
+				Errors: []string{"jobs.some-job.get.some-resource.passed references an unknown pipeline ('other-pipeline')"},
+			})
+		})
+
+		It("does not error", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("writes the validation errors to stderr", func() {
+			Expect(stderrBuf).To(gbytes.Say("invalid pipeline:"))
+			Expect(stderrBuf).To(gbytes.Say(`references an unknown pipeline \('other-pipeline'\)`))
+		})
+
+		It("fails", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the pipeline was set by a newer build", func() {
+		BeforeEach(func() {
+			fakeTeam.SavePipelineFromBuildReturns(nil, false, db.ErrSetByNewerBuild)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(db.ErrSetByNewerBuild))
+		})
+	})
+})
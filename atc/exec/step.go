@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/vars"
 )
 
 //go:generate counterfeiter . Step
@@ -49,6 +50,12 @@ type RunState interface {
 
 	Result(atc.PlanID, interface{}) bool
 	StoreResult(atc.PlanID, interface{})
+
+	Variables() *vars.BuildVariables
+
+	// NewLocalScope returns a RunState sharing this state's artifacts and
+	// results, but with its own child scope of vars.
+	NewLocalScope() RunState
 }
 
 // VersionInfo is the version and metadata of a resource that was fetched or
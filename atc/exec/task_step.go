@@ -2,6 +2,8 @@ package exec
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -58,6 +60,7 @@ type TaskDelegate interface {
 	Initializing(lager.Logger, atc.TaskConfig)
 	Starting(lager.Logger, atc.TaskConfig)
 	Finished(lager.Logger, ExitStatus)
+	Cached(lager.Logger, db.TaskOutputCache)
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -71,6 +74,7 @@ type TaskStep struct {
 	secrets           creds.Secrets
 	strategy          worker.ContainerPlacementStrategy
 	workerClient      worker.Client
+	cacheFactory      db.TaskOutputCacheFactory
 	delegate          TaskDelegate
 	lockFactory       lock.LockFactory
 	succeeded         bool
@@ -85,6 +89,7 @@ func NewTaskStep(
 	secrets creds.Secrets,
 	strategy worker.ContainerPlacementStrategy,
 	workerClient worker.Client,
+	cacheFactory db.TaskOutputCacheFactory,
 	delegate TaskDelegate,
 	lockFactory lock.LockFactory,
 ) Step {
@@ -97,6 +102,7 @@ func NewTaskStep(
 		secrets:           secrets,
 		strategy:          strategy,
 		workerClient:      workerClient,
+		cacheFactory:      cacheFactory,
 		delegate:          delegate,
 		lockFactory:       lockFactory,
 	}
@@ -117,6 +123,13 @@ func NewTaskStep(
 // are registered with the artifact.Repository. If no outputs are specified, the
 // task's entire working directory is registered as an ArtifactSource under the
 // name of the task.
+//
+// If the plan skips the task when it is unchanged, a cache key is computed
+// from the TaskConfig, the image and the versions of the task's inputs. When
+// the outputs of a previous successful run with the same key are still
+// available, they are registered with the artifact.Repository instead of
+// running the task, and the delegate is told which build produced them.
+// Otherwise the outputs of a successful run are kept for later builds.
 func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 	logger = logger.Session("task-step", lager.Data{
@@ -124,7 +137,10 @@ func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 		"job-id":    step.metadata.JobID,
 	})
 
-	variables := creds.NewVariables(step.secrets, step.metadata.TeamName, step.metadata.PipelineName)
+	variables := vars.NewMultiVars([]vars.Variables{
+		creds.NewVariables(step.secrets, step.metadata.TeamName, step.metadata.PipelineName),
+		state.Variables(),
+	})
 
 	resourceTypes, err := creds.NewVersionedResourceTypes(variables, step.plan.VersionedResourceTypes).Evaluate()
 	if err != nil {
@@ -178,6 +194,25 @@ func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 
 	step.delegate.Initializing(logger, config)
 
+	var cacheKey string
+	if step.plan.SkipIfUnchanged && step.metadata.JobID != 0 {
+		cacheKey, err = step.cacheKey(repository, config)
+		if err != nil {
+			return err
+		}
+
+		cached, err := step.registerCachedOutputs(logger, repository, config, cacheKey)
+		if err != nil {
+			return err
+		}
+
+		if cached {
+			step.succeeded = true
+			step.delegate.Finished(logger, ExitStatus(0))
+			return nil
+		}
+	}
+
 	workerSpec, err := step.workerSpec(logger, resourceTypes, repository, config)
 	if err != nil {
 		return err
@@ -261,6 +296,13 @@ func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 		}
 	}
 
+	if cacheKey != "" && step.succeeded {
+		err = step.saveCachedOutputs(logger, config, result.VolumeMounts, cacheKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 
 }
@@ -357,6 +399,7 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *artifact.Re
 		Dir:       metadata.WorkingDirectory,
 		Env:       config.Params.Env(),
 		Type:      metadata.Type,
+		Hermetic:  step.plan.Hermetic,
 
 		Inputs:  []worker.InputSource{},
 		Outputs: worker.OutputPaths{},
@@ -381,6 +424,7 @@ func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.Versione
 		Tags:          step.plan.Tags,
 		TeamID:        step.metadata.TeamID,
 		ResourceTypes: resourceTypes,
+		Hermetic:      step.plan.Hermetic,
 	}
 
 	imageSpec, err := step.imageSpec(logger, repository, config)
@@ -442,6 +486,136 @@ func (step *TaskStep) registerCaches(logger lager.Logger, repository *artifact.R
 	return nil
 }
 
+// cacheKey identifies a run of the task by its config, whether it is
+// privileged, and the versions of its inputs and image artifact. Inputs which
+// were not fetched by the job, e.g. outputs of other tasks, are identified by
+// the versions of all of the job's inputs. An image_resource is identified by
+// its config, so it should specify a version for a new image to rerun the task.
+func (step *TaskStep) cacheKey(repository *artifact.Repository, config atc.TaskConfig) (string, error) {
+	key := struct {
+		Config        atc.TaskConfig         `json:"config"`
+		Privileged    bool                   `json:"privileged"`
+		Image         atc.Version            `json:"image,omitempty"`
+		Inputs        map[string]atc.Version `json:"inputs"`
+		InputVersions map[string]atc.Version `json:"input_versions,omitempty"`
+	}{
+		Config:     config,
+		Privileged: bool(step.plan.Privileged),
+		Inputs:     map[string]atc.Version{},
+	}
+
+	unversioned := false
+
+	for _, input := range config.Inputs {
+		inputName := input.Name
+		if sourceName, ok := step.plan.InputMapping[inputName]; ok {
+			inputName = sourceName
+		}
+
+		if version, found := step.plan.InputVersions[inputName]; found {
+			key.Inputs[input.Name] = version
+		} else if _, found := repository.SourceFor(artifact.Name(inputName)); found {
+			unversioned = true
+		}
+	}
+
+	if step.plan.ImageArtifactName != "" {
+		if version, found := step.plan.InputVersions[step.plan.ImageArtifactName]; found {
+			key.Image = version
+		} else {
+			unversioned = true
+		}
+	}
+
+	if unversioned {
+		key.InputVersions = step.plan.InputVersions
+	}
+
+	payload, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
+
+// registerCachedOutputs registers the outputs cached for the key, returning
+// false if they were not cached or any of their volumes are gone.
+func (step *TaskStep) registerCachedOutputs(logger lager.Logger, repository *artifact.Repository, config atc.TaskConfig, key string) (bool, error) {
+	cache, found, err := step.cacheFactory.Find(step.metadata.JobID, step.plan.Name, key)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	volumes := map[string]worker.Volume{}
+	for _, output := range config.Outputs {
+		handle, found := cache.Outputs[output.Name]
+		if !found {
+			return false, nil
+		}
+
+		volume, found, err := step.workerClient.FindVolume(logger, step.metadata.TeamID, handle)
+		if err != nil {
+			return false, err
+		}
+
+		if !found {
+			logger.Info("cached-output-volume-not-found", lager.Data{"output": output.Name, "handle": handle})
+			return false, nil
+		}
+
+		volumes[output.Name] = volume
+	}
+
+	for _, output := range config.Outputs {
+		outputName := output.Name
+		if destinationName, ok := step.plan.OutputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		repository.RegisterSource(artifact.Name(outputName), NewTaskArtifactSource(volumes[output.Name]))
+	}
+
+	step.delegate.Cached(logger, cache)
+
+	return true, nil
+}
+
+// saveCachedOutputs keeps the volumes of the task's outputs as task caches
+// and records the key they were produced for. The volumes are looked up
+// through their task caches, as initializing a copy-on-write volume as a task
+// cache makes a new volume on the worker.
+func (step *TaskStep) saveCachedOutputs(logger lager.Logger, config atc.TaskConfig, volumeMounts []worker.VolumeMount, key string) error {
+	logger.Debug("caching-outputs", lager.Data{"key": key})
+
+	for _, output := range config.Outputs {
+		outputPath := artifactsPath(output, step.containerMetadata.WorkingDirectory)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				err := mount.Volume.InitializeTaskCache(
+					logger,
+					step.metadata.JobID,
+					step.plan.Name,
+					db.TaskOutputCachePath(key, output.Name),
+					bool(step.plan.Privileged))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return step.cacheFactory.Save(step.metadata.JobID, step.plan.Name, db.TaskOutputCache{
+		Key:     key,
+		BuildID: step.metadata.BuildID,
+	})
+}
+
 type taskArtifactSource struct {
 	worker.Volume
 }
@@ -18,12 +18,14 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/execfakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/vars"
 )
 
 var _ = Describe("TaskStep", func() {
@@ -41,6 +43,7 @@ var _ = Describe("TaskStep", func() {
 		fakeLockFactory *lockfakes.FakeLockFactory
 
 		fakeSecretManager *credsfakes.FakeSecrets
+		fakeCacheFactory  *dbfakes.FakeTaskOutputCacheFactory
 		fakeDelegate      *execfakes.FakeTaskDelegate
 		taskPlan          *atc.TaskPlan
 
@@ -82,12 +85,15 @@ var _ = Describe("TaskStep", func() {
 		fakeSecretManager = new(credsfakes.FakeSecrets)
 		fakeSecretManager.GetReturns("super-secret-source", nil, true, nil)
 
+		fakeCacheFactory = new(dbfakes.FakeTaskOutputCacheFactory)
+
 		fakeDelegate = new(execfakes.FakeTaskDelegate)
 		fakeDelegate.StdoutReturns(stdoutBuf)
 		fakeDelegate.StderrReturns(stderrBuf)
 
 		repo = artifact.NewRepository()
 		state = new(execfakes.FakeRunState)
+		state.VariablesReturns(vars.NewBuildVariables(nil))
 		state.ArtifactsReturns(repo)
 
 		uninterpolatedResourceTypes := atc.VersionedResourceTypes{
@@ -138,6 +144,7 @@ var _ = Describe("TaskStep", func() {
 			fakeSecretManager,
 			fakeStrategy,
 			fakeClient,
+			fakeCacheFactory,
 			fakeDelegate,
 			fakeLockFactory,
 		)
@@ -253,6 +260,19 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when hermetic", func() {
+			BeforeEach(func() {
+				taskPlan.Hermetic = true
+			})
+
+			It("runs the task in a hermetic container on a worker that supports it", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				_, _, _, _, containerSpec, workerSpec, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Hermetic).To(BeTrue())
+				Expect(workerSpec.Hermetic).To(BeTrue())
+			})
+		})
+
 		Context("when the configuration specifies paths for inputs", func() {
 			var inputSource *workerfakes.FakeArtifactSource
 			var otherInputSource *workerfakes.FakeArtifactSource
@@ -1184,5 +1204,208 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the task is skipped if unchanged", func() {
+			var (
+				fakeOutputVolume *workerfakes.FakeVolume
+				taskResult       worker.TaskResult
+			)
+
+			BeforeEach(func() {
+				stepMetadata.JobID = 12
+
+				taskPlan.SkipIfUnchanged = true
+				taskPlan.InputVersions = map[string]atc.Version{
+					"some-resource": {"ref": "v1"},
+				}
+				taskPlan.InputMapping = map[string]string{"some-input": "some-resource"}
+				taskPlan.OutputMapping = map[string]string{"some-output": "some-mapped-output"}
+				taskPlan.Config = &atc.TaskConfig{
+					Platform:  "some-platform",
+					RootfsURI: "some-image",
+					Run: atc.TaskRunConfig{
+						Path: "ls",
+					},
+					Inputs: []atc.TaskInputConfig{
+						{Name: "some-input"},
+					},
+					Outputs: []atc.TaskOutputConfig{
+						{Name: "some-output"},
+					},
+				}
+
+				repo.RegisterSource("some-resource", new(workerfakes.FakeArtifactSource))
+
+				fakeOutputVolume = new(workerfakes.FakeVolume)
+				fakeOutputVolume.HandleReturns("some-output-handle")
+
+				taskResult = worker.TaskResult{
+					Status: 0,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeOutputVolume,
+							MountPath: "some-artifact-root/some-output/",
+						},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			Context("when the outputs are not cached", func() {
+				BeforeEach(func() {
+					fakeCacheFactory.FindReturns(db.TaskOutputCache{}, false, nil)
+				})
+
+				Context("when the task succeeds", func() {
+					BeforeEach(func() {
+						fakeClient.RunTaskStepReturns(taskResult)
+					})
+
+					It("runs the task", func() {
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+						Expect(taskStep.Succeeded()).To(BeTrue())
+					})
+
+					It("keeps the output volumes as task caches for the key", func() {
+						jobID, stepName, key := fakeCacheFactory.FindArgsForCall(0)
+						Expect(jobID).To(Equal(12))
+						Expect(stepName).To(Equal("some-task"))
+
+						Expect(fakeOutputVolume.InitializeTaskCacheCallCount()).To(Equal(1))
+						_, jID, stepName, cachePath, _ := fakeOutputVolume.InitializeTaskCacheArgsForCall(0)
+						Expect(jID).To(Equal(12))
+						Expect(stepName).To(Equal("some-task"))
+						Expect(cachePath).To(Equal(db.TaskOutputCachePath(key, "some-output")))
+					})
+
+					It("saves the key of the outputs", func() {
+						_, _, key := fakeCacheFactory.FindArgsForCall(0)
+
+						Expect(fakeCacheFactory.SaveCallCount()).To(Equal(1))
+						jobID, stepName, cache := fakeCacheFactory.SaveArgsForCall(0)
+						Expect(jobID).To(Equal(12))
+						Expect(stepName).To(Equal("some-task"))
+						Expect(cache).To(Equal(db.TaskOutputCache{
+							Key:     key,
+							BuildID: stepMetadata.BuildID,
+						}))
+					})
+
+					It("keys the outputs by the versions of the inputs", func() {
+						_, _, key := fakeCacheFactory.FindArgsForCall(0)
+
+						taskPlan.InputVersions = map[string]atc.Version{
+							"some-resource": {"ref": "v2"},
+						}
+
+						otherStep := exec.NewTaskStep(
+							planID,
+							*taskPlan,
+							atc.ContainerLimits{},
+							stepMetadata,
+							containerMetadata,
+							fakeSecretManager,
+							fakeStrategy,
+							fakeClient,
+							fakeCacheFactory,
+							fakeDelegate,
+							fakeLockFactory,
+						)
+						Expect(otherStep.Run(ctx, state)).To(Succeed())
+
+						_, _, otherKey := fakeCacheFactory.FindArgsForCall(1)
+						Expect(otherKey).ToNot(Equal(key))
+					})
+				})
+
+				Context("when the task fails", func() {
+					BeforeEach(func() {
+						taskResult.Status = 1
+						fakeClient.RunTaskStepReturns(taskResult)
+					})
+
+					It("does not cache the outputs", func() {
+						Expect(fakeOutputVolume.InitializeTaskCacheCallCount()).To(BeZero())
+						Expect(fakeCacheFactory.SaveCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the outputs are cached", func() {
+				var cache db.TaskOutputCache
+
+				BeforeEach(func() {
+					cache = db.TaskOutputCache{
+						Key:       "some-key",
+						BuildID:   1000,
+						BuildName: "7",
+						Outputs:   map[string]string{"some-output": "some-output-handle"},
+					}
+
+					fakeCacheFactory.FindReturns(cache, true, nil)
+				})
+
+				Context("when the output volumes still exist", func() {
+					BeforeEach(func() {
+						fakeClient.FindVolumeReturns(fakeOutputVolume, true, nil)
+					})
+
+					It("does not run the task", func() {
+						Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+						Expect(taskStep.Succeeded()).To(BeTrue())
+					})
+
+					It("registers the cached outputs", func() {
+						_, teamID, handle := fakeClient.FindVolumeArgsForCall(0)
+						Expect(teamID).To(Equal(stepMetadata.TeamID))
+						Expect(handle).To(Equal("some-output-handle"))
+
+						source, found := repo.SourceFor("some-mapped-output")
+						Expect(found).To(BeTrue())
+						Expect(source).To(Equal(exec.NewTaskArtifactSource(fakeOutputVolume)))
+					})
+
+					It("tells the delegate which build produced the outputs", func() {
+						Expect(fakeDelegate.CachedCallCount()).To(Equal(1))
+						_, cached := fakeDelegate.CachedArgsForCall(0)
+						Expect(cached).To(Equal(cache))
+					})
+
+					It("initializes and finishes the step successfully", func() {
+						Expect(fakeDelegate.InitializingCallCount()).To(Equal(1))
+						Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+						_, status := fakeDelegate.FinishedArgsForCall(0)
+						Expect(status).To(Equal(exec.ExitStatus(0)))
+					})
+				})
+
+				Context("when an output volume is gone", func() {
+					BeforeEach(func() {
+						fakeClient.FindVolumeReturns(nil, false, nil)
+						fakeClient.RunTaskStepReturns(taskResult)
+					})
+
+					It("runs the task", func() {
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+						Expect(fakeDelegate.CachedCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the task does not belong to a job (one-off build)", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 0
+					fakeClient.RunTaskStepReturns(taskResult)
+				})
+
+				It("does not cache the outputs", func() {
+					Expect(fakeCacheFactory.FindCallCount()).To(BeZero())
+					Expect(fakeCacheFactory.SaveCallCount()).To(BeZero())
+				})
+			})
+		})
+
 	})
 })
@@ -0,0 +1,140 @@
+package atc
+
+import (
+	"time"
+
+	"github.com/gorhill/cronexpr"
+)
+
+// FreezeWindowConfig is a recurring window, e.g. a weekend change freeze,
+// during which the scheduler does not start builds of the window's jobs.
+// Builds may still be triggered manually by the team's owners.
+type FreezeWindowConfig struct {
+	Name string `json:"name"`
+
+	// Start is a cron expression for when the window opens, evaluated in
+	// Location, which defaults to UTC.
+	Start    string `json:"start"`
+	Duration string `json:"duration"`
+	Location string `json:"location,omitempty"`
+
+	// Jobs are the jobs frozen by the window. All of the pipeline's jobs are
+	// frozen if none are given.
+	Jobs []string `json:"jobs,omitempty"`
+}
+
+type FreezeWindowConfigs []FreezeWindowConfig
+
+func (windows FreezeWindowConfigs) Lookup(name string) (FreezeWindowConfig, bool) {
+	for _, window := range windows {
+		if window.Name == name {
+			return window, true
+		}
+	}
+
+	return FreezeWindowConfig{}, false
+}
+
+// Freeze is a freeze window which is currently open.
+type Freeze struct {
+	Window string `json:"window"`
+	Until  int64  `json:"until"`
+}
+
+// maxFreezeWindowStarts bounds the number of starts of a window looked at
+// when working out whether it's open, in case of a frequent schedule with a
+// long duration.
+const maxFreezeWindowStarts = 10000
+
+// OpenUntil returns when the window closes if it's open at the given time.
+func (window FreezeWindowConfig) OpenUntil(t time.Time) (time.Time, bool, error) {
+	expr, err := cronexpr.Parse(window.Start)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	location := time.UTC
+	if window.Location != "" {
+		location, err = time.LoadLocation(window.Location)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+
+	t = t.In(location)
+
+	var until time.Time
+	var open bool
+
+	start := expr.Next(t.Add(-duration))
+	for i := 0; i < maxFreezeWindowStarts && !start.IsZero() && !start.After(t); i++ {
+		until = start.Add(duration)
+		open = true
+
+		start = expr.Next(start)
+	}
+
+	return until, open, nil
+}
+
+// Freezes reports whether the window applies to the given job.
+func (window FreezeWindowConfig) Freezes(jobName string) bool {
+	if len(window.Jobs) == 0 {
+		return true
+	}
+
+	for _, name := range window.Jobs {
+		if name == jobName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Open returns the windows which are open at the given time. Windows which
+// can't be parsed are never open; they are rejected when the config is saved.
+func (windows FreezeWindowConfigs) Open(t time.Time) []Freeze {
+	var freezes []Freeze
+
+	for _, window := range windows {
+		until, open, err := window.OpenUntil(t)
+		if err != nil || !open {
+			continue
+		}
+
+		freezes = append(freezes, Freeze{Window: window.Name, Until: until.Unix()})
+	}
+
+	return freezes
+}
+
+// FreezeFor returns the open window freezing the given job at the given time.
+// When several are open, the one closing last is returned.
+func (windows FreezeWindowConfigs) FreezeFor(jobName string, t time.Time) (Freeze, bool) {
+	var freeze Freeze
+	var frozen bool
+
+	for _, window := range windows {
+		if !window.Freezes(jobName) {
+			continue
+		}
+
+		until, open, err := window.OpenUntil(t)
+		if err != nil || !open {
+			continue
+		}
+
+		if !frozen || until.Unix() > freeze.Until {
+			freeze = Freeze{Window: window.Name, Until: until.Unix()}
+			frozen = true
+		}
+	}
+
+	return freeze, frozen
+}
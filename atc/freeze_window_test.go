@@ -0,0 +1,111 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FreezeWindowConfig", func() {
+	var window atc.FreezeWindowConfig
+
+	BeforeEach(func() {
+		window = atc.FreezeWindowConfig{
+			Name:     "weekend",
+			Start:    "0 18 * * 5",
+			Duration: "62h",
+		}
+	})
+
+	Describe("OpenUntil", func() {
+		friday := time.Date(2019, time.September, 20, 18, 0, 0, 0, time.UTC)
+		monday := friday.Add(62 * time.Hour)
+
+		It("is open from the start for the duration", func() {
+			until, open, err := window.OpenUntil(friday.Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeTrue())
+			Expect(until).To(BeTemporally("==", monday))
+
+			_, open, err = window.OpenUntil(friday)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeTrue())
+		})
+
+		It("is closed before the start and after the duration", func() {
+			_, open, err := window.OpenUntil(friday.Add(-time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeFalse())
+
+			_, open, err = window.OpenUntil(monday)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeFalse())
+		})
+
+		Context("when a location is given", func() {
+			BeforeEach(func() {
+				window.Location = "America/Toronto"
+			})
+
+			It("evaluates the start in that location", func() {
+				_, open, err := window.OpenUntil(friday.Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(open).To(BeFalse())
+
+				until, open, err := window.OpenUntil(friday.Add(5 * time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(open).To(BeTrue())
+				Expect(until).To(BeTemporally("==", monday.Add(4*time.Hour)))
+			})
+		})
+
+		Context("when the start is invalid", func() {
+			BeforeEach(func() {
+				window.Start = "bogus"
+			})
+
+			It("returns an error", func() {
+				_, _, err := window.OpenUntil(friday)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("FreezeFor", func() {
+		var windows atc.FreezeWindowConfigs
+
+		saturday := time.Date(2019, time.September, 21, 12, 0, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			window.Jobs = []string{"deploy"}
+
+			windows = atc.FreezeWindowConfigs{
+				window,
+				{Name: "saturday", Start: "0 0 * * 6", Duration: "24h"},
+			}
+		})
+
+		It("returns the window closing last among the ones freezing the job", func() {
+			freeze, frozen := windows.FreezeFor("deploy", saturday)
+			Expect(frozen).To(BeTrue())
+			Expect(freeze).To(Equal(atc.Freeze{
+				Window: "weekend",
+				Until:  time.Date(2019, time.September, 23, 8, 0, 0, 0, time.UTC).Unix(),
+			}))
+		})
+
+		It("only applies windows to their jobs", func() {
+			freeze, frozen := windows.FreezeFor("unit", saturday)
+			Expect(frozen).To(BeTrue())
+			Expect(freeze.Window).To(Equal("saturday"))
+		})
+
+		It("does not freeze jobs outside of the windows", func() {
+			_, frozen := windows.FreezeFor("deploy", saturday.Add(72*time.Hour))
+			Expect(frozen).To(BeFalse())
+		})
+	})
+})
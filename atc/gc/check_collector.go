@@ -0,0 +1,52 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type checkCollector struct {
+	checkLifecycle db.CheckLifecycle
+	stalePeriod    time.Duration
+	recyclePeriod  time.Duration
+	logsToRetain   int
+}
+
+func NewCheckCollector(checkLifecycle db.CheckLifecycle, stalePeriod time.Duration, recyclePeriod time.Duration, logsToRetain int) Collector {
+	return &checkCollector{
+		checkLifecycle: checkLifecycle,
+		stalePeriod:    stalePeriod,
+		recyclePeriod:  recyclePeriod,
+		logsToRetain:   logsToRetain,
+	}
+}
+
+func (c *checkCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("check-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := c.checkLifecycle.ErrorStaleChecks(c.stalePeriod)
+	if err != nil {
+		logger.Error("failed-to-error-stale-checks", err)
+		return err
+	}
+
+	err = c.checkLifecycle.RemoveExpiredChecks(c.recyclePeriod, c.logsToRetain)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-checks", err)
+		return err
+	}
+
+	err = c.checkLifecycle.RemoveExpiredCheckEvents(c.logsToRetain)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-check-events", err)
+		return err
+	}
+
+	return nil
+}
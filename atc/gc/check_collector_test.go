@@ -0,0 +1,81 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckCollector", func() {
+	var (
+		collector          gc.Collector
+		fakeCheckLifecycle *dbfakes.FakeCheckLifecycle
+		runErr             error
+	)
+
+	BeforeEach(func() {
+		fakeCheckLifecycle = new(dbfakes.FakeCheckLifecycle)
+		collector = gc.NewCheckCollector(fakeCheckLifecycle, 2*time.Hour, time.Hour, 10)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(context.TODO())
+	})
+
+	It("errors the checks which were started over the stale period ago", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeCheckLifecycle.ErrorStaleChecksCallCount()).To(Equal(1))
+		Expect(fakeCheckLifecycle.ErrorStaleChecksArgsForCall(0)).To(Equal(2 * time.Hour))
+	})
+
+	Context("when erroring the stale checks fails", func() {
+		BeforeEach(func() {
+			fakeCheckLifecycle.ErrorStaleChecksReturns(errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+
+	It("removes the checks which expired over the recycle period, apart from the latest ones", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeCheckLifecycle.RemoveExpiredChecksCallCount()).To(Equal(1))
+
+		recyclePeriod, checksToRetain := fakeCheckLifecycle.RemoveExpiredChecksArgsForCall(0)
+		Expect(recyclePeriod).To(Equal(time.Hour))
+		Expect(checksToRetain).To(Equal(10))
+	})
+
+	Context("when removing the checks fails", func() {
+		BeforeEach(func() {
+			fakeCheckLifecycle.RemoveExpiredChecksReturns(errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+
+	It("removes the logs of all but the latest checks", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeCheckLifecycle.RemoveExpiredCheckEventsCallCount()).To(Equal(1))
+		Expect(fakeCheckLifecycle.RemoveExpiredCheckEventsArgsForCall(0)).To(Equal(10))
+	})
+
+	Context("when removing the check logs fails", func() {
+		BeforeEach(func() {
+			fakeCheckLifecycle.RemoveExpiredCheckEventsReturns(errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type pipelineConfigCollector struct {
+	pipelineConfigLifecycle db.PipelineConfigLifecycle
+	configsToRetain         int
+}
+
+func NewPipelineConfigCollector(pipelineConfigLifecycle db.PipelineConfigLifecycle, configsToRetain int) Collector {
+	return &pipelineConfigCollector{
+		pipelineConfigLifecycle: pipelineConfigLifecycle,
+		configsToRetain:         configsToRetain,
+	}
+}
+
+func (c *pipelineConfigCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("pipeline-config-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := c.pipelineConfigLifecycle.RemoveExpiredPipelineConfigs(c.configsToRetain)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-pipeline-configs", err)
+		return err
+	}
+
+	return nil
+}
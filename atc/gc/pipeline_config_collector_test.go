@@ -0,0 +1,45 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineConfigCollector", func() {
+	var (
+		collector                   gc.Collector
+		fakePipelineConfigLifecycle *dbfakes.FakePipelineConfigLifecycle
+		runErr                      error
+	)
+
+	BeforeEach(func() {
+		fakePipelineConfigLifecycle = new(dbfakes.FakePipelineConfigLifecycle)
+		collector = gc.NewPipelineConfigCollector(fakePipelineConfigLifecycle, 50)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(context.TODO())
+	})
+
+	It("removes the configs of each pipeline apart from the latest ones", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakePipelineConfigLifecycle.RemoveExpiredPipelineConfigsCallCount()).To(Equal(1))
+		Expect(fakePipelineConfigLifecycle.RemoveExpiredPipelineConfigsArgsForCall(0)).To(Equal(50))
+	})
+
+	Context("when removing the configs fails", func() {
+		BeforeEach(func() {
+			fakePipelineConfigLifecycle.RemoveExpiredPipelineConfigsReturns(errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+})
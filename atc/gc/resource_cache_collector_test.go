@@ -134,7 +134,7 @@ var _ = Describe("ResourceCacheCollector", func() {
 
 					Context("when pipeline is paused", func() {
 						BeforeEach(func() {
-							err := defaultPipeline.Pause()
+							err := defaultPipeline.Pause("", "")
 							Expect(err).NotTo(HaveOccurred())
 						})
 
@@ -1,18 +1,24 @@
 package atc
 
+import "strings"
+
 type Job struct {
 	ID int `json:"id"`
 
-	Name                 string `json:"name"`
-	PipelineName         string `json:"pipeline_name"`
-	TeamName             string `json:"team_name"`
-	Paused               bool   `json:"paused,omitempty"`
-	FirstLoggedBuildID   int    `json:"first_logged_build_id,omitempty"`
-	DisableManualTrigger bool   `json:"disable_manual_trigger,omitempty"`
-	NextBuild            *Build `json:"next_build"`
-	FinishedBuild        *Build `json:"finished_build"`
-	TransitionBuild      *Build `json:"transition_build,omitempty"`
-	HasNewInputs         bool   `json:"has_new_inputs,omitempty"`
+	Name                 string       `json:"name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	TeamName             string       `json:"team_name"`
+	Paused               bool         `json:"paused,omitempty"`
+	PausedBy             string       `json:"paused_by,omitempty"`
+	PauseComment         string       `json:"pause_comment,omitempty"`
+	Frozen               *Freeze      `json:"frozen,omitempty"`
+	FirstLoggedBuildID   int          `json:"first_logged_build_id,omitempty"`
+	DisableManualTrigger bool         `json:"disable_manual_trigger,omitempty"`
+	NextBuild            *Build       `json:"next_build"`
+	FinishedBuild        *Build       `json:"finished_build"`
+	TransitionBuild      *Build       `json:"transition_build,omitempty"`
+	HasNewInputs         bool         `json:"has_new_inputs,omitempty"`
 
 	Inputs  []JobInput  `json:"inputs"`
 	Outputs []JobOutput `json:"outputs"`
@@ -30,6 +36,35 @@ type JobInput struct {
 	Tags     Tags           `json:"tags,omitempty"`
 }
 
+// PassedJob is a job named in an input's passed constraints. Jobs of other
+// pipelines in the same team are named as pipeline/job.
+type PassedJob struct {
+	Pipeline string
+	Job      string
+}
+
+func ParsePassedJob(name string) PassedJob {
+	segs := strings.SplitN(name, "/", 2)
+	if len(segs) == 1 {
+		return PassedJob{Job: name}
+	}
+
+	return PassedJob{Pipeline: segs[0], Job: segs[1]}
+}
+
+// IsExternal reports whether the job belongs to another pipeline.
+func (job PassedJob) IsExternal() bool {
+	return job.Pipeline != ""
+}
+
+func (job PassedJob) String() string {
+	if job.IsExternal() {
+		return job.Pipeline + "/" + job.Job
+	}
+
+	return job.Job
+}
+
 type JobOutput struct {
 	Name     string `json:"name"`
 	Resource string `json:"resource"`
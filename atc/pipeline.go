@@ -1,14 +1,130 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// InstanceVarsQueryParam is the query param in which API requests identify an
+// instance of a pipeline by its instance vars, encoded as JSON.
+const InstanceVarsQueryParam = "instance_vars"
+
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	InstanceVars  InstanceVars   `json:"instance_vars,omitempty"`
+	Paused        bool           `json:"paused"`
+	PausedBy      string         `json:"paused_by,omitempty"`
+	PauseComment  string         `json:"pause_comment,omitempty"`
+	Frozen        []Freeze       `json:"frozen,omitempty"`
+	Public        bool           `json:"public"`
+	Archived      bool           `json:"archived"`
+	Groups        GroupConfigs   `json:"groups,omitempty"`
+	Display       *DisplayConfig `json:"display,omitempty"`
+	TeamName      string         `json:"team_name"`
+	ParentBuildID int            `json:"parent_build_id,omitempty"`
+	ParentJobID   int            `json:"parent_job_id,omitempty"`
+}
+
+func (pipeline Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         pipeline.Name,
+		InstanceVars: pipeline.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// PauseRequest is the optional body of a request to pause a pipeline or a
+// job, saying why it's being paused.
+type PauseRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// PipelineConfigVersion is a config that a pipeline has been saved with. The
+// config itself is left out when listing a pipeline's config versions.
+type PipelineConfigVersion struct {
+	Version   int     `json:"version"`
+	CreatedBy string  `json:"created_by,omitempty"`
+	CreatedAt int64   `json:"created_at"`
+	Config    *Config `json:"config,omitempty"`
+}
+
+// InstanceVars are the vars that tell an instance of a pipeline apart from the
+// other instances sharing its name.
+type InstanceVars map[string]interface{}
+
+// PipelineRef identifies a pipeline by its name and, if it is an instance of
+// an instanced pipeline, its instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+// String returns the ref as 'name/key:value,other-key:value', with the instance
+// vars ordered by key, or just the name if it has no instance vars.
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	keys := make([]string, 0, len(ref.InstanceVars))
+	for key := range ref.InstanceVars {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s:%v", key, ref.InstanceVars[key])
+	}
+
+	return ref.Name + "/" + strings.Join(pairs, ",")
+}
+
+// QueryParams returns the query params identifying the pipeline's instance in
+// API requests. They are empty if the ref has no instance vars.
+func (ref PipelineRef) QueryParams() url.Values {
+	params := url.Values{}
+	if len(ref.InstanceVars) == 0 {
+		return params
+	}
+
+	payload, err := json.Marshal(ref.InstanceVars)
+	if err != nil {
+		// instance vars are always parsed from JSON or YAML, so they can always
+		// be marshaled back
+		panic(err)
+	}
+
+	params.Set(InstanceVarsQueryParam, string(payload))
+
+	return params
+}
+
+// InstanceVarsFromQueryParams returns the instance vars given by the query
+// params of an API request, or nil if there are none.
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	payload := params.Get(InstanceVarsQueryParam)
+	if payload == "" {
+		return nil, nil
+	}
+
+	var instanceVars InstanceVars
+	err := json.Unmarshal([]byte(payload), &instanceVars)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", InstanceVarsQueryParam, err)
+	}
+
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	return instanceVars, nil
+}
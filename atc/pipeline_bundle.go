@@ -0,0 +1,57 @@
+package atc
+
+// PipelineBundleFormat is the version of the bundle format written by this
+// version of Concourse. Bundles of any other format are rejected on import.
+const PipelineBundleFormat = 1
+
+// PipelineBundle is a self-contained copy of a pipeline for moving it to
+// another cluster: its config along with the state which would otherwise be
+// lost, i.e. the versions of its resources, its pins and its pause states.
+type PipelineBundle struct {
+	Format int    `json:"format"`
+	Config Config `json:"config"`
+
+	Paused       bool   `json:"paused"`
+	PausedBy     string `json:"paused_by,omitempty"`
+	PauseComment string `json:"pause_comment,omitempty"`
+
+	ResourceTypes []ResourceTypeBundle `json:"resource_types,omitempty"`
+	Resources     []ResourceBundle     `json:"resources,omitempty"`
+	Jobs          []JobBundle          `json:"jobs,omitempty"`
+}
+
+// ResourceTypeBundle records the version of a custom resource type, which
+// is part of the resource config of the resources using it.
+type ResourceTypeBundle struct {
+	Name    string  `json:"name"`
+	Version Version `json:"version,omitempty"`
+}
+
+type ResourceBundle struct {
+	Name string `json:"name"`
+
+	// Versions are in the order they were found, oldest first.
+	Versions []BundledVersion `json:"versions,omitempty"`
+
+	// PinnedVersion is the version pinned through the API. Versions pinned in
+	// the config are part of the config.
+	PinnedVersion Version `json:"pinned_version,omitempty"`
+	PinComment    string  `json:"pin_comment,omitempty"`
+}
+
+type BundledVersion struct {
+	Version  Version         `json:"version"`
+	Metadata []MetadataField `json:"metadata,omitempty"`
+	Enabled  bool            `json:"enabled"`
+}
+
+// JobBundle records the pause state of a job, and, if asked for when
+// exporting, a summary of its builds. Builds are not recreated on import.
+type JobBundle struct {
+	Name         string `json:"name"`
+	Paused       bool   `json:"paused"`
+	PausedBy     string `json:"paused_by,omitempty"`
+	PauseComment string `json:"pause_comment,omitempty"`
+
+	Builds []Build `json:"builds,omitempty"`
+}
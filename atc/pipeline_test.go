@@ -0,0 +1,70 @@
+package atc_test
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineRef", func() {
+	var ref atc.PipelineRef
+
+	BeforeEach(func() {
+		ref = atc.PipelineRef{Name: "some-pipeline"}
+	})
+
+	Context("when the ref has no instance vars", func() {
+		It("is shown as its name", func() {
+			Expect(ref.String()).To(Equal("some-pipeline"))
+		})
+
+		It("has no query params", func() {
+			Expect(ref.QueryParams()).To(BeEmpty())
+		})
+	})
+
+	Context("when the ref has instance vars", func() {
+		BeforeEach(func() {
+			ref.InstanceVars = atc.InstanceVars{
+				"branch": "release-1.2",
+				"arch":   "amd64",
+			}
+		})
+
+		It("is shown with its instance vars ordered by key", func() {
+			Expect(ref.String()).To(Equal("some-pipeline/arch:amd64,branch:release-1.2"))
+		})
+
+		It("identifies the instance in its query params", func() {
+			Expect(ref.QueryParams()).To(Equal(url.Values{
+				"instance_vars": {`{"arch":"amd64","branch":"release-1.2"}`},
+			}))
+		})
+
+		It("round-trips the instance vars through its query params", func() {
+			instanceVars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instanceVars).To(Equal(ref.InstanceVars))
+		})
+	})
+})
+
+var _ = Describe("InstanceVarsFromQueryParams", func() {
+	It("returns nil when there are no instance vars", func() {
+		instanceVars, err := atc.InstanceVarsFromQueryParams(url.Values{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instanceVars).To(BeNil())
+
+		instanceVars, err = atc.InstanceVarsFromQueryParams(url.Values{"instance_vars": {"{}"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instanceVars).To(BeNil())
+	})
+
+	It("errors when the instance vars are not a JSON object", func() {
+		_, err := atc.InstanceVarsFromQueryParams(url.Values{"instance_vars": {"nope"}})
+		Expect(err).To(MatchError(ContainSubstring("invalid instance_vars")))
+	})
+})
@@ -1,23 +1,30 @@
 package atc
 
+import "encoding/json"
+
 type Plan struct {
 	ID       PlanID `json:"id"`
 	Attempts []int  `json:"attempts,omitempty"`
 
-	Aggregate  *AggregatePlan  `json:"aggregate,omitempty"`
-	InParallel *InParallelPlan `json:"in_parallel,omitempty"`
-	Do         *DoPlan         `json:"do,omitempty"`
-	Get        *GetPlan        `json:"get,omitempty"`
-	Put        *PutPlan        `json:"put,omitempty"`
-	Task       *TaskPlan       `json:"task,omitempty"`
-	OnAbort    *OnAbortPlan    `json:"on_abort,omitempty"`
-	OnError    *OnErrorPlan    `json:"on_error,omitempty"`
-	Ensure     *EnsurePlan     `json:"ensure,omitempty"`
-	OnSuccess  *OnSuccessPlan  `json:"on_success,omitempty"`
-	OnFailure  *OnFailurePlan  `json:"on_failure,omitempty"`
-	Try        *TryPlan        `json:"try,omitempty"`
-	Timeout    *TimeoutPlan    `json:"timeout,omitempty"`
-	Retry      *RetryPlan      `json:"retry,omitempty"`
+	Aggregate   *AggregatePlan   `json:"aggregate,omitempty"`
+	InParallel  *InParallelPlan  `json:"in_parallel,omitempty"`
+	Do          *DoPlan          `json:"do,omitempty"`
+	Get         *GetPlan         `json:"get,omitempty"`
+	Put         *PutPlan         `json:"put,omitempty"`
+	Task        *TaskPlan        `json:"task,omitempty"`
+	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
+	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	OnAbort     *OnAbortPlan     `json:"on_abort,omitempty"`
+	OnError     *OnErrorPlan     `json:"on_error,omitempty"`
+	Ensure      *EnsurePlan      `json:"ensure,omitempty"`
+	OnSuccess   *OnSuccessPlan   `json:"on_success,omitempty"`
+	OnFailure   *OnFailurePlan   `json:"on_failure,omitempty"`
+	OnRetry     *OnRetryPlan     `json:"on_retry,omitempty"`
+	Try         *TryPlan         `json:"try,omitempty"`
+	Timeout     *TimeoutPlan     `json:"timeout,omitempty"`
+	Retry       *RetryPlan       `json:"retry,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
+	If          *IfPlan          `json:"if,omitempty"`
 
 	// used for 'fly execute'
 	ArtifactInput  *ArtifactInputPlan  `json:"artifact_input,omitempty"`
@@ -53,6 +60,13 @@ type OnFailurePlan struct {
 	Next Plan `json:"on_failure"`
 }
 
+// An OnRetryPlan is an attempt of a RetryPlan, with a hook to run when the
+// attempt is about to be retried.
+type OnRetryPlan struct {
+	Step Plan `json:"step"`
+	Next Plan `json:"on_retry"`
+}
+
 type EnsurePlan struct {
 	Step Plan `json:"step"`
 	Next Plan `json:"ensure"`
@@ -72,6 +86,15 @@ type TryPlan struct {
 	Step Plan `json:"step"`
 }
 
+// An IfPlan only runs its step if the condition holds once the build reaches
+// it. InputVersions are the versions of the job's inputs, which the condition
+// may refer to.
+type IfPlan struct {
+	Condition     string             `json:"condition"`
+	InputVersions map[string]Version `json:"input_versions,omitempty"`
+	Step          Plan               `json:"step"`
+}
+
 type AggregatePlan []Plan
 
 type InParallelPlan struct {
@@ -82,6 +105,27 @@ type InParallelPlan struct {
 
 type DoPlan []Plan
 
+// An AcrossPlan runs one sub-plan for every combination of its vars' values.
+// Each sub-plan has already had its values interpolated.
+type AcrossPlan struct {
+	Vars     []AcrossVar     `json:"vars"`
+	Steps    []VarScopedPlan `json:"steps"`
+	FailFast bool            `json:"fail_fast,omitempty"`
+}
+
+type AcrossVar struct {
+	Var         string        `json:"name"`
+	Values      []interface{} `json:"values"`
+	MaxInFlight int           `json:"max_in_flight,omitempty"`
+}
+
+// A VarScopedPlan is a single combination of an AcrossPlan. Values are in the
+// same order as the AcrossPlan's Vars.
+type VarScopedPlan struct {
+	Step   Plan          `json:"step"`
+	Values []interface{} `json:"values"`
+}
+
 type GetPlan struct {
 	Type        string   `json:"type"`
 	Name        string   `json:"name,omitempty"`
@@ -92,6 +136,8 @@ type GetPlan struct {
 	VersionFrom *PlanID  `json:"version_from,omitempty"`
 	Tags        Tags     `json:"tags,omitempty"`
 
+	Limits *ContainerLimits `json:"container_limits,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -104,6 +150,8 @@ type PutPlan struct {
 	Tags     Tags          `json:"tags,omitempty"`
 	Inputs   *InputsConfig `json:"inputs,omitempty"`
 
+	Limits *ContainerLimits `json:"container_limits,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -111,6 +159,7 @@ type TaskPlan struct {
 	Name string `json:"name,omitempty"`
 
 	Privileged bool `json:"privileged"`
+	Hermetic   bool `json:"hermetic,omitempty"`
 	Tags       Tags `json:"tags,omitempty"`
 
 	ConfigPath string      `json:"config_path,omitempty"`
@@ -122,10 +171,59 @@ type TaskPlan struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 
+	// SkipIfUnchanged reuses the outputs of the last successful run of the
+	// step if it had the same config, image and input versions. The versions
+	// of the job's inputs are given by InputVersions.
+	SkipIfUnchanged bool               `json:"skip_if_unchanged,omitempty"`
+	InputVersions   map[string]Version `json:"input_versions,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
-type RetryPlan []Plan
+type SetPipelinePlan struct {
+	Name     string                 `json:"name"`
+	File     string                 `json:"file"`
+	Vars     map[string]interface{} `json:"vars,omitempty"`
+	VarFiles []string               `json:"var_files,omitempty"`
+}
+
+type LoadVarPlan struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Format string `json:"format,omitempty"`
+	Reveal bool   `json:"reveal,omitempty"`
+}
+
+// A RetryPlan runs each of its attempts in order until one of them works.
+type RetryPlan struct {
+	Attempts []Plan        `json:"attempts"`
+	Backoff  *RetryBackoff `json:"backoff,omitempty"`
+
+	// only retry on the given outcome (RetryOnError or RetryOnFailure)
+	On string `json:"on,omitempty"`
+}
+
+// UnmarshalJSON also accepts a plain list of attempts, which is how retry
+// plans were stored before they could be configured.
+func (plan *RetryPlan) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		*plan = RetryPlan{}
+		return json.Unmarshal(data, &plan.Attempts)
+	}
+
+	type target RetryPlan
+	return json.Unmarshal(data, (*target)(plan))
+}
+
+// A RetryBackoff configures how long to wait before each retry of a step. The
+// first retry waits Initial, and each subsequent one waits Factor times as
+// long as the previous, up to Max.
+type RetryBackoff struct {
+	Initial string  `json:"initial,omitempty"`
+	Max     string  `json:"max,omitempty"`
+	Factor  float64 `json:"factor,omitempty"`
+	Jitter  bool    `json:"jitter,omitempty"`
+}
 
 type DependentGetPlan struct {
 	Type     string `json:"type"`
@@ -37,6 +37,10 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Put = &t
 	case TaskPlan:
 		plan.Task = &t
+	case SetPipelinePlan:
+		plan.SetPipeline = &t
+	case LoadVarPlan:
+		plan.LoadVar = &t
 	case OnAbortPlan:
 		plan.OnAbort = &t
 	case OnErrorPlan:
@@ -47,12 +51,18 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.OnSuccess = &t
 	case OnFailurePlan:
 		plan.OnFailure = &t
+	case OnRetryPlan:
+		plan.OnRetry = &t
 	case TryPlan:
 		plan.Try = &t
 	case TimeoutPlan:
 		plan.Timeout = &t
 	case RetryPlan:
 		plan.Retry = &t
+	case AcrossPlan:
+		plan.Across = &t
+	case IfPlan:
+		plan.If = &t
 	case ArtifactInputPlan:
 		plan.ArtifactInput = &t
 	case ArtifactOutputPlan:
@@ -5,4 +5,8 @@ import "encoding/json"
 type PublicBuildPlan struct {
 	Schema string           `json:"schema"`
 	Plan   *json.RawMessage `json:"plan"`
+
+	// the outcome of each attempt of the build's retried steps, keyed by the
+	// ID of their retry plan
+	Attempts map[PlanID][]BuildAttempt `json:"attempts,omitempty"`
 }
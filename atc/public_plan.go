@@ -12,15 +12,20 @@ func (plan Plan) Public() *json.RawMessage {
 		Get            *json.RawMessage `json:"get,omitempty"`
 		Put            *json.RawMessage `json:"put,omitempty"`
 		Task           *json.RawMessage `json:"task,omitempty"`
+		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
+		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		OnError        *json.RawMessage `json:"on_error,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
 		OnSuccess      *json.RawMessage `json:"on_success,omitempty"`
 		OnFailure      *json.RawMessage `json:"on_failure,omitempty"`
+		OnRetry        *json.RawMessage `json:"on_retry,omitempty"`
 		Try            *json.RawMessage `json:"try,omitempty"`
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
+		If             *json.RawMessage `json:"if,omitempty"`
 		ArtifactInput  *json.RawMessage `json:"artifact_input,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
 	}
@@ -51,6 +56,14 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Task = plan.Task.Public()
 	}
 
+	if plan.SetPipeline != nil {
+		public.SetPipeline = plan.SetPipeline.Public()
+	}
+
+	if plan.LoadVar != nil {
+		public.LoadVar = plan.LoadVar.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -71,6 +84,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.OnFailure = plan.OnFailure.Public()
 	}
 
+	if plan.OnRetry != nil {
+		public.OnRetry = plan.OnRetry.Public()
+	}
+
 	if plan.Try != nil {
 		public.Try = plan.Try.Public()
 	}
@@ -83,6 +100,14 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
+	if plan.If != nil {
+		public.If = plan.If.Public()
+	}
+
 	if plan.ArtifactInput != nil {
 		public.ArtifactInput = plan.ArtifactInput.Public()
 	}
@@ -202,6 +227,16 @@ func (plan OnFailurePlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan OnRetryPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step *json.RawMessage `json:"step"`
+		Next *json.RawMessage `json:"on_retry"`
+	}{
+		Step: plan.Step.Public(),
+		Next: plan.Next.Public(),
+	})
+}
+
 func (plan OnSuccessPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step *json.RawMessage `json:"step"`
@@ -234,6 +269,22 @@ func (plan TaskPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan SetPipelinePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
+func (plan LoadVarPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
 func (plan TimeoutPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step     *json.RawMessage `json:"step"`
@@ -253,15 +304,51 @@ func (plan TryPlan) Public() *json.RawMessage {
 }
 
 func (plan RetryPlan) Public() *json.RawMessage {
-	public := make([]*json.RawMessage, len(plan))
+	public := make([]*json.RawMessage, len(plan.Attempts))
 
-	for i := 0; i < len(plan); i++ {
-		public[i] = plan[i].Public()
+	for i := 0; i < len(plan.Attempts); i++ {
+		public[i] = plan.Attempts[i].Public()
 	}
 
 	return enc(public)
 }
 
+func (plan AcrossPlan) Public() *json.RawMessage {
+	type scopedStep struct {
+		Step   *json.RawMessage `json:"step"`
+		Values []interface{}    `json:"values"`
+	}
+
+	steps := make([]scopedStep, len(plan.Steps))
+
+	for i := 0; i < len(plan.Steps); i++ {
+		steps[i] = scopedStep{
+			Step:   plan.Steps[i].Step.Public(),
+			Values: plan.Steps[i].Values,
+		}
+	}
+
+	return enc(struct {
+		Vars     []AcrossVar  `json:"vars"`
+		Steps    []scopedStep `json:"steps"`
+		FailFast bool         `json:"fail_fast,omitempty"`
+	}{
+		Vars:     plan.Vars,
+		Steps:    steps,
+		FailFast: plan.FailFast,
+	})
+}
+
+func (plan IfPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Condition string           `json:"condition"`
+		Step      *json.RawMessage `json:"step"`
+	}{
+		Condition: plan.Condition,
+		Step:      plan.Step.Public(),
+	})
+}
+
 func (plan ArtifactInputPlan) Public() *json.RawMessage {
 	return enc(plan)
 }
@@ -1,6 +1,8 @@
 package atc_test
 
 import (
+	"encoding/json"
+
 	"github.com/concourse/concourse/atc"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -222,36 +224,42 @@ var _ = Describe("Plan", func() {
 					atc.Plan{
 						ID: "24",
 						Retry: &atc.RetryPlan{
-							atc.Plan{
-								ID: "25",
-								Task: &atc.TaskPlan{
-									Name:       "name",
-									ConfigPath: "some/config/path.yml",
-									Config: &atc.TaskConfig{
-										Params: atc.TaskEnv{"some": "secret"},
+							Attempts: []atc.Plan{
+								atc.Plan{
+									ID: "25",
+									Task: &atc.TaskPlan{
+										Name:       "name",
+										ConfigPath: "some/config/path.yml",
+										Config: &atc.TaskConfig{
+											Params: atc.TaskEnv{"some": "secret"},
+										},
 									},
 								},
-							},
-							atc.Plan{
-								ID: "26",
-								Task: &atc.TaskPlan{
-									Name:       "name",
-									ConfigPath: "some/config/path.yml",
-									Config: &atc.TaskConfig{
-										Params: atc.TaskEnv{"some": "secret"},
+								atc.Plan{
+									ID: "26",
+									Task: &atc.TaskPlan{
+										Name:       "name",
+										ConfigPath: "some/config/path.yml",
+										Config: &atc.TaskConfig{
+											Params: atc.TaskEnv{"some": "secret"},
+										},
 									},
 								},
-							},
-							atc.Plan{
-								ID: "27",
-								Task: &atc.TaskPlan{
-									Name:       "name",
-									ConfigPath: "some/config/path.yml",
-									Config: &atc.TaskConfig{
-										Params: atc.TaskEnv{"some": "secret"},
+								atc.Plan{
+									ID: "27",
+									Task: &atc.TaskPlan{
+										Name:       "name",
+										ConfigPath: "some/config/path.yml",
+										Config: &atc.TaskConfig{
+											Params: atc.TaskEnv{"some": "secret"},
+										},
 									},
 								},
 							},
+							Backoff: &atc.RetryBackoff{
+								Initial: "1s",
+							},
+							On: atc.RetryOnError,
 						},
 					},
 
@@ -340,6 +348,92 @@ var _ = Describe("Plan", func() {
 							},
 						},
 					},
+
+					atc.Plan{
+						ID: "38",
+						Across: &atc.AcrossPlan{
+							Vars: []atc.AcrossVar{
+								{
+									Var:         "some-var",
+									Values:      []interface{}{"a"},
+									MaxInFlight: 2,
+								},
+							},
+							Steps: []atc.VarScopedPlan{
+								{
+									Step: atc.Plan{
+										ID: "39",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+											Config: &atc.TaskConfig{
+												Params: atc.TaskEnv{"some": "secret"},
+											},
+										},
+									},
+									Values: []interface{}{"a"},
+								},
+							},
+							FailFast: true,
+						},
+					},
+
+					atc.Plan{
+						ID: "40",
+						SetPipeline: &atc.SetPipelinePlan{
+							Name:     "some-pipeline",
+							File:     "some-input/pipeline.yml",
+							Vars:     map[string]interface{}{"some": "secret"},
+							VarFiles: []string{"some-input/vars.yml"},
+						},
+					},
+
+					atc.Plan{
+						ID: "41",
+						LoadVar: &atc.LoadVarPlan{
+							Name:   "some-var",
+							File:   "some-input/version",
+							Format: "trim",
+							Reveal: true,
+						},
+					},
+
+					atc.Plan{
+						ID: "42",
+						If: &atc.IfPlan{
+							Condition: `build.job_name == "some-job"`,
+							InputVersions: map[string]atc.Version{
+								"some-input": {"ref": "some-ref"},
+							},
+							Step: atc.Plan{
+								ID: "43",
+								Task: &atc.TaskPlan{
+									Name:       "conditional",
+									Privileged: true,
+								},
+							},
+						},
+					},
+
+					atc.Plan{
+						ID: "44",
+						OnRetry: &atc.OnRetryPlan{
+							Step: atc.Plan{
+								ID: "45",
+								Task: &atc.TaskPlan{
+									Name:       "flaky",
+									Privileged: true,
+								},
+							},
+							Next: atc.Plan{
+								ID: "46",
+								Task: &atc.TaskPlan{
+									Name:       "cleanup",
+									Privileged: true,
+								},
+							},
+						},
+					},
 				},
 			}
 
@@ -591,10 +685,115 @@ var _ = Describe("Plan", func() {
 				"limit": 1,
 				"fail_fast": true
 			}
+		},
+		{
+			"id": "38",
+			"across": {
+				"vars": [
+					{
+						"name": "some-var",
+						"values": ["a"],
+						"max_in_flight": 2
+					}
+				],
+				"steps": [
+					{
+						"step": {
+							"id": "39",
+							"task": {
+								"name": "name",
+								"privileged": false
+							}
+						},
+						"values": ["a"]
+					}
+				],
+				"fail_fast": true
+			}
+		},
+		{
+			"id": "40",
+			"set_pipeline": {
+				"name": "some-pipeline"
+			}
+		},
+		{
+			"id": "41",
+			"load_var": {
+				"name": "some-var"
+			}
+		},
+		{
+			"id": "42",
+			"if": {
+				"condition": "build.job_name == \"some-job\"",
+				"step": {
+					"id": "43",
+					"task": {
+						"name": "conditional",
+						"privileged": true
+					}
+				}
+			}
+		},
+		{
+			"id": "44",
+			"on_retry": {
+				"step": {
+					"id": "45",
+					"task": {
+						"name": "flaky",
+						"privileged": true
+					}
+				},
+				"on_retry": {
+					"id": "46",
+					"task": {
+						"name": "cleanup",
+						"privileged": true
+					}
+				}
+			}
 		}
   ]
 }
 `))
 		})
 	})
+
+	Describe("RetryPlan", func() {
+		It("can be unmarshaled from a list of attempts, as stored by older versions", func() {
+			var plan atc.Plan
+			err := json.Unmarshal([]byte(`{"id":"1","retry":[{"id":"2","task":{"name":"some-task"}}]}`), &plan)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(plan.Retry).To(Equal(&atc.RetryPlan{
+				Attempts: []atc.Plan{
+					{ID: "2", Task: &atc.TaskPlan{Name: "some-task"}},
+				},
+			}))
+		})
+
+		It("round-trips through JSON", func() {
+			plan := atc.Plan{
+				ID: "1",
+				Retry: &atc.RetryPlan{
+					Attempts: []atc.Plan{
+						{ID: "2", Task: &atc.TaskPlan{Name: "some-task"}},
+					},
+					Backoff: &atc.RetryBackoff{Initial: "1s", Max: "1m", Factor: 2, Jitter: true},
+					On:      atc.RetryOnFailure,
+				},
+			}
+
+			payload, err := json.Marshal(plan)
+			Expect(err).ToNot(HaveOccurred())
+
+			var unmarshaled atc.Plan
+			err = json.Unmarshal(payload, &unmarshaled)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(unmarshaled).To(Equal(plan))
+		})
+	})
 })
@@ -0,0 +1,20 @@
+package radar
+
+import "time"
+
+// backOff doubles the interval on which a resource is checked for each time
+// in a row it has failed to check, up to maxInterval, so that resources which
+// fail because e.g. their repository host is rate limiting them don't keep
+// making things worse. A maxInterval shorter than the interval leaves it be.
+func backOff(interval time.Duration, consecutiveErrors int, maxInterval time.Duration) time.Duration {
+	backedOff := interval
+	for i := 0; i < consecutiveErrors && backedOff < maxInterval; i++ {
+		backedOff *= 2
+	}
+
+	if backedOff > maxInterval && interval < maxInterval {
+		return maxInterval
+	}
+
+	return backedOff
+}
@@ -0,0 +1,255 @@
+package radar
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// CheckRunner runs the checks queued up in the database, up to Workers of
+// them at a time. Every ATC runs one, each starting the oldest pending check
+// whenever it has a free worker. The checks still running when it's signalled
+// are interrupted.
+type CheckRunner struct {
+	Logger         lager.Logger
+	CheckFactory   db.CheckFactory
+	ScannerFactory ScannerFactory
+	Notifications  Notifications
+	Workers        int
+	Interval       time.Duration
+	Clock          clock.Clock
+}
+
+func (runner CheckRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	notifier, err := runner.Notifications.Listen("check_created")
+	if err != nil {
+		return err
+	}
+
+	defer runner.Notifications.Unlisten("check_created", notifier)
+
+	ticker := runner.Clock.NewTicker(runner.Interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	workers := make(chan struct{}, runner.Workers)
+	finished := make(chan struct{}, 1)
+	running := new(sync.WaitGroup)
+
+	runner.startChecks(ctx, workers, finished, running)
+
+	for {
+		select {
+		case <-notifier:
+			runner.startChecks(ctx, workers, finished, running)
+
+		case <-finished:
+			runner.startChecks(ctx, workers, finished, running)
+
+		case <-ticker.C():
+			runner.startChecks(ctx, workers, finished, running)
+
+		case <-signals:
+			runner.Logger.Info("interrupting-running-checks")
+			cancel()
+			running.Wait()
+			return nil
+		}
+	}
+}
+
+func (runner CheckRunner) startChecks(ctx context.Context, workers chan struct{}, finished chan struct{}, running *sync.WaitGroup) {
+	for {
+		select {
+		case workers <- struct{}{}:
+		default:
+			return
+		}
+
+		check, found, err := runner.CheckFactory.StartNextCheck()
+		if err != nil || !found {
+			<-workers
+
+			if err != nil {
+				runner.Logger.Error("failed-to-start-next-check", err)
+			}
+
+			return
+		}
+
+		running.Add(1)
+		go func(check db.Check) {
+			defer running.Done()
+
+			runner.runCheck(ctx, check)
+
+			<-workers
+
+			select {
+			case finished <- struct{}{}:
+			default:
+			}
+		}(check)
+	}
+}
+
+func (runner CheckRunner) runCheck(ctx context.Context, check db.Check) {
+	logger := runner.Logger.Session("check", lager.Data{
+		"check":      check.ID(),
+		"created-by": check.CreatedBy(),
+	})
+
+	stderr := newCheckEventWriter(check, event.Origin{Source: event.OriginSourceStderr}, runner.Clock)
+
+	err := runner.scan(ctx, logger, check, stderr)
+
+	flushErr := stderr.Flush()
+	if flushErr != nil {
+		logger.Error("failed-to-flush-check-output", flushErr)
+	}
+
+	// another ATC is already checking, or checked less than the interval ago
+	if err == ErrFailedToAcquireLock {
+		logger.Debug("check-skipped")
+
+		err = check.Skip()
+		if err != nil {
+			logger.Error("failed-to-skip-check", err)
+		}
+
+		return
+	}
+
+	if err != nil {
+		logger.Info("check-errored", lager.Data{"error": err.Error()})
+
+		saveErr := check.SaveEvent(event.Error{
+			Message: err.Error(),
+			Time:    runner.Clock.Now().Unix(),
+		})
+		if saveErr != nil {
+			logger.Error("failed-to-save-error-event", saveErr)
+		}
+	}
+
+	err = check.Finish(err)
+	if err != nil {
+		logger.Error("failed-to-finish-check", err)
+	}
+}
+
+func (runner CheckRunner) scan(ctx context.Context, logger lager.Logger, check db.Check, stderr io.Writer) error {
+	pipeline, found, err := check.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errPipelineRemoved
+	}
+
+	var scanner Scanner
+	var id int
+	if check.ResourceTypeID() != 0 {
+		scanner = runner.ScannerFactory.NewResourceTypeScanner(pipeline, stderr)
+		id = check.ResourceTypeID()
+	} else {
+		scanner = runner.ScannerFactory.NewResourceScanner(pipeline, stderr)
+		id = check.ResourceID()
+	}
+
+	switch check.CreatedBy() {
+	case atc.CheckCreatedByManual:
+		return scanner.ScanFromVersion(ctx, logger, id, check.Plan().From)
+
+	case atc.CheckCreatedByInterval:
+		_, err = scanner.Run(ctx, logger, id)
+		return err
+
+	default:
+		return scanner.Scan(ctx, logger, id)
+	}
+}
+
+func newCheckEventWriter(check db.Check, origin event.Origin, clock clock.Clock) *checkEventWriter {
+	return &checkEventWriter{
+		check:  check,
+		origin: origin,
+		clock:  clock,
+	}
+}
+
+// checkEventWriter saves whatever the check's script writes as log events of
+// the check, holding back a trailing incomplete UTF-8 character until the
+// rest of it is written. Whatever is held back when the check finishes is
+// saved by Flush.
+type checkEventWriter struct {
+	check    db.Check
+	origin   event.Origin
+	clock    clock.Clock
+	dangling []byte
+}
+
+func (writer *checkEventWriter) Write(data []byte) (int, error) {
+	text := append(writer.dangling, data...)
+
+	// only the start of a character which is cut short is held back, i.e.
+	// at most utf8.UTFMax-1 bytes; invalid bytes are saved as they are
+	incomplete := 0
+	for i := 1; i < utf8.UTFMax && i <= len(text); i++ {
+		if utf8.RuneStart(text[len(text)-i]) {
+			if !utf8.FullRune(text[len(text)-i:]) {
+				incomplete = i
+			}
+
+			break
+		}
+	}
+
+	complete := text[:len(text)-incomplete]
+	writer.dangling = append([]byte{}, text[len(text)-incomplete:]...)
+
+	if len(complete) == 0 {
+		return len(data), nil
+	}
+
+	err := writer.save(complete)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+// Flush saves whatever has been held back.
+func (writer *checkEventWriter) Flush() error {
+	if len(writer.dangling) == 0 {
+		return nil
+	}
+
+	text := writer.dangling
+	writer.dangling = nil
+
+	return writer.save(text)
+}
+
+func (writer *checkEventWriter) save(text []byte) error {
+	return writer.check.SaveEvent(event.Log{
+		Time:    writer.clock.Now().Unix(),
+		Payload: string(text),
+		Origin:  writer.origin,
+	})
+}
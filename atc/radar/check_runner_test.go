@@ -0,0 +1,333 @@
+package radar_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/concourse/concourse/atc/radar"
+	"github.com/concourse/concourse/atc/radar/radarfakes"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckRunner", func() {
+	var (
+		fakeCheckFactory   *dbfakes.FakeCheckFactory
+		fakeScannerFactory *radarfakes.FakeScannerFactory
+		fakeScanner        *radarfakes.FakeScanner
+		fakeNotifications  *radarfakes.FakeNotifications
+		fakeClock          *fakeclock.FakeClock
+		fakePipeline       *dbfakes.FakePipeline
+		fakeCheck          *dbfakes.FakeCheck
+		checkCreated       chan bool
+		workers            int
+
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeScannerFactory = new(radarfakes.FakeScannerFactory)
+		fakeScanner = new(radarfakes.FakeScanner)
+		fakeNotifications = new(radarfakes.FakeNotifications)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(0, 123))
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		checkCreated = make(chan bool)
+		fakeNotifications.ListenReturns(checkCreated, nil)
+
+		fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
+		fakeScannerFactory.NewResourceTypeScannerReturns(fakeScanner)
+
+		fakeCheck = new(dbfakes.FakeCheck)
+		fakeCheck.IDReturns(42)
+		fakeCheck.ResourceIDReturns(7)
+		fakeCheck.PipelineReturns(fakePipeline, true, nil)
+		fakeCheck.CreatedByReturns(atc.CheckCreatedByManual)
+		fakeCheck.PlanReturns(atc.CheckPlan{Resource: "some-resource", From: atc.Version{"ref": "v1"}})
+
+		fakeCheckFactory.StartNextCheckReturnsOnCall(0, fakeCheck, true, nil)
+
+		workers = 2
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(CheckRunner{
+			Logger:         lagertest.NewTestLogger("test"),
+			CheckFactory:   fakeCheckFactory,
+			ScannerFactory: fakeScannerFactory,
+			Notifications:  fakeNotifications,
+			Workers:        workers,
+			Interval:       time.Minute,
+			Clock:          fakeClock,
+		})
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		<-process.Wait()
+	})
+
+	It("listens for created checks", func() {
+		Expect(fakeNotifications.ListenArgsForCall(0)).To(Equal("check_created"))
+	})
+
+	It("runs the pending check and records its outcome", func() {
+		Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+		Expect(fakeCheck.FinishArgsForCall(0)).To(BeNil())
+
+		pipeline, _ := fakeScannerFactory.NewResourceScannerArgsForCall(0)
+		Expect(pipeline).To(Equal(fakePipeline))
+		Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+
+		_, _, resourceID, from := fakeScanner.ScanFromVersionArgsForCall(0)
+		Expect(resourceID).To(Equal(7))
+		Expect(from).To(Equal(atc.Version{"ref": "v1"}))
+	})
+
+	Context("when the check writes to stderr", func() {
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionStub = func(context.Context, lager.Logger, int, atc.Version) error {
+				_, stderr := fakeScannerFactory.NewResourceScannerArgsForCall(0)
+				_, err := stderr.Write([]byte("some-stderr"))
+				return err
+			}
+		})
+
+		It("saves it as a log event of the check", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeCheck.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Time:    fakeClock.Now().Unix(),
+				Origin:  event.Origin{Source: event.OriginSourceStderr},
+				Payload: "some-stderr",
+			}))
+		})
+	})
+
+	Context("when the check writes a character split across writes", func() {
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionStub = func(context.Context, lager.Logger, int, atc.Version) error {
+				_, stderr := fakeScannerFactory.NewResourceScannerArgsForCall(0)
+				_, err := stderr.Write([]byte("caf\xc3"))
+				if err != nil {
+					return err
+				}
+
+				_, err = stderr.Write([]byte("\xa9"))
+				return err
+			}
+		})
+
+		It("holds back the start of the character until the rest of it is written", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.SaveEventCallCount()).To(Equal(2))
+			Expect(fakeCheck.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Time:    fakeClock.Now().Unix(),
+				Origin:  event.Origin{Source: event.OriginSourceStderr},
+				Payload: "caf",
+			}))
+			Expect(fakeCheck.SaveEventArgsForCall(1)).To(Equal(event.Log{
+				Time:    fakeClock.Now().Unix(),
+				Origin:  event.Origin{Source: event.OriginSourceStderr},
+				Payload: "\u00e9",
+			}))
+		})
+	})
+
+	Context("when the check writes invalid UTF-8", func() {
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionStub = func(context.Context, lager.Logger, int, atc.Version) error {
+				_, stderr := fakeScannerFactory.NewResourceScannerArgsForCall(0)
+				_, err := stderr.Write([]byte("bad\xff"))
+				if err != nil {
+					return err
+				}
+
+				_, err = stderr.Write([]byte("\ufffd"))
+				return err
+			}
+		})
+
+		It("saves it without holding it back", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.SaveEventCallCount()).To(Equal(2))
+			Expect(fakeCheck.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Time:    fakeClock.Now().Unix(),
+				Origin:  event.Origin{Source: event.OriginSourceStderr},
+				Payload: "bad\xff",
+			}))
+			Expect(fakeCheck.SaveEventArgsForCall(1)).To(Equal(event.Log{
+				Time:    fakeClock.Now().Unix(),
+				Origin:  event.Origin{Source: event.OriginSourceStderr},
+				Payload: "\ufffd",
+			}))
+		})
+	})
+
+	Context("when the check finishes in the middle of a character", func() {
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionStub = func(context.Context, lager.Logger, int, atc.Version) error {
+				_, stderr := fakeScannerFactory.NewResourceScannerArgsForCall(0)
+				_, err := stderr.Write([]byte("caf\xc3"))
+				return err
+			}
+		})
+
+		It("saves what was held back once the check is done", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.SaveEventCallCount()).To(Equal(2))
+			Expect(fakeCheck.SaveEventArgsForCall(1)).To(Equal(event.Log{
+				Time:    fakeClock.Now().Unix(),
+				Origin:  event.Origin{Source: event.OriginSourceStderr},
+				Payload: "\xc3",
+			}))
+		})
+	})
+
+	Context("when the check fails", func() {
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionReturns(errors.New("nope"))
+		})
+
+		It("records the error", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishArgsForCall(0)).To(MatchError("nope"))
+		})
+
+		It("saves an error event", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeCheck.SaveEventArgsForCall(0)).To(Equal(event.Error{
+				Message: "nope",
+				Time:    fakeClock.Now().Unix(),
+			}))
+		})
+	})
+
+	Context("when the check was created on an interval", func() {
+		BeforeEach(func() {
+			fakeCheck.CreatedByReturns(atc.CheckCreatedByInterval)
+			fakeScanner.RunReturns(time.Minute, ErrFailedToAcquireLock)
+		})
+
+		It("runs the scanner, which may skip checking if another ATC is", func() {
+			Eventually(fakeCheck.SkipCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishCallCount()).To(BeZero())
+
+			Expect(fakeScanner.RunCallCount()).To(Equal(1))
+			_, _, resourceID := fakeScanner.RunArgsForCall(0)
+			Expect(resourceID).To(Equal(7))
+		})
+	})
+
+	Context("when the check was created by a webhook", func() {
+		BeforeEach(func() {
+			fakeCheck.CreatedByReturns(atc.CheckCreatedByWebhook)
+		})
+
+		It("scans the resource", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeScanner.ScanCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the check is of a resource type", func() {
+		BeforeEach(func() {
+			fakeCheck.ResourceIDReturns(0)
+			fakeCheck.ResourceTypeIDReturns(9)
+		})
+
+		It("uses the resource type scanner", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeScannerFactory.NewResourceTypeScannerCallCount()).To(Equal(1))
+
+			_, _, resourceTypeID, _ := fakeScanner.ScanFromVersionArgsForCall(0)
+			Expect(resourceTypeID).To(Equal(9))
+		})
+	})
+
+	Context("when the pipeline is gone", func() {
+		BeforeEach(func() {
+			fakeCheck.PipelineReturns(nil, false, nil)
+		})
+
+		It("errors the check", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishArgsForCall(0)).To(HaveOccurred())
+			Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when all of the workers are busy", func() {
+		var release chan struct{}
+
+		BeforeEach(func() {
+			workers = 1
+			release = make(chan struct{})
+
+			fakeCheckFactory.StartNextCheckReturnsOnCall(1, fakeCheck, true, nil)
+
+			fakeScanner.ScanFromVersionStub = func(context.Context, lager.Logger, int, atc.Version) error {
+				<-release
+				return nil
+			}
+		})
+
+		It("starts the next check once one finishes", func() {
+			Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
+
+			checkCreated <- true
+			Consistently(fakeCheckFactory.StartNextCheckCallCount).Should(Equal(1))
+
+			release <- struct{}{}
+			Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(2))
+
+			release <- struct{}{}
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(2))
+		})
+	})
+
+	Context("when a check is created", func() {
+		It("starts it", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+
+			// once with each free worker, and once more when the check finished
+			Eventually(fakeCheckFactory.StartNextCheckCallCount).Should(Equal(3))
+
+			fakeCheckFactory.StartNextCheckReturnsOnCall(3, fakeCheck, true, nil)
+			checkCreated <- true
+
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(2))
+		})
+	})
+
+	Context("when signalled while a check is running", func() {
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionStub = func(ctx context.Context, _ lager.Logger, _ int, _ atc.Version) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+		})
+
+		It("interrupts the check and waits for it to finish", func() {
+			Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
+
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive())
+
+			Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+			Expect(fakeCheck.FinishArgsForCall(0)).To(Equal(context.Canceled))
+		})
+	})
+})
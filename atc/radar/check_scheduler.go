@@ -0,0 +1,159 @@
+package radar
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// CheckScheduler queues up the interval checks of every pipeline's resources.
+// Resources sharing a resource config scope, e.g. the same git repository
+// used by many pipelines with global resources enabled, are checked once for
+// all of them, on the shortest check_every of any of them. Scopes which keep
+// failing to check are checked less and less often, up to maxInterval.
+//
+// Checks of resource types with a rate limit, e.g. to stay under GitHub's
+// rate limit when there are many git resources, are only queued up while the
+// checks created by every ATC in the last runInterval are under the limit.
+// Checks which would exceed it are queued up on a later run instead.
+type CheckScheduler struct {
+	checkFactory    db.CheckFactory
+	defaultInterval time.Duration
+	maxInterval     time.Duration
+	rateLimits      map[string]float64
+	runInterval     time.Duration
+}
+
+func NewCheckScheduler(
+	checkFactory db.CheckFactory,
+	defaultInterval time.Duration,
+	maxInterval time.Duration,
+	rateLimits map[string]float64,
+	runInterval time.Duration,
+) *CheckScheduler {
+	return &CheckScheduler{
+		checkFactory:    checkFactory,
+		defaultInterval: defaultInterval,
+		maxInterval:     maxInterval,
+		rateLimits:      rateLimits,
+		runInterval:     runInterval,
+	}
+}
+
+func (scheduler *CheckScheduler) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("check-scheduler")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	resources, err := scheduler.checkFactory.Resources()
+	if err != nil {
+		logger.Error("failed-to-get-resources", err)
+		return err
+	}
+
+	type scopeCheck struct {
+		resource db.Resource
+		interval time.Duration
+	}
+
+	var scopeIDs []int
+	scopeChecks := map[int]scopeCheck{}
+
+	budgets := checkBudgets{}
+
+	for _, resource := range resources {
+		interval := backOff(
+			checkEveryOrDefault(resource.CheckEvery(), scheduler.defaultInterval),
+			resource.ConsecutiveCheckErrors(),
+			scheduler.maxInterval,
+		)
+
+		// resources which have never been checked, or whose type or source
+		// changed since they were last checked, have no scope yet, so are
+		// checked on their own
+		scopeID := resource.ResourceConfigScopeID()
+		if scopeID == 0 {
+			scheduler.schedule(logger, budgets, resource, interval)
+			continue
+		}
+
+		scoped, found := scopeChecks[scopeID]
+		if !found {
+			scopeIDs = append(scopeIDs, scopeID)
+		}
+
+		if !found || interval < scoped.interval {
+			scopeChecks[scopeID] = scopeCheck{resource, interval}
+		}
+	}
+
+	for _, scopeID := range scopeIDs {
+		scoped := scopeChecks[scopeID]
+		scheduler.schedule(logger, budgets, scoped.resource, scoped.interval)
+	}
+
+	return nil
+}
+
+// checkBudgets tracks how many more checks of each rate limited resource type
+// may be queued up during a run.
+type checkBudgets map[string]int
+
+func (scheduler *CheckScheduler) schedule(logger lager.Logger, budgets checkBudgets, resource db.Resource, interval time.Duration) {
+	logger = logger.WithData(lager.Data{
+		"pipeline": resource.PipelineName(),
+		"resource": resource.Name(),
+	})
+
+	limit := scheduler.rateLimits[resource.Type()]
+	if limit > 0 {
+		budget, found := budgets[resource.Type()]
+		if !found {
+			var err error
+			budget, err = scheduler.budget(resource.Type(), limit)
+			if err != nil {
+				logger.Error("failed-to-count-recent-checks", err)
+				return
+			}
+		}
+
+		budgets[resource.Type()] = budget
+
+		if budget <= 0 {
+			logger.Debug("rate-limited")
+			return
+		}
+	}
+
+	created, err := scheduler.checkFactory.ScheduleResourceCheck(resource.ID(), interval)
+	if err != nil {
+		logger.Error("failed-to-schedule-resource-check", err)
+		return
+	}
+
+	if created && limit > 0 {
+		budgets[resource.Type()]--
+	}
+}
+
+// budget is how many more checks of the resource type may be created without
+// exceeding its limit, counting the checks created by every ATC over the last
+// run interval, or over the time it takes for one check to be allowed if
+// that's longer.
+func (scheduler *CheckScheduler) budget(resourceType string, limit float64) (int, error) {
+	window := time.Duration(float64(time.Second) / limit)
+	if window < scheduler.runInterval {
+		window = scheduler.runInterval
+	}
+
+	recent, err := scheduler.checkFactory.CountRecentResourceChecks(resourceType, window)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(limit*window.Seconds()) - recent, nil
+}
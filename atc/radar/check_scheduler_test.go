@@ -0,0 +1,198 @@
+package radar_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/radar"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckScheduler", func() {
+	var (
+		fakeCheckFactory *dbfakes.FakeCheckFactory
+		rateLimits       map[string]float64
+		runErr           error
+	)
+
+	newResource := func(id int, scopeID int, checkEvery string) *dbfakes.FakeResource {
+		resource := new(dbfakes.FakeResource)
+		resource.IDReturns(id)
+		resource.ResourceConfigScopeIDReturns(scopeID)
+		resource.CheckEveryReturns(checkEvery)
+		resource.TypeReturns("git")
+		return resource
+	}
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeCheckFactory.ScheduleResourceCheckReturns(true, nil)
+		rateLimits = nil
+	})
+
+	JustBeforeEach(func() {
+		scheduler := NewCheckScheduler(fakeCheckFactory, time.Minute, time.Hour, rateLimits, 10*time.Second)
+
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = scheduler.Run(ctx)
+	})
+
+	Context("when resources share a resource config scope", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.ResourcesReturns([]db.Resource{
+				newResource(1, 10, ""),
+				newResource(2, 10, "30s"),
+				newResource(3, 10, "5m"),
+				newResource(4, 20, "bogus"),
+			}, nil)
+		})
+
+		It("schedules one check per scope, on the shortest interval of its resources", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(Equal(2))
+
+			resourceID, interval := fakeCheckFactory.ScheduleResourceCheckArgsForCall(0)
+			Expect(resourceID).To(Equal(2))
+			Expect(interval).To(Equal(30 * time.Second))
+
+			resourceID, interval = fakeCheckFactory.ScheduleResourceCheckArgsForCall(1)
+			Expect(resourceID).To(Equal(4))
+			Expect(interval).To(Equal(time.Minute))
+		})
+	})
+
+	Context("when resources have not been checked yet", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.ResourcesReturns([]db.Resource{
+				newResource(1, 0, ""),
+				newResource(2, 0, "10s"),
+			}, nil)
+		})
+
+		It("schedules each of their checks", func() {
+			Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(Equal(2))
+
+			resourceID, interval := fakeCheckFactory.ScheduleResourceCheckArgsForCall(0)
+			Expect(resourceID).To(Equal(1))
+			Expect(interval).To(Equal(time.Minute))
+
+			resourceID, interval = fakeCheckFactory.ScheduleResourceCheckArgsForCall(1)
+			Expect(resourceID).To(Equal(2))
+			Expect(interval).To(Equal(10 * time.Second))
+		})
+	})
+
+	Context("when a scope keeps failing to check", func() {
+		BeforeEach(func() {
+			failing := newResource(1, 10, "")
+			failing.ConsecutiveCheckErrorsReturns(2)
+
+			fakeCheckFactory.ResourcesReturns([]db.Resource{failing}, nil)
+		})
+
+		It("schedules its check on a backed off interval", func() {
+			_, interval := fakeCheckFactory.ScheduleResourceCheckArgsForCall(0)
+			Expect(interval).To(Equal(4 * time.Minute))
+		})
+	})
+
+	Context("when the resources' type is rate limited", func() {
+		BeforeEach(func() {
+			rateLimits = map[string]float64{"git": 0.3}
+
+			fakeCheckFactory.ResourcesReturns([]db.Resource{
+				newResource(1, 10, ""),
+				newResource(2, 20, ""),
+				newResource(3, 30, ""),
+				newResource(4, 40, ""),
+			}, nil)
+		})
+
+		Context("when no checks of the type were created recently", func() {
+			It("schedules as many checks as the limit allows per run", func() {
+				Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(Equal(3))
+
+				resourceType, within := fakeCheckFactory.CountRecentResourceChecksArgsForCall(0)
+				Expect(resourceType).To(Equal("git"))
+				Expect(within).To(Equal(10 * time.Second))
+			})
+		})
+
+		Context("when checks of the type were created recently", func() {
+			BeforeEach(func() {
+				fakeCheckFactory.CountRecentResourceChecksReturns(2, nil)
+			})
+
+			It("only schedules the remainder", func() {
+				Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(Equal(1))
+				Expect(fakeCheckFactory.CountRecentResourceChecksCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a check is already queued up", func() {
+			BeforeEach(func() {
+				fakeCheckFactory.ScheduleResourceCheckReturnsOnCall(0, false, nil)
+			})
+
+			It("does not count it against the limit", func() {
+				Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(Equal(4))
+			})
+		})
+
+		Context("when the limit is less than one check per run", func() {
+			BeforeEach(func() {
+				rateLimits = map[string]float64{"git": 0.05}
+			})
+
+			It("counts the checks created over the time it takes for one check to be allowed", func() {
+				Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(Equal(1))
+
+				_, within := fakeCheckFactory.CountRecentResourceChecksArgsForCall(0)
+				Expect(within).To(Equal(20 * time.Second))
+			})
+		})
+
+		Context("when counting the recent checks fails", func() {
+			BeforeEach(func() {
+				fakeCheckFactory.CountRecentResourceChecksReturns(0, errors.New("nope"))
+			})
+
+			It("does not schedule them", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when scheduling a check fails", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.ResourcesReturns([]db.Resource{
+				newResource(1, 10, ""),
+				newResource(2, 20, ""),
+			}, nil)
+			fakeCheckFactory.ScheduleResourceCheckReturnsOnCall(0, false, errors.New("nope"))
+		})
+
+		It("carries on with the other scopes", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeCheckFactory.ScheduleResourceCheckCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when getting the resources fails", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.ResourcesReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
@@ -2,6 +2,7 @@
 package radarfakes
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -11,11 +12,12 @@ import (
 )
 
 type FakeScanner struct {
-	RunStub        func(lager.Logger, int) (time.Duration, error)
+	RunStub        func(context.Context, lager.Logger, int) (time.Duration, error)
 	runMutex       sync.RWMutex
 	runArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 int
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
 	}
 	runReturns struct {
 		result1 time.Duration
@@ -25,11 +27,12 @@ type FakeScanner struct {
 		result1 time.Duration
 		result2 error
 	}
-	ScanStub        func(lager.Logger, int) error
+	ScanStub        func(context.Context, lager.Logger, int) error
 	scanMutex       sync.RWMutex
 	scanArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 int
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
 	}
 	scanReturns struct {
 		result1 error
@@ -37,12 +40,13 @@ type FakeScanner struct {
 	scanReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ScanFromVersionStub        func(lager.Logger, int, atc.Version) error
+	ScanFromVersionStub        func(context.Context, lager.Logger, int, atc.Version) error
 	scanFromVersionMutex       sync.RWMutex
 	scanFromVersionArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 int
-		arg3 atc.Version
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+		arg4 atc.Version
 	}
 	scanFromVersionReturns struct {
 		result1 error
@@ -54,17 +58,18 @@ type FakeScanner struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeScanner) Run(arg1 lager.Logger, arg2 int) (time.Duration, error) {
+func (fake *FakeScanner) Run(arg1 context.Context, arg2 lager.Logger, arg3 int) (time.Duration, error) {
 	fake.runMutex.Lock()
 	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
 	fake.runArgsForCall = append(fake.runArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 int
-	}{arg1, arg2})
-	fake.recordInvocation("Run", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Run", []interface{}{arg1, arg2, arg3})
 	fake.runMutex.Unlock()
 	if fake.RunStub != nil {
-		return fake.RunStub(arg1, arg2)
+		return fake.RunStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -79,17 +84,17 @@ func (fake *FakeScanner) RunCallCount() int {
 	return len(fake.runArgsForCall)
 }
 
-func (fake *FakeScanner) RunCalls(stub func(lager.Logger, int) (time.Duration, error)) {
+func (fake *FakeScanner) RunCalls(stub func(context.Context, lager.Logger, int) (time.Duration, error)) {
 	fake.runMutex.Lock()
 	defer fake.runMutex.Unlock()
 	fake.RunStub = stub
 }
 
-func (fake *FakeScanner) RunArgsForCall(i int) (lager.Logger, int) {
+func (fake *FakeScanner) RunArgsForCall(i int) (context.Context, lager.Logger, int) {
 	fake.runMutex.RLock()
 	defer fake.runMutex.RUnlock()
 	argsForCall := fake.runArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeScanner) RunReturns(result1 time.Duration, result2 error) {
@@ -118,17 +123,18 @@ func (fake *FakeScanner) RunReturnsOnCall(i int, result1 time.Duration, result2
 	}{result1, result2}
 }
 
-func (fake *FakeScanner) Scan(arg1 lager.Logger, arg2 int) error {
+func (fake *FakeScanner) Scan(arg1 context.Context, arg2 lager.Logger, arg3 int) error {
 	fake.scanMutex.Lock()
 	ret, specificReturn := fake.scanReturnsOnCall[len(fake.scanArgsForCall)]
 	fake.scanArgsForCall = append(fake.scanArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 int
-	}{arg1, arg2})
-	fake.recordInvocation("Scan", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Scan", []interface{}{arg1, arg2, arg3})
 	fake.scanMutex.Unlock()
 	if fake.ScanStub != nil {
-		return fake.ScanStub(arg1, arg2)
+		return fake.ScanStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -143,17 +149,17 @@ func (fake *FakeScanner) ScanCallCount() int {
 	return len(fake.scanArgsForCall)
 }
 
-func (fake *FakeScanner) ScanCalls(stub func(lager.Logger, int) error) {
+func (fake *FakeScanner) ScanCalls(stub func(context.Context, lager.Logger, int) error) {
 	fake.scanMutex.Lock()
 	defer fake.scanMutex.Unlock()
 	fake.ScanStub = stub
 }
 
-func (fake *FakeScanner) ScanArgsForCall(i int) (lager.Logger, int) {
+func (fake *FakeScanner) ScanArgsForCall(i int) (context.Context, lager.Logger, int) {
 	fake.scanMutex.RLock()
 	defer fake.scanMutex.RUnlock()
 	argsForCall := fake.scanArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeScanner) ScanReturns(result1 error) {
@@ -179,18 +185,19 @@ func (fake *FakeScanner) ScanReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeScanner) ScanFromVersion(arg1 lager.Logger, arg2 int, arg3 atc.Version) error {
+func (fake *FakeScanner) ScanFromVersion(arg1 context.Context, arg2 lager.Logger, arg3 int, arg4 atc.Version) error {
 	fake.scanFromVersionMutex.Lock()
 	ret, specificReturn := fake.scanFromVersionReturnsOnCall[len(fake.scanFromVersionArgsForCall)]
 	fake.scanFromVersionArgsForCall = append(fake.scanFromVersionArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 int
-		arg3 atc.Version
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("ScanFromVersion", []interface{}{arg1, arg2, arg3})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+		arg4 atc.Version
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ScanFromVersion", []interface{}{arg1, arg2, arg3, arg4})
 	fake.scanFromVersionMutex.Unlock()
 	if fake.ScanFromVersionStub != nil {
-		return fake.ScanFromVersionStub(arg1, arg2, arg3)
+		return fake.ScanFromVersionStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -205,17 +212,17 @@ func (fake *FakeScanner) ScanFromVersionCallCount() int {
 	return len(fake.scanFromVersionArgsForCall)
 }
 
-func (fake *FakeScanner) ScanFromVersionCalls(stub func(lager.Logger, int, atc.Version) error) {
+func (fake *FakeScanner) ScanFromVersionCalls(stub func(context.Context, lager.Logger, int, atc.Version) error) {
 	fake.scanFromVersionMutex.Lock()
 	defer fake.scanFromVersionMutex.Unlock()
 	fake.ScanFromVersionStub = stub
 }
 
-func (fake *FakeScanner) ScanFromVersionArgsForCall(i int) (lager.Logger, int, atc.Version) {
+func (fake *FakeScanner) ScanFromVersionArgsForCall(i int) (context.Context, lager.Logger, int, atc.Version) {
 	fake.scanFromVersionMutex.RLock()
 	defer fake.scanFromVersionMutex.RUnlock()
 	argsForCall := fake.scanFromVersionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeScanner) ScanFromVersionReturns(result1 error) {
@@ -246,10 +253,10 @@ func (fake *FakeScanner) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.runMutex.RLock()
 	defer fake.runMutex.RUnlock()
-	fake.scanMutex.RLock()
-	defer fake.scanMutex.RUnlock()
 	fake.scanFromVersionMutex.RLock()
 	defer fake.scanFromVersionMutex.RUnlock()
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
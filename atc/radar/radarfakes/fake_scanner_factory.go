@@ -1,19 +1,20 @@
 // Code generated by counterfeiter. DO NOT EDIT.
-package resourceserverfakes
+package radarfakes
 
 import (
+	"io"
 	"sync"
 
-	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/radar"
 )
 
 type FakeScannerFactory struct {
-	NewResourceScannerStub        func(db.Pipeline) radar.Scanner
+	NewResourceScannerStub        func(db.Pipeline, io.Writer) radar.Scanner
 	newResourceScannerMutex       sync.RWMutex
 	newResourceScannerArgsForCall []struct {
 		arg1 db.Pipeline
+		arg2 io.Writer
 	}
 	newResourceScannerReturns struct {
 		result1 radar.Scanner
@@ -21,10 +22,11 @@ type FakeScannerFactory struct {
 	newResourceScannerReturnsOnCall map[int]struct {
 		result1 radar.Scanner
 	}
-	NewResourceTypeScannerStub        func(db.Pipeline) radar.Scanner
+	NewResourceTypeScannerStub        func(db.Pipeline, io.Writer) radar.Scanner
 	newResourceTypeScannerMutex       sync.RWMutex
 	newResourceTypeScannerArgsForCall []struct {
 		arg1 db.Pipeline
+		arg2 io.Writer
 	}
 	newResourceTypeScannerReturns struct {
 		result1 radar.Scanner
@@ -36,16 +38,17 @@ type FakeScannerFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeScannerFactory) NewResourceScanner(arg1 db.Pipeline) radar.Scanner {
+func (fake *FakeScannerFactory) NewResourceScanner(arg1 db.Pipeline, arg2 io.Writer) radar.Scanner {
 	fake.newResourceScannerMutex.Lock()
 	ret, specificReturn := fake.newResourceScannerReturnsOnCall[len(fake.newResourceScannerArgsForCall)]
 	fake.newResourceScannerArgsForCall = append(fake.newResourceScannerArgsForCall, struct {
 		arg1 db.Pipeline
-	}{arg1})
-	fake.recordInvocation("NewResourceScanner", []interface{}{arg1})
+		arg2 io.Writer
+	}{arg1, arg2})
+	fake.recordInvocation("NewResourceScanner", []interface{}{arg1, arg2})
 	fake.newResourceScannerMutex.Unlock()
 	if fake.NewResourceScannerStub != nil {
-		return fake.NewResourceScannerStub(arg1)
+		return fake.NewResourceScannerStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -60,17 +63,17 @@ func (fake *FakeScannerFactory) NewResourceScannerCallCount() int {
 	return len(fake.newResourceScannerArgsForCall)
 }
 
-func (fake *FakeScannerFactory) NewResourceScannerCalls(stub func(db.Pipeline) radar.Scanner) {
+func (fake *FakeScannerFactory) NewResourceScannerCalls(stub func(db.Pipeline, io.Writer) radar.Scanner) {
 	fake.newResourceScannerMutex.Lock()
 	defer fake.newResourceScannerMutex.Unlock()
 	fake.NewResourceScannerStub = stub
 }
 
-func (fake *FakeScannerFactory) NewResourceScannerArgsForCall(i int) db.Pipeline {
+func (fake *FakeScannerFactory) NewResourceScannerArgsForCall(i int) (db.Pipeline, io.Writer) {
 	fake.newResourceScannerMutex.RLock()
 	defer fake.newResourceScannerMutex.RUnlock()
 	argsForCall := fake.newResourceScannerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeScannerFactory) NewResourceScannerReturns(result1 radar.Scanner) {
@@ -96,16 +99,17 @@ func (fake *FakeScannerFactory) NewResourceScannerReturnsOnCall(i int, result1 r
 	}{result1}
 }
 
-func (fake *FakeScannerFactory) NewResourceTypeScanner(arg1 db.Pipeline) radar.Scanner {
+func (fake *FakeScannerFactory) NewResourceTypeScanner(arg1 db.Pipeline, arg2 io.Writer) radar.Scanner {
 	fake.newResourceTypeScannerMutex.Lock()
 	ret, specificReturn := fake.newResourceTypeScannerReturnsOnCall[len(fake.newResourceTypeScannerArgsForCall)]
 	fake.newResourceTypeScannerArgsForCall = append(fake.newResourceTypeScannerArgsForCall, struct {
 		arg1 db.Pipeline
-	}{arg1})
-	fake.recordInvocation("NewResourceTypeScanner", []interface{}{arg1})
+		arg2 io.Writer
+	}{arg1, arg2})
+	fake.recordInvocation("NewResourceTypeScanner", []interface{}{arg1, arg2})
 	fake.newResourceTypeScannerMutex.Unlock()
 	if fake.NewResourceTypeScannerStub != nil {
-		return fake.NewResourceTypeScannerStub(arg1)
+		return fake.NewResourceTypeScannerStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -120,17 +124,17 @@ func (fake *FakeScannerFactory) NewResourceTypeScannerCallCount() int {
 	return len(fake.newResourceTypeScannerArgsForCall)
 }
 
-func (fake *FakeScannerFactory) NewResourceTypeScannerCalls(stub func(db.Pipeline) radar.Scanner) {
+func (fake *FakeScannerFactory) NewResourceTypeScannerCalls(stub func(db.Pipeline, io.Writer) radar.Scanner) {
 	fake.newResourceTypeScannerMutex.Lock()
 	defer fake.newResourceTypeScannerMutex.Unlock()
 	fake.NewResourceTypeScannerStub = stub
 }
 
-func (fake *FakeScannerFactory) NewResourceTypeScannerArgsForCall(i int) db.Pipeline {
+func (fake *FakeScannerFactory) NewResourceTypeScannerArgsForCall(i int) (db.Pipeline, io.Writer) {
 	fake.newResourceTypeScannerMutex.RLock()
 	defer fake.newResourceTypeScannerMutex.RUnlock()
 	argsForCall := fake.newResourceTypeScannerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeScannerFactory) NewResourceTypeScannerReturns(result1 radar.Scanner) {
@@ -182,4 +186,4 @@ func (fake *FakeScannerFactory) recordInvocation(key string, args []interface{})
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ resourceserver.ScannerFactory = new(FakeScannerFactory)
+var _ radar.ScannerFactory = new(FakeScannerFactory)
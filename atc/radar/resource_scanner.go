@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"time"
 
@@ -26,10 +27,12 @@ type resourceScanner struct {
 	resourceFactory       resource.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
 	defaultInterval       time.Duration
+	maxInterval           time.Duration
 	dbPipeline            db.Pipeline
 	externalURL           string
 	variables             vars.Variables
 	strategy              worker.ContainerPlacementStrategy
+	stderr                io.Writer
 }
 
 func NewResourceScanner(
@@ -38,10 +41,12 @@ func NewResourceScanner(
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	defaultInterval time.Duration,
+	maxInterval time.Duration,
 	dbPipeline db.Pipeline,
 	externalURL string,
 	variables vars.Variables,
 	strategy worker.ContainerPlacementStrategy,
+	stderr io.Writer,
 ) Scanner {
 	return &resourceScanner{
 		clock:                 clock,
@@ -49,10 +54,12 @@ func NewResourceScanner(
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
 		defaultInterval:       defaultInterval,
+		maxInterval:           maxInterval,
 		dbPipeline:            dbPipeline,
 		externalURL:           externalURL,
 		variables:             variables,
 		strategy:              strategy,
+		stderr:                stderr,
 	}
 }
 
@@ -60,29 +67,23 @@ var ErrFailedToAcquireLock = errors.New("failed to acquire lock")
 var ErrResourceTypeNotFound = errors.New("resource type not found")
 var ErrResourceTypeCheckError = errors.New("resource type failed to check")
 
-func (scanner *resourceScanner) Run(logger lager.Logger, resourceID int) (time.Duration, error) {
-	interval, err := scanner.scan(logger.Session("tick"), resourceID, nil, false, false)
-
-	err = swallowErrResourceScriptFailed(err)
-
-	return interval, err
+func (scanner *resourceScanner) Run(ctx context.Context, logger lager.Logger, resourceID int) (time.Duration, error) {
+	return scanner.scan(ctx, logger.Session("tick"), resourceID, nil, false, false)
 }
 
-func (scanner *resourceScanner) ScanFromVersion(logger lager.Logger, resourceID int, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceID, fromVersion, true, true)
+func (scanner *resourceScanner) ScanFromVersion(ctx context.Context, logger lager.Logger, resourceID int, fromVersion atc.Version) error {
+	_, err := scanner.scan(ctx, logger, resourceID, fromVersion, true, true)
 
 	return err
 }
 
-func (scanner *resourceScanner) Scan(logger lager.Logger, resourceID int) error {
-	_, err := scanner.scan(logger, resourceID, nil, true, false)
-
-	err = swallowErrResourceScriptFailed(err)
+func (scanner *resourceScanner) Scan(ctx context.Context, logger lager.Logger, resourceID int) error {
+	_, err := scanner.scan(ctx, logger, resourceID, nil, true, false)
 
 	return err
 }
 
-func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceScanner) scan(ctx context.Context, logger lager.Logger, resourceID int, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
 	savedResource, found, err := scanner.dbPipeline.ResourceByID(resourceID)
 	if err != nil {
 		return 0, err
@@ -104,7 +105,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 		return 0, err
 	}
 
-	interval, err := scanner.checkInterval(savedResource.CheckEvery())
+	interval, err := scanner.checkInterval(savedResource.CheckEvery(), savedResource.ConsecutiveCheckErrors())
 	if err != nil {
 		scanner.setResourceCheckError(logger, savedResource, err)
 		logger.Error("failed-to-read-check-interval", err)
@@ -133,7 +134,10 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 				return 0, ErrResourceTypeCheckError
 			} else {
 				logger.Debug("waiting-on-resource-type-version", lager.Data{"resource-type": parentType.Name()})
-				scanner.clock.Sleep(10 * time.Second)
+				err = sleep(ctx, scanner.clock, 10*time.Second)
+				if err != nil {
+					return 0, err
+				}
 
 				found, err := parentType.Reload()
 				if err != nil {
@@ -219,7 +223,12 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 
 		if !acquired {
 			lockLogger.Debug("did-not-get-lock")
-			scanner.clock.Sleep(time.Second)
+
+			err = sleep(ctx, scanner.clock, time.Second)
+			if err != nil {
+				return interval, err
+			}
+
 			continue
 		}
 
@@ -253,6 +262,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 	}
 
 	return interval, scanner.check(
+		ctx,
 		logger,
 		savedResource,
 		resourceConfigScope,
@@ -265,6 +275,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 }
 
 func (scanner *resourceScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResource db.Resource,
 	resourceConfigScope db.ResourceConfigScope,
@@ -323,7 +334,7 @@ func (scanner *resourceScanner) check(
 	owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries)
 
 	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(
-		context.Background(),
+		ctx,
 		logger,
 		owner,
 		containerSpec,
@@ -340,7 +351,7 @@ func (scanner *resourceScanner) check(
 	}
 
 	container, err := chosenWorker.FindOrCreateContainer(
-		context.Background(),
+		ctx,
 		logger,
 		worker.NoopImageFetchingDelegate{},
 		owner,
@@ -370,11 +381,11 @@ func (scanner *resourceScanner) check(
 		"from": fromVersion,
 	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	res := scanner.resourceFactory.NewResourceForContainer(container)
-	newVersions, err := res.Check(ctx, source, fromVersion)
+	newVersions, err := res.Check(checkCtx, resource.IOConfig{Stderr: scanner.stderr}, source, fromVersion)
 	if err == context.DeadlineExceeded {
 		err = fmt.Errorf("Timed out after %v while checking for new versions - perhaps increase your resource check timeout?", timeout)
 	}
@@ -427,13 +438,6 @@ func (scanner *resourceScanner) check(
 	return nil
 }
 
-func swallowErrResourceScriptFailed(err error) error {
-	if _, ok := err.(resource.ErrResourceScriptFailed); ok {
-		return nil
-	}
-	return err
-}
-
 func (scanner *resourceScanner) parseResourceCheckTimeoutOrDefault(checkTimeout string) (time.Duration, error) {
 	interval := GlobalResourceCheckTimeout
 	if checkTimeout != "" {
@@ -448,7 +452,7 @@ func (scanner *resourceScanner) parseResourceCheckTimeoutOrDefault(checkTimeout
 	return interval, nil
 }
 
-func (scanner *resourceScanner) checkInterval(checkEvery string) (time.Duration, error) {
+func (scanner *resourceScanner) checkInterval(checkEvery string, consecutiveErrors int) (time.Duration, error) {
 	interval := scanner.defaultInterval
 	if checkEvery != "" {
 		configuredInterval, err := time.ParseDuration(checkEvery)
@@ -459,7 +463,7 @@ func (scanner *resourceScanner) checkInterval(checkEvery string) (time.Duration,
 		interval = configuredInterval
 	}
 
-	return interval, nil
+	return backOff(interval, consecutiveErrors, scanner.maxInterval), nil
 }
 
 func (scanner *resourceScanner) setResourceCheckError(logger lager.Logger, savedResource db.Resource, err error) {
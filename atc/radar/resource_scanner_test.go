@@ -23,6 +23,7 @@ import (
 	rfakes "github.com/concourse/concourse/atc/resource/resourcefakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("ResourceScanner", func() {
@@ -34,6 +35,7 @@ var _ = Describe("ResourceScanner", func() {
 		fakeWorker                *workerfakes.FakeWorker
 		fakePool                  *workerfakes.FakePool
 		fakeStrategy              *workerfakes.FakeContainerPlacementStrategy
+		stderr                    *gbytes.Buffer
 		fakeResourceFactory       *rfakes.FakeResourceFactory
 		fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
 		fakeDBPipeline            *dbfakes.FakePipeline
@@ -85,6 +87,7 @@ var _ = Describe("ResourceScanner", func() {
 
 		fakeContainer = new(workerfakes.FakeContainer)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
+		stderr = gbytes.NewBuffer()
 		fakePool = new(workerfakes.FakePool)
 		fakeWorker = new(workerfakes.FakeWorker)
 		fakeResourceFactory = new(rfakes.FakeResourceFactory)
@@ -128,10 +131,12 @@ var _ = Describe("ResourceScanner", func() {
 			fakeResourceFactory,
 			fakeResourceConfigFactory,
 			interval,
+			time.Hour,
 			fakeDBPipeline,
 			"https://www.example.com",
 			variables,
 			fakeStrategy,
+			stderr,
 		)
 	})
 
@@ -154,7 +159,7 @@ var _ = Describe("ResourceScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualInterval, runErr = scanner.Run(scanLogger, 39)
+			actualInterval, runErr = scanner.Run(context.TODO(), scanLogger, 39)
 		})
 
 		Context("when the lock cannot be acquired", func() {
@@ -316,6 +321,28 @@ var _ = Describe("ResourceScanner", func() {
 					})
 				})
 
+				Context("when the resource has failed to check several times in a row", func() {
+					BeforeEach(func() {
+						fakeDBResource.ConsecutiveCheckErrorsReturns(3)
+					})
+
+					It("backs off exponentially", func() {
+						leaseInterval, _ := fakeResourceConfigScope.UpdateLastCheckStartTimeArgsForCall(0)
+						Expect(leaseInterval).To(Equal(8 * interval))
+						Expect(actualInterval).To(Equal(8 * interval))
+					})
+
+					Context("when backing off would exceed the maximum interval", func() {
+						BeforeEach(func() {
+							fakeDBResource.ConsecutiveCheckErrorsReturns(10)
+						})
+
+						It("backs off to the maximum interval", func() {
+							Expect(actualInterval).To(Equal(time.Hour))
+						})
+					})
+				})
+
 				It("grabs a periodic resource checking lock before checking, breaks lock after done", func() {
 					Expect(fakeResourceConfigScope.AcquireResourceCheckingLockCallCount()).To(Equal(1))
 					Expect(fakeResourceConfigScope.UpdateLastCheckStartTimeCallCount()).To(Equal(1))
@@ -412,9 +439,14 @@ var _ = Describe("ResourceScanner", func() {
 
 				Context("when there is no current version", func() {
 					It("checks from nil", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(BeNil())
 					})
+
+					It("streams the check's stderr", func() {
+						_, ioConfig, _, _ := fakeResource.CheckArgsForCall(0)
+						Expect(ioConfig.Stderr).To(Equal(stderr))
+					})
 				})
 
 				Context("when there is a current version", func() {
@@ -427,7 +459,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("checks from it", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "1"}))
 					})
 				})
@@ -451,7 +483,7 @@ var _ = Describe("ResourceScanner", func() {
 						}
 
 						check := 0
-						fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+						fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 							defer GinkgoRecover()
 
 							Expect(source).To(Equal(resourceConfig.Source))
@@ -506,8 +538,8 @@ var _ = Describe("ResourceScanner", func() {
 						fakeResource.CheckReturns(nil, scriptFail)
 					})
 
-					It("returns no error", func() {
-						Expect(runErr).NotTo(HaveOccurred())
+					It("returns the error", func() {
+						Expect(runErr).To(Equal(scriptFail))
 					})
 				})
 
@@ -588,7 +620,7 @@ var _ = Describe("ResourceScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			scanErr = scanner.Scan(lagertest.NewTestLogger("test"), 39)
+			scanErr = scanner.Scan(context.TODO(), lagertest.NewTestLogger("test"), 39)
 		})
 
 		Context("if the lock can be acquired and last checked updated", func() {
@@ -792,7 +824,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				It("times out after the specified timeout", func() {
 					now := time.Now()
-					ctx, _, _ := fakeResource.CheckArgsForCall(0)
+					ctx, _, _, _ := fakeResource.CheckArgsForCall(0)
 					deadline, _ := ctx.Deadline()
 					Expect(deadline).Should(BeTemporally("~", now.Add(10*time.Second), time.Second))
 				})
@@ -842,7 +874,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("checks from the pinned version", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "1"}))
 					})
 				})
@@ -874,7 +906,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -907,7 +939,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -941,7 +973,7 @@ var _ = Describe("ResourceScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(resourceConfig.Source))
@@ -982,7 +1014,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when the check does not return any new versions", func() {
 				BeforeEach(func() {
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						return []atc.Version{}, nil
 					}
 				})
@@ -1018,8 +1050,8 @@ var _ = Describe("ResourceScanner", func() {
 					fakeResource.CheckReturns(nil, scriptFail)
 				})
 
-				It("returns no error", func() {
-					Expect(scanErr).NotTo(HaveOccurred())
+				It("returns the error", func() {
+					Expect(scanErr).To(Equal(scriptFail))
 				})
 
 				It("sets the resource's check error", func() {
@@ -1054,7 +1086,7 @@ var _ = Describe("ResourceScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			scanErr = scanner.ScanFromVersion(lagertest.NewTestLogger("test"), 39, fromVersion)
+			scanErr = scanner.ScanFromVersion(context.TODO(), lagertest.NewTestLogger("test"), 39, fromVersion)
 		})
 
 		Context("if the lock can be acquired and last checked updated", func() {
@@ -1065,7 +1097,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -1078,7 +1110,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
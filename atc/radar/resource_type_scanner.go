@@ -2,6 +2,7 @@ package radar
 
 import (
 	"context"
+	"io"
 	"reflect"
 	"time"
 
@@ -25,6 +26,7 @@ type resourceTypeScanner struct {
 	externalURL           string
 	variables             vars.Variables
 	strategy              worker.ContainerPlacementStrategy
+	stderr                io.Writer
 }
 
 func NewResourceTypeScanner(
@@ -37,6 +39,7 @@ func NewResourceTypeScanner(
 	externalURL string,
 	variables vars.Variables,
 	strategy worker.ContainerPlacementStrategy,
+	stderr io.Writer,
 ) Scanner {
 	return &resourceTypeScanner{
 		clock:                 clock,
@@ -48,24 +51,25 @@ func NewResourceTypeScanner(
 		externalURL:           externalURL,
 		variables:             variables,
 		strategy:              strategy,
+		stderr:                stderr,
 	}
 }
 
-func (scanner *resourceTypeScanner) Run(logger lager.Logger, resourceTypeID int) (time.Duration, error) {
-	return scanner.scan(logger.Session("tick"), resourceTypeID, nil, false, false)
+func (scanner *resourceTypeScanner) Run(ctx context.Context, logger lager.Logger, resourceTypeID int) (time.Duration, error) {
+	return scanner.scan(ctx, logger.Session("tick"), resourceTypeID, nil, false, false)
 }
 
-func (scanner *resourceTypeScanner) ScanFromVersion(logger lager.Logger, resourceTypeID int, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceTypeID, fromVersion, true, true)
+func (scanner *resourceTypeScanner) ScanFromVersion(ctx context.Context, logger lager.Logger, resourceTypeID int, fromVersion atc.Version) error {
+	_, err := scanner.scan(ctx, logger, resourceTypeID, fromVersion, true, true)
 	return err
 }
 
-func (scanner *resourceTypeScanner) Scan(logger lager.Logger, resourceTypeID int) error {
-	_, err := scanner.scan(logger, resourceTypeID, nil, true, false)
+func (scanner *resourceTypeScanner) Scan(ctx context.Context, logger lager.Logger, resourceTypeID int) error {
+	_, err := scanner.scan(ctx, logger, resourceTypeID, nil, true, false)
 	return err
 }
 
-func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceTypeScanner) scan(ctx context.Context, logger lager.Logger, resourceTypeID int, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
 	savedResourceType, found, err := scanner.dbPipeline.ResourceTypeByID(resourceTypeID)
 	if err != nil {
 		logger.Error("failed-to-find-resource-type-in-db", err)
@@ -103,7 +107,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 			continue
 		}
 
-		if err = scanner.Scan(logger, parentType.ID()); err != nil {
+		if err = scanner.Scan(ctx, logger, parentType.ID()); err != nil {
 			logger.Error("failed-to-scan-parent-resource-type-version", err)
 			scanner.setCheckError(logger, savedResourceType, err)
 			return 0, err
@@ -163,7 +167,11 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 		if !acquired {
 			lockLogger.Debug("did-not-get-lock")
 			if mustComplete {
-				scanner.clock.Sleep(time.Second)
+				err = sleep(ctx, scanner.clock, time.Second)
+				if err != nil {
+					return interval, err
+				}
+
 				continue
 			} else {
 				return interval, ErrFailedToAcquireLock
@@ -184,7 +192,11 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 		if !updated {
 			lockLogger.Debug("did-not-update-last-checked")
 			if mustComplete {
-				scanner.clock.Sleep(time.Second)
+				err = sleep(ctx, scanner.clock, time.Second)
+				if err != nil {
+					return interval, err
+				}
+
 				continue
 			} else {
 				return interval, ErrFailedToAcquireLock
@@ -207,6 +219,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 	}
 
 	return interval, scanner.check(
+		ctx,
 		logger,
 		savedResourceType,
 		resourceConfigScope,
@@ -218,6 +231,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 }
 
 func (scanner *resourceTypeScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResourceType db.ResourceType,
 	resourceConfigScope db.ResourceConfigScope,
@@ -258,7 +272,7 @@ func (scanner *resourceTypeScanner) check(
 	owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries)
 
 	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(
-		context.Background(),
+		ctx,
 		logger,
 		owner,
 		containerSpec,
@@ -275,7 +289,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	container, err := chosenWorker.FindOrCreateContainer(
-		context.Background(),
+		ctx,
 		logger,
 		worker.NoopImageFetchingDelegate{},
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries),
@@ -295,7 +309,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	res := scanner.resourceFactory.NewResourceForContainer(container)
-	newVersions, err := res.Check(context.TODO(), source, fromVersion)
+	newVersions, err := res.Check(ctx, resource.IOConfig{Stderr: scanner.stderr}, source, fromVersion)
 	resourceConfigScope.SetCheckError(err)
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
@@ -22,6 +22,7 @@ import (
 	rfakes "github.com/concourse/concourse/atc/resource/resourcefakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("ResourceTypeScanner", func() {
@@ -32,6 +33,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		fakeWorker                *workerfakes.FakeWorker
 		fakePool                  *workerfakes.FakePool
 		fakeStrategy              *workerfakes.FakeContainerPlacementStrategy
+		stderr                    *gbytes.Buffer
 		fakeResourceFactory       *rfakes.FakeResourceFactory
 		fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
 		fakeDBPipeline            *dbfakes.FakePipeline
@@ -74,6 +76,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 		fakeContainer = new(workerfakes.FakeContainer)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
+		stderr = gbytes.NewBuffer()
 		fakePool = new(workerfakes.FakePool)
 		fakeWorker = new(workerfakes.FakeWorker)
 		fakeResourceFactory = new(rfakes.FakeResourceFactory)
@@ -111,6 +114,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 			"https://www.example.com",
 			variables,
 			fakeStrategy,
+			stderr,
 		)
 	})
 
@@ -133,7 +137,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualInterval, runErr = scanner.Run(lagertest.NewTestLogger("test"), fakeResourceType.ID())
+			actualInterval, runErr = scanner.Run(context.TODO(), lagertest.NewTestLogger("test"), fakeResourceType.ID())
 		})
 
 		Context("when the lock cannot be acquired", func() {
@@ -326,9 +330,14 @@ var _ = Describe("ResourceTypeScanner", func() {
 					})
 
 					It("checks from nil", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(BeNil())
 					})
+
+					It("streams the check's stderr", func() {
+						_, ioConfig, _, _ := fakeResource.CheckArgsForCall(0)
+						Expect(ioConfig.Stderr).To(Equal(stderr))
+					})
 				})
 
 				Context("when there is a current version", func() {
@@ -342,7 +351,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 					It("checks with it", func() {
 						Expect(fakeResource.CheckCallCount()).To(Equal(1))
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "42"}))
 					})
 				})
@@ -366,7 +375,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 						}
 
 						check := 0
-						fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+						fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 							defer GinkgoRecover()
 
 							Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -446,7 +455,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			runErr = scanner.Scan(lagertest.NewTestLogger("test"), fakeResourceType.ID())
+			runErr = scanner.Scan(context.TODO(), lagertest.NewTestLogger("test"), fakeResourceType.ID())
 		})
 
 		Context("when the lock can be acquired and last checked is updated", func() {
@@ -678,7 +687,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -694,7 +703,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 				It("checks with it", func() {
 					Expect(fakeResource.CheckCallCount()).To(Equal(1))
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "42"}))
 				})
 			})
@@ -718,7 +727,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -862,7 +871,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			scanErr = scanner.ScanFromVersion(lagertest.NewTestLogger("test"), 57, fromVersion)
+			scanErr = scanner.ScanFromVersion(context.TODO(), lagertest.NewTestLogger("test"), 57, fromVersion)
 		})
 
 		Context("if the lock can be acquired", func() {
@@ -878,7 +887,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from the current version", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"custom": "version"}))
 				})
 			})
@@ -891,7 +900,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
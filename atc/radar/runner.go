@@ -1,45 +1,43 @@
 package radar
 
 import (
-	"context"
 	"os"
-	"sync"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
 )
 
-//go:generate counterfeiter . ScanRunnerFactory
-
+// Runner queues up the interval checks of a pipeline's resource types as they
+// become due. The checks themselves are run by a CheckRunner on any ATC. The
+// checks of resources are queued up by the CheckScheduler, as they may be
+// shared between pipelines.
 type Runner struct {
 	logger lager.Logger
 
 	noop bool
 
-	scanRunnerFactory ScanRunnerFactory
-	pipeline          db.Pipeline
-	syncInterval      time.Duration
-
-	scanning   *sync.Map
-	scanningWg *sync.WaitGroup
+	checkFactory                 db.CheckFactory
+	pipeline                     db.Pipeline
+	syncInterval                 time.Duration
+	resourceTypeCheckingInterval time.Duration
 }
 
 func NewRunner(
 	logger lager.Logger,
 	noop bool,
-	scanRunnerFactory ScanRunnerFactory,
+	checkFactory db.CheckFactory,
 	pipeline db.Pipeline,
 	syncInterval time.Duration,
+	resourceTypeCheckingInterval time.Duration,
 ) *Runner {
 	return &Runner{
-		logger:            logger,
-		noop:              noop,
-		scanRunnerFactory: scanRunnerFactory,
-		pipeline:          pipeline,
-		syncInterval:      syncInterval,
-		scanning:          &sync.Map{},
-		scanningWg:        &sync.WaitGroup{},
+		logger:                       logger,
+		noop:                         noop,
+		checkFactory:                 checkFactory,
+		pipeline:                     pipeline,
+		syncInterval:                 syncInterval,
+		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
 	}
 }
 
@@ -55,97 +53,55 @@ func (r *Runner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	}
 
 	ticker := time.NewTicker(r.syncInterval)
-	scannerContext, cancel := context.WithCancel(context.Background())
+	defer ticker.Stop()
 
-	err := r.tick(scannerContext)
+	err := r.tick()
 	if err != nil {
-		cancel()
 		return err
 	}
 
 	for {
 		select {
 		case <-ticker.C:
-			err := r.tick(scannerContext)
-			if err != nil {
-				break
-			}
+			_ = r.tick()
 		case <-signals:
-			ticker.Stop()
-			cancel()
-			r.scanningWg.Wait()
 			return nil
 		}
 	}
 }
 
-func (r *Runner) tick(ctx context.Context) error {
+func (r *Runner) tick() error {
 	resourceTypes, err := r.pipeline.ResourceTypes()
 	if err != nil {
 		r.logger.Error("failed-to-get-resource-types", err)
 		return err
 	}
 
-	resources, err := r.pipeline.Resources()
-	if err != nil {
-		r.logger.Error("failed-to-get-resources", err)
-		return err
-	}
+	for _, resourceType := range resourceTypes {
+		interval := checkEveryOrDefault(resourceType.CheckEvery(), r.resourceTypeCheckingInterval)
 
-	r.scanResourceTypes(ctx, resourceTypes)
-	r.scanResources(ctx, resources)
+		_, err := r.checkFactory.ScheduleResourceTypeCheck(resourceType.ID(), interval)
+		if err != nil {
+			r.logger.Error("failed-to-schedule-resource-type-check", err, lager.Data{
+				"resource-type": resourceType.Name(),
+			})
+		}
+	}
 
 	return nil
 }
 
-func (r *Runner) scanResources(ctx context.Context, resources db.Resources) {
-	for _, resource := range resources {
-		scopedName := "resource:" + resource.Name()
-		if _, found := r.scanning.Load(scopedName); found {
-			continue
-		}
-
-		logger := r.logger.Session("scan-resource", lager.Data{
-			"resource": resource.Name(),
-		})
-
-		r.scanningWg.Add(1)
-		go func(res db.Resource, scopedName string) {
-			defer r.scanningWg.Done()
-
-			r.scanning.Store(scopedName, true)
-			runner := r.scanRunnerFactory.ScanResourceRunner(logger, res)
-			err := runner.Run(ctx)
-			if err != nil {
-				r.logger.Error("failed-to-run-scan-resource", err)
-			}
-			r.scanning.Delete(scopedName)
-		}(resource, scopedName)
+// checkEveryOrDefault falls back on the default interval when check_every
+// can't be parsed, so that the check still runs and records the error.
+func checkEveryOrDefault(checkEvery string, defaultInterval time.Duration) time.Duration {
+	if checkEvery == "" {
+		return defaultInterval
 	}
-}
-
-func (r *Runner) scanResourceTypes(ctx context.Context, resourceTypes db.ResourceTypes) {
-	for _, resourceType := range resourceTypes {
-		scopedName := "resource-type:" + resourceType.Name()
-		if _, found := r.scanning.Load(scopedName); found {
-			continue
-		}
 
-		logger := r.logger.Session("scan-resource-type", lager.Data{
-			"resource-type": resourceType.Name(),
-		})
-
-		r.scanningWg.Add(1)
-		go func(res db.ResourceType, scopedName string) {
-			defer r.scanningWg.Done()
-
-			r.scanning.Store(scopedName, true)
-			runner := r.scanRunnerFactory.ScanResourceTypeRunner(logger, res)
-			err := runner.Run(ctx)
-			if err != nil {
-				r.logger.Error("failed-to-run-scan-resource-type", err)
-			}
-			r.scanning.Delete(scopedName)
-		}(resourceType, scopedName)
+	interval, err := time.ParseDuration(checkEvery)
+	if err != nil {
+		return defaultInterval
 	}
+
+	return interval
 }
@@ -1,8 +1,10 @@
 package radar
 
 import (
+	"context"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 )
@@ -10,7 +12,20 @@ import (
 //go:generate counterfeiter . Scanner
 
 type Scanner interface {
-	Run(lager.Logger, int) (time.Duration, error)
-	Scan(lager.Logger, int) error
-	ScanFromVersion(lager.Logger, int, atc.Version) error
+	Run(context.Context, lager.Logger, int) (time.Duration, error)
+	Scan(context.Context, lager.Logger, int) error
+	ScanFromVersion(context.Context, lager.Logger, int, atc.Version) error
+}
+
+// sleep waits for the duration, unless the context is done first.
+func sleep(ctx context.Context, clock clock.Clock, duration time.Duration) error {
+	timer := clock.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -1,32 +1,38 @@
 package radar
 
 import (
+	"io"
 	"time"
 
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/vars"
 )
 
 // ScannerFactory is the same interface as resourceserver/server.go
 // They are in two places because there would be cyclic dependencies otherwise
 
-// go:generate counterfeiter . ScannerFactory
+//go:generate counterfeiter . ScannerFactory
 type ScannerFactory interface {
-	NewResourceScanner(dbPipeline db.Pipeline) Scanner
-	NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
+	NewResourceScanner(dbPipeline db.Pipeline, stderr io.Writer) Scanner
+	NewResourceTypeScanner(dbPipeline db.Pipeline, stderr io.Writer) Scanner
 }
 
 type scannerFactory struct {
+	logger                       lager.Logger
 	pool                         worker.Pool
 	resourceFactory              resource.ResourceFactory
 	resourceConfigFactory        db.ResourceConfigFactory
 	resourceTypeCheckingInterval time.Duration
 	resourceCheckingInterval     time.Duration
+	resourceCheckingMaxInterval  time.Duration
 	externalURL                  string
 	secretManager                creds.Secrets
+	varSourcePool                creds.VarSourcePool
 	strategy                     worker.ContainerPlacementStrategy
 }
 
@@ -36,29 +42,35 @@ var ContainerExpiries = db.ContainerOwnerExpiries{
 }
 
 func NewScannerFactory(
+	logger lager.Logger,
 	pool worker.Pool,
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	resourceTypeCheckingInterval time.Duration,
 	resourceCheckingInterval time.Duration,
+	resourceCheckingMaxInterval time.Duration,
 	externalURL string,
 	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
 	strategy worker.ContainerPlacementStrategy,
 ) ScannerFactory {
 	return &scannerFactory{
+		logger:                       logger,
 		pool:                         pool,
 		resourceFactory:              resourceFactory,
 		resourceConfigFactory:        resourceConfigFactory,
 		resourceCheckingInterval:     resourceCheckingInterval,
 		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
+		resourceCheckingMaxInterval:  resourceCheckingMaxInterval,
 		externalURL:                  externalURL,
 		secretManager:                secretManager,
+		varSourcePool:                varSourcePool,
 		strategy:                     strategy,
 	}
 }
 
-func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
-	variables := creds.NewVariables(f.secretManager, dbPipeline.TeamName(), dbPipeline.Name())
+func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline, stderr io.Writer) Scanner {
+	variables := vars.NewTrackedVariables(dbPipeline.Variables(f.logger, f.secretManager, f.varSourcePool))
 
 	return NewResourceScanner(
 		clock.NewClock(),
@@ -66,15 +78,17 @@ func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
 		f.resourceFactory,
 		f.resourceConfigFactory,
 		f.resourceCheckingInterval,
+		f.resourceCheckingMaxInterval,
 		dbPipeline,
 		f.externalURL,
 		variables,
 		f.strategy,
+		redactingWriter{stderr, variables},
 	)
 }
 
-func (f *scannerFactory) NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner {
-	variables := creds.NewVariables(f.secretManager, dbPipeline.TeamName(), dbPipeline.Name())
+func (f *scannerFactory) NewResourceTypeScanner(dbPipeline db.Pipeline, stderr io.Writer) Scanner {
+	variables := vars.NewTrackedVariables(dbPipeline.Variables(f.logger, f.secretManager, f.varSourcePool))
 
 	return NewResourceTypeScanner(
 		clock.NewClock(),
@@ -86,5 +100,23 @@ func (f *scannerFactory) NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
 		f.externalURL,
 		variables,
 		f.strategy,
+		redactingWriter{stderr, variables},
 	)
 }
+
+// redactingWriter redacts the credentials interpolated into the source of the
+// checked resource (or resource type) from the check's output, the same way
+// they are redacted from the output of a build.
+type redactingWriter struct {
+	writer    io.Writer
+	variables *vars.TrackedVariables
+}
+
+func (w redactingWriter) Write(data []byte) (int, error) {
+	_, err := w.writer.Write([]byte(w.variables.Redact(string(data))))
+	if err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
@@ -1,17 +1,24 @@
 package atc
 
 type Resource struct {
-	Name         string `json:"name"`
-	PipelineName string `json:"pipeline_name"`
-	TeamName     string `json:"team_name"`
-	Type         string `json:"type"`
-	LastChecked  int64  `json:"last_checked,omitempty"`
-	Icon         string `json:"icon,omitempty"`
+	Name                 string       `json:"name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	TeamName             string       `json:"team_name"`
+	Type                 string       `json:"type"`
+	LastChecked          int64        `json:"last_checked,omitempty"`
+	Icon                 string       `json:"icon,omitempty"`
 
 	FailingToCheck  bool   `json:"failing_to_check,omitempty"`
 	CheckSetupError string `json:"check_setup_error,omitempty"`
 	CheckError      string `json:"check_error,omitempty"`
 
+	// CheckInterval is the interval the resource was last checked on, which
+	// is backed off from its check_every while it fails to check.
+	CheckInterval          string `json:"check_interval,omitempty"`
+	ConsecutiveCheckErrors int    `json:"consecutive_check_errors,omitempty"`
+	LatestCheckID          int    `json:"latest_check_id,omitempty"`
+
 	PinnedVersion  Version `json:"pinned_version,omitempty"`
 	PinnedInConfig bool    `json:"pinned_in_config,omitempty"`
 	PinComment     string  `json:"pin_comment,omitempty"`
@@ -8,9 +8,11 @@ import (
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/resource"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("Resource Check", func() {
@@ -25,6 +27,8 @@ var _ = Describe("Resource Check", func() {
 
 		checkScriptProcess *gardenfakes.FakeProcess
 
+		checkStderr *gbytes.Buffer
+
 		checkResult []atc.Version
 		checkErr    error
 	)
@@ -43,6 +47,8 @@ var _ = Describe("Resource Check", func() {
 			return checkScriptExitStatus, nil
 		}
 
+		checkStderr = gbytes.NewBuffer()
+
 		checkResult = nil
 		checkErr = nil
 	})
@@ -62,7 +68,7 @@ var _ = Describe("Resource Check", func() {
 			return checkScriptProcess, nil
 		}
 
-		checkResult, checkErr = resourceForContainer.Check(context.TODO(), source, version)
+		checkResult, checkErr = resourceForContainer.Check(context.TODO(), resource.IOConfig{Stderr: checkStderr}, source, version)
 	})
 
 	It("runs /opt/resource/check the request on stdin", func() {
@@ -119,6 +125,10 @@ var _ = Describe("Resource Check", func() {
 			Expect(checkErr.Error()).To(ContainSubstring("exit status 9"))
 			Expect(checkErr.Error()).To(ContainSubstring("some-stderr"))
 		})
+
+		It("streams stderr of the process", func() {
+			Expect(checkStderr).To(gbytes.Say("some-stderr"))
+		})
 	})
 
 	Context("when the output of /opt/resource/check is malformed", func() {
@@ -7,6 +7,33 @@ type ClearTaskCacheResponse struct {
 type SaveConfigResponse struct {
 	Errors   []string        `json:"errors,omitempty"`
 	Warnings []ConfigWarning `json:"warnings,omitempty"`
+	DryRun   *ConfigImpact   `json:"dry_run,omitempty"`
+}
+
+// ConfigImpact is what saving a config would do to its pipeline, as reported
+// by a dry run.
+type ConfigImpact struct {
+	// Resources whose type or source changes, giving them a new resource
+	// config scope without the versions of the old one.
+	NewResourceScopes []string `json:"new_resource_scopes,omitempty"`
+
+	RenamedJobs   []JobRename `json:"renamed_jobs,omitempty"`
+	TriggeredJobs []string    `json:"triggered_jobs,omitempty"`
+
+	UnresolvedCredentials []string `json:"unresolved_credentials,omitempty"`
+}
+
+type JobRename struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ImportPipelineResponse reports the config warnings of an imported bundle,
+// and the parts of it which could not be restored.
+type ImportPipelineResponse struct {
+	Errors   []string        `json:"errors,omitempty"`
+	Warnings []ConfigWarning `json:"warnings,omitempty"`
+	Skipped  []string        `json:"skipped,omitempty"`
 }
 
 type ConfigResponse struct {
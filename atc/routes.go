@@ -6,6 +6,13 @@ const (
 	SaveConfig = "SaveConfig"
 	GetConfig  = "GetConfig"
 
+	ListPipelineConfigVersions = "ListPipelineConfigVersions"
+	GetPipelineConfigVersion   = "GetPipelineConfigVersion"
+	RollbackPipelineConfig     = "RollbackPipelineConfig"
+
+	ExportPipeline = "ExportPipeline"
+	ImportPipeline = "ImportPipeline"
+
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
 	CreateBuild         = "CreateBuild"
@@ -36,8 +43,14 @@ const (
 	GetResource          = "GetResource"
 	CheckResource        = "CheckResource"
 	CheckResourceWebHook = "CheckResourceWebHook"
+	PipelineWebHook      = "PipelineWebHook"
+	TeamWebHook          = "TeamWebHook"
 	CheckResourceType    = "CheckResourceType"
 
+	GetCheck           = "GetCheck"
+	CheckEvents        = "CheckEvents"
+	ListResourceChecks = "ListResourceChecks"
+
 	ListResourceVersions          = "ListResourceVersions"
 	GetResourceVersion            = "GetResourceVersion"
 	EnableResourceVersion         = "EnableResourceVersion"
@@ -58,6 +71,7 @@ const (
 	OrderPipelines      = "OrderPipelines"
 	PausePipeline       = "PausePipeline"
 	UnpausePipeline     = "UnpausePipeline"
+	ArchivePipeline     = "ArchivePipeline"
 	ExposePipeline      = "ExposePipeline"
 	HidePipeline        = "HidePipeline"
 	RenamePipeline      = "RenamePipeline"
@@ -107,11 +121,18 @@ const (
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	SaveConfigDryRun        = "dry_run"
+	ExportPipelineBuilds    = "include_builds"
 )
 
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", Method: "GET", Name: ListPipelineConfigVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", Method: "GET", Name: GetPipelineConfigVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version/rollback", Method: "PUT", Name: RollbackPipelineConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/export", Method: "GET", Name: ExportPipeline},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/import", Method: "PUT", Name: ImportPipeline},
 
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
@@ -145,6 +166,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/ordering", Method: "PUT", Name: OrderPipelines},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/pause", Method: "PUT", Name: PausePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/unpause", Method: "PUT", Name: UnpausePipeline},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/archive", Method: "PUT", Name: ArchivePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/expose", Method: "PUT", Name: ExposePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/hide", Method: "PUT", Name: HidePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/versions-db", Method: "GET", Name: GetVersionsDB},
@@ -159,8 +181,14 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name", Method: "GET", Name: GetResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/webhook", Method: "POST", Name: PipelineWebHook},
+	{Path: "/api/v1/teams/:team_name/webhook", Method: "POST", Name: TeamWebHook},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
 
+	{Path: "/api/v1/checks/:check_id", Method: "GET", Name: GetCheck},
+	{Path: "/api/v1/checks/:check_id/events", Method: "GET", Name: CheckEvents},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", Method: "GET", Name: ListResourceChecks},
+
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "GET", Name: ListResourceVersions},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id", Method: "GET", Name: GetResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/enable", Method: "PUT", Name: EnableResourceVersion},
@@ -1,10 +1,16 @@
 package factory
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/vars"
+	"github.com/ghodss/yaml"
 )
 
 var ErrResourceNotFound = errors.New("resource not found")
@@ -99,6 +105,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		return factory.across(planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -108,7 +118,11 @@ func (factory *buildFactory) constructPlanFromConfig(
 			return atc.Plan{}, err
 		}
 	} else {
-		retryStep := make(atc.RetryPlan, planConfig.Attempts)
+		retryStep := atc.RetryPlan{
+			Attempts: make([]atc.Plan, planConfig.Attempts),
+			Backoff:  planConfig.Backoff,
+			On:       planConfig.RetryOn,
+		}
 
 		for i := 0; i < planConfig.Attempts; i++ {
 			attempt, err := factory.constructUnhookedPlan(planConfig, resources, resourceTypes, inputs)
@@ -116,19 +130,204 @@ func (factory *buildFactory) constructPlanFromConfig(
 				return atc.Plan{}, err
 			}
 
-			retryStep[i] = attempt
+			// the last attempt is never retried
+			if planConfig.OnRetry != nil && i < planConfig.Attempts-1 {
+				hook, err := factory.constructPlanFromConfig(*planConfig.OnRetry, resources, resourceTypes, inputs)
+				if err != nil {
+					return atc.Plan{}, err
+				}
+
+				attempt = factory.planFactory.NewPlan(atc.OnRetryPlan{
+					Step: attempt,
+					Next: hook,
+				})
+			}
+
+			retryStep.Attempts[i] = attempt
 		}
 
 		plan = factory.planFactory.NewPlan(retryStep)
 	}
 
-	return factory.applyHooks(constructionParams{
+	plan, err = factory.applyHooks(constructionParams{
 		plan:          plan,
 		hooks:         planConfig.Hooks(),
 		resources:     resources,
 		resourceTypes: resourceTypes,
 		inputs:        inputs,
 	})
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	if planConfig.If != "" {
+		inputVersions := map[string]atc.Version{}
+		for _, input := range inputs {
+			inputVersions[input.Name] = input.Version
+		}
+
+		plan = factory.planFactory.NewPlan(atc.IfPlan{
+			Condition:     planConfig.If,
+			InputVersions: inputVersions,
+			Step:          plan,
+		})
+	}
+
+	return plan, nil
+}
+
+func (factory *buildFactory) across(
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	acrossVars := planConfig.Across
+	failFast := planConfig.FailFast
+
+	planConfig.Across = nil
+	planConfig.FailFast = false
+
+	plan := atc.AcrossPlan{
+		FailFast: failFast,
+	}
+
+	for _, v := range acrossVars {
+		plan.Vars = append(plan.Vars, atc.AcrossVar{
+			Var:         v.Var,
+			Values:      v.Values,
+			MaxInFlight: v.MaxInFlight,
+		})
+	}
+
+	for _, values := range acrossCombinations(acrossVars) {
+		scope := vars.StaticVariables{}
+		for i, v := range acrossVars {
+			scope[v.Var] = values[i]
+		}
+
+		scopedConfig, err := interpolatePlanConfig(planConfig, scope)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		step, err := factory.constructPlanFromConfig(
+			scopedConfig,
+			resources,
+			resourceTypes,
+			inputs,
+		)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		plan.Steps = append(plan.Steps, atc.VarScopedPlan{
+			Step:   step,
+			Values: values,
+		})
+	}
+
+	return factory.planFactory.NewPlan(plan), nil
+}
+
+// acrossCombinations returns every combination of the vars' values, varying
+// the last var the fastest.
+func acrossCombinations(acrossVars []atc.AcrossVarConfig) [][]interface{} {
+	combinations := [][]interface{}{{}}
+
+	for _, v := range acrossVars {
+		var next [][]interface{}
+
+		for _, combination := range combinations {
+			for _, value := range v.Values {
+				values := make([]interface{}, len(combination), len(combination)+1)
+				copy(values, combination)
+
+				next = append(next, append(values, value))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
+
+// interpolatePlanConfig substitutes the given variables into the config,
+// leaving any other ((vars)) in place to be resolved when the step runs.
+func interpolatePlanConfig(planConfig atc.PlanConfig, variables vars.StaticVariables) (atc.PlanConfig, error) {
+	payload, err := json.Marshal(planConfig)
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	var config interface{}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	err = decoder.Decode(&config)
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	payload, err = json.Marshal(embedScalarVars(config, variables))
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	interpolatedPayload, err := vars.NewTemplate(payload).Evaluate(variables, vars.EvaluateOpts{})
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	var interpolated atc.PlanConfig
+	err = yaml.Unmarshal(interpolatedPayload, &interpolated)
+	if err != nil {
+		return atc.PlanConfig{}, err
+	}
+
+	return interpolated, nil
+}
+
+// embedScalarVars substitutes the number and boolean values of the given
+// variables wherever they are embedded in a larger string, e.g.
+// golang:((go)), which the template only allows for strings and integers.
+// Values which make up a whole field are left to the template so that they
+// keep their type.
+func embedScalarVars(node interface{}, variables vars.StaticVariables) interface{} {
+	switch typedNode := node.(type) {
+	case map[string]interface{}:
+		for key, value := range typedNode {
+			typedNode[key] = embedScalarVars(value, variables)
+		}
+
+	case []interface{}:
+		for i, value := range typedNode {
+			typedNode[i] = embedScalarVars(value, variables)
+		}
+
+	case string:
+		for name, value := range variables {
+			var str string
+			switch typedValue := value.(type) {
+			case float64:
+				str = strconv.FormatFloat(typedValue, 'f', -1, 64)
+			case bool:
+				str = strconv.FormatBool(typedValue)
+			default:
+				continue
+			}
+
+			for _, ref := range []string{"((" + name + "))", "((!" + name + "))"} {
+				if typedNode != ref {
+					typedNode = strings.Replace(typedNode, ref, str, -1)
+				}
+			}
+		}
+
+		return typedNode
+	}
+
+	return node
 }
 
 func (factory *buildFactory) constructUnhookedPlan(
@@ -173,6 +372,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Params:   planConfig.Params,
 			Tags:     planConfig.Tags,
 			Inputs:   planConfig.Inputs,
+			Limits:   planConfig.Limits,
 
 			VersionedResourceTypes: resourceTypes,
 		}
@@ -187,6 +387,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 
 			Params: planConfig.GetParams,
 			Tags:   planConfig.Tags,
+			Limits: planConfig.Limits,
 			Source: resource.Source,
 
 			VersionedResourceTypes: resourceTypes,
@@ -225,14 +426,16 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Params:   planConfig.Params,
 			Version:  &version,
 			Tags:     planConfig.Tags,
+			Limits:   planConfig.Limits,
 
 			VersionedResourceTypes: resourceTypes,
 		})
 
 	case planConfig.Task != "":
-		plan = factory.planFactory.NewPlan(atc.TaskPlan{
+		taskPlan := atc.TaskPlan{
 			Name:              planConfig.Task,
 			Privileged:        planConfig.Privileged,
+			Hermetic:          planConfig.Hermetic,
 			Config:            planConfig.TaskConfig,
 			ConfigPath:        planConfig.TaskConfigPath,
 			Vars:              planConfig.TaskVars,
@@ -243,7 +446,34 @@ func (factory *buildFactory) constructUnhookedPlan(
 			ImageArtifactName: planConfig.ImageArtifactName,
 
 			VersionedResourceTypes: resourceTypes,
+		}
+
+		if planConfig.SkipIfUnchanged {
+			taskPlan.SkipIfUnchanged = true
+			taskPlan.InputVersions = map[string]atc.Version{}
+			for _, input := range inputs {
+				taskPlan.InputVersions[input.Name] = input.Version
+			}
+		}
+
+		plan = factory.planFactory.NewPlan(taskPlan)
+
+	case planConfig.SetPipeline != "":
+		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
+			Name:     planConfig.SetPipeline,
+			File:     planConfig.TaskConfigPath,
+			Vars:     planConfig.TaskVars,
+			VarFiles: planConfig.VarFiles,
 		})
+
+	case planConfig.LoadVar != "":
+		plan = factory.planFactory.NewPlan(atc.LoadVarPlan{
+			Name:   planConfig.LoadVar,
+			File:   planConfig.TaskConfigPath,
+			Format: planConfig.Format,
+			Reveal: planConfig.Reveal,
+		})
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			*planConfig.Try,
@@ -0,0 +1,239 @@
+package factory_test
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across", func() {
+	var (
+		buildFactory factory.BuildFactory
+
+		resources           atc.ResourceConfigs
+		resourceTypes       atc.VersionedResourceTypes
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resources = atc.ResourceConfigs{
+			{
+				Name:   "some-resource",
+				Type:   "git",
+				Source: atc.Source{"uri": "git://some-resource"},
+			},
+		}
+
+		resourceTypes = atc.VersionedResourceTypes{}
+	})
+
+	Context("when I have a step across multiple vars", func() {
+		It("returns a sub-plan for every combination with the values interpolated", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "test-((go))",
+						TaskConfigPath: "ci/test.yml",
+						TaskVars: atc.Params{
+							"go":       "((go))",
+							"postgres": "((postgres))",
+							"token":    "((some-secret))",
+						},
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:         "go",
+								Values:      []interface{}{"1.12", "1.13"},
+								MaxInFlight: 2,
+							},
+							{
+								Var:    "postgres",
+								Values: []interface{}{"10", "11"},
+							},
+						},
+						FailFast: true,
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskPlan := func(goVersion, pgVersion string) atc.Plan {
+				return expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:       "test-" + goVersion,
+					ConfigPath: "ci/test.yml",
+					Vars: atc.Params{
+						"go":       goVersion,
+						"postgres": pgVersion,
+						"token":    "((some-secret))",
+					},
+					VersionedResourceTypes: resourceTypes,
+				})
+			}
+
+			var steps []atc.VarScopedPlan
+			for _, goVersion := range []string{"1.12", "1.13"} {
+				for _, pgVersion := range []string{"10", "11"} {
+					steps = append(steps, atc.VarScopedPlan{
+						Step:   taskPlan(goVersion, pgVersion),
+						Values: []interface{}{goVersion, pgVersion},
+					})
+				}
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "go",
+						Values:      []interface{}{"1.12", "1.13"},
+						MaxInFlight: 2,
+					},
+					{
+						Var:    "postgres",
+						Values: []interface{}{"10", "11"},
+					},
+				},
+				Steps:    steps,
+				FailFast: true,
+			})
+			Expect(actual).To(Equal(expected))
+		})
+	})
+
+	Context("when a var's values are numbers", func() {
+		It("keeps them as numbers in whole fields and embeds them as written in strings", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "test-((go))",
+						TaskConfigPath: "ci/test.yml",
+						TaskVars: atc.Params{
+							"image": "golang:((go))",
+							"go":    "((go))",
+						},
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go",
+								Values: []interface{}{1.13, 20191016.0},
+							},
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			var steps []atc.VarScopedPlan
+			for _, goVersion := range []float64{1.13, 20191016} {
+				goVersionStr := strconv.FormatFloat(goVersion, 'f', -1, 64)
+
+				steps = append(steps, atc.VarScopedPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:       "test-" + goVersionStr,
+						ConfigPath: "ci/test.yml",
+						Vars: atc.Params{
+							"image": "golang:" + goVersionStr,
+							"go":    goVersion,
+						},
+						VersionedResourceTypes: resourceTypes,
+					}),
+					Values: []interface{}{goVersion},
+				})
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:    "go",
+						Values: []interface{}{1.13, 20191016.0},
+					},
+				},
+				Steps: steps,
+			})
+			Expect(actual).To(Equal(expected))
+		})
+	})
+
+	Context("when the step across vars has hooks", func() {
+		It("applies the hooks to every combination", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Put: "some-resource",
+						Params: atc.Params{
+							"tag": "((tag))",
+						},
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "tag",
+								Values: []interface{}{"a", "b"},
+							},
+						},
+						Failure: &atc.PlanConfig{
+							Task: "alert-((tag))",
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			var steps []atc.VarScopedPlan
+			for _, tag := range []string{"a", "b"} {
+				putPlan := expectedPlanFactory.NewPlan(atc.PutPlan{
+					Type:     "git",
+					Name:     "some-resource",
+					Resource: "some-resource",
+					Source:   atc.Source{"uri": "git://some-resource"},
+					Params:   atc.Params{"tag": tag},
+
+					VersionedResourceTypes: resourceTypes,
+				})
+
+				dependentGetPlan := expectedPlanFactory.NewPlan(atc.GetPlan{
+					Type:        "git",
+					Name:        "some-resource",
+					Resource:    "some-resource",
+					VersionFrom: &putPlan.ID,
+					Source:      atc.Source{"uri": "git://some-resource"},
+
+					VersionedResourceTypes: resourceTypes,
+				})
+
+				stepPlan := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+					Step: putPlan,
+					Next: dependentGetPlan,
+				})
+
+				failurePlan := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "alert-" + tag,
+					VersionedResourceTypes: resourceTypes,
+				})
+
+				steps = append(steps, atc.VarScopedPlan{
+					Step: expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+						Step: stepPlan,
+						Next: failurePlan,
+					}),
+					Values: []interface{}{tag},
+				})
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:    "tag",
+						Values: []interface{}{"a", "b"},
+					},
+				},
+				Steps: steps,
+			})
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory If", func() {
+	var (
+		buildFactory factory.BuildFactory
+
+		resources           atc.ResourceConfigs
+		resourceTypes       atc.VersionedResourceTypes
+		inputs              []db.BuildInput
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resources = atc.ResourceConfigs{}
+		resourceTypes = atc.VersionedResourceTypes{}
+
+		inputs = []db.BuildInput{
+			{
+				Name:    "some-input",
+				Version: atc.Version{"ref": "some-ref"},
+			},
+		}
+	})
+
+	Context("when a step has a condition", func() {
+		It("wraps the step in an if plan along with the input versions", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						If:   `inputs.some-input.ref == "some-ref"`,
+					},
+				},
+			}, resources, resourceTypes, inputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.IfPlan{
+				Condition: `inputs.some-input.ref == "some-ref"`,
+				InputVersions: map[string]atc.Version{
+					"some-input": {"ref": "some-ref"},
+				},
+				Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a step with a condition has hooks and a timeout", func() {
+		It("wraps the step along with its hooks", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:    "some-task",
+						Timeout: "10s",
+						If:      `build.job_name == "some-job"`,
+						Success: &atc.PlanConfig{
+							Task: "some-hook",
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.IfPlan{
+				Condition:     `build.job_name == "some-job"`,
+				InputVersions: map[string]atc.Version{},
+				Step: expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TimeoutPlan{
+						Duration: "10s",
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "some-task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
+					Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-hook",
+						VersionedResourceTypes: resourceTypes,
+					}),
+				}),
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory LoadVar", func() {
+	Describe("LoadVarPlan", func() {
+		var (
+			buildFactory factory.BuildFactory
+
+			resources           atc.ResourceConfigs
+			resourceTypes       atc.VersionedResourceTypes
+			actualPlanFactory   atc.PlanFactory
+			expectedPlanFactory atc.PlanFactory
+		)
+
+		BeforeEach(func() {
+			actualPlanFactory = atc.NewPlanFactory(123)
+			expectedPlanFactory = atc.NewPlanFactory(123)
+			buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+			resources = atc.ResourceConfigs{}
+			resourceTypes = atc.VersionedResourceTypes{}
+		})
+
+		It("returns the correct plan", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-input/version",
+						Format:         "trim",
+						Reveal:         true,
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.LoadVarPlan{
+				Name:   "some-var",
+				File:   "some-input/version",
+				Format: "trim",
+				Reveal: true,
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
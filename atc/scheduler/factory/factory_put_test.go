@@ -88,6 +88,56 @@ var _ = Describe("Factory Put", func() {
 			})
 		})
 
+		Context("with a put with container limits", func() {
+			var memory uint64
+
+			BeforeEach(func() {
+				memory = 1024
+
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Put:      "some-put",
+							Resource: "some-resource",
+							Limits:   &atc.ContainerLimits{Memory: &memory},
+						},
+					},
+				}
+			})
+
+			It("limits both the put and the get after it", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				putPlan := expectedPlanFactory.NewPlan(atc.PutPlan{
+					Type:     "git",
+					Name:     "some-put",
+					Resource: "some-resource",
+					Source: atc.Source{
+						"uri": "git://some-resource",
+					},
+					Limits:                 &atc.ContainerLimits{Memory: &memory},
+					VersionedResourceTypes: resourceTypes,
+				})
+
+				expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+					Step: putPlan,
+					Next: expectedPlanFactory.NewPlan(atc.GetPlan{
+						Type:     "git",
+						Name:     "some-put",
+						Resource: "some-resource",
+						Source: atc.Source{
+							"uri": "git://some-resource",
+						},
+						VersionFrom:            &putPlan.ID,
+						Limits:                 &atc.ContainerLimits{Memory: &memory},
+						VersionedResourceTypes: resourceTypes,
+					}),
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
 		Context("with a put for a non-existent resource", func() {
 			BeforeEach(func() {
 				input = atc.JobConfig{
@@ -48,41 +48,47 @@ var _ = Describe("Factory Retry Step", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			expected := expectedPlanFactory.NewPlan(atc.RetryPlan{
-				expectedPlanFactory.NewPlan(atc.TaskPlan{
-					Name:                   "second task",
-					VersionedResourceTypes: resourceTypes,
-				}),
-				expectedPlanFactory.NewPlan(atc.TaskPlan{
-					Name:                   "second task",
-					VersionedResourceTypes: resourceTypes,
-				}),
-				expectedPlanFactory.NewPlan(atc.TaskPlan{
-					Name:                   "second task",
-					VersionedResourceTypes: resourceTypes,
-				}),
+				Attempts: []atc.Plan{
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "second task",
+						VersionedResourceTypes: resourceTypes,
+					}),
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "second task",
+						VersionedResourceTypes: resourceTypes,
+					}),
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "second task",
+						VersionedResourceTypes: resourceTypes,
+					}),
+				},
 			})
 
 			Expect(actual).To(testhelpers.MatchPlan(expected))
 		})
 	})
 
-	Context("when there is a task annotated with 'attempts' and 'on_success'", func() {
-		It("builds correctly", func() {
+	Context("when there is a task annotated with 'attempts', 'backoff' and 'retry_on'", func() {
+		It("configures the retry plan", func() {
 			actual, err := buildFactory.Create(atc.JobConfig{
 				Plan: atc.PlanSequence{
 					{
 						Task:     "second task",
-						Attempts: 3,
-						Success: &atc.PlanConfig{
-							Task: "second task",
+						Attempts: 2,
+						Backoff: &atc.RetryBackoff{
+							Initial: "1s",
+							Max:     "1m",
+							Factor:  3,
+							Jitter:  true,
 						},
+						RetryOn: atc.RetryOnError,
 					},
 				},
 			}, nil, resourceTypes, nil)
 			Expect(err).NotTo(HaveOccurred())
 
-			expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-				Step: expectedPlanFactory.NewPlan(atc.RetryPlan{
+			expected := expectedPlanFactory.NewPlan(atc.RetryPlan{
+				Attempts: []atc.Plan{
 					expectedPlanFactory.NewPlan(atc.TaskPlan{
 						Name:                   "second task",
 						VersionedResourceTypes: resourceTypes,
@@ -91,10 +97,99 @@ var _ = Describe("Factory Retry Step", func() {
 						Name:                   "second task",
 						VersionedResourceTypes: resourceTypes,
 					}),
+				},
+				Backoff: &atc.RetryBackoff{
+					Initial: "1s",
+					Max:     "1m",
+					Factor:  3,
+					Jitter:  true,
+				},
+				On: atc.RetryOnError,
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when there is a task annotated with 'attempts' and 'on_retry'", func() {
+		It("hooks every attempt but the last", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:     "second task",
+						Attempts: 3,
+						OnRetry: &atc.PlanConfig{
+							Task: "cleanup",
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.RetryPlan{
+				Attempts: []atc.Plan{
+					expectedPlanFactory.NewPlan(atc.OnRetryPlan{
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "second task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+						Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "cleanup",
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
+					expectedPlanFactory.NewPlan(atc.OnRetryPlan{
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "second task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+						Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "cleanup",
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
 					expectedPlanFactory.NewPlan(atc.TaskPlan{
 						Name:                   "second task",
 						VersionedResourceTypes: resourceTypes,
 					}),
+				},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when there is a task annotated with 'attempts' and 'on_success'", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:     "second task",
+						Attempts: 3,
+						Success: &atc.PlanConfig{
+							Task: "second task",
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+				Step: expectedPlanFactory.NewPlan(atc.RetryPlan{
+					Attempts: []atc.Plan{
+						expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "second task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+						expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "second task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+						expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "second task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+					},
 				}),
 				Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
 					Name:                   "second task",
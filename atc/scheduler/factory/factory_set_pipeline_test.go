@@ -0,0 +1,53 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory SetPipeline", func() {
+	Describe("SetPipelinePlan", func() {
+		var (
+			buildFactory factory.BuildFactory
+
+			resources           atc.ResourceConfigs
+			resourceTypes       atc.VersionedResourceTypes
+			actualPlanFactory   atc.PlanFactory
+			expectedPlanFactory atc.PlanFactory
+		)
+
+		BeforeEach(func() {
+			actualPlanFactory = atc.NewPlanFactory(123)
+			expectedPlanFactory = atc.NewPlanFactory(123)
+			buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+			resources = atc.ResourceConfigs{}
+			resourceTypes = atc.VersionedResourceTypes{}
+		})
+
+		It("returns the correct plan", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						SetPipeline:    "some-pipeline",
+						TaskConfigPath: "some-input/pipeline.yml",
+						TaskVars:       atc.Params{"foo": "bar"},
+						VarFiles:       []string{"some-input/vars.yml"},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.SetPipelinePlan{
+				Name:     "some-pipeline",
+				File:     "some-input/pipeline.yml",
+				Vars:     map[string]interface{}{"foo": "bar"},
+				VarFiles: []string{"some-input/vars.yml"},
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package factory_test
 
 import (
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/scheduler/factory"
 	"github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
@@ -181,5 +182,63 @@ var _ = Describe("Factory Task", func() {
 				Expect(actual).To(testhelpers.MatchPlan(expected))
 			})
 		})
+
+		Context("when the task is skipped if unchanged", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Task:            "some-task",
+							SkipIfUnchanged: true,
+						},
+					},
+				}
+			})
+
+			It("creates build plan with the versions of the job's inputs", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, []db.BuildInput{
+					{
+						Name:    "some-input",
+						Version: atc.Version{"ref": "some-ref"},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+					SkipIfUnchanged:        true,
+					InputVersions: map[string]atc.Version{
+						"some-input": {"ref": "some-ref"},
+					},
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
+		Context("when the task is hermetic", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Task:     "some-task",
+							Hermetic: true,
+						},
+					},
+				}
+			})
+
+			It("creates build plan with a hermetic task", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+					Hermetic:               true,
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
 	})
 })
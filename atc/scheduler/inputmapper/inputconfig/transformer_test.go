@@ -34,7 +34,7 @@ var _ = Describe("Transformer", func() {
 			JustBeforeEach(func() {
 				algorithmInputs, tranformErr = transformer.TransformInputConfigs(
 					&algorithm.VersionsDB{
-						JobIDs:      map[string]int{"j1": 1, "j2": 2},
+						JobIDs:      map[string]int{"j1": 1, "j2": 2, "other-pipeline/j3": 3},
 						ResourceIDs: map[string]int{"r1": 11, "r2": 12},
 					},
 					"j1",
@@ -85,6 +85,28 @@ var _ = Describe("Transformer", func() {
 				})
 			})
 
+			Context("when an input has passed constraints on a job of another pipeline", func() {
+				BeforeEach(func() {
+					jobInputs = []atc.JobInput{{
+						Name:     "job-input-1",
+						Resource: "r1",
+						Version:  &atc.VersionConfig{Latest: true},
+						Passed:   []string{"j2", "other-pipeline/j3"},
+					}}
+				})
+
+				It("includes the other pipeline's job in the JobSet", func() {
+					Expect(algorithmInputs).To(ConsistOf(algorithm.InputConfig{
+						Name:            "job-input-1",
+						UseEveryVersion: false,
+						PinnedVersionID: 0,
+						ResourceID:      11,
+						Passed:          algorithm.JobSet{2: struct{}{}, 3: struct{}{}},
+						JobID:           1,
+					}))
+				})
+			})
+
 			Context("when an input has version: every", func() {
 				BeforeEach(func() {
 					jobInputs = []atc.JobInput{{
@@ -25,9 +25,20 @@ func (s *Scheduler) Schedule(
 ) (map[string]time.Duration, error) {
 	jobSchedulingTime := map[string]time.Duration{}
 
+	now := time.Now()
+	freezeWindows := s.Pipeline.FreezeWindows()
+
+	frozen := map[string]bool{}
+	for _, job := range jobs {
+		if freeze, found := freezeWindows.FreezeFor(job.Name(), now); found {
+			logger.Debug("job-frozen", lager.Data{"job": job.Name(), "window": freeze.Window})
+			frozen[job.Name()] = true
+		}
+	}
+
 	for _, job := range jobs {
 		jStart := time.Now()
-		err := s.ensurePendingBuildExists(logger, versions, job, resources)
+		err := s.ensurePendingBuildExists(logger, versions, job, resources, frozen[job.Name()])
 		jobSchedulingTime[job.Name()] = time.Since(jStart)
 
 		if err != nil {
@@ -48,6 +59,13 @@ func (s *Scheduler) Schedule(
 			continue
 		}
 
+		if frozen[job.Name()] {
+			nextPendingBuildsForJob = manuallyTriggered(nextPendingBuildsForJob)
+			if len(nextPendingBuildsForJob) == 0 {
+				continue
+			}
+		}
+
 		err := s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuildsForJob)
 		jobSchedulingTime[job.Name()] = jobSchedulingTime[job.Name()] + time.Since(jStart)
 
@@ -64,6 +82,7 @@ func (s *Scheduler) ensurePendingBuildExists(
 	versions *algorithm.VersionsDB,
 	job db.Job,
 	resources db.Resources,
+	frozen bool,
 ) error {
 	inputMapping, err := s.InputMapper.SaveNextInputMapping(logger, versions, job, resources)
 	if err != nil {
@@ -77,7 +96,10 @@ func (s *Scheduler) ensurePendingBuildExists(
 		//trigger: true, and the version has not been used
 		if ok && inputVersion.FirstOccurrence {
 			hasNewInputs = true
-			if inputConfig.Trigger {
+
+			// new versions are still tracked during a freeze window, they just
+			// don't trigger builds until it closes
+			if inputConfig.Trigger && !frozen {
 				err := job.EnsurePendingBuildExists()
 				if err != nil {
 					logger.Error("failed-to-ensure-pending-build-exists", err)
@@ -97,3 +119,16 @@ func (s *Scheduler) ensurePendingBuildExists(
 
 	return nil
 }
+
+// manuallyTriggered returns the pending builds which can still be started
+// while their job is frozen.
+func manuallyTriggered(builds []db.Build) []db.Build {
+	var manual []db.Build
+	for _, build := range builds {
+		if build.IsManuallyTriggered() {
+			manual = append(manual, build)
+		}
+	}
+
+	return manual
+}
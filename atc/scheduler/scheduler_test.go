@@ -292,6 +292,60 @@ var _ = Describe("Scheduler", func() {
 						Expect(scheduleErr).NotTo(HaveOccurred())
 					})
 				})
+
+				Context("when the job is in an open freeze window", func() {
+					var manualBuild *dbfakes.FakeBuild
+
+					BeforeEach(func() {
+						fakePipeline.FreezeWindowsReturns(atc.FreezeWindowConfigs{
+							{Name: "always", Start: "* * * * *", Duration: "1h", Jobs: []string{"some-job"}},
+						})
+
+						manualBuild = new(dbfakes.FakeBuild)
+						manualBuild.IsManuallyTriggeredReturns(true)
+					})
+
+					It("doesn't create a pending build", func() {
+						Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(BeZero())
+						Expect(scheduleErr).NotTo(HaveOccurred())
+					})
+
+					It("still marks the job as having new inputs", func() {
+						Expect(fakeJob.SetHasNewInputsCallCount()).To(Equal(1))
+						Expect(fakeJob.SetHasNewInputsArgsForCall(0)).To(Equal(true))
+					})
+
+					It("doesn't start pending builds which weren't triggered manually", func() {
+						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(BeZero())
+					})
+
+					Context("when a build was triggered manually", func() {
+						BeforeEach(func() {
+							fakePipeline.GetAllPendingBuildsReturns(map[string][]db.Build{
+								"some-job": {new(dbfakes.FakeBuild), manualBuild},
+							}, nil)
+						})
+
+						It("starts only that build", func() {
+							Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+							_, _, _, _, actualPendingBuilds := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+							Expect(actualPendingBuilds).To(Equal([]db.Build{manualBuild}))
+						})
+					})
+				})
+
+				Context("when a freeze window is open for other jobs", func() {
+					BeforeEach(func() {
+						fakePipeline.FreezeWindowsReturns(atc.FreezeWindowConfigs{
+							{Name: "always", Start: "* * * * *", Duration: "1h", Jobs: []string{"other-job"}},
+						})
+					})
+
+					It("creates a pending build", func() {
+						Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(Equal(1))
+						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+					})
+				})
 			})
 
 			Context("when no first occurrence", func() {
@@ -122,6 +122,14 @@ func stripIDs(plan atc.Plan) (atc.Plan, []string) {
 		ids = append(ids, subIDs...)
 	}
 
+	if plan.OnRetry != nil {
+		plan.OnRetry.Step, subIDs = stripIDs(plan.OnRetry.Step)
+		ids = append(ids, subIDs...)
+
+		plan.OnRetry.Next, subIDs = stripIDs(plan.OnRetry.Next)
+		ids = append(ids, subIDs...)
+	}
+
 	if plan.Ensure != nil {
 		plan.Ensure.Step, subIDs = stripIDs(plan.Ensure.Step)
 		ids = append(ids, subIDs...)
@@ -140,6 +148,18 @@ func stripIDs(plan atc.Plan) (atc.Plan, []string) {
 		ids = append(ids, subIDs...)
 	}
 
+	if plan.If != nil {
+		plan.If.Step, subIDs = stripIDs(plan.If.Step)
+		ids = append(ids, subIDs...)
+	}
+
+	if plan.Across != nil {
+		for i, p := range plan.Across.Steps {
+			plan.Across.Steps[i].Step, subIDs = stripIDs(p.Step)
+			ids = append(ids, subIDs...)
+		}
+	}
+
 	if plan.Get != nil {
 		if plan.Get.VersionFrom != nil {
 			planID := atc.PlanID("<stripped>")
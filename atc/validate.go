@@ -3,9 +3,12 @@ package atc
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/gorhill/cronexpr"
 )
 
 func formatErr(groupName string, err error) string {
@@ -43,6 +46,21 @@ func (c Config) Validate() ([]ConfigWarning, []string) {
 		errorMessages = append(errorMessages, formatErr("resource types", resourceTypesErr))
 	}
 
+	displayErr := validateDisplay(c)
+	if displayErr != nil {
+		errorMessages = append(errorMessages, formatErr("display", displayErr))
+	}
+
+	varSourcesErr := validateVarSources(c)
+	if varSourcesErr != nil {
+		errorMessages = append(errorMessages, formatErr("var sources", varSourcesErr))
+	}
+
+	freezeWindowsErr := validateFreezeWindows(c)
+	if freezeWindowsErr != nil {
+		errorMessages = append(errorMessages, formatErr("freeze windows", freezeWindowsErr))
+	}
+
 	jobWarnings, jobsErr := validateJobs(c)
 	if jobsErr != nil {
 		errorMessages = append(errorMessages, formatErr("jobs", jobsErr))
@@ -137,6 +155,16 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.WebhookFilter != nil {
+			if resource.WebhookToken == "" {
+				errorMessages = append(errorMessages, identifier+" has a webhook_filter but no webhook_token to verify payloads with")
+			}
+
+			if err := resource.WebhookFilter.Validate(); err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid webhook_filter: %s", identifier, err))
+			}
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -207,6 +235,151 @@ func usedResources(c Config) map[string]bool {
 	return usedResources
 }
 
+func validateDisplay(c Config) error {
+	if c.Display == nil {
+		return nil
+	}
+
+	errorMessages := []string{}
+
+	if c.Display.BackgroundImage != "" {
+		backgroundImage, err := url.Parse(c.Display.BackgroundImage)
+		if err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("display.background_image is not a valid URL: %s", err))
+		} else if backgroundImage.Scheme != "http" && backgroundImage.Scheme != "https" {
+			errorMessages = append(errorMessages, "display.background_image must be an http or https URL")
+		}
+	}
+
+	if c.Display.DefaultGroup != "" {
+		found := false
+		for _, group := range c.Groups {
+			if group.Name == c.Display.DefaultGroup {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			errorMessages = append(errorMessages, fmt.Sprintf("display.default_group refers to a group that does not exist ('%s')", c.Display.DefaultGroup))
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
+func validateVarSources(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]int{}
+
+	for i, varSource := range c.VarSources {
+		var identifier string
+		if varSource.Name == "" {
+			identifier = fmt.Sprintf("var_sources[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("var_sources.%s", varSource.Name)
+		}
+
+		if other, exists := names[varSource.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"var_sources[%d] and var_sources[%d] have the same name ('%s')",
+					other, i, varSource.Name))
+		} else if varSource.Name != "" {
+			names[varSource.Name] = i
+		}
+
+		if varSource.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if strings.ContainsAny(varSource.Name, ".:") {
+			errorMessages = append(errorMessages, identifier+" has an invalid name; it may not contain '.' or ':'")
+		}
+
+		if varSource.Type == "" {
+			errorMessages = append(errorMessages, identifier+" has no type")
+		} else if !isVarSourceType(varSource.Type) {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"%s has an unknown type '%s' (must be one of: %s)",
+					identifier, varSource.Type, strings.Join(VarSourceTypes, ", ")))
+		}
+
+		if varSource.HasRedactedValues() {
+			errorMessages = append(errorMessages, identifier+" has redacted values; set its credentials or reference them as ((vars))")
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
+func validateFreezeWindows(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]int{}
+
+	for i, window := range c.FreezeWindows {
+		var identifier string
+		if window.Name == "" {
+			identifier = fmt.Sprintf("freeze_windows[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("freeze_windows.%s", window.Name)
+		}
+
+		if other, exists := names[window.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"freeze_windows[%d] and freeze_windows[%d] have the same name ('%s')",
+					other, i, window.Name))
+		} else if window.Name != "" {
+			names[window.Name] = i
+		}
+
+		if window.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		}
+
+		if window.Start == "" {
+			errorMessages = append(errorMessages, identifier+" has no start")
+		} else if _, err := cronexpr.Parse(window.Start); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid start: %s", identifier, err))
+		}
+
+		if window.Duration == "" {
+			errorMessages = append(errorMessages, identifier+" has no duration")
+		} else if duration, err := time.ParseDuration(window.Duration); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid duration: %s", identifier, err))
+		} else if duration <= 0 {
+			errorMessages = append(errorMessages, identifier+" must have a positive duration")
+		}
+
+		if window.Location != "" {
+			if _, err := time.LoadLocation(window.Location); err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid location: %s", identifier, err))
+			}
+		}
+
+		for _, job := range window.Jobs {
+			if _, exists := c.Jobs.Lookup(job); !exists {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("%s has unknown job '%s'", identifier, job))
+			}
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
+func isVarSourceType(sourceType string) bool {
+	for _, t := range VarSourceTypes {
+		if t == sourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
 func validateJobs(c Config) ([]ConfigWarning, error) {
 	errorMessages := []string{}
 	warnings := []ConfigWarning{}
@@ -363,6 +536,14 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		foundTypes.Find("task")
 	}
 
+	if plan.SetPipeline != "" {
+		foundTypes.Find("set_pipeline")
+	}
+
+	if plan.LoadVar != "" {
+		foundTypes.Find("load_var")
+	}
+
 	if plan.Do != nil {
 		foundTypes.Find("do")
 	}
@@ -449,6 +630,24 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		}
 
 		for _, job := range plan.Passed {
+			if strings.Contains(job, "/") {
+				// jobs of other pipelines can only be checked against the
+				// team's pipelines when the config is saved
+				passedJob := ParsePassedJob(job)
+				if passedJob.Pipeline == "" || passedJob.Job == "" || strings.Contains(passedJob.Job, "/") {
+					errorMessages = append(
+						errorMessages,
+						fmt.Sprintf(
+							"%s.passed references an invalid job ('%s'); jobs of other pipelines are referenced as pipeline/job",
+							identifier,
+							job,
+						),
+					)
+				}
+
+				continue
+			}
+
 			jobConfig, found := c.Jobs.Lookup(job)
 			if !found {
 				errorMessages = append(
@@ -555,6 +754,36 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			plan, identifier)...,
 		)
 
+	case plan.SetPipeline != "":
+		identifier = fmt.Sprintf("%s.set_pipeline.%s", identifier, plan.SetPipeline)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any pipeline configuration")
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config"},
+			plan, identifier)...,
+		)
+
+	case plan.LoadVar != "":
+		identifier = fmt.Sprintf("%s.load_var.%s", identifier, plan.LoadVar)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any file")
+		}
+
+		switch plan.Format {
+		case "", "json", "yaml", "yml", "trim", "raw":
+		default:
+			errorMessages = append(errorMessages, identifier+" has an unknown format: "+plan.Format)
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config"},
+			plan, identifier)...,
+		)
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
@@ -597,6 +826,17 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		errorMessages = append(errorMessages, planErrMessages...)
 	}
 
+	if plan.OnRetry != nil {
+		subIdentifier := fmt.Sprintf("%s.on_retry", identifier)
+		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.OnRetry)
+		warnings = append(warnings, planWarnings...)
+		errorMessages = append(errorMessages, planErrMessages...)
+
+		if plan.Attempts == 0 {
+			errorMessages = append(errorMessages, identifier+" specifies on_retry without attempts")
+		}
+	}
+
 	if plan.Timeout != "" {
 		_, err := time.ParseDuration(plan.Timeout)
 		if err != nil {
@@ -610,9 +850,129 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if plan.Backoff != nil {
+		errorMessages = append(errorMessages, validateBackoff(identifier, plan)...)
+	}
+
+	switch plan.RetryOn {
+	case "", RetryOnError, RetryOnFailure:
+	default:
+		subIdentifier := fmt.Sprintf("%s.retry_on", identifier)
+		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" must be '%s' or '%s', not '%s'", RetryOnError, RetryOnFailure, plan.RetryOn))
+	}
+
+	if plan.RetryOn != "" && plan.Attempts == 0 {
+		errorMessages = append(errorMessages, identifier+" specifies retry_on without attempts")
+	}
+
+	if plan.SkipIfUnchanged && plan.Task == "" {
+		errorMessages = append(errorMessages, identifier+" specifies skip_if_unchanged but is not a task step")
+	}
+
+	if plan.Hermetic && plan.Task == "" {
+		errorMessages = append(errorMessages, identifier+" specifies hermetic but is not a task step")
+	}
+
+	if plan.Limits != nil && plan.Get == "" && plan.Put == "" {
+		errorMessages = append(errorMessages, identifier+" specifies container_limits but is not a get or put step")
+	}
+
+	if plan.FailFast && len(plan.Across) == 0 {
+		errorMessages = append(errorMessages, identifier+" specifies fail_fast without across")
+	}
+
+	if len(plan.Across) > 0 {
+		errorMessages = append(errorMessages, validateAcross(identifier, plan)...)
+	}
+
+	if plan.If != "" {
+		_, err := ParseCondition(plan.If)
+		if err != nil {
+			subIdentifier := fmt.Sprintf("%s.if", identifier)
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid condition: %s", err))
+		}
+	}
+
 	return warnings, errorMessages
 }
 
+func validateBackoff(identifier string, plan PlanConfig) []string {
+	errorMessages := []string{}
+
+	subIdentifier := fmt.Sprintf("%s.backoff", identifier)
+
+	if plan.Attempts == 0 {
+		errorMessages = append(errorMessages, identifier+" specifies a backoff without attempts")
+	}
+
+	var initial, max time.Duration
+
+	if plan.Backoff.Initial != "" {
+		var err error
+		initial, err = time.ParseDuration(plan.Backoff.Initial)
+		if err != nil {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(".initial refers to a duration that could not be parsed ('%s')", plan.Backoff.Initial))
+		}
+	}
+
+	if plan.Backoff.Max != "" {
+		var err error
+		max, err = time.ParseDuration(plan.Backoff.Max)
+		if err != nil {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(".max refers to a duration that could not be parsed ('%s')", plan.Backoff.Max))
+		}
+	}
+
+	if initial < 0 || max < 0 {
+		errorMessages = append(errorMessages, subIdentifier+" cannot have a negative duration")
+	}
+
+	if initial > 0 && max > 0 && max < initial {
+		errorMessages = append(errorMessages, subIdentifier+" has a max shorter than its initial duration")
+	}
+
+	if plan.Backoff.Factor != 0 && plan.Backoff.Factor < 1 {
+		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid factor (%g), which must be at least 1", plan.Backoff.Factor))
+	}
+
+	return errorMessages
+}
+
+func validateAcross(identifier string, plan PlanConfig) []string {
+	errorMessages := []string{}
+
+	if plan.Get != "" {
+		errorMessages = append(errorMessages, identifier+" cannot use across, as its version is determined before the build starts")
+	}
+
+	names := map[string]int{}
+
+	for i, v := range plan.Across {
+		subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+		if v.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" has no var")
+		} else if other, exists := names[v.Var]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"%s.across[%d] and %s.across[%d] have the same var ('%s')",
+					identifier, other, identifier, i, v.Var))
+		} else {
+			names[v.Var] = i
+		}
+
+		if len(v.Values) == 0 {
+			errorMessages = append(errorMessages, subIdentifier+" has no values")
+		}
+
+		if v.MaxInFlight < 0 {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid max_in_flight (%d)", v.MaxInFlight))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	errorMessages := []string{}
 	foundInapplicableFields := []string{}
@@ -238,6 +238,30 @@ var _ = Describe("ValidateConfig", func() {
 				))
 			})
 		})
+
+		Context("when a resource has a webhook filter but no webhook token", func() {
+			BeforeEach(func() {
+				config.Resources[0].WebhookFilter = &WebhookFilter{Branch: "master"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has a webhook_filter but no webhook_token"))
+			})
+		})
+
+		Context("when a resource has a malformed webhook filter", func() {
+			BeforeEach(func() {
+				config.Resources[0].WebhookToken = "some-token"
+				config.Resources[0].WebhookFilter = &WebhookFilter{Branch: "release/[v"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has an invalid webhook_filter"))
+			})
+		})
 	})
 
 	Describe("unused resources", func() {
@@ -484,6 +508,243 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("invalid display", func() {
+		Context("when the background image is not an http URL", func() {
+			BeforeEach(func() {
+				config.Display = &DisplayConfig{
+					BackgroundImage: "file:///etc/passwd",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid display:"))
+				Expect(errorMessages[0]).To(ContainSubstring("display.background_image must be an http or https URL"))
+			})
+		})
+
+		Context("when the default group does not exist", func() {
+			BeforeEach(func() {
+				config.Display = &DisplayConfig{
+					DefaultGroup: "bogus-group",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid display:"))
+				Expect(errorMessages[0]).To(ContainSubstring("display.default_group refers to a group that does not exist ('bogus-group')"))
+			})
+		})
+
+		Context("when the display is valid", func() {
+			BeforeEach(func() {
+				config.Display = &DisplayConfig{
+					BackgroundImage: "https://example.com/image.png",
+					DefaultGroup:    "some-group",
+				}
+			})
+
+			It("returns no errors", func() {
+				Expect(errorMessages).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("invalid var sources", func() {
+		BeforeEach(func() {
+			config.VarSources = VarSourceConfigs{
+				{
+					Name:   "some-var-source",
+					Type:   "vault",
+					Config: map[string]interface{}{"url": "https://vault.example.com"},
+				},
+			}
+		})
+
+		Context("when a var source has no name", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Type: "vault",
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[1] has no name"))
+			})
+		})
+
+		Context("when a var source name contains a colon", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Name: "bogus:source",
+					Type: "vault",
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources.bogus:source has an invalid name"))
+			})
+		})
+
+		Context("when a var source has no type", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Name: "bogus-source",
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources.bogus-source has no type"))
+			})
+		})
+
+		Context("when a var source has an unknown type", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Name: "bogus-source",
+					Type: "bogus",
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources.bogus-source has an unknown type 'bogus'"))
+			})
+		})
+
+		Context("when a var source has redacted values", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Name:   "exported-source",
+					Type:   "vault",
+					Config: map[string]interface{}{"client_token": "((redacted))"},
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources.exported-source has redacted values"))
+			})
+		})
+
+		Context("when two var sources have the same name", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, config.VarSources...)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[0] and var_sources[1] have the same name ('some-var-source')"))
+			})
+		})
+	})
+
+	Describe("invalid freeze windows", func() {
+		BeforeEach(func() {
+			config.FreezeWindows = FreezeWindowConfigs{
+				{
+					Name:     "weekend",
+					Start:    "0 18 * * 5",
+					Duration: "62h",
+					Location: "Europe/London",
+					Jobs:     []string{"some-job"},
+				},
+			}
+		})
+
+		It("does not return an error for a valid window", func() {
+			Expect(errorMessages).To(BeEmpty())
+		})
+
+		Context("when a freeze window has no name", func() {
+			BeforeEach(func() {
+				config.FreezeWindows[0].Name = ""
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid freeze windows:"))
+				Expect(errorMessages[0]).To(ContainSubstring("freeze_windows[0] has no name"))
+			})
+		})
+
+		Context("when a freeze window has an invalid start", func() {
+			BeforeEach(func() {
+				config.FreezeWindows[0].Start = "every friday"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("freeze_windows.weekend has an invalid start"))
+			})
+		})
+
+		Context("when a freeze window has an invalid duration", func() {
+			BeforeEach(func() {
+				config.FreezeWindows[0].Duration = "a weekend"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("freeze_windows.weekend has an invalid duration"))
+			})
+		})
+
+		Context("when a freeze window has a negative duration", func() {
+			BeforeEach(func() {
+				config.FreezeWindows[0].Duration = "-1h"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("freeze_windows.weekend must have a positive duration"))
+			})
+		})
+
+		Context("when a freeze window has an invalid location", func() {
+			BeforeEach(func() {
+				config.FreezeWindows[0].Location = "Middle/Earth"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("freeze_windows.weekend has an invalid location"))
+			})
+		})
+
+		Context("when a freeze window has an unknown job", func() {
+			BeforeEach(func() {
+				config.FreezeWindows[0].Jobs = []string{"bogus-job"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("freeze_windows.weekend has unknown job 'bogus-job'"))
+			})
+		})
+
+		Context("when two freeze windows have the same name", func() {
+			BeforeEach(func() {
+				config.FreezeWindows = append(config.FreezeWindows, config.FreezeWindows...)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("freeze_windows[0] and freeze_windows[1] have the same name ('weekend')"))
+			})
+		})
+	})
+
 	Describe("validating a job", func() {
 		var job JobConfig
 
@@ -742,6 +1003,75 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a set_pipeline plan has no file specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline: "some-pipeline",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline does not specify any pipeline configuration"))
+				})
+			})
+
+			Context("when a set_pipeline plan has invalid fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline:    "some-pipeline",
+						TaskConfigPath: "some-input/pipeline.yml",
+						Resource:       "some-resource",
+						Privileged:     true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline has invalid fields specified (resource, privileged)"))
+				})
+			})
+
+			Context("when a load_var plan has no file specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar: "some-var",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var does not specify any file"))
+				})
+			})
+
+			Context("when a load_var plan has an unknown format", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-input/version",
+						Format:         "toml",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var has an unknown format: toml"))
+				})
+			})
+
 			Context("when a task plan has config path and config specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1206,6 +1536,298 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a retry plan has a valid backoff and retry_on", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 3,
+						Backoff: &RetryBackoff{
+							Initial: "1s",
+							Max:     "1m",
+							Factor:  1.5,
+							Jitter:  true,
+						},
+						RetryOn: RetryOnError,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
+			Context("when a retry plan has an invalid backoff", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 3,
+						Backoff: &RetryBackoff{
+							Initial: "1m",
+							Max:     "bogus",
+							Factor:  0.5,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.backoff.max refers to a duration that could not be parsed ('bogus')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.backoff has an invalid factor (0.5), which must be at least 1"))
+				})
+			})
+
+			Context("when a retry plan has a max backoff shorter than its initial backoff", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 3,
+						Backoff: &RetryBackoff{
+							Initial: "1m",
+							Max:     "1s",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.backoff has a max shorter than its initial duration"))
+				})
+			})
+
+			Context("when a step has a backoff and retry_on but no attempts", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:     "some-resource",
+						Backoff: &RetryBackoff{Initial: "1s"},
+						RetryOn: RetryOnFailure,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource specifies a backoff without attempts"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource specifies retry_on without attempts"))
+				})
+			})
+
+			Context("when a step other than a task is skipped if unchanged", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:             "some-resource",
+						SkipIfUnchanged: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource specifies skip_if_unchanged but is not a task step"))
+				})
+			})
+
+			Context("when a step other than a get or put has container limits", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:   "some-task",
+						Limits: &ContainerLimits{},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task specifies container_limits but is not a get or put step"))
+				})
+			})
+
+			Context("when a step other than a task is hermetic", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Hermetic: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource specifies hermetic but is not a task step"))
+				})
+			})
+
+			Context("when a retry plan has an invalid on_retry hook", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 3,
+						OnRetry: &PlanConfig{
+							Put:      "custom-name",
+							Resource: "some-missing-resource",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.on_retry.put.custom-name refers to a resource that does not exist ('some-missing-resource')"))
+				})
+			})
+
+			Context("when a step has an on_retry hook but no attempts", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						OnRetry: &PlanConfig{
+							Put: "some-resource",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource specifies on_retry without attempts"))
+				})
+			})
+
+			Context("when a retry plan has an unknown retry_on", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 3,
+						RetryOn:  "bogus",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.retry_on must be 'error' or 'failure', not 'bogus'"))
+				})
+			})
+
+			Context("when an across step has a var with no values", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some/config.yml",
+						Across: []AcrossVarConfig{
+							{Var: "some-var"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.across[0] has no values"))
+				})
+			})
+
+			Context("when an across step has the same var twice", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some/config.yml",
+						Across: []AcrossVarConfig{
+							{Var: "some-var", Values: []interface{}{"a"}},
+							{Var: "some-var", Values: []interface{}{"b"}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.across[0] and jobs.some-other-job.plan[0].task.some-task.across[1] have the same var ('some-var')"))
+				})
+			})
+
+			Context("when an across step is a get", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						Across: []AcrossVarConfig{
+							{Var: "some-var", Values: []interface{}{"a"}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource cannot use across"))
+				})
+			})
+
+			Context("when a step specifies fail_fast without across", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some/config.yml",
+						FailFast:       true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task specifies fail_fast without across"))
+				})
+			})
+
+			Context("when a step has a valid condition", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some/config.yml",
+						If:             `build.pipeline_name == "some-pipeline" && vars.some-var != 'some-value'`,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a step has a condition that cannot be parsed", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some/config.yml",
+						If:             `build.bogus == "some-value"`,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.if has an invalid condition: invalid reference: build.bogus"))
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1304,6 +1926,37 @@ var _ = Describe("ValidateConfig", func() {
 					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.passed references a job ('some-empty-job') which doesn't interact with the resource ('some-resource')"))
 				})
 			})
+
+			Context("when a job's input's passed constraints reference a job of another pipeline", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:    "some-resource",
+						Passed: []string{"other-pipeline/other-job"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job's input's passed constraints reference a job of another pipeline without naming the pipeline", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:    "some-resource",
+						Passed: []string{"/other-job"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.passed references an invalid job ('/other-job'); jobs of other pipelines are referenced as pipeline/job"))
+				})
+			})
 		})
 
 		Context("when two jobs have the same name", func() {
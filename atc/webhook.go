@@ -0,0 +1,127 @@
+package atc
+
+import (
+	"path"
+	"strings"
+)
+
+// WebhookEventPush is the event of a push of commits or tags, whichever
+// repository host sent it.
+const WebhookEventPush = "push"
+
+// WebhookFilter decides which payloads sent to a pipeline's webhook trigger a
+// check of the resource. Each field is a glob pattern, as understood by
+// path.Match; an empty Repository or Branch matches anything, and an empty
+// Event matches pushes.
+type WebhookFilter struct {
+	// Repository is matched against each of the URLs the repository of the
+	// payload is known by, with their scheme, user, and .git suffix stripped,
+	// e.g. github.com/concourse/concourse.
+	Repository string `json:"repository,omitempty"`
+	Branch     string `json:"branch,omitempty"`
+	Event      string `json:"event,omitempty"`
+}
+
+// WebhookPayload is what's known about a webhook payload once it has been
+// parsed, regardless of the repository host which sent it. A single push may
+// update many branches.
+type WebhookPayload struct {
+	Event        string
+	Repositories []string
+	Branches     []string
+}
+
+// Validate returns an error if any of the filter's patterns are malformed.
+func (filter WebhookFilter) Validate() error {
+	for _, pattern := range []string{
+		NormalizeRepositoryURL(filter.Repository),
+		filter.Branch,
+		filter.Event,
+	} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether the payload passes the filter. Malformed patterns
+// never match; they are rejected when the config is saved.
+func (filter WebhookFilter) Matches(payload WebhookPayload) bool {
+	event := filter.Event
+	if event == "" {
+		event = WebhookEventPush
+	}
+
+	if !globMatches(event, payload.Event) {
+		return false
+	}
+
+	if filter.Branch != "" && !anyGlobMatches(filter.Branch, payload.Branches) {
+		return false
+	}
+
+	if filter.Repository == "" {
+		return true
+	}
+
+	var repositories []string
+	for _, repository := range payload.Repositories {
+		repositories = append(repositories, NormalizeRepositoryURL(repository))
+	}
+
+	return anyGlobMatches(NormalizeRepositoryURL(filter.Repository), repositories)
+}
+
+// NormalizeRepositoryURL strips a repository URL down to its host and path so
+// that the different URLs of a repository compare equal, e.g.
+// https://github.com/concourse/concourse.git and
+// git@github.com:concourse/concourse both become
+// github.com/concourse/concourse.
+func NormalizeRepositoryURL(url string) string {
+	url = strings.TrimSpace(url)
+
+	scheme := strings.Index(url, "://")
+	if scheme != -1 {
+		url = url[scheme+3:]
+	}
+
+	host := url
+	rest := ""
+	if slash := strings.Index(url, "/"); slash != -1 {
+		host, rest = url[:slash], url[slash:]
+	}
+
+	if at := strings.LastIndex(host, "@"); at != -1 {
+		host = host[at+1:]
+	}
+
+	if colon := strings.Index(host, ":"); colon != -1 {
+		if scheme == -1 {
+			// scp-like syntax, i.e. host:owner/repo
+			rest = "/" + host[colon+1:] + rest
+		}
+
+		host = host[:colon]
+	}
+
+	rest = strings.TrimSuffix(strings.TrimSuffix(rest, "/"), ".git")
+
+	return strings.ToLower(host) + rest
+}
+
+func globMatches(pattern string, value string) bool {
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
+
+func anyGlobMatches(pattern string, values []string) bool {
+	for _, value := range values {
+		if globMatches(pattern, value) {
+			return true
+		}
+	}
+
+	return false
+}
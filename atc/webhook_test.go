@@ -0,0 +1,63 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebhookFilter", func() {
+	var payload atc.WebhookPayload
+
+	BeforeEach(func() {
+		payload = atc.WebhookPayload{
+			Event: atc.WebhookEventPush,
+			Repositories: []string{
+				"https://github.com/concourse/concourse.git",
+				"git@github.com:concourse/concourse.git",
+			},
+			Branches: []string{"release/v5"},
+		}
+	})
+
+	DescribeTable("Matches",
+		func(filter atc.WebhookFilter, matches bool) {
+			Expect(filter.Matches(payload)).To(Equal(matches))
+		},
+		Entry("an empty filter matches pushes", atc.WebhookFilter{}, true),
+		Entry("the repository, by any of its urls", atc.WebhookFilter{Repository: "ssh://git@github.com/concourse/concourse"}, true),
+		Entry("a repository pattern", atc.WebhookFilter{Repository: "github.com/concourse/*"}, true),
+		Entry("another repository", atc.WebhookFilter{Repository: "github.com/concourse/fly"}, false),
+		Entry("the branch", atc.WebhookFilter{Branch: "release/v5"}, true),
+		Entry("a branch pattern", atc.WebhookFilter{Branch: "release/*"}, true),
+		Entry("another branch", atc.WebhookFilter{Branch: "master"}, false),
+		Entry("another event", atc.WebhookFilter{Event: "pull_request"}, false),
+		Entry("a malformed pattern", atc.WebhookFilter{Branch: "release/[v"}, false),
+	)
+
+	It("does not match other events unless asked to", func() {
+		payload.Event = "pull_request"
+		Expect(atc.WebhookFilter{}.Matches(payload)).To(BeFalse())
+		Expect(atc.WebhookFilter{Event: "pull_*"}.Matches(payload)).To(BeTrue())
+	})
+
+	Describe("Validate", func() {
+		It("rejects malformed patterns", func() {
+			Expect(atc.WebhookFilter{Repository: "github.com/concourse/*"}.Validate()).To(Succeed())
+			Expect(atc.WebhookFilter{Branch: "release/[v"}.Validate()).ToNot(Succeed())
+		})
+	})
+})
+
+var _ = DescribeTable("NormalizeRepositoryURL",
+	func(url string) {
+		Expect(atc.NormalizeRepositoryURL(url)).To(Equal("github.com/concourse/concourse"))
+	},
+	Entry("https", "https://github.com/concourse/concourse.git"),
+	Entry("with a user and trailing slash", "https://user@GitHub.com/concourse/concourse/"),
+	Entry("ssh", "ssh://git@github.com:22/concourse/concourse.git"),
+	Entry("scp-like", "git@github.com:concourse/concourse.git"),
+	Entry("no scheme", "github.com/concourse/concourse"),
+)
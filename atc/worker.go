@@ -22,6 +22,10 @@ type Worker struct {
 
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
+	// The CPU shares and bytes of memory available to the worker's
+	// containers, if known.
+	Capacity *ContainerLimits `json:"capacity,omitempty"`
+
 	Platform  string   `json:"platform"`
 	Tags      []string `json:"tags"`
 	Team      string   `json:"team"`
@@ -29,6 +33,7 @@ type Worker struct {
 	Version   string   `json:"version"`
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
+	Hermetic  bool     `json:"hermetic,omitempty"`
 	State     string   `json:"state"`
 }
 
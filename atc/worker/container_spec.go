@@ -15,6 +15,10 @@ type WorkerSpec struct {
 	Tags          []string
 	TeamID        int
 	ResourceTypes atc.VersionedResourceTypes
+
+	// Whether the worker must be able to run containers without external
+	// network access.
+	Hermetic bool
 }
 
 type ContainerSpec struct {
@@ -44,6 +48,10 @@ type ContainerSpec struct {
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Whether to deny the container external network access. Only workers
+	// which advertise support for hermetic containers can create them.
+	Hermetic bool
 }
 
 //go:generate counterfeiter . InputSource
@@ -114,5 +122,9 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if spec.Hermetic {
+		attrs = append(attrs, "hermetic")
+	}
+
 	return strings.Join(attrs, ", ")
 }
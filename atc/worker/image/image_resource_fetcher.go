@@ -247,7 +247,7 @@ func (i *imageResourceFetcher) ensureVersionOfType(
 	}
 
 	checkResourceType := i.resourceFactory.NewResourceForContainer(resourceTypeContainer)
-	versions, err := checkResourceType.Check(context.TODO(), resourceType.Source, nil)
+	versions, err := checkResourceType.Check(context.TODO(), resource.IOConfig{}, resourceType.Source, nil)
 	if err != nil {
 		return err
 	}
@@ -305,7 +305,7 @@ func (i *imageResourceFetcher) getLatestVersion(
 	}
 
 	checkingResource := i.resourceFactory.NewResourceForContainer(imageContainer)
-	versions, err := checkingResource.Check(context.TODO(), i.imageResource.Source, nil)
+	versions, err := checkingResource.Check(context.TODO(), resource.IOConfig{}, i.imageResource.Source, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -329,7 +329,7 @@ var _ = Describe("Image", func() {
 
 							It("ran 'check' with the right config", func() {
 								Expect(fakeCheckResource.CheckCallCount()).To(Equal(1))
-								_, checkSource, checkVersion := fakeCheckResource.CheckArgsForCall(0)
+								_, _, checkSource, checkVersion := fakeCheckResource.CheckArgsForCall(0)
 								Expect(checkVersion).To(BeNil())
 								Expect(checkSource).To(Equal(atc.Source{"some": "super-secret-sauce"}))
 							})
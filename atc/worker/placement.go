@@ -8,6 +8,9 @@ import (
 	"github.com/concourse/concourse/atc/db"
 )
 
+// ContainerPlacementStrategy chooses a worker for a container. Workers without
+// the capacity for the container's limits are left out of the workers it
+// chooses from, unless none of them have the capacity.
 type ContainerPlacementStrategy interface {
 	//TODO: Don't pass around container metadata since it's not guaranteed to be deterministic.
 	// Change this after check containers stop being reused
@@ -151,3 +154,33 @@ func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []W
 func (strategy *RandomPlacementStrategy) ModifiesActiveTasks() bool {
 	return false
 }
+
+// workersWithCapacity returns the workers that have the capacity for the
+// limits of the container. If none of them do, all of the workers are
+// returned, as running the container on an oversubscribed worker is better
+// than not running it at all.
+func workersWithCapacity(logger lager.Logger, workers []Worker, spec ContainerSpec) []Worker {
+	if spec.Limits.CPU == nil && spec.Limits.Memory == nil {
+		return workers
+	}
+
+	candidates := []Worker{}
+	for _, w := range workers {
+		hasCapacity, err := w.HasCapacityFor(spec.Limits)
+		if err != nil {
+			logger.Error("failed-to-determine-worker-capacity", err, lager.Data{"worker": w.Name()})
+			hasCapacity = true
+		}
+
+		if hasCapacity {
+			candidates = append(candidates, w)
+		}
+	}
+
+	if len(candidates) == 0 {
+		logger.Info("all-workers-oversubscribed")
+		return workers
+	}
+
+	return candidates
+}
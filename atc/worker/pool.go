@@ -189,7 +189,9 @@ dance:
 	}
 
 	if worker == nil {
-		worker, err = strategy.Choose(logger, compatibleWorkers, containerSpec)
+		candidates := workersWithCapacity(logger, compatibleWorkers, containerSpec)
+
+		worker, err = strategy.Choose(logger, candidates, containerSpec)
 		if err != nil {
 			return nil, err
 		}
@@ -280,6 +280,70 @@ var _ = Describe("Pool", func() {
 					Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
 				})
 
+				It("does not check the capacity of the workers", func() {
+					Expect(workerA.HasCapacityForCallCount()).To(BeZero())
+					Expect(workerB.HasCapacityForCallCount()).To(BeZero())
+				})
+
+				Context("when the container has limits", func() {
+					var cpu, memory uint64
+
+					BeforeEach(func() {
+						cpu = 512
+						memory = 1024
+
+						spec.Limits = ContainerLimits{CPU: &cpu, Memory: &memory}
+
+						workerA.HasCapacityForReturns(true, nil)
+						workerB.HasCapacityForReturns(true, nil)
+					})
+
+					It("checks the capacity of the workers satisfying the spec for the limits", func() {
+						Expect(workerA.HasCapacityForCallCount()).To(Equal(1))
+						Expect(workerA.HasCapacityForArgsForCall(0)).To(Equal(spec.Limits))
+
+						Expect(workerB.HasCapacityForCallCount()).To(Equal(1))
+						Expect(workerB.HasCapacityForArgsForCall(0)).To(Equal(spec.Limits))
+
+						Expect(workerC.HasCapacityForCallCount()).To(BeZero())
+					})
+
+					Context("when a worker does not have the capacity", func() {
+						BeforeEach(func() {
+							workerB.HasCapacityForReturns(false, nil)
+						})
+
+						It("chooses from the workers that do", func() {
+							_, candidates, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(candidates).To(ConsistOf(workerA))
+						})
+					})
+
+					Context("when the capacity of a worker cannot be determined", func() {
+						BeforeEach(func() {
+							workerA.HasCapacityForReturns(false, nil)
+							workerB.HasCapacityForReturns(false, errors.New("nope"))
+						})
+
+						It("chooses from the workers including it", func() {
+							_, candidates, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(candidates).To(ConsistOf(workerB))
+						})
+					})
+
+					Context("when no worker has the capacity", func() {
+						BeforeEach(func() {
+							workerA.HasCapacityForReturns(false, nil)
+							workerB.HasCapacityForReturns(false, nil)
+						})
+
+						It("chooses from all workers satisfying the spec", func() {
+							_, candidates, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(candidates).To(ConsistOf(workerA, workerB))
+						})
+					})
+				})
+
 				Context("when no workers satisfy the spec", func() {
 					BeforeEach(func() {
 						workerA.SatisfiesReturns(false)
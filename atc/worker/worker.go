@@ -25,6 +25,16 @@ const userPropertyName = "user"
 
 var ResourceConfigCheckSessionExpiredError = errors.New("no db container was found for owner")
 
+// HermeticNotSupportedError is returned when a hermetic container is to be
+// created on a worker whose runtime cannot block its network access.
+type HermeticNotSupportedError struct {
+	WorkerName string
+}
+
+func (err HermeticNotSupportedError) Error() string {
+	return fmt.Sprintf("worker '%s' does not support hermetic containers", err.WorkerName)
+}
+
 //go:generate counterfeiter . Worker
 
 type Worker interface {
@@ -59,6 +69,7 @@ type Worker interface {
 	CreateVolume(logger lager.Logger, spec VolumeSpec, teamID int, volumeType db.VolumeType) (Volume, error)
 
 	GardenClient() gclient.Client
+	HasCapacityFor(ContainerLimits) (bool, error)
 	ActiveTasks() (int, error)
 	IncreaseActiveTasks() error
 	DecreaseActiveTasks() error
@@ -190,6 +201,10 @@ func (worker *gardenWorker) FindOrCreateContainer(
 		err               error
 	)
 
+	if containerSpec.Hermetic && !worker.dbWorker.Hermetic() {
+		return nil, HermeticNotSupportedError{WorkerName: worker.dbWorker.Name()}
+	}
+
 	// ensure either creatingContainer or createdContainer exists
 	creatingContainer, createdContainer, err = worker.dbWorker.FindContainer(owner)
 	if err != nil {
@@ -202,6 +217,14 @@ func (worker *gardenWorker) FindOrCreateContainer(
 		containerHandle = createdContainer.Handle()
 	} else {
 
+		if containerSpec.Limits.CPU != nil {
+			metadata.CPULimit = *containerSpec.Limits.CPU
+		}
+
+		if containerSpec.Limits.Memory != nil {
+			metadata.MemoryLimit = *containerSpec.Limits.Memory
+		}
+
 		logger.Debug("creating-container-in-db")
 		creatingContainer, err = worker.dbWorker.CreateContainer(
 			owner,
@@ -690,6 +713,10 @@ func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool
 		return false
 	}
 
+	if spec.Hermetic && !worker.dbWorker.Hermetic() {
+		return false
+	}
+
 	return true
 }
 
@@ -748,6 +775,36 @@ insert_coin:
 	return true
 }
 
+// HasCapacityFor returns whether a container with the given limits fits in the
+// capacity advertised by the worker alongside the limits of its existing
+// containers. Limits are only checked against the capacity the worker
+// advertised, so any container fits on a worker that advertised none.
+func (worker *gardenWorker) HasCapacityFor(limits ContainerLimits) (bool, error) {
+	capacity := worker.dbWorker.Capacity()
+
+	checkCPU := capacity.CPU != nil && limits.CPU != nil
+	checkMemory := capacity.Memory != nil && limits.Memory != nil
+
+	if !checkCPU && !checkMemory {
+		return true, nil
+	}
+
+	allocatedCPU, allocatedMemory, err := worker.dbWorker.Allocated()
+	if err != nil {
+		return false, err
+	}
+
+	if checkCPU && allocatedCPU+*limits.CPU > *capacity.CPU {
+		return false, nil
+	}
+
+	if checkMemory && allocatedMemory+*limits.Memory > *capacity.Memory {
+		return false, nil
+	}
+
+	return true, nil
+}
+
 func (worker *gardenWorker) ActiveTasks() (int, error) {
 	return worker.dbWorker.ActiveTasks()
 }
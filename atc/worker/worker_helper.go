@@ -35,19 +35,22 @@ func (w workerHelper) createGardenContainer(
 
 	env := append(fetchedImage.Metadata.Env, containerSpec.Env...)
 
-	if w.dbWorker.HTTPProxyURL() != "" {
-		env = append(env, fmt.Sprintf("http_proxy=%s", w.dbWorker.HTTPProxyURL()))
-	}
+	// a hermetic container could reach the network through a proxy
+	if !containerSpec.Hermetic {
+		if w.dbWorker.HTTPProxyURL() != "" {
+			env = append(env, fmt.Sprintf("http_proxy=%s", w.dbWorker.HTTPProxyURL()))
+		}
 
-	if w.dbWorker.HTTPSProxyURL() != "" {
-		env = append(env, fmt.Sprintf("https_proxy=%s", w.dbWorker.HTTPSProxyURL()))
-	}
+		if w.dbWorker.HTTPSProxyURL() != "" {
+			env = append(env, fmt.Sprintf("https_proxy=%s", w.dbWorker.HTTPSProxyURL()))
+		}
 
-	if w.dbWorker.NoProxy() != "" {
-		env = append(env, fmt.Sprintf("no_proxy=%s", w.dbWorker.NoProxy()))
+		if w.dbWorker.NoProxy() != "" {
+			env = append(env, fmt.Sprintf("no_proxy=%s", w.dbWorker.NoProxy()))
+		}
 	}
 
-	return w.gardenClient.Create(
+	gardenContainer, err := w.gardenClient.Create(
 		garden.ContainerSpec{
 			Handle:     handleToCreate,
 			RootFSPath: fetchedImage.URL,
@@ -57,6 +60,20 @@ func (w workerHelper) createGardenContainer(
 			Env:        env,
 			Properties: gardenProperties,
 		})
+	if err != nil {
+		return nil, err
+	}
+
+	// workers which support hermetic containers deny all outbound traffic by
+	// default, so every other container is allowed to reach the network
+	if w.dbWorker.Hermetic() && !containerSpec.Hermetic {
+		err = gardenContainer.NetOut(garden.NetOutRule{Protocol: garden.ProtocolAll})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gardenContainer, nil
 }
 
 func (w workerHelper) constructGardenWorkerContainer(
@@ -706,6 +706,33 @@ var _ = Describe("Worker", func() {
 			})
 		})
 
+		Context("when hermetic containers are required", func() {
+			BeforeEach(func() {
+				spec.Platform = "some-platform"
+				spec.Hermetic = true
+			})
+
+			Context("when the worker supports them", func() {
+				BeforeEach(func() {
+					fakeDBWorker.HermeticReturns(true)
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when the worker does not support them", func() {
+				BeforeEach(func() {
+					fakeDBWorker.HermeticReturns(false)
+				})
+
+				It("returns false", func() {
+					Expect(satisfies).To(BeFalse())
+				})
+			})
+		})
+
 		Context("when the resource type is supported by the worker", func() {
 			BeforeEach(func() {
 				spec.ResourceType = "some-resource"
@@ -996,6 +1023,64 @@ var _ = Describe("Worker", func() {
 					}))
 				})
 
+				It("does not allow the container outbound network access", func() {
+					Expect(fakeGardenContainer.NetOutCallCount()).To(BeZero())
+				})
+
+				Context("when the worker supports hermetic containers", func() {
+					BeforeEach(func() {
+						fakeDBWorker.HermeticReturns(true)
+					})
+
+					It("allows the container outbound network access", func() {
+						Expect(fakeGardenContainer.NetOutCallCount()).To(Equal(1))
+						Expect(fakeGardenContainer.NetOutArgsForCall(0)).To(Equal(garden.NetOutRule{Protocol: garden.ProtocolAll}))
+					})
+
+					Context("when allowing network access fails", func() {
+						BeforeEach(func() {
+							fakeGardenContainer.NetOutReturns(disasterErr)
+						})
+
+						It("returns the error", func() {
+							Expect(findOrCreateErr).To(Equal(disasterErr))
+						})
+
+						It("marks the container as failed", func() {
+							Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the container is hermetic", func() {
+						BeforeEach(func() {
+							containerSpec.Hermetic = true
+						})
+
+						It("does not allow the container outbound network access", func() {
+							Expect(fakeGardenContainer.NetOutCallCount()).To(BeZero())
+						})
+
+						It("does not configure the worker's proxies", func() {
+							actualSpec := fakeGardenClient.CreateArgsForCall(0)
+							Expect(actualSpec.Env).To(Equal([]string{"IMAGE=ENV", "SOME=ENV"}))
+						})
+					})
+				})
+
+				Context("when the container is hermetic but the worker does not support it", func() {
+					BeforeEach(func() {
+						containerSpec.Hermetic = true
+					})
+
+					It("returns an error", func() {
+						Expect(findOrCreateErr).To(Equal(HermeticNotSupportedError{WorkerName: workerName}))
+					})
+
+					It("does not create the container in garden", func() {
+						Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when the input and output destination paths overlap", func() {
 					var (
 						fakeRemoteInputUnderInput    *workerfakes.FakeInputSource
@@ -1525,13 +1610,143 @@ var _ = Describe("Worker", func() {
 				fakeDBWorker.FindContainerReturns(nil, nil, nil)
 			})
 
-			It("creates a creating container in database", func() {
+			It("creates a creating container in database with the container's limits", func() {
 				Expect(fakeDBWorker.CreateContainerCallCount()).To(Equal(1))
 				owner, metadata := fakeDBWorker.CreateContainerArgsForCall(0)
 				Expect(owner).To(Equal(fakeContainerOwner))
-				Expect(metadata).To(Equal(containerMetadata))
+
+				expectedMetadata := containerMetadata
+				expectedMetadata.CPULimit = 1024
+				expectedMetadata.MemoryLimit = 1024
+				Expect(metadata).To(Equal(expectedMetadata))
+			})
+
+			Context("when the container has no limits", func() {
+				BeforeEach(func() {
+					containerSpec.Limits = ContainerLimits{}
+				})
+
+				It("creates a creating container in database with the given metadata", func() {
+					_, metadata := fakeDBWorker.CreateContainerArgsForCall(0)
+					Expect(metadata).To(Equal(containerMetadata))
+				})
 			})
+		})
+	})
 
+	Describe("HasCapacityFor", func() {
+		var (
+			limits ContainerLimits
+
+			hasCapacity bool
+			capacityErr error
+		)
+
+		uint64Ptr := func(i uint64) *uint64 { return &i }
+
+		BeforeEach(func() {
+			limits = ContainerLimits{
+				CPU:    uint64Ptr(512),
+				Memory: uint64Ptr(1024),
+			}
+
+			fakeDBWorker.AllocatedReturns(1024, 2048, nil)
+		})
+
+		JustBeforeEach(func() {
+			hasCapacity, capacityErr = gardenWorker.HasCapacityFor(limits)
+		})
+
+		Context("when the worker does not advertise its capacity", func() {
+			It("returns true without looking up what is allocated", func() {
+				Expect(capacityErr).ToNot(HaveOccurred())
+				Expect(hasCapacity).To(BeTrue())
+				Expect(fakeDBWorker.AllocatedCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the worker advertises its capacity", func() {
+			BeforeEach(func() {
+				fakeDBWorker.CapacityReturns(atc.ContainerLimits{
+					CPU:    uint64Ptr(2048),
+					Memory: uint64Ptr(4096),
+				})
+			})
+
+			Context("when the limits fit", func() {
+				It("returns true", func() {
+					Expect(capacityErr).ToNot(HaveOccurred())
+					Expect(hasCapacity).To(BeTrue())
+				})
+			})
+
+			Context("when the limits fill the capacity exactly", func() {
+				BeforeEach(func() {
+					limits.CPU = uint64Ptr(1024)
+					limits.Memory = uint64Ptr(2048)
+				})
+
+				It("returns true", func() {
+					Expect(hasCapacity).To(BeTrue())
+				})
+			})
+
+			Context("when the cpu limit does not fit", func() {
+				BeforeEach(func() {
+					limits.CPU = uint64Ptr(1025)
+				})
+
+				It("returns false", func() {
+					Expect(hasCapacity).To(BeFalse())
+				})
+			})
+
+			Context("when the memory limit does not fit", func() {
+				BeforeEach(func() {
+					limits.Memory = uint64Ptr(2049)
+				})
+
+				It("returns false", func() {
+					Expect(hasCapacity).To(BeFalse())
+				})
+			})
+
+			Context("when the container has no limits", func() {
+				BeforeEach(func() {
+					limits = ContainerLimits{}
+				})
+
+				It("returns true without looking up what is allocated", func() {
+					Expect(hasCapacity).To(BeTrue())
+					Expect(fakeDBWorker.AllocatedCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when looking up what is allocated fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeDBWorker.AllocatedReturns(0, 0, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(capacityErr).To(Equal(disaster))
+				})
+			})
+		})
+
+		Context("when the worker only advertises its memory capacity", func() {
+			BeforeEach(func() {
+				fakeDBWorker.CapacityReturns(atc.ContainerLimits{
+					Memory: uint64Ptr(4096),
+				})
+
+				limits.CPU = uint64Ptr(1000000)
+			})
+
+			It("only checks the memory limit", func() {
+				Expect(hasCapacity).To(BeTrue())
+			})
 		})
 	})
 })
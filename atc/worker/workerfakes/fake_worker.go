@@ -193,6 +193,19 @@ type FakeWorker struct {
 	gardenClientReturnsOnCall map[int]struct {
 		result1 gclient.Client
 	}
+	HasCapacityForStub        func(worker.ContainerLimits) (bool, error)
+	hasCapacityForMutex       sync.RWMutex
+	hasCapacityForArgsForCall []struct {
+		arg1 worker.ContainerLimits
+	}
+	hasCapacityForReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasCapacityForReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	IncreaseActiveTasksStub        func() error
 	increaseActiveTasksMutex       sync.RWMutex
 	increaseActiveTasksArgsForCall []struct {
@@ -1081,6 +1094,69 @@ func (fake *FakeWorker) GardenClientReturnsOnCall(i int, result1 gclient.Client)
 	}{result1}
 }
 
+func (fake *FakeWorker) HasCapacityFor(arg1 worker.ContainerLimits) (bool, error) {
+	fake.hasCapacityForMutex.Lock()
+	ret, specificReturn := fake.hasCapacityForReturnsOnCall[len(fake.hasCapacityForArgsForCall)]
+	fake.hasCapacityForArgsForCall = append(fake.hasCapacityForArgsForCall, struct {
+		arg1 worker.ContainerLimits
+	}{arg1})
+	fake.recordInvocation("HasCapacityFor", []interface{}{arg1})
+	fake.hasCapacityForMutex.Unlock()
+	if fake.HasCapacityForStub != nil {
+		return fake.HasCapacityForStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hasCapacityForReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) HasCapacityForCallCount() int {
+	fake.hasCapacityForMutex.RLock()
+	defer fake.hasCapacityForMutex.RUnlock()
+	return len(fake.hasCapacityForArgsForCall)
+}
+
+func (fake *FakeWorker) HasCapacityForCalls(stub func(worker.ContainerLimits) (bool, error)) {
+	fake.hasCapacityForMutex.Lock()
+	defer fake.hasCapacityForMutex.Unlock()
+	fake.HasCapacityForStub = stub
+}
+
+func (fake *FakeWorker) HasCapacityForArgsForCall(i int) worker.ContainerLimits {
+	fake.hasCapacityForMutex.RLock()
+	defer fake.hasCapacityForMutex.RUnlock()
+	argsForCall := fake.hasCapacityForArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) HasCapacityForReturns(result1 bool, result2 error) {
+	fake.hasCapacityForMutex.Lock()
+	defer fake.hasCapacityForMutex.Unlock()
+	fake.HasCapacityForStub = nil
+	fake.hasCapacityForReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) HasCapacityForReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasCapacityForMutex.Lock()
+	defer fake.hasCapacityForMutex.Unlock()
+	fake.HasCapacityForStub = nil
+	if fake.hasCapacityForReturnsOnCall == nil {
+		fake.hasCapacityForReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasCapacityForReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) IncreaseActiveTasks() error {
 	fake.increaseActiveTasksMutex.Lock()
 	ret, specificReturn := fake.increaseActiveTasksReturnsOnCall[len(fake.increaseActiveTasksArgsForCall)]
@@ -1611,6 +1687,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.findVolumeForTaskCacheMutex.RUnlock()
 	fake.gardenClientMutex.RLock()
 	defer fake.gardenClientMutex.RUnlock()
+	fake.hasCapacityForMutex.RLock()
+	defer fake.hasCapacityForMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
 	fake.isOwnedByTeamMutex.RLock()
@@ -78,11 +78,14 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetResourceVersion,
 			atc.ListResources,
 			atc.ListResourceTypes,
-			atc.ListResourceVersions:
+			atc.ListResourceVersions,
+			atc.ListResourceChecks:
 			newHandler = wrappa.checkPipelineAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// authenticated
 		case atc.CreateBuild,
+			atc.GetCheck,
+			atc.CheckEvents,
 			atc.GetContainer,
 			atc.HijackContainer,
 			atc.ListContainers,
@@ -101,6 +104,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// unauthenticated / delegating to handler (validate token if provided)
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.PipelineWebHook,
+			atc.TeamWebHook,
 			atc.GetInfo,
 			atc.ListTeams,
 			atc.ListAllPipelines,
@@ -129,6 +134,11 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ListPipelineConfigVersions,
+			atc.GetPipelineConfigVersion,
+			atc.RollbackPipelineConfig,
+			atc.ExportPipeline,
+			atc.ImportPipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
@@ -138,6 +148,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenamePipeline,
 			atc.UnpauseJob,
 			atc.UnpausePipeline,
+			atc.ArchivePipeline,
 			atc.ExposePipeline,
 			atc.HidePipeline,
 			atc.SaveConfig,
@@ -180,11 +180,14 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListResources:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResources]),
 				atc.ListResourceTypes:             openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResourceTypes]),
 				atc.ListResourceVersions:          openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResourceVersions]),
+				atc.ListResourceChecks:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResourceChecks]),
 				atc.GetResourceCausality:          openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceCausality]),
 				atc.GetResourceVersion:            openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceVersion]),
 
 				// authenticated
 				atc.CreateBuild:     authenticated(inputHandlers[atc.CreateBuild]),
+				atc.GetCheck:        authenticated(inputHandlers[atc.GetCheck]),
+				atc.CheckEvents:     authenticated(inputHandlers[atc.CheckEvents]),
 				atc.GetContainer:    authenticated(inputHandlers[atc.GetContainer]),
 				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
 				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
@@ -203,6 +206,8 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
 				atc.DownloadCLI:          authenticateIfTokenProvided(inputHandlers[atc.DownloadCLI]),
 				atc.CheckResourceWebHook: authenticateIfTokenProvided(inputHandlers[atc.CheckResourceWebHook]),
+				atc.PipelineWebHook:      authenticateIfTokenProvided(inputHandlers[atc.PipelineWebHook]),
+				atc.TeamWebHook:          authenticateIfTokenProvided(inputHandlers[atc.TeamWebHook]),
 				atc.ListAllPipelines:     authenticateIfTokenProvided(inputHandlers[atc.ListAllPipelines]),
 				atc.ListBuilds:           authenticateIfTokenProvided(inputHandlers[atc.ListBuilds]),
 				atc.ListPipelines:        authenticateIfTokenProvided(inputHandlers[atc.ListPipelines]),
@@ -218,32 +223,38 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListActiveUsersSince: authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
 
 				// authorized (requested team matches resource team)
-				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
-				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
-				atc.CreateJobBuild:          authorized(inputHandlers[atc.CreateJobBuild]),
-				atc.DeletePipeline:          authorized(inputHandlers[atc.DeletePipeline]),
-				atc.DisableResourceVersion:  authorized(inputHandlers[atc.DisableResourceVersion]),
-				atc.EnableResourceVersion:   authorized(inputHandlers[atc.EnableResourceVersion]),
-				atc.PinResourceVersion:      authorized(inputHandlers[atc.PinResourceVersion]),
-				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
-				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
-				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
-				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
-				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
-				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
-				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
-				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
-				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
-				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
-				atc.SaveConfig:              authorized(inputHandlers[atc.SaveConfig]),
-				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
-				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
-				atc.ExposePipeline:          authorized(inputHandlers[atc.ExposePipeline]),
-				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
-				atc.CreatePipelineBuild:     authorized(inputHandlers[atc.CreatePipelineBuild]),
-				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
-				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
-				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.CheckResource:              authorized(inputHandlers[atc.CheckResource]),
+				atc.CheckResourceType:          authorized(inputHandlers[atc.CheckResourceType]),
+				atc.CreateJobBuild:             authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.DeletePipeline:             authorized(inputHandlers[atc.DeletePipeline]),
+				atc.DisableResourceVersion:     authorized(inputHandlers[atc.DisableResourceVersion]),
+				atc.EnableResourceVersion:      authorized(inputHandlers[atc.EnableResourceVersion]),
+				atc.PinResourceVersion:         authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.UnpinResource:              authorized(inputHandlers[atc.UnpinResource]),
+				atc.SetPinCommentOnResource:    authorized(inputHandlers[atc.SetPinCommentOnResource]),
+				atc.GetConfig:                  authorized(inputHandlers[atc.GetConfig]),
+				atc.ListPipelineConfigVersions: authorized(inputHandlers[atc.ListPipelineConfigVersions]),
+				atc.GetPipelineConfigVersion:   authorized(inputHandlers[atc.GetPipelineConfigVersion]),
+				atc.RollbackPipelineConfig:     authorized(inputHandlers[atc.RollbackPipelineConfig]),
+				atc.ExportPipeline:             authorized(inputHandlers[atc.ExportPipeline]),
+				atc.ImportPipeline:             authorized(inputHandlers[atc.ImportPipeline]),
+				atc.GetCC:                      authorized(inputHandlers[atc.GetCC]),
+				atc.GetVersionsDB:              authorized(inputHandlers[atc.GetVersionsDB]),
+				atc.ListJobInputs:              authorized(inputHandlers[atc.ListJobInputs]),
+				atc.OrderPipelines:             authorized(inputHandlers[atc.OrderPipelines]),
+				atc.PauseJob:                   authorized(inputHandlers[atc.PauseJob]),
+				atc.PausePipeline:              authorized(inputHandlers[atc.PausePipeline]),
+				atc.RenamePipeline:             authorized(inputHandlers[atc.RenamePipeline]),
+				atc.SaveConfig:                 authorized(inputHandlers[atc.SaveConfig]),
+				atc.UnpauseJob:                 authorized(inputHandlers[atc.UnpauseJob]),
+				atc.UnpausePipeline:            authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ArchivePipeline:            authorized(inputHandlers[atc.ArchivePipeline]),
+				atc.ExposePipeline:             authorized(inputHandlers[atc.ExposePipeline]),
+				atc.HidePipeline:               authorized(inputHandlers[atc.HidePipeline]),
+				atc.CreatePipelineBuild:        authorized(inputHandlers[atc.CreatePipelineBuild]),
+				atc.ClearTaskCache:             authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.CreateArtifact:             authorized(inputHandlers[atc.CreateArtifact]),
+				atc.GetArtifact:                authorized(inputHandlers[atc.GetArtifact]),
 			}
 		})
 
@@ -17,11 +17,14 @@ type WorkerConfig struct {
 
 	Ephemeral bool `long:"ephemeral" description:"If set, the worker will be immediately removed upon stalling."`
 
+	CPUCapacity    *int    `long:"cpu-capacity"    description:"Number of cpu shares available to containers. Containers are placed on other workers rather than exceed it with their cpu limits."`
+	MemoryCapacity *string `long:"memory-capacity" description:"Memory available to containers, e.g. 16GB. Containers are placed on other workers rather than exceed it with their memory limits."`
+
 	Version string `long:"version" hidden:"true" description:"Version of the worker. This is normally baked in to the binary, so this flag is hidden."`
 }
 
-func (c WorkerConfig) Worker() atc.Worker {
-	return atc.Worker{
+func (c WorkerConfig) Worker() (atc.Worker, error) {
+	worker := atc.Worker{
 		Tags:          c.Tags,
 		Team:          c.TeamName,
 		Name:          c.Name,
@@ -32,4 +35,18 @@ func (c WorkerConfig) Worker() atc.Worker {
 		NoProxy:       c.NoProxy,
 		Ephemeral:     c.Ephemeral,
 	}
+
+	if c.CPUCapacity != nil || c.MemoryCapacity != nil {
+		capacity, err := atc.ParseContainerLimits(map[string]interface{}{
+			"cpu":    c.CPUCapacity,
+			"memory": c.MemoryCapacity,
+		})
+		if err != nil {
+			return atc.Worker{}, err
+		}
+
+		worker.Capacity = &capacity
+	}
+
+	return worker, nil
 }
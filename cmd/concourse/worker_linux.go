@@ -31,6 +31,8 @@ type GardenBackend struct {
 	GDN          string    `long:"bin"    default:"gdn" description:"Path to 'gdn' executable (or leave as 'gdn' to find it in $PATH)."`
 	GardenConfig flag.File `long:"config"               description:"Path to a config file to use for Garden. You can also specify Garden flags as env vars, e.g. 'CONCOURSE_GARDEN_FOO_BAR=a,b' for '--foo-bar a --foo-bar b'."`
 
+	Hermetic bool `long:"hermetic" description:"Deny containers outbound network access unless they are allowed it when created, so that hermetic tasks can run on this worker. An external Garden server must be configured with '--deny-network 0.0.0.0/0' itself."`
+
 	DNS DNSConfig `group:"DNS Proxy Configuration" namespace:"dns-proxy"`
 }
 
@@ -45,9 +47,21 @@ func (cmd *WorkerCommand) gardenRunner(logger lager.Logger) (atc.Worker, ifrit.R
 		return atc.Worker{}, nil, err
 	}
 
-	worker := cmd.Worker.Worker()
+	worker, err := cmd.Worker.Worker()
+	if err != nil {
+		return atc.Worker{}, nil, err
+	}
+
 	worker.Platform = "linux"
 
+	if cmd.Garden.Hermetic {
+		if cmd.Garden.UseHoudini {
+			return atc.Worker{}, nil, errors.New("the Houdini Garden backend does not support hermetic containers")
+		}
+
+		worker.Hermetic = true
+	}
+
 	if cmd.Certs.Dir != "" {
 		worker.CertsPath = &cmd.Certs.Dir
 	}
@@ -117,6 +131,12 @@ func (cmd *WorkerCommand) gdnRunner(logger lager.Logger) (ifrit.Runner, error) {
 
 	gdnServerFlags = append(gdnServerFlags, detectGardenFlags(logger)...)
 
+	if cmd.Garden.Hermetic {
+		// containers which are not hermetic are allowed outbound access by
+		// the ATC when it creates them
+		gdnServerFlags = append(gdnServerFlags, "--deny-network", "0.0.0.0/0")
+	}
+
 	if cmd.Garden.DNS.Enable {
 		dnsProxyRunner, err := cmd.dnsProxyRunner(logger.Session("dns-proxy"))
 		if err != nil {
@@ -21,9 +21,12 @@ func (cmd WorkerCommand) lessenRequirements(prefix string, command *flags.Comman
 }
 
 func (cmd *WorkerCommand) gardenRunner(logger lager.Logger) (atc.Worker, ifrit.Runner, error) {
-	worker := cmd.Worker.Worker()
+	worker, err := cmd.Worker.Worker()
+	if err != nil {
+		return atc.Worker{}, nil, err
+	}
+
 	worker.Platform = runtime.GOOS
-	var err error
 	worker.Name, err = cmd.workerName()
 	if err != nil {
 		return atc.Worker{}, nil, err
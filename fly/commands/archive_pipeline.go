@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+)
+
+type ArchivePipelineCommand struct {
+	Pipeline        flaghelpers.PipelineFlag `short:"p"  long:"pipeline" required:"true" description:"Pipeline to archive"`
+	SkipInteractive bool                     `short:"n"  long:"non-interactive"          description:"Archive the pipeline without confirmation"`
+}
+
+func (command *ArchivePipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *ArchivePipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("!!! archiving pipeline `%s` will pause it permanently; its builds will be kept\n\n", pipelineRef)
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := target.Team().ArchivePipeline(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		fmt.Printf("archived '%s'\n", pipelineRef)
+	} else {
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
+	}
+
+	return nil
+}
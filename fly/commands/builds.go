@@ -99,7 +99,7 @@ func (command *BuildsCommand) Execute([]string) error {
 
 		var found bool
 		builds, _, found, err = currentTeam.PipelineBuilds(
-			string(command.Pipeline),
+			command.Pipeline.Ref(),
 			page,
 		)
 		if err != nil {
@@ -112,7 +112,7 @@ func (command *BuildsCommand) Execute([]string) error {
 	} else if command.jobFlag() {
 		var found bool
 		builds, _, found, err = currentTeam.JobBuilds(
-			command.Job.PipelineName,
+			command.Job.PipelineRef,
 			command.Job.JobName,
 			page,
 		)
@@ -266,9 +266,9 @@ func roundSecondsOffDuration(d time.Duration) time.Duration {
 }
 
 func (command *BuildsCommand) jobFlag() bool {
-	return command.Job.PipelineName != "" && command.Job.JobName != ""
+	return command.Job.PipelineRef.Name != "" && command.Job.JobName != ""
 }
 
 func (command *BuildsCommand) pipelineFlag() bool {
-	return command.Pipeline != ""
+	return command.Pipeline.Name != ""
 }
@@ -2,15 +2,22 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type CheckResourceCommand struct {
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to check version for"`
 	Version  *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource to check from, e.g. ref:abcd or path:thing-1.2.3.tgz"`
+	Async    bool                     `short:"a" long:"async"                                                   description:"Return once the check is queued rather than waiting for it to finish"`
+	Watch    bool                     `short:"w" long:"watch"                                                   description:"Stream the output of the check while waiting for it to finish"`
 }
 
 func (command *CheckResourceCommand) Execute(args []string) error {
@@ -29,15 +36,63 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 		version = *command.Version
 	}
 
-	found, err := target.Team().CheckResource(command.Resource.PipelineName, command.Resource.ResourceName, version)
+	check, found, err := target.Team().CheckResource(command.Resource.PipelineRef, command.Resource.ResourceName, version)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineRef, command.Resource.ResourceName)
+	}
+
+	if command.Async {
+		fmt.Printf("queued check %d of '%s'\n", check.ID, command.Resource.ResourceName)
+		return nil
+	}
+
+	check, err = awaitCheck(target.Client(), check, command.Watch)
+	if err != nil {
+		return err
+	}
+
+	if check.Status == atc.CheckStatusErrored {
+		return fmt.Errorf("check of '%s' errored: %s", command.Resource.ResourceName, check.CheckError)
 	}
 
 	fmt.Printf("checked '%s'\n", command.Resource.ResourceName)
 	return nil
 }
+
+// checkPollInterval is how often a check is looked up while waiting for it
+// to finish.
+const checkPollInterval = time.Second
+
+func awaitCheck(client concourse.Client, check atc.Check, watch bool) (atc.Check, error) {
+	if watch {
+		events, err := client.CheckEvents(strconv.Itoa(check.ID))
+		if err != nil {
+			return check, err
+		}
+
+		eventstream.Render(os.Stdout, events, eventstream.RenderOptions{})
+
+		events.Close()
+	}
+
+	for check.IsRunning() {
+		time.Sleep(checkPollInterval)
+
+		latest, found, err := client.Check(strconv.Itoa(check.ID))
+		if err != nil {
+			return check, err
+		}
+
+		if !found {
+			return check, fmt.Errorf("check %d not found", check.ID)
+		}
+
+		check = latest
+	}
+
+	return check, nil
+}
@@ -11,6 +11,8 @@ import (
 type CheckResourceTypeCommand struct {
 	ResourceType flaghelpers.ResourceFlag `short:"r" long:"resource-type" required:"true" value-name:"PIPELINE/RESOURCE-TYPE" description:"Name of a resource-type to check"`
 	Version      *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource type to check from, e.g. digest:sha256@..."`
+	Async        bool                     `short:"a" long:"async"                                                   description:"Return once the check is queued rather than waiting for it to finish"`
+	Watch        bool                     `short:"w" long:"watch"                                                   description:"Stream the output of the check while waiting for it to finish"`
 }
 
 func (command *CheckResourceTypeCommand) Execute(args []string) error {
@@ -29,13 +31,27 @@ func (command *CheckResourceTypeCommand) Execute(args []string) error {
 		version = *command.Version
 	}
 
-	found, err := target.Team().CheckResourceType(command.ResourceType.PipelineName, command.ResourceType.ResourceName, version)
+	check, found, err := target.Team().CheckResourceType(command.ResourceType.PipelineRef, command.ResourceType.ResourceName, version)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("pipeline '%s' or resource-type '%s' not found\n", command.ResourceType.PipelineName, command.ResourceType.ResourceName)
+		return fmt.Errorf("pipeline '%s' or resource-type '%s' not found\n", command.ResourceType.PipelineRef, command.ResourceType.ResourceName)
+	}
+
+	if command.Async {
+		fmt.Printf("queued check %d of '%s'\n", check.ID, command.ResourceType.ResourceName)
+		return nil
+	}
+
+	check, err = awaitCheck(target.Client(), check, command.Watch)
+	if err != nil {
+		return err
+	}
+
+	if check.Status == atc.CheckStatusErrored {
+		return fmt.Errorf("check of '%s' errored: %s", command.ResourceType.ResourceName, check.CheckError)
 	}
 
 	fmt.Printf("checked '%s'\n", command.ResourceType.ResourceName)
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ExportPipelineCommand struct {
+	Pipeline      flaghelpers.PipelineFlag `short:"p"  long:"pipeline"       required:"true" description:"Pipeline to export"`
+	Output        string                   `short:"o"  long:"output"                         description:"File to write the bundle to, instead of stdout"`
+	IncludeBuilds bool                     `long:"include-builds"                            description:"Include a summary of each job's builds"`
+}
+
+func (command *ExportPipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *ExportPipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	bundle, found, err := target.Team().ExportPipeline(command.Pipeline.Ref(), command.IncludeBuilds)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	payload, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	payload = append(payload, '\n')
+
+	if command.Output == "" {
+		_, err = os.Stdout.Write(payload)
+		return err
+	}
+
+	return ioutil.WriteFile(command.Output, payload, 0644)
+}
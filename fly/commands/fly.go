@@ -48,6 +48,11 @@ type FlyCommand struct {
 	SetPipeline      SetPipelineCommand      `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
 	PausePipeline    PausePipelineCommand    `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
 	UnpausePipeline  UnpausePipelineCommand  `command:"unpause-pipeline"    alias:"up"   description:"Un-pause a pipeline"`
+	ArchivePipeline  ArchivePipelineCommand  `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
+	PipelineHistory  PipelineHistoryCommand  `command:"pipeline-history"    alias:"ph"   description:"List the config versions of a pipeline"`
+	RollbackPipeline RollbackPipelineCommand `command:"rollback-pipeline"   alias:"rbp"  description:"Roll back a pipeline to an earlier config version"`
+	ExportPipeline   ExportPipelineCommand   `command:"export-pipeline"     alias:"xp"   description:"Export a pipeline with its versions and pause states, to import elsewhere"`
+	ImportPipeline   ImportPipelineCommand   `command:"import-pipeline"     alias:"ip"   description:"Create a pipeline from a bundle written by export-pipeline"`
 	ExposePipeline   ExposePipelineCommand   `command:"expose-pipeline"     alias:"ep"   description:"Make a pipeline publicly viewable"`
 	HidePipeline     HidePipelineCommand     `command:"hide-pipeline"       alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline   RenamePipelineCommand   `command:"rename-pipeline"     alias:"rp"   description:"Rename a pipeline"`
@@ -268,16 +268,17 @@ func (command *HijackCommand) getContainerFingerprint(target rc.Target) (*contai
 		}
 	}
 
-	pipelineName := command.Check.PipelineName
-	if command.Job.PipelineName != "" {
-		pipelineName = command.Job.PipelineName
+	pipelineRef := command.Check.PipelineRef
+	if command.Job.PipelineRef.Name != "" {
+		pipelineRef = command.Job.PipelineRef
 	}
 
 	for _, field := range []struct {
 		fp  *string
 		cmd string
 	}{
-		{fp: &fingerprint.pipelineName, cmd: pipelineName},
+		{fp: &fingerprint.pipelineName, cmd: pipelineRef.Name},
+		{fp: &fingerprint.instanceVars, cmd: pipelineRef.QueryParams().Get(atc.InstanceVarsQueryParam)},
 		{fp: &fingerprint.buildNameOrID, cmd: command.Build},
 		{fp: &fingerprint.stepName, cmd: command.StepName},
 		{fp: &fingerprint.stepType, cmd: command.StepType},
@@ -357,7 +358,7 @@ func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (m
 	} else if fingerprint.buildNameOrID != "" {
 		reqValues["build_id"] = fingerprint.buildNameOrID
 	} else {
-		build, err := GetBuild(locator.client, nil, "", "", "")
+		build, err := GetBuild(locator.client, nil, "", "", atc.PipelineRef{})
 		if err != nil {
 			return reqValues, err
 		}
@@ -379,12 +380,16 @@ func (locator checkContainerLocator) locate(fingerprint *containerFingerprint) (
 	if fingerprint.pipelineName != "" {
 		reqValues["pipeline_name"] = fingerprint.pipelineName
 	}
+	if fingerprint.instanceVars != "" {
+		reqValues[atc.InstanceVarsQueryParam] = fingerprint.instanceVars
+	}
 
 	return reqValues, nil
 }
 
 type containerFingerprint struct {
 	pipelineName  string
+	instanceVars  string
 	jobName       string
 	buildNameOrID string
 
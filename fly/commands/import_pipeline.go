@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type ImportPipelineCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline" required:"true" description:"Name of the pipeline to create"`
+	Bundle   atc.PathFlag             `short:"b"  long:"bundle"   required:"true" description:"Bundle written by export-pipeline"`
+}
+
+func (command *ImportPipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *ImportPipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	payload, err := ioutil.ReadFile(string(command.Bundle))
+	if err != nil {
+		return err
+	}
+
+	var bundle atc.PipelineBundle
+	err = json.Unmarshal(payload, &bundle)
+	if err != nil {
+		return fmt.Errorf("malformed bundle: %s", err)
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	response, err := target.Team().ImportPipeline(pipelineRef, bundle)
+	if err == concourse.ErrPipelineExists {
+		displayhelpers.Failf("pipeline '%s' already exists; only new pipelines can be imported", pipelineRef)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(response.Skipped) > 0 {
+		displayhelpers.ShowErrors("some of the bundle could not be restored", response.Skipped)
+	}
+
+	fmt.Printf("imported '%s'\n", pipelineRef)
+
+	if bundle.Paused {
+		fmt.Println("the pipeline was paused when it was exported, and has been left paused")
+	}
+
+	return nil
+}
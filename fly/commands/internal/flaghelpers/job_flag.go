@@ -7,23 +7,30 @@ import (
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
+// JobFlag identifies a job as '<pipeline>/<job>', where the pipeline may be
+// an instance given as '<pipeline>/<key>:<value>,<key>:<value>'.
 type JobFlag struct {
-	PipelineName string
-	JobName      string
+	PipelineRef atc.PipelineRef
+	JobName     string
 }
 
 func (job *JobFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", -1)
+	i := strings.LastIndex(value, "/")
+	if i == -1 {
+		return errors.New("argument format should be <pipeline>/<job>")
+	}
 
-	if len(vs) != 2 {
+	pipelineRef, err := parsePipelineRef(value[:i])
+	if err != nil {
 		return errors.New("argument format should be <pipeline>/<job>")
 	}
 
-	job.PipelineName = vs[0]
-	job.JobName = vs[1]
+	job.PipelineRef = pipelineRef
+	job.JobName = value[i+1:]
 
 	return nil
 }
@@ -43,29 +50,33 @@ func (flag *JobFlag) Complete(match string) []flags.Completion {
 
 	team := target.Team()
 	comps := []flags.Completion{}
-	vs := strings.SplitN(match, "/", 2)
 
-	if len(vs) == 1 {
-		pipelines, err := team.ListPipelines()
-		if err != nil {
-			return comps
-		}
-
-		for _, pipeline := range pipelines {
-			if strings.HasPrefix(pipeline.Name, vs[0]) {
-				comps = append(comps, flags.Completion{Item: pipeline.Name + "/"})
+	if i := strings.LastIndex(match, "/"); i != -1 {
+		pipelineRef, err := parsePipelineRef(match[:i])
+		if err == nil {
+			jobs, err := team.ListJobs(pipelineRef)
+			if err == nil {
+				for _, job := range jobs {
+					if strings.HasPrefix(job.Name, match[i+1:]) {
+						comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", match[:i], job.Name)})
+					}
+				}
+
+				return comps
 			}
 		}
-	} else if len(vs) == 2 {
-		jobs, err := team.ListJobs(vs[0])
-		if err != nil {
-			return comps
-		}
+	}
 
-		for _, job := range jobs {
-			if strings.HasPrefix(job.Name, vs[1]) {
-				comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", vs[0], job.Name)})
-			}
+	// the pipeline, or the instance vars of an instance, are still being typed
+	pipelines, err := team.ListPipelines()
+	if err != nil {
+		return comps
+	}
+
+	for _, pipeline := range pipelines {
+		ref := pipeline.Ref().String()
+		if strings.HasPrefix(ref, match) {
+			comps = append(comps, flags.Completion{Item: ref + "/"})
 		}
 	}
 
@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -16,4 +17,27 @@ var _ = Describe("JobFlag", func() {
 			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
 		})
 	})
+
+	Context("when the instance vars of the pipeline are malformed", func() {
+		It("displays an error message", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/invalid/some-job")
+			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
+		})
+	})
+
+	Context("when the pipeline is an instance", func() {
+		It("parses the instance vars and the job", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/branch:feature/foo/some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobFlag.PipelineRef).To(Equal(atc.PipelineRef{
+				Name:         "pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature/foo"},
+			}))
+			Expect(jobFlag.JobName).To(Equal("some-job"))
+		})
+	})
 })
@@ -6,18 +6,70 @@ import (
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
-type PipelineFlag string
+// PipelineFlag identifies a pipeline as '<pipeline>', or an instance of a
+// pipeline as '<pipeline>/<key>:<value>,<key>:<value>'.
+type PipelineFlag struct {
+	Name         string
+	InstanceVars atc.InstanceVars
+}
+
+func (flag *PipelineFlag) UnmarshalFlag(value string) error {
+	ref, err := parsePipelineRef(value)
+	if err != nil {
+		return err
+	}
+
+	flag.Name = ref.Name
+	flag.InstanceVars = ref.InstanceVars
+
+	return nil
+}
 
 func (flag *PipelineFlag) Validate() error {
-	if strings.Contains(string(*flag), "/") {
-		return errors.New("pipeline name cannot contain '/'")
+	if flag.Name == "" {
+		return errors.New("pipeline name cannot be empty")
 	}
 	return nil
 }
 
+func (flag PipelineFlag) Ref() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         flag.Name,
+		InstanceVars: flag.InstanceVars,
+	}
+}
+
+func (flag PipelineFlag) String() string {
+	return flag.Ref().String()
+}
+
+// parsePipelineRef parses '<pipeline>' or '<pipeline>/<key>:<value>,...'.
+// Instance var values are always strings.
+func parsePipelineRef(value string) (atc.PipelineRef, error) {
+	vs := strings.SplitN(value, "/", 2)
+
+	ref := atc.PipelineRef{Name: vs[0]}
+	if len(vs) == 1 {
+		return ref, nil
+	}
+
+	ref.InstanceVars = atc.InstanceVars{}
+	for _, pair := range strings.Split(vs[1], ",") {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return atc.PipelineRef{}, errors.New("argument format should be <pipeline>/<key>:<value>,<key>:<value>")
+		}
+
+		ref.InstanceVars[kv[0]] = kv[1]
+	}
+
+	return ref, nil
+}
+
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
@@ -38,8 +90,9 @@ func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 
 	comps := []flags.Completion{}
 	for _, pipeline := range pipelines {
-		if strings.HasPrefix(pipeline.Name, match) {
-			comps = append(comps, flags.Completion{Item: pipeline.Name})
+		ref := pipeline.Ref().String()
+		if strings.HasPrefix(ref, match) {
+			comps = append(comps, flags.Completion{Item: ref})
 		}
 	}
 
@@ -0,0 +1,60 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineFlag", func() {
+	var pipelineFlag *PipelineFlag
+
+	BeforeEach(func() {
+		pipelineFlag = &PipelineFlag{}
+	})
+
+	Context("when only a pipeline name is specified", func() {
+		It("refers to the pipeline without instance vars", func() {
+			err := pipelineFlag.UnmarshalFlag("some-pipeline")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelineFlag.Ref()).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+	})
+
+	Context("when instance vars are specified", func() {
+		It("refers to the instance with those vars", func() {
+			err := pipelineFlag.UnmarshalFlag("some-pipeline/branch:feature/foo,arch:amd64")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelineFlag.Ref()).To(Equal(atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch": "feature/foo",
+					"arch":   "amd64",
+				},
+			}))
+		})
+
+		It("is shown with its instance vars", func() {
+			err := pipelineFlag.UnmarshalFlag("some-pipeline/branch:release-1.2,arch:amd64")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelineFlag.String()).To(Equal("some-pipeline/arch:amd64,branch:release-1.2"))
+		})
+	})
+
+	Context("when an instance var is not a key:value pair", func() {
+		It("displays an error message", func() {
+			err := pipelineFlag.UnmarshalFlag("some-pipeline/branch")
+			Expect(err).To(MatchError("argument format should be <pipeline>/<key>:<value>,<key>:<value>"))
+		})
+	})
+
+	Context("when the pipeline name is empty", func() {
+		It("fails validation", func() {
+			err := pipelineFlag.UnmarshalFlag("/branch:master")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelineFlag.Validate()).To(MatchError("pipeline name cannot be empty"))
+		})
+	})
+})
@@ -3,22 +3,30 @@ package flaghelpers
 import (
 	"errors"
 	"strings"
+
+	"github.com/concourse/concourse/atc"
 )
 
+// ResourceFlag identifies a resource as '<pipeline>/<resource>', where the
+// pipeline may be an instance given as '<pipeline>/<key>:<value>,<key>:<value>'.
 type ResourceFlag struct {
-	PipelineName string
+	PipelineRef  atc.PipelineRef
 	ResourceName string
 }
 
 func (resource *ResourceFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", 2)
+	i := strings.LastIndex(value, "/")
+	if i == -1 {
+		return errors.New("argument format should be <pipeline>/<resource>")
+	}
 
-	if len(vs) != 2 {
+	pipelineRef, err := parsePipelineRef(value[:i])
+	if err != nil {
 		return errors.New("argument format should be <pipeline>/<resource>")
 	}
 
-	resource.PipelineName = vs[0]
-	resource.ResourceName = vs[1]
+	resource.PipelineRef = pipelineRef
+	resource.ResourceName = value[i+1:]
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -16,4 +17,18 @@ var _ = Describe("ResourceFlag", func() {
 			Expect(err).To(MatchError("argument format should be <pipeline>/<resource>"))
 		})
 	})
+
+	Context("when the pipeline is an instance", func() {
+		It("parses the instance vars and the resource", func() {
+			resourceFlag := &ResourceFlag{}
+
+			err := resourceFlag.UnmarshalFlag("pipeline/branch:feature/foo/some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resourceFlag.PipelineRef).To(Equal(atc.PipelineRef{
+				Name:         "pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature/foo"},
+			}))
+			Expect(resourceFlag.ResourceName).To(Equal("some-resource"))
+		})
+	})
 })
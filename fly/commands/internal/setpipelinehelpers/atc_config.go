@@ -19,12 +19,13 @@ import (
 )
 
 type ATCConfig struct {
-	PipelineName     string
+	PipelineRef      atc.PipelineRef
 	Team             concourse.Team
 	TargetName       rc.TargetName
 	Target           string
 	SkipInteraction  bool
 	CheckCredentials bool
+	DryRun           bool
 }
 
 func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
@@ -42,12 +43,12 @@ func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
 }
 
 func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTemplateWithParams) error {
-	evaluatedTemplate, err := yamlTemplateWithParams.Evaluate(false, false)
+	evaluatedTemplate, _, err := yamlTemplateWithParams.EvaluatePipeline(false, false)
 	if err != nil {
 		return err
 	}
 
-	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineName)
+	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	if err != nil {
 		return err
 	}
@@ -58,6 +59,58 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
+	return atcConfig.apply(existingConfig, existingConfigVersion, newConfig, evaluatedTemplate)
+}
+
+// Rollback saves the pipeline with the config it had at the given version.
+// The version is shown as a diff and confirmed like Set, but saved by the
+// server from its stored config, as the config it returns has the var
+// sources' credentials redacted.
+func (atcConfig ATCConfig) Rollback(version int) error {
+	configVersion, found, err := atcConfig.Team.PipelineConfigVersion(atcConfig.PipelineRef, version)
+	if err != nil {
+		return err
+	}
+
+	if !found || configVersion.Config == nil {
+		return fmt.Errorf("version %d of pipeline '%s' not found", version, atcConfig.PipelineRef)
+	}
+
+	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
+	if err != nil {
+		return err
+	}
+
+	diffExists := diff(existingConfig, *configVersion.Config)
+
+	if !diffExists {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	if !atcConfig.ApplyConfigInteraction() {
+		fmt.Println("bailing out")
+		return nil
+	}
+
+	warnings, found, err := atcConfig.Team.RollbackPipelineConfig(atcConfig.PipelineRef, version, existingConfigVersion)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("version %d of pipeline '%s' not found", version, atcConfig.PipelineRef)
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	atcConfig.showPipelineUpdateResult(false, true)
+	return nil
+}
+
+func (atcConfig ATCConfig) apply(existingConfig atc.Config, existingConfigVersion string, newConfig atc.Config, payload []byte) error {
 	diffExists := diff(existingConfig, newConfig)
 
 	if !diffExists {
@@ -65,15 +118,19 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return nil
 	}
 
+	if atcConfig.DryRun {
+		return atcConfig.dryRun(existingConfigVersion, payload)
+	}
+
 	if !atcConfig.ApplyConfigInteraction() {
 		fmt.Println("bailing out")
 		return nil
 	}
 
 	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
-		atcConfig.PipelineName,
+		atcConfig.PipelineRef,
 		existingConfigVersion,
-		evaluatedTemplate,
+		payload,
 		atcConfig.CheckCredentials,
 	)
 	if err != nil {
@@ -89,7 +146,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 }
 
 func (atcConfig ATCConfig) UnpausePipelineCommand() string {
-	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineName)
+	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineRef)
 }
 
 func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool) {
@@ -102,9 +159,11 @@ func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool)
 			fmt.Println("Could not parse targetURL")
 		}
 
-		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineName)
+		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineRef.Name)
 		if err != nil {
 			fmt.Println("Could not parse pipelineURL")
+		} else {
+			pipelineURL.RawQuery = atcConfig.PipelineRef.QueryParams().Encode()
 		}
 
 		fmt.Println("pipeline created!")
@@ -156,6 +215,40 @@ func diff(existingConfig atc.Config, newConfig atc.Config) bool {
 		}
 	}
 
+	varSourceDiffs := diffIndices(VarSourceIndex(existingConfig.VarSources), VarSourceIndex(newConfig.VarSources))
+	if len(varSourceDiffs) > 0 {
+		diffExists = true
+		fmt.Println("var sources:")
+
+		for _, diff := range varSourceDiffs {
+			diff.Render(indent, "var source")
+		}
+	}
+
+	if practicallyDifferent(existingConfig.Vars, newConfig.Vars) {
+		diffExists = true
+		fmt.Println("vars:")
+
+		renderSectionDiff(indent, existingConfig.Vars, newConfig.Vars)
+	}
+
+	if practicallyDifferent(existingConfig.Display, newConfig.Display) {
+		diffExists = true
+		fmt.Println("display:")
+
+		renderSectionDiff(indent, existingConfig.Display, newConfig.Display)
+	}
+
+	freezeWindowDiffs := diffIndices(FreezeWindowIndex(existingConfig.FreezeWindows), FreezeWindowIndex(newConfig.FreezeWindows))
+	if len(freezeWindowDiffs) > 0 {
+		diffExists = true
+		fmt.Println("freeze windows:")
+
+		for _, diff := range freezeWindowDiffs {
+			diff.Render(indent, "freeze window")
+		}
+	}
+
 	jobDiffs := diffIndices(JobIndex(existingConfig.Jobs), JobIndex(newConfig.Jobs))
 	if len(jobDiffs) > 0 {
 		diffExists = true
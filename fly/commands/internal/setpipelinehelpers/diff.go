@@ -114,6 +114,36 @@ func (index ResourceTypeIndex) FindEquivalent(obj interface{}) (interface{}, boo
 	return atc.ResourceTypes(index).Lookup(name(obj))
 }
 
+type VarSourceIndex atc.VarSourceConfigs
+
+func (index VarSourceIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index VarSourceIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return atc.VarSourceConfigs(index).Lookup(name(obj))
+}
+
+type FreezeWindowIndex atc.FreezeWindowConfigs
+
+func (index FreezeWindowIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index FreezeWindowIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return atc.FreezeWindowConfigs(index).Lookup(name(obj))
+}
+
 func groupDiffIndices(oldIndex GroupIndex, newIndex GroupIndex) Diffs {
 	diffs := Diffs{}
 
@@ -209,6 +239,15 @@ func renderDiff(to io.Writer, a, b string) {
 	}
 }
 
+// renderSectionDiff renders the diff of a top-level section of the config
+// which is not a list of named objects, e.g. vars.
+func renderSectionDiff(to io.Writer, before, after interface{}) {
+	payloadA, _ := yaml.Marshal(before)
+	payloadB, _ := yaml.Marshal(after)
+
+	renderDiff(to, string(payloadA), string(payloadB))
+}
+
 func practicallyDifferent(a, b interface{}) bool {
 	if reflect.DeepEqual(a, b) {
 		return false
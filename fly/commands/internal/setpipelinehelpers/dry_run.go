@@ -0,0 +1,73 @@
+package setpipelinehelpers
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+)
+
+func (atcConfig ATCConfig) dryRun(existingConfigVersion string, payload []byte) error {
+	impact, warnings, err := atcConfig.Team.DryRunPipelineConfig(
+		atcConfig.PipelineRef,
+		existingConfigVersion,
+		payload,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	showConfigImpact(impact)
+
+	fmt.Println("dry run: configuration not applied")
+	return nil
+}
+
+func showConfigImpact(impact atc.ConfigImpact) {
+	fmt.Println("")
+
+	if len(impact.NewResourceScopes) == 0 &&
+		len(impact.RenamedJobs) == 0 &&
+		len(impact.TriggeredJobs) == 0 &&
+		len(impact.UnresolvedCredentials) == 0 {
+		fmt.Println("no further impact")
+		fmt.Println("")
+		return
+	}
+
+	if len(impact.NewResourceScopes) > 0 {
+		fmt.Println("resources that will lose their version history:")
+		for _, name := range impact.NewResourceScopes {
+			fmt.Printf("  - %s\n", name)
+		}
+		fmt.Println("")
+	}
+
+	if len(impact.RenamedJobs) > 0 {
+		fmt.Println("jobs that will be renamed:")
+		for _, rename := range impact.RenamedJobs {
+			fmt.Printf("  - %s -> %s\n", rename.From, rename.To)
+		}
+		fmt.Println("")
+	}
+
+	if len(impact.TriggeredJobs) > 0 {
+		fmt.Println("jobs that will trigger a build immediately:")
+		for _, name := range impact.TriggeredJobs {
+			fmt.Printf("  - %s\n", name)
+		}
+		fmt.Println("")
+	}
+
+	if len(impact.UnresolvedCredentials) > 0 {
+		fmt.Println("credentials that can't be resolved:")
+		for _, message := range impact.UnresolvedCredentials {
+			fmt.Printf("  - %s\n", message)
+		}
+		fmt.Println("")
+	}
+}
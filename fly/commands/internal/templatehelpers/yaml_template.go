@@ -30,9 +30,41 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 	allowEmpty bool,
 	strict bool,
 ) ([]byte, error) {
+	config, params, err := yamlTemplate.load(strict)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluatedConfig, err := vars.NewTemplateResolver(config, params).Resolve(false, allowEmpty)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluatedConfig, nil
+}
+
+// EvaluatePipeline evaluates the template as a pipeline config, merging in
+// the files listed in its include section. The returned origins say which
+// file each resource, resource type and job came from, and are empty if the
+// config does not include any files.
+func (yamlTemplate YamlTemplateWithParams) EvaluatePipeline(
+	allowEmpty bool,
+	strict bool,
+) ([]byte, vars.Origins, error) {
+	config, params, err := yamlTemplate.load(strict)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vars.NewTemplateResolver(config, params).
+		WithIncludes(string(yamlTemplate.filePath), ioutil.ReadFile).
+		ResolveWithOrigins(false, allowEmpty)
+}
+
+func (yamlTemplate YamlTemplateWithParams) load(strict bool) ([]byte, []vars.Variables, error) {
 	config, err := ioutil.ReadFile(string(yamlTemplate.filePath))
 	if err != nil {
-		return nil, fmt.Errorf("could not read file: %s", err.Error())
+		return nil, nil, fmt.Errorf("could not read file: %s", err.Error())
 	}
 
 	if strict {
@@ -43,7 +75,7 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 		// We should consider being strict throughout the entire stack by default.
 		err = yaml.UnmarshalStrict(config, make(map[string]interface{}))
 		if err != nil {
-			return nil, fmt.Errorf("error parsing yaml before applying templates: %s", err.Error())
+			return nil, nil, fmt.Errorf("error parsing yaml before applying templates: %s", err.Error())
 		}
 	}
 
@@ -65,22 +97,17 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 		path := yamlTemplate.templateVariablesFiles[i]
 		templateVars, err := ioutil.ReadFile(string(path))
 		if err != nil {
-			return nil, fmt.Errorf("could not read template variables file (%s): %s", string(path), err.Error())
+			return nil, nil, fmt.Errorf("could not read template variables file (%s): %s", string(path), err.Error())
 		}
 
 		var staticVars vars.StaticVariables
 		err = yaml.Unmarshal(templateVars, &staticVars)
 		if err != nil {
-			return nil, fmt.Errorf("could not unmarshal template variables (%s): %s", string(path), err.Error())
+			return nil, nil, fmt.Errorf("could not unmarshal template variables (%s): %s", string(path), err.Error())
 		}
 
 		params = append(params, staticVars)
 	}
 
-	evaluatedConfig, err := vars.NewTemplateResolver(config, params).Resolve(false, allowEmpty)
-	if err != nil {
-		return nil, err
-	}
-
-	return evaluatedConfig, nil
+	return config, params, nil
 }
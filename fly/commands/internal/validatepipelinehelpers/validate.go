@@ -6,12 +6,14 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/vars"
 	"github.com/ghodss/yaml"
 )
 
 func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool, output bool) error {
-	evaluatedTemplate, err := yamlTemplate.Evaluate(true, strict)
+	evaluatedTemplate, origins, err := yamlTemplate.EvaluatePipeline(true, strict)
 	if err != nil {
 		return err
 	}
@@ -47,6 +49,10 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 		displayhelpers.Failf("configuration invalid")
 	}
 
+	if len(origins) > 0 {
+		showOrigins(origins)
+	}
+
 	if output {
 		fmt.Println(string(evaluatedTemplate))
 	} else {
@@ -55,3 +61,15 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 
 	return nil
 }
+
+// showOrigins prints where each element of a config with includes came from.
+// It goes to stderr so that --output can still be piped.
+func showOrigins(origins vars.Origins) {
+	fmt.Fprintln(ui.Stderr, "origins:")
+
+	for _, origin := range origins {
+		fmt.Fprintf(ui.Stderr, "  %s.%s: %s\n", origin.Section, origin.Name, origin)
+	}
+
+	fmt.Fprintln(ui.Stderr, "")
+}
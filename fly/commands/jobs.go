@@ -5,18 +5,19 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type JobsCommand struct {
-	Pipeline string `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
-	Json     bool   `long:"json" description:"Print command result as JSON"`
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
 }
 
 func (command *JobsCommand) Execute([]string) error {
-	pipelineName := command.Pipeline
+	pipelineRef := command.Pipeline.Ref()
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -31,7 +32,7 @@ func (command *JobsCommand) Execute([]string) error {
 	var headers []string
 	var jobs []atc.Job
 
-	jobs, err = target.Team().ListJobs(pipelineName)
+	jobs, err = target.Team().ListJobs(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -44,25 +45,23 @@ func (command *JobsCommand) Execute([]string) error {
 		return nil
 	}
 
-	headers = []string{"name", "paused", "status", "next"}
+	headers = []string{"name", "paused", "frozen", "status", "next"}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
 	}
 
 	for _, p := range jobs {
-		var pausedColumn ui.TableCell
-		if p.Paused {
-			pausedColumn.Contents = "yes"
-			pausedColumn.Color = color.New(color.FgCyan)
-		} else {
-			pausedColumn.Contents = "no"
-		}
-
 		row := ui.TableRow{}
 		row = append(row, ui.TableCell{Contents: p.Name})
 
-		row = append(row, pausedColumn)
+		row = append(row, pausedCell(p.Paused, p.PausedBy, p.PauseComment))
+
+		if p.Frozen != nil {
+			row = append(row, frozenCell(*p.Frozen))
+		} else {
+			row = append(row, frozenCell())
+		}
 
 		var statusColumn ui.TableCell
 		if p.FinishedBuild != nil {
@@ -23,7 +23,12 @@ func (command *OrderPipelinesCommand) Validate() ([]string, error) {
 		if err != nil {
 			return nil, err
 		}
-		pipelines = append(pipelines, string(p))
+
+		if len(p.InstanceVars) != 0 {
+			return nil, fmt.Errorf("instances are ordered along with the other instances of their pipeline, so specify '%s' instead of '%s'", p.Name, p)
+		}
+
+		pipelines = append(pipelines, p.Name)
 	}
 	return pipelines, nil
 
@@ -8,7 +8,8 @@ import (
 )
 
 type PauseJobCommand struct {
-	Job flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to pause"`
+	Job     flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to pause"`
+	Comment string              `short:"c" long:"comment" description:"Why the job is being paused, shown alongside it"`
 }
 
 func (command *PauseJobCommand) Execute(args []string) error {
@@ -22,13 +23,13 @@ func (command *PauseJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	found, err := target.Team().PauseJob(command.Job.PipelineName, command.Job.JobName)
+	found, err := target.Team().PauseJob(command.Job.PipelineRef, command.Job.JobName, command.Comment)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("%s/%s not found\n", command.Job.PipelineName, command.Job.JobName)
+		return fmt.Errorf("%s/%s not found\n", command.Job.PipelineRef, command.Job.JobName)
 	}
 
 	fmt.Printf("paused '%s'\n", command.Job.JobName)
@@ -10,6 +10,7 @@ import (
 
 type PausePipelineCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline" required:"true" description:"Pipeline to pause"`
+	Comment  string                   `short:"c"  long:"comment" description:"Why the pipeline is being paused, shown alongside it"`
 }
 
 func (command *PausePipelineCommand) Validate() error {
@@ -22,7 +23,7 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref()
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -34,15 +35,15 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	found, err := target.Team().PausePipeline(pipelineName)
+	found, err := target.Team().PausePipeline(pipelineRef, command.Comment)
 	if err != nil {
 		return err
 	}
 
 	if found {
-		fmt.Printf("paused '%s'\n", pipelineName)
+		fmt.Printf("paused '%s'\n", pipelineRef)
 	} else {
-		displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
 	}
 
 	return nil
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+// pausedCell shows whether a pipeline or job is paused, along with who
+// paused it and why, when known.
+func pausedCell(paused bool, pausedBy string, comment string) ui.TableCell {
+	if !paused {
+		return ui.TableCell{Contents: "no"}
+	}
+
+	contents := "yes"
+	if comment != "" {
+		contents += ": " + comment
+	}
+
+	if pausedBy != "" {
+		contents += fmt.Sprintf(" (by %s)", pausedBy)
+	}
+
+	return ui.TableCell{Contents: contents, Color: ui.OnColor}
+}
+
+// frozenCell shows the freeze windows which are currently open.
+func frozenCell(freezes ...atc.Freeze) ui.TableCell {
+	if len(freezes) == 0 {
+		return ui.TableCell{Contents: "no"}
+	}
+
+	windows := []string{}
+	for _, freeze := range freezes {
+		until := time.Unix(freeze.Until, 0).Format(timeDateLayout)
+		windows = append(windows, fmt.Sprintf("%s until %s", freeze.Window, until))
+	}
+
+	return ui.TableCell{Contents: strings.Join(windows, ", "), Color: ui.OnColor}
+}
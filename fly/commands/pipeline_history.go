@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Show the config history of this pipeline"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *PipelineHistoryCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *PipelineHistoryCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	versions, found, err := target.Team().PipelineConfigVersions(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(versions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "created by", Color: color.New(color.Bold)},
+			{Contents: "created at", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, version := range versions {
+		createdByCell := ui.TableCell{Contents: version.CreatedBy}
+		if version.CreatedBy == "" {
+			createdByCell.Contents = "n/a"
+			createdByCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(version.Version)},
+			createdByCell,
+			{Contents: time.Unix(version.CreatedAt, 0).Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
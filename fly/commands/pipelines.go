@@ -31,10 +31,10 @@ func (command *PipelinesCommand) Execute([]string) error {
 
 	if command.All {
 		pipelines, err = target.Client().ListPipelines()
-		headers = []string{"name", "team", "paused", "public"}
+		headers = []string{"name", "team", "paused", "frozen", "public"}
 	} else {
 		pipelines, err = target.Team().ListPipelines()
-		headers = []string{"name", "paused", "public"}
+		headers = []string{"name", "paused", "frozen", "public"}
 	}
 	if err != nil {
 		return err
@@ -54,14 +54,6 @@ func (command *PipelinesCommand) Execute([]string) error {
 	}
 
 	for _, p := range pipelines {
-		var pausedColumn ui.TableCell
-		if p.Paused {
-			pausedColumn.Contents = "yes"
-			pausedColumn.Color = ui.OnColor
-		} else {
-			pausedColumn.Contents = "no"
-		}
-
 		var publicColumn ui.TableCell
 		if p.Public {
 			publicColumn.Contents = "yes"
@@ -71,11 +63,12 @@ func (command *PipelinesCommand) Execute([]string) error {
 		}
 
 		row := ui.TableRow{}
-		row = append(row, ui.TableCell{Contents: p.Name})
+		row = append(row, ui.TableCell{Contents: p.Ref().String()})
 		if command.All {
 			row = append(row, ui.TableCell{Contents: p.TeamName})
 		}
-		row = append(row, pausedColumn)
+		row = append(row, pausedCell(p.Paused, p.PausedBy, p.PauseComment))
+		row = append(row, frozenCell(p.Frozen...))
 		row = append(row, publicColumn)
 
 		table.Data = append(table.Data, row)
@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
@@ -10,7 +12,7 @@ import (
 
 type RenamePipelineCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"o"  long:"old-name" required:"true"  description:"Pipeline to rename"`
-	Name     flaghelpers.PipelineFlag `short:"n"  long:"new-name" required:"true"  description:"Name to set as pipeline name"`
+	Name     string                   `short:"n"  long:"new-name" required:"true"  description:"Name to set as pipeline name"`
 }
 
 func (command *RenamePipelineCommand) Validate() error {
@@ -19,7 +21,11 @@ func (command *RenamePipelineCommand) Validate() error {
 		return err
 	}
 
-	return command.Name.Validate()
+	if strings.Contains(command.Name, "/") {
+		return errors.New("pipeline name cannot contain '/'")
+	}
+
+	return nil
 }
 
 func (command *RenamePipelineCommand) Execute([]string) error {
@@ -38,16 +44,16 @@ func (command *RenamePipelineCommand) Execute([]string) error {
 		return err
 	}
 
-	oldName := string(command.Pipeline)
-	newName := string(command.Name)
+	oldRef := command.Pipeline.Ref()
+	newName := command.Name
 
-	found, err := target.Team().RenamePipeline(oldName, newName)
+	found, err := target.Team().RenamePipeline(oldRef, newName)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		displayhelpers.Failf("pipeline '%s' not found\n", oldName)
+		displayhelpers.Failf("pipeline '%s' not found\n", oldRef)
 		return nil
 	}
 
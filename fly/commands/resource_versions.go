@@ -35,7 +35,7 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 
 	team := target.Team()
 
-	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page)
+	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineRef, command.Resource.ResourceName, page)
 	if err != nil {
 		return err
 	}
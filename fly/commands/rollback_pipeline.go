@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type RollbackPipelineCommand struct {
+	Pipeline        flaghelpers.PipelineFlag `short:"p"  long:"pipeline"         required:"true" description:"Pipeline to roll back"`
+	Version         int                      `short:"v"  long:"version"          required:"true" description:"Config version to roll back to, as shown by pipeline-history"`
+	SkipInteractive bool                     `short:"n"  long:"non-interactive"                  description:"Skips interactions, uses default values"`
+}
+
+func (command *RollbackPipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *RollbackPipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	atcConfig := setpipelinehelpers.ATCConfig{
+		Team:            target.Team(),
+		PipelineRef:     command.Pipeline.Ref(),
+		TargetName:      Fly.Target,
+		Target:          target.Client().URL(),
+		SkipInteraction: command.SkipInteractive,
+	}
+
+	return atcConfig.Rollback(command.Version)
+}
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
@@ -14,6 +16,7 @@ type SetPipelineCommand struct {
 	DisableAnsiColor bool `long:"no-color"               description:"Disable color output"`
 
 	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
+	DryRun           bool `long:"dry-run"      description:"Report what applying the configuration would do, without applying it"`
 
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
 	Config   atc.PathFlag             `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file"`
@@ -35,7 +38,16 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	}
 	configPath := command.Config
 	templateVariablesFiles := command.VarsFrom
-	pipelineName := string(command.Pipeline)
+
+	// an instance's vars are also available to its config, taking precedence
+	// over the vars given with --var
+	templateVariables := append([]flaghelpers.VariablePairFlag{}, command.Var...)
+	for name, value := range command.Pipeline.InstanceVars {
+		templateVariables = append(templateVariables, flaghelpers.VariablePairFlag{
+			Name:  name,
+			Value: fmt.Sprint(value),
+		})
+	}
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -51,13 +63,14 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 
 	atcConfig := setpipelinehelpers.ATCConfig{
 		Team:             target.Team(),
-		PipelineName:     pipelineName,
+		PipelineRef:      command.Pipeline.Ref(),
 		TargetName:       Fly.Target,
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
+		DryRun:           command.DryRun,
 	}
 
-	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
+	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, templateVariables, command.YAMLVar)
 	return atcConfig.Set(yamlTemplateWithParams)
 }
@@ -18,7 +18,7 @@ type TriggerJobCommand struct {
 }
 
 func (command *TriggerJobCommand) Execute(args []string) error {
-	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+	pipelineRef, jobName := command.Job.PipelineRef, command.Job.JobName
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -30,11 +30,11 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().CreateJobBuild(pipelineName, jobName)
+	build, err := target.Team().CreateJobBuild(pipelineRef, jobName)
 	if err != nil {
 		return err
 	}
-	fmt.Printf("started %s/%s #%s\n", pipelineName, jobName, build.Name)
+	fmt.Printf("started %s/%s #%s\n", pipelineRef, jobName, build.Name)
 
 	if command.Watch {
 		terminate := make(chan os.Signal, 1)
@@ -43,7 +43,7 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 			<-terminate
 			fmt.Fprintf(ui.Stderr, "\ndetached, build is still running...\n")
 			fmt.Fprintf(ui.Stderr, "re-attach to it with:\n\n")
-			fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineName, jobName, build.Name)))
+			fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineRef, jobName, build.Name)))
 			os.Exit(2)
 		}(terminate)
 
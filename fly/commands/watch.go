@@ -30,7 +30,7 @@ func (command *WatchCommand) Execute(args []string) error {
 	var buildId int
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" {
-		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineName)
+		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef)
 		if err != nil {
 			return err
 		}
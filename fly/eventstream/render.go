@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
@@ -51,6 +53,29 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.TaskCached:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1msatisfied from cache: reusing the outputs of build #%s\x1b[0m\n", e.BuildName)
+
+		case event.Skipped:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mskipping step, as its condition does not hold: %s\x1b[0m\n", e.Condition)
+
+		case event.Retrying:
+			dstImpl.SetTimestamp(e.Time)
+			if e.Wait == "" || e.Wait == "0s" {
+				fmt.Fprintf(dstImpl, "\x1b[1mretrying (attempt %d)\x1b[0m\n", e.Attempt)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mretrying in %s (attempt %d)\x1b[0m\n", e.Wait, e.Attempt)
+			}
+
+		case event.FinishAttempt:
+			if e.Status != atc.StatusSucceeded {
+				dstImpl.SetTimestamp(e.Time)
+				duration := time.Duration(e.Time-e.StartTime) * time.Second
+				fmt.Fprintf(dstImpl, "\x1b[1mattempt %d %s after %s\x1b[0m\n", e.Attempt, e.Status, duration)
+			}
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
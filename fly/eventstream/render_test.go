@@ -119,6 +119,96 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a TaskCached event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.TaskCached{
+				Time:      time.Now().Unix(),
+				Key:       "some-key",
+				BuildID:   42,
+				BuildName: "7",
+			}
+		})
+
+		It("prints the build whose outputs were reused", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1msatisfied from cache: reusing the outputs of build #7\x1b[0m\n"))
+		})
+	})
+
+	Context("when a Skipped event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Skipped{
+				Time:      time.Now().Unix(),
+				Condition: `build.job_name == "some-job"`,
+			}
+		})
+
+		It("prints the condition which did not hold", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mskipping step, as its condition does not hold: build.job_name == \"some-job\"\x1b[0m\n"))
+		})
+	})
+
+	Context("when a Retrying event is received", func() {
+		Context("with a wait", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.Retrying{
+					Time:    time.Now().Unix(),
+					Attempt: 2,
+					Wait:    "1.5s",
+				}
+			})
+
+			It("prints the attempt and how long it waits", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mretrying in 1.5s (attempt 2)\x1b[0m\n"))
+			})
+		})
+
+		Context("without a wait", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.Retrying{
+					Time:    time.Now().Unix(),
+					Attempt: 2,
+					Wait:    "0s",
+				}
+			})
+
+			It("prints the attempt", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mretrying (attempt 2)\x1b[0m\n"))
+			})
+		})
+	})
+
+	Context("when a FinishAttempt event is received", func() {
+		Context("for an attempt that did not succeed", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.FinishAttempt{
+					Time:      100,
+					StartTime: 10,
+					Attempt:   1,
+					Status:    atc.StatusFailed,
+				}
+			})
+
+			It("prints the attempt's outcome and duration", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mattempt 1 failed after 1m30s\x1b[0m\n"))
+			})
+		})
+
+		Context("for an attempt that succeeded", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.FinishAttempt{
+					Time:      100,
+					StartTime: 10,
+					Attempt:   2,
+					Status:    atc.StatusSucceeded,
+				}
+			})
+
+			It("prints nothing", func() {
+				Expect(out.Contents()).ToNot(ContainSubstring("attempt 2"))
+			})
+		})
+	})
+
 	Context("and a StartTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.StartTask{
@@ -1,22 +1,38 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"github.com/onsi/gomega/gbytes"
 	"github.com/onsi/gomega/gexec"
 	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
 )
 
 var _ = Describe("CheckResource", func() {
 	var (
 		flyCmd *exec.Cmd
+
+		succeededCheck atc.Check
 	)
 
+	BeforeEach(func() {
+		succeededCheck = atc.Check{
+			ID:        123,
+			Status:    atc.CheckStatusSucceeded,
+			CreatedBy: atc.CheckCreatedByManual,
+			Plan:      atc.CheckPlan{Resource: "myresource"},
+		}
+	})
+
 	Context("when ATC request succeeds", func() {
 		BeforeEach(func() {
 			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
@@ -24,7 +40,7 @@ var _ = Describe("CheckResource", func() {
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, succeededCheck),
 				),
 			)
 		})
@@ -52,7 +68,7 @@ var _ = Describe("CheckResource", func() {
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":null}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, succeededCheck),
 				),
 			)
 		})
@@ -80,7 +96,7 @@ var _ = Describe("CheckResource", func() {
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref1":"fake-ref-1","ref2":"fake-ref-2"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, succeededCheck),
 				),
 			)
 		})
@@ -101,6 +117,120 @@ var _ = Describe("CheckResource", func() {
 		})
 	})
 
+	Context("when the check is still running", func() {
+		BeforeEach(func() {
+			pendingCheck := succeededCheck
+			pendingCheck.Status = atc.CheckStatusPending
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, pendingCheck),
+				),
+			)
+		})
+
+		Context("when the check succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, succeededCheck),
+					),
+				)
+			})
+
+			It("waits for the check to finish", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("checked 'myresource'"))
+			})
+		})
+
+		Context("when the check errors", func() {
+			BeforeEach(func() {
+				erroredCheck := succeededCheck
+				erroredCheck.Status = atc.CheckStatusErrored
+				erroredCheck.CheckError = "bad version"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, erroredCheck),
+					),
+				)
+			})
+
+			It("fails with the check error", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("check of 'myresource' errored: bad version"))
+			})
+		})
+
+		Context("when --watch is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/checks/123/events"),
+						func(w http.ResponseWriter, r *http.Request) {
+							w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+							w.WriteHeader(http.StatusOK)
+
+							payload, err := json.Marshal(event.Message{Event: event.Log{Payload: "some-stderr\n"}})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{ID: "0", Name: "event", Data: payload}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{ID: "1", Name: "end"}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, succeededCheck),
+					),
+				)
+			})
+
+			It("streams the output of the check", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource", "--watch")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("some-stderr"))
+				Expect(sess.Out).To(gbytes.Say("checked 'myresource'"))
+			})
+		})
+
+		Context("when --async is given", func() {
+			It("returns without waiting for the check", func() {
+				Expect(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource", "--async")
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(gbytes.Say("queued check 123 of 'myresource'"))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(2))
+			})
+		})
+	})
+
 	Context("when pipeline or resource is not found", func() {
 		BeforeEach(func() {
 			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -15,8 +17,19 @@ import (
 var _ = Describe("CheckResourceType", func() {
 	var (
 		flyCmd *exec.Cmd
+
+		succeededCheck atc.Check
 	)
 
+	BeforeEach(func() {
+		succeededCheck = atc.Check{
+			ID:        123,
+			Status:    atc.CheckStatusSucceeded,
+			CreatedBy: atc.CheckCreatedByManual,
+			Plan:      atc.CheckPlan{ResourceType: "myresource"},
+		}
+	})
+
 	Context("when version is specified", func() {
 		BeforeEach(func() {
 			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/check"
@@ -24,7 +37,7 @@ var _ = Describe("CheckResourceType", func() {
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, succeededCheck),
 				),
 			)
 		})
@@ -52,7 +65,7 @@ var _ = Describe("CheckResourceType", func() {
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":null}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, succeededCheck),
 				),
 			)
 		})
@@ -73,6 +86,82 @@ var _ = Describe("CheckResourceType", func() {
 		})
 	})
 
+	Context("when the check is still running", func() {
+		BeforeEach(func() {
+			pendingCheck := succeededCheck
+			pendingCheck.Status = atc.CheckStatusPending
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/check"),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, pendingCheck),
+				),
+			)
+		})
+
+		Context("when the check succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, succeededCheck),
+					),
+				)
+			})
+
+			It("waits for the check to finish", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource-type", "-r", "mypipeline/myresource")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("checked 'myresource'"))
+			})
+		})
+
+		Context("when the check errors", func() {
+			BeforeEach(func() {
+				erroredCheck := succeededCheck
+				erroredCheck.Status = atc.CheckStatusErrored
+				erroredCheck.CheckError = "bad version"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, erroredCheck),
+					),
+				)
+			})
+
+			It("fails with the check error", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource-type", "-r", "mypipeline/myresource")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("check of 'myresource' errored: bad version"))
+			})
+		})
+
+		Context("when --async is given", func() {
+			It("returns without waiting for the check", func() {
+				Expect(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource-type", "-r", "mypipeline/myresource", "--async")
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(gbytes.Say("queued check 123 of 'myresource'"))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(2))
+			})
+		})
+	})
+
 	Context("when pipeline or resource-type is not found", func() {
 		BeforeEach(func() {
 			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/check"
@@ -97,9 +97,9 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
-		Context("when specifying a pipeline name with a '/' character in it", func() {
-			It("fails and says '/' characters are not allowed", func() {
-				flyCmd := exec.Command(flyPath, "-t", targetName, "hide-pipeline", "-p", "forbidden/pipelinename")
+		Context("when specifying malformed instance vars", func() {
+			It("fails and says how instance vars are specified", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "hide-pipeline", "-p", "some-pipeline/forbidden")
 
 				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
@@ -107,7 +107,7 @@ var _ = Describe("Fly CLI", func() {
 				<-sess.Exited
 				Expect(sess.ExitCode()).To(Equal(1))
 
-				Expect(sess.Err).To(gbytes.Say("error: pipeline name cannot contain '/'"))
+				Expect(sess.Err).To(gbytes.Say("argument format should be <pipeline>/<key>:<value>,<key>:<value>"))
 			})
 		})
 
@@ -3,6 +3,7 @@ package integration_test
 import (
 	"fmt"
 	"os/exec"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/ui"
@@ -143,9 +144,41 @@ var _ = Describe("Fly CLI", func() {
 
 				Expect(sess.Out).To(PrintTable(ui.Table{
 					Data: []ui.TableRow{
-						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}},
-						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}},
-						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}},
+						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}, {Contents: "failed"}, {Contents: "n/a"}},
+						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}},
+					},
+				}))
+			})
+		})
+
+		Context("when a job was paused with a comment or is frozen", func() {
+			var until time.Time
+
+			BeforeEach(func() {
+				until = time.Date(2019, time.September, 23, 8, 0, 0, 0, time.UTC)
+
+				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "-p", "pipeline")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Job{
+							{Name: "job-1", Paused: true, PauseComment: "waiting on a fix upstream"},
+							{Name: "job-2", Frozen: &atc.Freeze{Window: "weekend", Until: until.Unix()}},
+						}),
+					),
+				)
+			})
+
+			It("shows why it was paused and the freeze window", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Data: []ui.TableRow{
+						{{Contents: "job-1"}, {Contents: "yes: waiting on a fix upstream", Color: color.New(color.FgCyan)}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-2"}, {Contents: "no"}, {Contents: "weekend until " + until.Local().Format("2006-01-02@15:04:05-0700"), Color: color.New(color.FgCyan)}, {Contents: "n/a"}, {Contents: "n/a"}},
 					},
 				}))
 			})
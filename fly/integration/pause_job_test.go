@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -53,6 +54,31 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when a comment is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--comment", "waiting on a fix upstream")
+
+					apiPath := fmt.Sprintf("/api/v1/teams/main/pipelines/%s/jobs/%s/pause", pipelineName, jobName)
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", apiPath),
+							ghttp.VerifyJSONRepresenting(atc.PauseRequest{Comment: "waiting on a fix upstream"}),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+					)
+				})
+
+				It("sends it along with the request", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+
+					Expect(sess.Out).To(gbytes.Say(fmt.Sprintf("paused '%s'\n", jobName)))
+				})
+			})
+
 			Context("when a job is paused using the API and either pipeline or job doesn't exist", func() {
 				BeforeEach(func() {
 					apiPath := fmt.Sprintf("/api/v1/teams/main/pipelines/%s/jobs/%s/pause", pipelineName, jobName)
@@ -0,0 +1,226 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		bundle atc.PipelineBundle
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		bundle = atc.PipelineBundle{
+			Format: atc.PipelineBundleFormat,
+			Config: atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}},
+			},
+			Paused: true,
+			Resources: []atc.ResourceBundle{
+				{
+					Name: "some-resource",
+					Versions: []atc.BundledVersion{
+						{Version: atc.Version{"ref": "v1"}, Enabled: true},
+					},
+					PinnedVersion: atc.Version{"ref": "v1"},
+				},
+			},
+			Jobs: []atc.JobBundle{{Name: "some-job", Paused: true}},
+		}
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "fly-test")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	Describe("export-pipeline", func() {
+		var (
+			args []string
+			sess *gexec.Session
+		)
+
+		BeforeEach(func() {
+			args = []string{"-p", "some-pipeline"}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "export-pipeline"}, args...)...)
+			sess, err = gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/export", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, bundle),
+					),
+				)
+			})
+
+			It("prints the bundle", func() {
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var exported atc.PipelineBundle
+				err := json.Unmarshal(sess.Out.Contents(), &exported)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exported).To(Equal(bundle))
+			})
+
+			Context("when an output file is given", func() {
+				var output string
+
+				BeforeEach(func() {
+					output = filepath.Join(tmpDir, "bundle.json")
+					args = append(args, "-o", output)
+				})
+
+				It("writes the bundle to it", func() {
+					Eventually(sess).Should(gexec.Exit(0))
+
+					payload, err := ioutil.ReadFile(output)
+					Expect(err).NotTo(HaveOccurred())
+
+					var exported atc.PipelineBundle
+					err = json.Unmarshal(payload, &exported)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(exported).To(Equal(bundle))
+				})
+			})
+		})
+
+		Context("when builds are asked for", func() {
+			BeforeEach(func() {
+				args = append(args, "--include-builds")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/export", "include_builds=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, bundle),
+					),
+				)
+			})
+
+			It("asks for them", func() {
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/export"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("prints an error", func() {
+				Eventually(sess.Err).Should(gbytes.Say("pipeline not found"))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+
+	Describe("import-pipeline", func() {
+		var (
+			args []string
+			sess *gexec.Session
+		)
+
+		BeforeEach(func() {
+			bundlePath := filepath.Join(tmpDir, "bundle.json")
+
+			payload, err := json.Marshal(bundle)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(bundlePath, payload, 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			args = []string{"-p", "some-pipeline", "-b", bundlePath}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "import-pipeline"}, args...)...)
+			sess, err = gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the pipeline is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/import"),
+						ghttp.VerifyJSONRepresenting(bundle),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.ImportPipelineResponse{
+							Skipped: []string{"versions of resource 'other-resource': not in the config"},
+						}),
+					),
+				)
+			})
+
+			It("reports what was skipped", func() {
+				Eventually(sess.Err).Should(gbytes.Say("some of the bundle could not be restored"))
+				Eventually(sess.Err).Should(gbytes.Say("versions of resource 'other-resource': not in the config"))
+				Eventually(sess).Should(gbytes.Say("imported 'some-pipeline'"))
+				Eventually(sess).Should(gbytes.Say("has been left paused"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the pipeline already exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/import"),
+						ghttp.RespondWith(http.StatusConflict, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				Eventually(sess.Err).Should(gbytes.Say("pipeline 'some-pipeline' already exists; only new pipelines can be imported"))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+
+		Context("when the bundle is malformed", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(args[3], []byte("not json"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails without importing", func() {
+				Eventually(sess.Err).Should(gbytes.Say("malformed bundle"))
+				Eventually(sess).Should(gexec.Exit(1))
+
+				for _, request := range atcServer.ReceivedRequests() {
+					Expect(request.URL.Path).NotTo(HaveSuffix("/import"))
+				}
+			})
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pipeline-history", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		Context("when a pipeline name is not specified", func() {
+			It("asks the user to specify a pipeline name", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-history")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("p", "pipeline") + "' was not specified"))
+			})
+		})
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+						ghttp.RespondWithJSONEncoded(200, []atc.PipelineConfigVersion{
+							{Version: 2, CreatedBy: "some-user", CreatedAt: 1568631300},
+							{Version: 1, CreatedAt: 1568631200},
+						}),
+					),
+				)
+			})
+
+			It("lists the config versions", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "version", Color: color.New(color.Bold)},
+						{Contents: "created by", Color: color.New(color.Bold)},
+						{Contents: "created at", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "some-user"}, {Contents: time.Unix(1568631300, 0).Format("2006-01-02@15:04:05-0700")}},
+						{{Contents: "1"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: time.Unix(1568631200, 0).Format("2006-01-02@15:04:05-0700")}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+              {
+                "version": 2,
+                "created_by": "some-user",
+                "created_at": 1568631300
+              },
+              {
+                "version": 1,
+                "created_at": 1568631200
+              }
+            ]`))
+				})
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("prints helpful message", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline 'some-pipeline' not found"))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package integration_test
 import (
 	"os"
 	"os/exec"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/ui"
@@ -52,6 +53,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "pipeline-1-longer",
                   "paused": false,
                   "public": false,
+                  "archived": false,
                   "team_name": ""
                 },
                 {
@@ -59,6 +61,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "pipeline-2",
                   "paused": true,
                   "public": false,
+                  "archived": false,
                   "team_name": ""
                 },
                 {
@@ -66,6 +69,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "pipeline-3",
                   "paused": false,
                   "public": true,
+                  "archived": false,
                   "team_name": ""
                 }
               ]`))
@@ -81,12 +85,81 @@ var _ = Describe("Fly CLI", func() {
 						Headers: ui.TableRow{
 							{Contents: "name", Color: color.New(color.Bold)},
 							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "frozen", Color: color.New(color.Bold)},
 							{Contents: "public", Color: color.New(color.Bold)},
 						},
 						Data: []ui.TableRow{
-							{{Contents: "pipeline-1-longer"}, {Contents: "no"}, {Contents: "no"}},
-							{{Contents: "pipeline-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}},
-							{{Contents: "pipeline-3"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+							{{Contents: "pipeline-1-longer"}, {Contents: "no"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-3"}, {Contents: "no"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+						},
+					}))
+				})
+			})
+
+			Context("when a pipeline has instances", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines")
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "pipeline-1", Paused: false, Public: false},
+								{Name: "pipeline-1", InstanceVars: atc.InstanceVars{"branch": "release-1.2"}, Paused: false, Public: false},
+								{Name: "pipeline-2", Paused: false, Public: false},
+							}),
+						),
+					)
+				})
+
+				It("shows each instance with its instance vars", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "frozen", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "pipeline-1"}, {Contents: "no"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-1/branch:release-1.2"}, {Contents: "no"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-2"}, {Contents: "no"}, {Contents: "no"}, {Contents: "no"}},
+						},
+					}))
+				})
+			})
+
+			Context("when a pipeline was paused with a comment or is frozen", func() {
+				var until time.Time
+
+				BeforeEach(func() {
+					until = time.Date(2019, time.September, 23, 8, 0, 0, 0, time.UTC)
+
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines")
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "pipeline-1", Paused: true, PausedBy: "some-user", PauseComment: "upgrading the database"},
+								{Name: "pipeline-2", Frozen: []atc.Freeze{{Window: "weekend", Until: until.Unix()}}},
+							}),
+						),
+					)
+				})
+
+				It("shows who paused it and why, and the open freeze windows", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Data: []ui.TableRow{
+							{{Contents: "pipeline-1"}, {Contents: "yes: upgrading the database (by some-user)", Color: color.New(color.FgCyan)}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-2"}, {Contents: "no"}, {Contents: "weekend until " + until.Local().Format("2006-01-02@15:04:05-0700"), Color: color.New(color.FgCyan)}, {Contents: "no"}},
 						},
 					}))
 				})
@@ -125,6 +198,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "pipeline-1-longer",
                   "paused": false,
                   "public": false,
+                  "archived": false,
                   "team_name": "main"
                 },
                 {
@@ -132,6 +206,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "pipeline-2",
                   "paused": true,
                   "public": false,
+                  "archived": false,
                   "team_name": "main"
                 },
                 {
@@ -139,6 +214,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "pipeline-3",
                   "paused": false,
                   "public": true,
+                  "archived": false,
                   "team_name": "main"
                 },
                 {
@@ -146,6 +222,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "foreign-pipeline-1",
                   "paused": false,
                   "public": true,
+                  "archived": false,
                   "team_name": "other"
                 },
                 {
@@ -153,6 +230,7 @@ var _ = Describe("Fly CLI", func() {
                   "name": "foreign-pipeline-2",
                   "paused": false,
                   "public": true,
+                  "archived": false,
                   "team_name": "other"
                 }
               ]`))
@@ -169,14 +247,15 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "name", Color: color.New(color.Bold)},
 							{Contents: "team", Color: color.New(color.Bold)},
 							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "frozen", Color: color.New(color.Bold)},
 							{Contents: "public", Color: color.New(color.Bold)},
 						},
 						Data: []ui.TableRow{
-							{{Contents: "pipeline-1-longer"}, {Contents: "main"}, {Contents: "no"}, {Contents: "no"}},
-							{{Contents: "pipeline-2"}, {Contents: "main"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}},
-							{{Contents: "pipeline-3"}, {Contents: "main"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
-							{{Contents: "foreign-pipeline-1"}, {Contents: "other"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
-							{{Contents: "foreign-pipeline-2"}, {Contents: "other"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+							{{Contents: "pipeline-1-longer"}, {Contents: "main"}, {Contents: "no"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-2"}, {Contents: "main"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-3"}, {Contents: "main"}, {Contents: "no"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+							{{Contents: "foreign-pipeline-1"}, {Contents: "other"}, {Contents: "no"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+							{{Contents: "foreign-pipeline-2"}, {Contents: "other"}, {Contents: "no"}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
 						},
 					}))
 				})
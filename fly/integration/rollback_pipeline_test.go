@@ -0,0 +1,128 @@
+package integration_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("rollback-pipeline", func() {
+		var (
+			stdin io.Writer
+			args  []string
+			sess  *gexec.Session
+
+			currentConfig atc.Config
+			oldConfig     atc.Config
+		)
+
+		BeforeEach(func() {
+			stdin = nil
+			args = []string{}
+
+			currentConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}, {Name: "some-new-job"}},
+			}
+
+			oldConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "rollback-pipeline"}, args...)...)
+			stdin, err = flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			sess, err = gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when a version is not specified", func() {
+			BeforeEach(func() {
+				args = append(args, "-p", "some-pipeline")
+			})
+
+			It("asks the user to specify a version", func() {
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("v", "version") + "' was not specified"))
+			})
+		})
+
+		Context("when the version exists", func() {
+			BeforeEach(func() {
+				args = append(args, "-p", "some-pipeline", "-v", "1")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1"),
+						ghttp.RespondWithJSONEncoded(200, atc.PipelineConfigVersion{Version: 1, Config: &oldConfig}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{Config: currentConfig}, http.Header{atc.ConfigVersionHeader: {"2"}}),
+					),
+				)
+			})
+
+			It("shows the diff and bails out if the user says no", func() {
+				Eventually(sess).Should(gbytes.Say("job some-new-job has been removed"))
+				Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+				fmt.Fprintf(stdin, "n\n")
+
+				Eventually(sess).Should(gbytes.Say("bailing out"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when the user says yes", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1/rollback"),
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "2"),
+							ghttp.RespondWith(http.StatusOK, "{}"),
+						),
+					)
+				})
+
+				It("has the server re-save the old config", func() {
+					Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+					fmt.Fprintf(stdin, "y\n")
+
+					Eventually(sess).Should(gbytes.Say("configuration updated"))
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				args = append(args, "-p", "some-pipeline", "-v", "7")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/7"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("prints helpful message", func() {
+				Eventually(sess.Err).Should(gbytes.Say("version 7 of pipeline 'some-pipeline' not found"))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -182,6 +182,31 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when the config includes other files", func() {
+				It("shows the included elements in the diff", func() {
+					flyCmd := exec.Command(
+						flyPath, "-t", targetName,
+						"set-pipeline",
+						"--pipeline", "awesome-pipeline",
+						"-c", "fixtures/includes/pipeline.yml",
+					)
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`resource repo has been added`))
+					Eventually(sess).Should(gbytes.Say(`job deploy has been added`))
+					Eventually(sess).Should(gbytes.Say(`job unit has been added`))
+					Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+					no(stdin)
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
 			Context("when configuring with old-style templated value that fails", func() {
 				It("shows helpful error messages", func() {
 					flyCmd := exec.Command(
@@ -452,6 +477,85 @@ this is super secure
 				})
 			})
 
+			Context("when setting an instance of the pipeline", func() {
+				BeforeEach(func() {
+					config = atc.Config{
+						Resources: atc.ResourceConfigs{
+							{
+								Name: "some-resource",
+								Type: "some-type",
+								Tags: atc.Tags{"val-1", "val-2"},
+								Source: atc.Source{
+									"private_key": `-----BEGIN SOME KEY-----
+this is super secure
+-----END SOME KEY-----
+`,
+									"config-a": "some-param-a",
+									"config-b": "some-param-b-via-instance",
+									"bool":     true,
+								},
+							},
+						},
+
+						Jobs: atc.JobConfigs{
+							{
+								Name: "some-job",
+								Plan: atc.PlanSequence{
+									{
+										Get: "some-resource",
+									},
+								},
+							},
+						},
+					}
+
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path,
+						ghttp.CombineHandlers(
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+							func(w http.ResponseWriter, r *http.Request) {
+								Expect(r.URL.Query().Get("instance_vars")).To(MatchJSON(`{"param-b":"some-param-b-via-instance"}`))
+
+								bodyConfig := getConfig(r)
+
+								receivedConfig := atc.Config{}
+								err = yaml.Unmarshal(bodyConfig, &receivedConfig)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(receivedConfig).To(Equal(config))
+
+								w.WriteHeader(http.StatusOK)
+								w.Write([]byte(`{}`))
+							},
+						),
+					)
+				})
+
+				It("saves the instance, interpolating its instance vars over the vars given with -v", func() {
+					Expect(func() {
+						flyCmd := exec.Command(
+							flyPath, "-t", targetName,
+							"set-pipeline",
+							"-n",
+							"--pipeline", "awesome-pipeline/param-b:some-param-b-via-instance",
+							"-c", "fixtures/vars-pipeline.yml",
+							"-l", "fixtures/vars-pipeline-params-a.yml",
+							"-l", "fixtures/vars-pipeline-params-types.yml",
+							"-v", "param-b=some-param-b-via-v",
+						)
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(3))
+				})
+			})
+
 			Context("when vars are overridden with -v, some with special types", func() {
 				BeforeEach(func() {
 					config = atc.Config{
@@ -741,9 +845,9 @@ this is super secure
 				})
 			})
 
-			Context("when specifying a pipeline name with a '/' character in it", func() {
-				It("fails and says '/' characters are not allowed", func() {
-					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "forbidden/pipelinename", "-c", configFile.Name())
+			Context("when specifying malformed instance vars", func() {
+				It("fails and says how instance vars are specified", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "some-pipeline/forbidden", "-c", configFile.Name())
 
 					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
 					Expect(err).NotTo(HaveOccurred())
@@ -751,7 +855,7 @@ this is super secure
 					<-sess.Exited
 					Expect(sess.ExitCode()).To(Equal(1))
 
-					Expect(sess.Err).To(gbytes.Say("error: pipeline name cannot contain '/'"))
+					Expect(sess.Err).To(gbytes.Say("argument format should be <pipeline>/<key>:<value>,<key>:<value>"))
 				})
 			})
 
@@ -860,6 +964,12 @@ this is super secure
 					changedConfig.Jobs[0].Serial = false
 					changedConfig.Jobs = append(changedConfig.Jobs[:2], newJob)
 
+					changedConfig.Vars = map[string]interface{}{"branch": "develop"}
+
+					changedConfig.FreezeWindows = atc.FreezeWindowConfigs{
+						{Name: "weekend", Start: "0 18 * * 5", Duration: "62h", Jobs: []string{"some-job"}},
+					}
+
 					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
 					Expect(err).NotTo(HaveOccurred())
 
@@ -914,6 +1024,12 @@ this is super secure
 						Eventually(sess).Should(gbytes.Say("resource type some-new-resource-type has been added"))
 						Eventually(sess.Out.Contents).Should(ContainSubstring(ansi.Color("name: some-new-resource-type", "green")))
 
+						Eventually(sess).Should(gbytes.Say("vars:"))
+						Eventually(sess.Out.Contents).Should(ContainSubstring(ansi.Color("branch: develop", "green")))
+
+						Eventually(sess).Should(gbytes.Say("freeze window weekend has been added"))
+						Eventually(sess.Out.Contents).Should(ContainSubstring(ansi.Color("start: 0 18 * * 5", "green")))
+
 						Eventually(sess).Should(gbytes.Say("job some-job has changed"))
 						Eventually(sess.Out.Contents).Should(ContainSubstring(ansi.Color("serial: true", "red")))
 
@@ -961,6 +1077,53 @@ this is super secure
 				})
 			})
 
+			Context("when the --dry-run flag is given", func() {
+				BeforeEach(func() {
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path,
+						ghttp.CombineHandlers(
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+							func(w http.ResponseWriter, r *http.Request) {
+								Expect(r.URL.Query()).To(HaveKey(atc.SaveConfigDryRun))
+							},
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.SaveConfigResponse{
+								DryRun: &atc.ConfigImpact{
+									NewResourceScopes:     []string{"some-resource"},
+									RenamedJobs:           []atc.JobRename{{From: "old-job", To: "some-job"}},
+									TriggeredJobs:         []string{"some-job"},
+									UnresolvedCredentials: []string{"undefined vars: some-var"},
+								},
+							}),
+						),
+					)
+					config.Resources[0].Name = "updated-name"
+				})
+
+				It("renders the report without asking to apply the configuration", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-c", configFile.Name(), "-p", "awesome-pipeline", "--dry-run")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say("resource updated-name has been added"))
+					Eventually(sess).Should(gbytes.Say("resources that will lose their version history:"))
+					Eventually(sess).Should(gbytes.Say("  - some-resource"))
+					Eventually(sess).Should(gbytes.Say("jobs that will be renamed:"))
+					Eventually(sess).Should(gbytes.Say("  - old-job -> some-job"))
+					Eventually(sess).Should(gbytes.Say("jobs that will trigger a build immediately:"))
+					Eventually(sess).Should(gbytes.Say("  - some-job"))
+					Eventually(sess).Should(gbytes.Say("credentials that can't be resolved:"))
+					Eventually(sess).Should(gbytes.Say("  - undefined vars: some-var"))
+					Eventually(sess).Should(gbytes.Say("dry run: configuration not applied"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+					Expect(string(sess.Out.Contents())).NotTo(ContainSubstring("apply configuration"))
+				})
+			})
+
 			Context("when configuring fails", func() {
 				BeforeEach(func() {
 					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
@@ -64,6 +64,45 @@ var _ = Describe("Fly CLI", func() {
 			Expect(sess.ExitCode()).To(Equal(0))
 		})
 
+		It("returns the resolved configuration and the origins of a configuration with includes", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/includes/pipeline.yml",
+				"-o",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("origins:"))
+			Eventually(sess.Err).Should(gbytes.Say(`  resources.repo: fixtures/includes/common.yml \(include 'common'\)`))
+			Eventually(sess.Err).Should(gbytes.Say(`  jobs.deploy: fixtures/includes/pipeline.yml`))
+			Eventually(sess.Err).Should(gbytes.Say(`  jobs.unit: fixtures/includes/common.yml \(include 'common'\)`))
+
+			Eventually(sess).Should(gbytes.Say("uri: https://example.com/repo.git"))
+			Expect(sess.Out).NotTo(gbytes.Say("include:"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		It("returns invalid when an included element conflicts", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/includes/conflicting-pipeline.yml",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say(`job 'unit' from fixtures/includes/common.yml \(include 'common'\) conflicts with the one from fixtures/includes/conflicting-pipeline.yml`))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+
 		It("returns invalid on validation error", func() {
 			flyCmd := exec.Command(
 				flyPath,
@@ -35,7 +35,7 @@ var _ = Describe("ATC Handler Build Outputs", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualBuilds, found, clientErr = team.BuildsWithVersionAsOutput("some-pipeline", "myresource", 2)
+			actualBuilds, found, clientErr = team.BuildsWithVersionAsOutput(atc.PipelineRef{Name: "some-pipeline"}, "myresource", 2)
 		})
 
 		Context("when the server returns builds", func() {
@@ -35,10 +35,10 @@ func (team *team) CreateBuild(plan atc.Plan) (atc.Build, error) {
 	return build, err
 }
 
-func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build, error) {
+func (team *team) CreateJobBuild(pipelineRef atc.PipelineRef, jobName string) (atc.Build, error) {
 	params := rata.Params{
 		"job_name":      jobName,
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -46,6 +46,7 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.CreateJobBuild,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &build,
 	})
@@ -53,11 +54,11 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	return build, err
 }
 
-func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
+func (team *team) JobBuild(pipelineRef atc.PipelineRef, jobName, buildName string) (atc.Build, bool, error) {
 	params := rata.Params{
 		"job_name":      jobName,
 		"build_name":    buildName,
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -65,6 +66,7 @@ func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build,
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetJobBuild,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &build,
 	})
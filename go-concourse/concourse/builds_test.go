@@ -90,7 +90,7 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 
 		It("takes a pipeline and a job and creates the build", func() {
-			build, err := team.CreateJobBuild(pipelineName, jobName)
+			build, err := team.CreateJobBuild(atc.PipelineRef{Name: pipelineName}, jobName)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(build).To(Equal(expectedBuild))
 		})
@@ -123,7 +123,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("returns the given build", func() {
-				build, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				build, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(build).To(Equal(expectedBuild))
@@ -143,7 +143,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("return false and no error", func() {
-				_, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				_, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -10,54 +10,43 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) CheckResource(pipelineName string, resourceName string, version atc.Version) (bool, error) {
+func (team *team) CheckResource(pipelineRef atc.PipelineRef, resourceName string, version atc.Version) (atc.Check, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"resource_name": resourceName,
 		"team_name":     team.name,
 	}
 
 	jsonBytes, err := json.Marshal(atc.CheckRequestBody{From: version})
 	if err != nil {
-		return false, err
+		return atc.Check{}, false, err
 	}
 
-	response := internal.Response{}
+	var check atc.Check
 	err = team.connection.Send(internal.Request{
-		ReturnResponseBody: true,
-		RequestName:        atc.CheckResource,
-		Params:             params,
-		Body:               bytes.NewBuffer(jsonBytes),
-		Header:             http.Header{"Content-Type": []string{"application/json"}},
-	}, &response)
+		RequestName: atc.CheckResource,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &check,
+	})
 
 	switch e := err.(type) {
 	case nil:
-		return true, nil
+		return check, true, nil
 	case internal.ResourceNotFoundError:
-		return false, nil
+		return check, false, nil
 	case internal.UnexpectedResponseError:
-		switch e.StatusCode {
-		case http.StatusBadRequest:
-			var checkRes atc.CheckResponseBody
-			err = json.Unmarshal([]byte(e.Body), &checkRes)
-			if err != nil {
-				return false, err
-			}
-
-			return false, CommandFailedError{
-				Command:    "check",
-				ExitStatus: checkRes.ExitStatus,
-				Output:     checkRes.Stderr,
-			}
-		case http.StatusInternalServerError:
-			return false, GenericError{
+		if e.StatusCode == http.StatusInternalServerError {
+			return check, false, GenericError{
 				e.Body,
 			}
-		default:
-			return false, err
+		} else {
+			return check, false, err
 		}
 	default:
-		return false, err
+		return check, false, err
 	}
 }
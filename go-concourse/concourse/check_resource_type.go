@@ -10,41 +10,43 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (bool, error) {
+func (team *team) CheckResourceType(pipelineRef atc.PipelineRef, resourceTypeName string, version atc.Version) (atc.Check, bool, error) {
 	params := rata.Params{
-		"pipeline_name":      pipelineName,
+		"pipeline_name":      pipelineRef.Name,
 		"resource_type_name": resourceTypeName,
 		"team_name":          team.name,
 	}
 
 	jsonBytes, err := json.Marshal(atc.CheckRequestBody{From: version})
 	if err != nil {
-		return false, err
+		return atc.Check{}, false, err
 	}
 
-	response := internal.Response{}
+	var check atc.Check
 	err = team.connection.Send(internal.Request{
-		ReturnResponseBody: true,
-		RequestName:        atc.CheckResourceType,
-		Params:             params,
-		Body:               bytes.NewBuffer(jsonBytes),
-		Header:             http.Header{"Content-Type": []string{"application/json"}},
-	}, &response)
+		RequestName: atc.CheckResourceType,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &check,
+	})
 
 	switch e := err.(type) {
 	case nil:
-		return true, nil
+		return check, true, nil
 	case internal.ResourceNotFoundError:
-		return false, nil
+		return check, false, nil
 	case internal.UnexpectedResponseError:
 		if e.StatusCode == http.StatusInternalServerError {
-			return false, GenericError{
+			return check, false, GenericError{
 				e.Body,
 			}
 		} else {
-			return false, err
+			return check, false, err
 		}
 	default:
-		return false, err
+		return check, false, err
 	}
 }
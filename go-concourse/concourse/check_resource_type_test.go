@@ -13,6 +13,16 @@ import (
 
 var _ = Describe("CheckResourceType", func() {
 	Context("when ATC request succeeds", func() {
+		expectedCheck := atc.Check{
+			ID:        123,
+			Status:    atc.CheckStatusPending,
+			CreatedBy: atc.CheckCreatedByManual,
+			Plan: atc.CheckPlan{
+				ResourceType: "myresource",
+				From:         atc.Version{"ref": "fake-ref"},
+			},
+		}
+
 		BeforeEach(func() {
 			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/myresource/check"
 
@@ -20,15 +30,16 @@ var _ = Describe("CheckResourceType", func() {
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedCheck),
 				),
 			)
 		})
 
 		It("sends check resource request to ATC", func() {
-			found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			check, found, err := team.CheckResourceType(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
+			Expect(check).To(Equal(expectedCheck))
 
 			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
 		})
@@ -46,7 +57,7 @@ var _ = Describe("CheckResourceType", func() {
 		})
 
 		It("returns a ResourceNotFoundError", func() {
-			found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, found, err := team.CheckResourceType(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -65,7 +76,7 @@ var _ = Describe("CheckResourceType", func() {
 		})
 
 		It("returns an error", func() {
-			_, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, _, err := team.CheckResourceType(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).To(HaveOccurred())
 
 			cre, ok := err.(concourse.GenericError)
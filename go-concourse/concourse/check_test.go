@@ -0,0 +1,117 @@
+package concourse_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Check", func() {
+	expectedURL := "/api/v1/checks/123"
+
+	Context("when the check exists", func() {
+		expectedCheck := atc.Check{
+			ID:         123,
+			Status:     atc.CheckStatusErrored,
+			CreatedBy:  atc.CheckCreatedByManual,
+			CreateTime: 100,
+			StartTime:  101,
+			EndTime:    102,
+			Plan:       atc.CheckPlan{Resource: "some-resource"},
+			CheckError: "some-error",
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedCheck),
+				),
+			)
+		})
+
+		It("returns the check", func() {
+			check, found, err := client.Check("123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(check).To(Equal(expectedCheck))
+		})
+	})
+
+	Context("when the check does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+				),
+			)
+		})
+
+		It("returns false and no error", func() {
+			_, found, err := client.Check("123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("CheckEvents", func() {
+		Context("when the server streams the check's events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/checks/123/events"),
+						func(w http.ResponseWriter, r *http.Request) {
+							w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+							w.WriteHeader(http.StatusOK)
+
+							payload, err := json.Marshal(event.Message{Event: event.Log{Payload: "some-stderr"}})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{ID: "0", Name: "event", Data: payload}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{ID: "1", Name: "end"}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+				)
+			})
+
+			It("returns the events until the end of the stream", func() {
+				stream, err := client.CheckEvents("123")
+				Expect(err).NotTo(HaveOccurred())
+
+				next, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(event.Log{Payload: "some-stderr"}))
+
+				_, err = stream.NextEvent()
+				Expect(err).To(Equal(io.EOF))
+
+				Expect(stream.Close()).To(Succeed())
+			})
+		})
+
+		Context("when the server returns 403", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, ""))
+			})
+
+			It("returns ErrForbidden", func() {
+				_, err := client.CheckEvents("123")
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+})
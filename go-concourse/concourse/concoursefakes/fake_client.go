@@ -96,6 +96,34 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	CheckStub        func(string) (atc.Check, bool, error)
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+		arg1 string
+	}
+	checkReturns struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}
+	CheckEventsStub        func(string) (concourse.Events, error)
+	checkEventsMutex       sync.RWMutex
+	checkEventsArgsForCall []struct {
+		arg1 string
+	}
+	checkEventsReturns struct {
+		result1 concourse.Events
+		result2 error
+	}
+	checkEventsReturnsOnCall map[int]struct {
+		result1 concourse.Events
+		result2 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -656,6 +684,135 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) Check(arg1 string) (atc.Check, bool, error) {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Check", []interface{}{arg1})
+	fake.checkMutex.Unlock()
+	if fake.CheckStub != nil {
+		return fake.CheckStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.checkReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *FakeClient) CheckCalls(stub func(string) (atc.Check, bool, error)) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = stub
+}
+
+func (fake *FakeClient) CheckArgsForCall(i int) string {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	argsForCall := fake.checkArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CheckReturns(result1 atc.Check, result2 bool, result3 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) CheckReturnsOnCall(i int, result1 atc.Check, result2 bool, result3 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 atc.Check
+			result2 bool
+			result3 error
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) CheckEvents(arg1 string) (concourse.Events, error) {
+	fake.checkEventsMutex.Lock()
+	ret, specificReturn := fake.checkEventsReturnsOnCall[len(fake.checkEventsArgsForCall)]
+	fake.checkEventsArgsForCall = append(fake.checkEventsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CheckEvents", []interface{}{arg1})
+	fake.checkEventsMutex.Unlock()
+	if fake.CheckEventsStub != nil {
+		return fake.CheckEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CheckEventsCallCount() int {
+	fake.checkEventsMutex.RLock()
+	defer fake.checkEventsMutex.RUnlock()
+	return len(fake.checkEventsArgsForCall)
+}
+
+func (fake *FakeClient) CheckEventsCalls(stub func(string) (concourse.Events, error)) {
+	fake.checkEventsMutex.Lock()
+	defer fake.checkEventsMutex.Unlock()
+	fake.CheckEventsStub = stub
+}
+
+func (fake *FakeClient) CheckEventsArgsForCall(i int) string {
+	fake.checkEventsMutex.RLock()
+	defer fake.checkEventsMutex.RUnlock()
+	argsForCall := fake.checkEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CheckEventsReturns(result1 concourse.Events, result2 error) {
+	fake.checkEventsMutex.Lock()
+	defer fake.checkEventsMutex.Unlock()
+	fake.CheckEventsStub = nil
+	fake.checkEventsReturns = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CheckEventsReturnsOnCall(i int, result1 concourse.Events, result2 error) {
+	fake.checkEventsMutex.Lock()
+	defer fake.checkEventsMutex.Unlock()
+	fake.CheckEventsStub = nil
+	if fake.checkEventsReturnsOnCall == nil {
+		fake.checkEventsReturnsOnCall = make(map[int]struct {
+			result1 concourse.Events
+			result2 error
+		})
+	}
+	fake.checkEventsReturnsOnCall[i] = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -1487,6 +1644,10 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	fake.checkEventsMutex.RLock()
+	defer fake.checkEventsMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
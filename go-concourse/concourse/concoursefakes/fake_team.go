@@ -10,10 +10,23 @@ import (
 )
 
 type FakeTeam struct {
-	BuildInputsForJobStub        func(string, string) ([]atc.BuildInput, bool, error)
+	ArchivePipelineStub        func(atc.PipelineRef) (bool, error)
+	archivePipelineMutex       sync.RWMutex
+	archivePipelineArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	archivePipelineReturns struct {
+		result1 bool
+		result2 error
+	}
+	archivePipelineReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	BuildInputsForJobStub        func(atc.PipelineRef, string) ([]atc.BuildInput, bool, error)
 	buildInputsForJobMutex       sync.RWMutex
 	buildInputsForJobArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	buildInputsForJobReturns struct {
@@ -41,10 +54,10 @@ type FakeTeam struct {
 		result2 concourse.Pagination
 		result3 error
 	}
-	BuildsWithVersionAsInputStub        func(string, string, int) ([]atc.Build, bool, error)
+	BuildsWithVersionAsInputStub        func(atc.PipelineRef, string, int) ([]atc.Build, bool, error)
 	buildsWithVersionAsInputMutex       sync.RWMutex
 	buildsWithVersionAsInputArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}
@@ -58,10 +71,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	BuildsWithVersionAsOutputStub        func(string, string, int) ([]atc.Build, bool, error)
+	BuildsWithVersionAsOutputStub        func(atc.PipelineRef, string, int) ([]atc.Build, bool, error)
 	buildsWithVersionAsOutputMutex       sync.RWMutex
 	buildsWithVersionAsOutputArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}
@@ -75,40 +88,44 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	CheckResourceStub        func(string, string, atc.Version) (bool, error)
+	CheckResourceStub        func(atc.PipelineRef, string, atc.Version) (atc.Check, bool, error)
 	checkResourceMutex       sync.RWMutex
 	checkResourceArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 atc.Version
 	}
 	checkResourceReturns struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
 	checkResourceReturnsOnCall map[int]struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
-	CheckResourceTypeStub        func(string, string, atc.Version) (bool, error)
+	CheckResourceTypeStub        func(atc.PipelineRef, string, atc.Version) (atc.Check, bool, error)
 	checkResourceTypeMutex       sync.RWMutex
 	checkResourceTypeArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 atc.Version
 	}
 	checkResourceTypeReturns struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
 	checkResourceTypeReturnsOnCall map[int]struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
-	ClearTaskCacheStub        func(string, string, string, string) (int64, error)
+	ClearTaskCacheStub        func(atc.PipelineRef, string, string, string) (int64, error)
 	clearTaskCacheMutex       sync.RWMutex
 	clearTaskCacheArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 string
 		arg4 string
@@ -148,10 +165,10 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
-	CreateJobBuildStub        func(string, string) (atc.Build, error)
+	CreateJobBuildStub        func(atc.PipelineRef, string) (atc.Build, error)
 	createJobBuildMutex       sync.RWMutex
 	createJobBuildArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	createJobBuildReturns struct {
@@ -179,10 +196,10 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	CreateOrUpdatePipelineConfigStub        func(string, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)
+	CreateOrUpdatePipelineConfigStub        func(atc.PipelineRef, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)
 	createOrUpdatePipelineConfigMutex       sync.RWMutex
 	createOrUpdatePipelineConfigArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 []byte
 		arg4 bool
@@ -199,10 +216,10 @@ type FakeTeam struct {
 		result3 []concourse.ConfigWarning
 		result4 error
 	}
-	CreatePipelineBuildStub        func(string, atc.Plan) (atc.Build, error)
+	CreatePipelineBuildStub        func(atc.PipelineRef, atc.Plan) (atc.Build, error)
 	createPipelineBuildMutex       sync.RWMutex
 	createPipelineBuildArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Plan
 	}
 	createPipelineBuildReturns struct {
@@ -213,10 +230,10 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
-	DeletePipelineStub        func(string) (bool, error)
+	DeletePipelineStub        func(atc.PipelineRef) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	deletePipelineReturns struct {
 		result1 bool
@@ -237,10 +254,10 @@ type FakeTeam struct {
 	destroyTeamReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DisableResourceVersionStub        func(string, string, int) (bool, error)
+	DisableResourceVersionStub        func(atc.PipelineRef, string, int) (bool, error)
 	disableResourceVersionMutex       sync.RWMutex
 	disableResourceVersionArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}
@@ -252,10 +269,27 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	EnableResourceVersionStub        func(string, string, int) (bool, error)
+	DryRunPipelineConfigStub        func(atc.PipelineRef, string, []byte) (atc.ConfigImpact, []concourse.ConfigWarning, error)
+	dryRunPipelineConfigMutex       sync.RWMutex
+	dryRunPipelineConfigArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 []byte
+	}
+	dryRunPipelineConfigReturns struct {
+		result1 atc.ConfigImpact
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
+	dryRunPipelineConfigReturnsOnCall map[int]struct {
+		result1 atc.ConfigImpact
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
+	EnableResourceVersionStub        func(atc.PipelineRef, string, int) (bool, error)
 	enableResourceVersionMutex       sync.RWMutex
 	enableResourceVersionArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}
@@ -267,10 +301,26 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	ExposePipelineStub        func(string) (bool, error)
+	ExportPipelineStub        func(atc.PipelineRef, bool) (atc.PipelineBundle, bool, error)
+	exportPipelineMutex       sync.RWMutex
+	exportPipelineArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 bool
+	}
+	exportPipelineReturns struct {
+		result1 atc.PipelineBundle
+		result2 bool
+		result3 error
+	}
+	exportPipelineReturnsOnCall map[int]struct {
+		result1 atc.PipelineBundle
+		result2 bool
+		result3 error
+	}
+	ExposePipelineStub        func(atc.PipelineRef) (bool, error)
 	exposePipelineMutex       sync.RWMutex
 	exposePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	exposePipelineReturns struct {
 		result1 bool
@@ -306,10 +356,10 @@ type FakeTeam struct {
 		result1 atc.Container
 		result2 error
 	}
-	HidePipelineStub        func(string) (bool, error)
+	HidePipelineStub        func(atc.PipelineRef) (bool, error)
 	hidePipelineMutex       sync.RWMutex
 	hidePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	hidePipelineReturns struct {
 		result1 bool
@@ -319,10 +369,24 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	JobStub        func(string, string) (atc.Job, bool, error)
+	ImportPipelineStub        func(atc.PipelineRef, atc.PipelineBundle) (atc.ImportPipelineResponse, error)
+	importPipelineMutex       sync.RWMutex
+	importPipelineArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 atc.PipelineBundle
+	}
+	importPipelineReturns struct {
+		result1 atc.ImportPipelineResponse
+		result2 error
+	}
+	importPipelineReturnsOnCall map[int]struct {
+		result1 atc.ImportPipelineResponse
+		result2 error
+	}
+	JobStub        func(atc.PipelineRef, string) (atc.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	jobReturns struct {
@@ -335,10 +399,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	JobBuildStub        func(string, string, string) (atc.Build, bool, error)
+	JobBuildStub        func(atc.PipelineRef, string, string) (atc.Build, bool, error)
 	jobBuildMutex       sync.RWMutex
 	jobBuildArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 string
 	}
@@ -352,10 +416,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	JobBuildsStub        func(string, string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
+	JobBuildsStub        func(atc.PipelineRef, string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
 	jobBuildsMutex       sync.RWMutex
 	jobBuildsArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 concourse.Page
 	}
@@ -384,10 +448,10 @@ type FakeTeam struct {
 		result1 []atc.Container
 		result2 error
 	}
-	ListJobsStub        func(string) ([]atc.Job, error)
+	ListJobsStub        func(atc.PipelineRef) ([]atc.Job, error)
 	listJobsMutex       sync.RWMutex
 	listJobsArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	listJobsReturns struct {
 		result1 []atc.Job
@@ -409,10 +473,10 @@ type FakeTeam struct {
 		result1 []atc.Pipeline
 		result2 error
 	}
-	ListResourcesStub        func(string) ([]atc.Resource, error)
+	ListResourcesStub        func(atc.PipelineRef) ([]atc.Resource, error)
 	listResourcesMutex       sync.RWMutex
 	listResourcesArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	listResourcesReturns struct {
 		result1 []atc.Resource
@@ -455,11 +519,12 @@ type FakeTeam struct {
 	orderingPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PauseJobStub        func(string, string) (bool, error)
+	PauseJobStub        func(atc.PipelineRef, string, string) (bool, error)
 	pauseJobMutex       sync.RWMutex
 	pauseJobArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
+		arg3 string
 	}
 	pauseJobReturns struct {
 		result1 bool
@@ -469,10 +534,11 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	PausePipelineStub        func(string) (bool, error)
+	PausePipelineStub        func(atc.PipelineRef, string) (bool, error)
 	pausePipelineMutex       sync.RWMutex
 	pausePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
+		arg2 string
 	}
 	pausePipelineReturns struct {
 		result1 bool
@@ -482,10 +548,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	PipelineStub        func(string) (atc.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (atc.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 atc.Pipeline
@@ -497,10 +563,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	PipelineBuildsStub        func(string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
+	PipelineBuildsStub        func(atc.PipelineRef, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
 	pipelineBuildsMutex       sync.RWMutex
 	pipelineBuildsArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 concourse.Page
 	}
 	pipelineBuildsReturns struct {
@@ -515,10 +581,10 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	PipelineConfigStub        func(string) (atc.Config, string, bool, error)
+	PipelineConfigStub        func(atc.PipelineRef) (atc.Config, string, bool, error)
 	pipelineConfigMutex       sync.RWMutex
 	pipelineConfigArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineConfigReturns struct {
 		result1 atc.Config
@@ -532,10 +598,41 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	RenamePipelineStub        func(string, string) (bool, error)
+	PipelineConfigVersionStub        func(atc.PipelineRef, int) (atc.PipelineConfigVersion, bool, error)
+	pipelineConfigVersionMutex       sync.RWMutex
+	pipelineConfigVersionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineConfigVersionReturns struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	PipelineConfigVersionsStub        func(atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error)
+	pipelineConfigVersionsMutex       sync.RWMutex
+	pipelineConfigVersionsArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineConfigVersionsReturns struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionsReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	RenamePipelineStub        func(atc.PipelineRef, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	renamePipelineReturns struct {
@@ -560,10 +657,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	ResourceStub        func(string, string) (atc.Resource, bool, error)
+	ResourceStub        func(atc.PipelineRef, string) (atc.Resource, bool, error)
 	resourceMutex       sync.RWMutex
 	resourceArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	resourceReturns struct {
@@ -576,10 +673,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	ResourceVersionsStub        func(string, string, concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
+	ResourceVersionsStub        func(atc.PipelineRef, string, concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
 	resourceVersionsMutex       sync.RWMutex
 	resourceVersionsArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 concourse.Page
 	}
@@ -595,6 +692,23 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RollbackPipelineConfigStub        func(atc.PipelineRef, int, string) ([]concourse.ConfigWarning, bool, error)
+	rollbackPipelineConfigMutex       sync.RWMutex
+	rollbackPipelineConfigArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+		arg3 string
+	}
+	rollbackPipelineConfigReturns struct {
+		result1 []concourse.ConfigWarning
+		result2 bool
+		result3 error
+	}
+	rollbackPipelineConfigReturnsOnCall map[int]struct {
+		result1 []concourse.ConfigWarning
+		result2 bool
+		result3 error
+	}
 	TeamStub        func(string) (atc.Team, bool, error)
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -610,10 +724,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	UnpauseJobStub        func(string, string) (bool, error)
+	UnpauseJobStub        func(atc.PipelineRef, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	unpauseJobReturns struct {
@@ -624,10 +738,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	UnpausePipelineStub        func(string) (bool, error)
+	UnpausePipelineStub        func(atc.PipelineRef) (bool, error)
 	unpausePipelineMutex       sync.RWMutex
 	unpausePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	unpausePipelineReturns struct {
 		result1 bool
@@ -637,10 +751,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	VersionedResourceTypesStub        func(string) (atc.VersionedResourceTypes, bool, error)
+	VersionedResourceTypesStub        func(atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)
 	versionedResourceTypesMutex       sync.RWMutex
 	versionedResourceTypesArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	versionedResourceTypesReturns struct {
 		result1 atc.VersionedResourceTypes
@@ -656,11 +770,74 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeTeam) BuildInputsForJob(arg1 string, arg2 string) ([]atc.BuildInput, bool, error) {
+func (fake *FakeTeam) ArchivePipeline(arg1 atc.PipelineRef) (bool, error) {
+	fake.archivePipelineMutex.Lock()
+	ret, specificReturn := fake.archivePipelineReturnsOnCall[len(fake.archivePipelineArgsForCall)]
+	fake.archivePipelineArgsForCall = append(fake.archivePipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	fake.recordInvocation("ArchivePipeline", []interface{}{arg1})
+	fake.archivePipelineMutex.Unlock()
+	if fake.ArchivePipelineStub != nil {
+		return fake.ArchivePipelineStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.archivePipelineReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ArchivePipelineCallCount() int {
+	fake.archivePipelineMutex.RLock()
+	defer fake.archivePipelineMutex.RUnlock()
+	return len(fake.archivePipelineArgsForCall)
+}
+
+func (fake *FakeTeam) ArchivePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
+	fake.archivePipelineMutex.Lock()
+	defer fake.archivePipelineMutex.Unlock()
+	fake.ArchivePipelineStub = stub
+}
+
+func (fake *FakeTeam) ArchivePipelineArgsForCall(i int) atc.PipelineRef {
+	fake.archivePipelineMutex.RLock()
+	defer fake.archivePipelineMutex.RUnlock()
+	argsForCall := fake.archivePipelineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ArchivePipelineReturns(result1 bool, result2 error) {
+	fake.archivePipelineMutex.Lock()
+	defer fake.archivePipelineMutex.Unlock()
+	fake.ArchivePipelineStub = nil
+	fake.archivePipelineReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ArchivePipelineReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.archivePipelineMutex.Lock()
+	defer fake.archivePipelineMutex.Unlock()
+	fake.ArchivePipelineStub = nil
+	if fake.archivePipelineReturnsOnCall == nil {
+		fake.archivePipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.archivePipelineReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) BuildInputsForJob(arg1 atc.PipelineRef, arg2 string) ([]atc.BuildInput, bool, error) {
 	fake.buildInputsForJobMutex.Lock()
 	ret, specificReturn := fake.buildInputsForJobReturnsOnCall[len(fake.buildInputsForJobArgsForCall)]
 	fake.buildInputsForJobArgsForCall = append(fake.buildInputsForJobArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("BuildInputsForJob", []interface{}{arg1, arg2})
@@ -681,13 +858,13 @@ func (fake *FakeTeam) BuildInputsForJobCallCount() int {
 	return len(fake.buildInputsForJobArgsForCall)
 }
 
-func (fake *FakeTeam) BuildInputsForJobCalls(stub func(string, string) ([]atc.BuildInput, bool, error)) {
+func (fake *FakeTeam) BuildInputsForJobCalls(stub func(atc.PipelineRef, string) ([]atc.BuildInput, bool, error)) {
 	fake.buildInputsForJobMutex.Lock()
 	defer fake.buildInputsForJobMutex.Unlock()
 	fake.BuildInputsForJobStub = stub
 }
 
-func (fake *FakeTeam) BuildInputsForJobArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) BuildInputsForJobArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
 	argsForCall := fake.buildInputsForJobArgsForCall[i]
@@ -789,11 +966,11 @@ func (fake *FakeTeam) BuildsReturnsOnCall(i int, result1 []atc.Build, result2 co
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) BuildsWithVersionAsInput(arg1 string, arg2 string, arg3 int) ([]atc.Build, bool, error) {
+func (fake *FakeTeam) BuildsWithVersionAsInput(arg1 atc.PipelineRef, arg2 string, arg3 int) ([]atc.Build, bool, error) {
 	fake.buildsWithVersionAsInputMutex.Lock()
 	ret, specificReturn := fake.buildsWithVersionAsInputReturnsOnCall[len(fake.buildsWithVersionAsInputArgsForCall)]
 	fake.buildsWithVersionAsInputArgsForCall = append(fake.buildsWithVersionAsInputArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}{arg1, arg2, arg3})
@@ -815,13 +992,13 @@ func (fake *FakeTeam) BuildsWithVersionAsInputCallCount() int {
 	return len(fake.buildsWithVersionAsInputArgsForCall)
 }
 
-func (fake *FakeTeam) BuildsWithVersionAsInputCalls(stub func(string, string, int) ([]atc.Build, bool, error)) {
+func (fake *FakeTeam) BuildsWithVersionAsInputCalls(stub func(atc.PipelineRef, string, int) ([]atc.Build, bool, error)) {
 	fake.buildsWithVersionAsInputMutex.Lock()
 	defer fake.buildsWithVersionAsInputMutex.Unlock()
 	fake.BuildsWithVersionAsInputStub = stub
 }
 
-func (fake *FakeTeam) BuildsWithVersionAsInputArgsForCall(i int) (string, string, int) {
+func (fake *FakeTeam) BuildsWithVersionAsInputArgsForCall(i int) (atc.PipelineRef, string, int) {
 	fake.buildsWithVersionAsInputMutex.RLock()
 	defer fake.buildsWithVersionAsInputMutex.RUnlock()
 	argsForCall := fake.buildsWithVersionAsInputArgsForCall[i]
@@ -857,11 +1034,11 @@ func (fake *FakeTeam) BuildsWithVersionAsInputReturnsOnCall(i int, result1 []atc
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) BuildsWithVersionAsOutput(arg1 string, arg2 string, arg3 int) ([]atc.Build, bool, error) {
+func (fake *FakeTeam) BuildsWithVersionAsOutput(arg1 atc.PipelineRef, arg2 string, arg3 int) ([]atc.Build, bool, error) {
 	fake.buildsWithVersionAsOutputMutex.Lock()
 	ret, specificReturn := fake.buildsWithVersionAsOutputReturnsOnCall[len(fake.buildsWithVersionAsOutputArgsForCall)]
 	fake.buildsWithVersionAsOutputArgsForCall = append(fake.buildsWithVersionAsOutputArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}{arg1, arg2, arg3})
@@ -883,13 +1060,13 @@ func (fake *FakeTeam) BuildsWithVersionAsOutputCallCount() int {
 	return len(fake.buildsWithVersionAsOutputArgsForCall)
 }
 
-func (fake *FakeTeam) BuildsWithVersionAsOutputCalls(stub func(string, string, int) ([]atc.Build, bool, error)) {
+func (fake *FakeTeam) BuildsWithVersionAsOutputCalls(stub func(atc.PipelineRef, string, int) ([]atc.Build, bool, error)) {
 	fake.buildsWithVersionAsOutputMutex.Lock()
 	defer fake.buildsWithVersionAsOutputMutex.Unlock()
 	fake.BuildsWithVersionAsOutputStub = stub
 }
 
-func (fake *FakeTeam) BuildsWithVersionAsOutputArgsForCall(i int) (string, string, int) {
+func (fake *FakeTeam) BuildsWithVersionAsOutputArgsForCall(i int) (atc.PipelineRef, string, int) {
 	fake.buildsWithVersionAsOutputMutex.RLock()
 	defer fake.buildsWithVersionAsOutputMutex.RUnlock()
 	argsForCall := fake.buildsWithVersionAsOutputArgsForCall[i]
@@ -925,11 +1102,11 @@ func (fake *FakeTeam) BuildsWithVersionAsOutputReturnsOnCall(i int, result1 []at
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResource(arg1 string, arg2 string, arg3 atc.Version) (bool, error) {
+func (fake *FakeTeam) CheckResource(arg1 atc.PipelineRef, arg2 string, arg3 atc.Version) (atc.Check, bool, error) {
 	fake.checkResourceMutex.Lock()
 	ret, specificReturn := fake.checkResourceReturnsOnCall[len(fake.checkResourceArgsForCall)]
 	fake.checkResourceArgsForCall = append(fake.checkResourceArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 atc.Version
 	}{arg1, arg2, arg3})
@@ -939,10 +1116,10 @@ func (fake *FakeTeam) CheckResource(arg1 string, arg2 string, arg3 atc.Version)
 		return fake.CheckResourceStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
 	fakeReturns := fake.checkResourceReturns
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeTeam) CheckResourceCallCount() int {
@@ -951,50 +1128,53 @@ func (fake *FakeTeam) CheckResourceCallCount() int {
 	return len(fake.checkResourceArgsForCall)
 }
 
-func (fake *FakeTeam) CheckResourceCalls(stub func(string, string, atc.Version) (bool, error)) {
+func (fake *FakeTeam) CheckResourceCalls(stub func(atc.PipelineRef, string, atc.Version) (atc.Check, bool, error)) {
 	fake.checkResourceMutex.Lock()
 	defer fake.checkResourceMutex.Unlock()
 	fake.CheckResourceStub = stub
 }
 
-func (fake *FakeTeam) CheckResourceArgsForCall(i int) (string, string, atc.Version) {
+func (fake *FakeTeam) CheckResourceArgsForCall(i int) (atc.PipelineRef, string, atc.Version) {
 	fake.checkResourceMutex.RLock()
 	defer fake.checkResourceMutex.RUnlock()
 	argsForCall := fake.checkResourceArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeTeam) CheckResourceReturns(result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceReturns(result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceMutex.Lock()
 	defer fake.checkResourceMutex.Unlock()
 	fake.CheckResourceStub = nil
 	fake.checkResourceReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResourceReturnsOnCall(i int, result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceReturnsOnCall(i int, result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceMutex.Lock()
 	defer fake.checkResourceMutex.Unlock()
 	fake.CheckResourceStub = nil
 	if fake.checkResourceReturnsOnCall == nil {
 		fake.checkResourceReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
+			result1 atc.Check
+			result2 bool
+			result3 error
 		})
 	}
 	fake.checkResourceReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResourceType(arg1 string, arg2 string, arg3 atc.Version) (bool, error) {
+func (fake *FakeTeam) CheckResourceType(arg1 atc.PipelineRef, arg2 string, arg3 atc.Version) (atc.Check, bool, error) {
 	fake.checkResourceTypeMutex.Lock()
 	ret, specificReturn := fake.checkResourceTypeReturnsOnCall[len(fake.checkResourceTypeArgsForCall)]
 	fake.checkResourceTypeArgsForCall = append(fake.checkResourceTypeArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 atc.Version
 	}{arg1, arg2, arg3})
@@ -1004,10 +1184,10 @@ func (fake *FakeTeam) CheckResourceType(arg1 string, arg2 string, arg3 atc.Versi
 		return fake.CheckResourceTypeStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
 	fakeReturns := fake.checkResourceTypeReturns
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeTeam) CheckResourceTypeCallCount() int {
@@ -1016,50 +1196,53 @@ func (fake *FakeTeam) CheckResourceTypeCallCount() int {
 	return len(fake.checkResourceTypeArgsForCall)
 }
 
-func (fake *FakeTeam) CheckResourceTypeCalls(stub func(string, string, atc.Version) (bool, error)) {
+func (fake *FakeTeam) CheckResourceTypeCalls(stub func(atc.PipelineRef, string, atc.Version) (atc.Check, bool, error)) {
 	fake.checkResourceTypeMutex.Lock()
 	defer fake.checkResourceTypeMutex.Unlock()
 	fake.CheckResourceTypeStub = stub
 }
 
-func (fake *FakeTeam) CheckResourceTypeArgsForCall(i int) (string, string, atc.Version) {
+func (fake *FakeTeam) CheckResourceTypeArgsForCall(i int) (atc.PipelineRef, string, atc.Version) {
 	fake.checkResourceTypeMutex.RLock()
 	defer fake.checkResourceTypeMutex.RUnlock()
 	argsForCall := fake.checkResourceTypeArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeTeam) CheckResourceTypeReturns(result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceTypeReturns(result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceTypeMutex.Lock()
 	defer fake.checkResourceTypeMutex.Unlock()
 	fake.CheckResourceTypeStub = nil
 	fake.checkResourceTypeReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResourceTypeReturnsOnCall(i int, result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceTypeReturnsOnCall(i int, result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceTypeMutex.Lock()
 	defer fake.checkResourceTypeMutex.Unlock()
 	fake.CheckResourceTypeStub = nil
 	if fake.checkResourceTypeReturnsOnCall == nil {
 		fake.checkResourceTypeReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
+			result1 atc.Check
+			result2 bool
+			result3 error
 		})
 	}
 	fake.checkResourceTypeReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) ClearTaskCache(arg1 string, arg2 string, arg3 string, arg4 string) (int64, error) {
+func (fake *FakeTeam) ClearTaskCache(arg1 atc.PipelineRef, arg2 string, arg3 string, arg4 string) (int64, error) {
 	fake.clearTaskCacheMutex.Lock()
 	ret, specificReturn := fake.clearTaskCacheReturnsOnCall[len(fake.clearTaskCacheArgsForCall)]
 	fake.clearTaskCacheArgsForCall = append(fake.clearTaskCacheArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 string
 		arg4 string
@@ -1082,13 +1265,13 @@ func (fake *FakeTeam) ClearTaskCacheCallCount() int {
 	return len(fake.clearTaskCacheArgsForCall)
 }
 
-func (fake *FakeTeam) ClearTaskCacheCalls(stub func(string, string, string, string) (int64, error)) {
+func (fake *FakeTeam) ClearTaskCacheCalls(stub func(atc.PipelineRef, string, string, string) (int64, error)) {
 	fake.clearTaskCacheMutex.Lock()
 	defer fake.clearTaskCacheMutex.Unlock()
 	fake.ClearTaskCacheStub = stub
 }
 
-func (fake *FakeTeam) ClearTaskCacheArgsForCall(i int) (string, string, string, string) {
+func (fake *FakeTeam) ClearTaskCacheArgsForCall(i int) (atc.PipelineRef, string, string, string) {
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
 	argsForCall := fake.clearTaskCacheArgsForCall[i]
@@ -1248,11 +1431,11 @@ func (fake *FakeTeam) CreateBuildReturnsOnCall(i int, result1 atc.Build, result2
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) CreateJobBuild(arg1 string, arg2 string) (atc.Build, error) {
+func (fake *FakeTeam) CreateJobBuild(arg1 atc.PipelineRef, arg2 string) (atc.Build, error) {
 	fake.createJobBuildMutex.Lock()
 	ret, specificReturn := fake.createJobBuildReturnsOnCall[len(fake.createJobBuildArgsForCall)]
 	fake.createJobBuildArgsForCall = append(fake.createJobBuildArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("CreateJobBuild", []interface{}{arg1, arg2})
@@ -1273,13 +1456,13 @@ func (fake *FakeTeam) CreateJobBuildCallCount() int {
 	return len(fake.createJobBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreateJobBuildCalls(stub func(string, string) (atc.Build, error)) {
+func (fake *FakeTeam) CreateJobBuildCalls(stub func(atc.PipelineRef, string) (atc.Build, error)) {
 	fake.createJobBuildMutex.Lock()
 	defer fake.createJobBuildMutex.Unlock()
 	fake.CreateJobBuildStub = stub
 }
 
-func (fake *FakeTeam) CreateJobBuildArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) CreateJobBuildArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.createJobBuildMutex.RLock()
 	defer fake.createJobBuildMutex.RUnlock()
 	argsForCall := fake.createJobBuildArgsForCall[i]
@@ -1381,7 +1564,7 @@ func (fake *FakeTeam) CreateOrUpdateReturnsOnCall(i int, result1 atc.Team, resul
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 string, arg2 string, arg3 []byte, arg4 bool) (bool, bool, []concourse.ConfigWarning, error) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 atc.PipelineRef, arg2 string, arg3 []byte, arg4 bool) (bool, bool, []concourse.ConfigWarning, error) {
 	var arg3Copy []byte
 	if arg3 != nil {
 		arg3Copy = make([]byte, len(arg3))
@@ -1390,7 +1573,7 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 string, arg2 string, arg
 	fake.createOrUpdatePipelineConfigMutex.Lock()
 	ret, specificReturn := fake.createOrUpdatePipelineConfigReturnsOnCall[len(fake.createOrUpdatePipelineConfigArgsForCall)]
 	fake.createOrUpdatePipelineConfigArgsForCall = append(fake.createOrUpdatePipelineConfigArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 []byte
 		arg4 bool
@@ -1413,13 +1596,13 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigCallCount() int {
 	return len(fake.createOrUpdatePipelineConfigArgsForCall)
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfigCalls(stub func(string, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigCalls(stub func(atc.PipelineRef, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)) {
 	fake.createOrUpdatePipelineConfigMutex.Lock()
 	defer fake.createOrUpdatePipelineConfigMutex.Unlock()
 	fake.CreateOrUpdatePipelineConfigStub = stub
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfigArgsForCall(i int) (string, string, []byte, bool) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigArgsForCall(i int) (atc.PipelineRef, string, []byte, bool) {
 	fake.createOrUpdatePipelineConfigMutex.RLock()
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	argsForCall := fake.createOrUpdatePipelineConfigArgsForCall[i]
@@ -1458,11 +1641,11 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigReturnsOnCall(i int, result1 b
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) CreatePipelineBuild(arg1 string, arg2 atc.Plan) (atc.Build, error) {
+func (fake *FakeTeam) CreatePipelineBuild(arg1 atc.PipelineRef, arg2 atc.Plan) (atc.Build, error) {
 	fake.createPipelineBuildMutex.Lock()
 	ret, specificReturn := fake.createPipelineBuildReturnsOnCall[len(fake.createPipelineBuildArgsForCall)]
 	fake.createPipelineBuildArgsForCall = append(fake.createPipelineBuildArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Plan
 	}{arg1, arg2})
 	fake.recordInvocation("CreatePipelineBuild", []interface{}{arg1, arg2})
@@ -1483,13 +1666,13 @@ func (fake *FakeTeam) CreatePipelineBuildCallCount() int {
 	return len(fake.createPipelineBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreatePipelineBuildCalls(stub func(string, atc.Plan) (atc.Build, error)) {
+func (fake *FakeTeam) CreatePipelineBuildCalls(stub func(atc.PipelineRef, atc.Plan) (atc.Build, error)) {
 	fake.createPipelineBuildMutex.Lock()
 	defer fake.createPipelineBuildMutex.Unlock()
 	fake.CreatePipelineBuildStub = stub
 }
 
-func (fake *FakeTeam) CreatePipelineBuildArgsForCall(i int) (string, atc.Plan) {
+func (fake *FakeTeam) CreatePipelineBuildArgsForCall(i int) (atc.PipelineRef, atc.Plan) {
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
 	argsForCall := fake.createPipelineBuildArgsForCall[i]
@@ -1522,11 +1705,11 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) DeletePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) DeletePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
 	fake.deletePipelineArgsForCall = append(fake.deletePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("DeletePipeline", []interface{}{arg1})
 	fake.deletePipelineMutex.Unlock()
@@ -1546,13 +1729,13 @@ func (fake *FakeTeam) DeletePipelineCallCount() int {
 	return len(fake.deletePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) DeletePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) DeletePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.deletePipelineMutex.Lock()
 	defer fake.deletePipelineMutex.Unlock()
 	fake.DeletePipelineStub = stub
 }
 
-func (fake *FakeTeam) DeletePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) DeletePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
 	argsForCall := fake.deletePipelineArgsForCall[i]
@@ -1645,11 +1828,11 @@ func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) DisableResourceVersion(arg1 string, arg2 string, arg3 int) (bool, error) {
+func (fake *FakeTeam) DisableResourceVersion(arg1 atc.PipelineRef, arg2 string, arg3 int) (bool, error) {
 	fake.disableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.disableResourceVersionReturnsOnCall[len(fake.disableResourceVersionArgsForCall)]
 	fake.disableResourceVersionArgsForCall = append(fake.disableResourceVersionArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}{arg1, arg2, arg3})
@@ -1671,13 +1854,13 @@ func (fake *FakeTeam) DisableResourceVersionCallCount() int {
 	return len(fake.disableResourceVersionArgsForCall)
 }
 
-func (fake *FakeTeam) DisableResourceVersionCalls(stub func(string, string, int) (bool, error)) {
+func (fake *FakeTeam) DisableResourceVersionCalls(stub func(atc.PipelineRef, string, int) (bool, error)) {
 	fake.disableResourceVersionMutex.Lock()
 	defer fake.disableResourceVersionMutex.Unlock()
 	fake.DisableResourceVersionStub = stub
 }
 
-func (fake *FakeTeam) DisableResourceVersionArgsForCall(i int) (string, string, int) {
+func (fake *FakeTeam) DisableResourceVersionArgsForCall(i int) (atc.PipelineRef, string, int) {
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
 	argsForCall := fake.disableResourceVersionArgsForCall[i]
@@ -1710,11 +1893,84 @@ func (fake *FakeTeam) DisableResourceVersionReturnsOnCall(i int, result1 bool, r
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) EnableResourceVersion(arg1 string, arg2 string, arg3 int) (bool, error) {
+func (fake *FakeTeam) DryRunPipelineConfig(arg1 atc.PipelineRef, arg2 string, arg3 []byte) (atc.ConfigImpact, []concourse.ConfigWarning, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.dryRunPipelineConfigMutex.Lock()
+	ret, specificReturn := fake.dryRunPipelineConfigReturnsOnCall[len(fake.dryRunPipelineConfigArgsForCall)]
+	fake.dryRunPipelineConfigArgsForCall = append(fake.dryRunPipelineConfigArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("DryRunPipelineConfig", []interface{}{arg1, arg2, arg3Copy})
+	fake.dryRunPipelineConfigMutex.Unlock()
+	if fake.DryRunPipelineConfigStub != nil {
+		return fake.DryRunPipelineConfigStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.dryRunPipelineConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigCallCount() int {
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
+	return len(fake.dryRunPipelineConfigArgsForCall)
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigCalls(stub func(atc.PipelineRef, string, []byte) (atc.ConfigImpact, []concourse.ConfigWarning, error)) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = stub
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigArgsForCall(i int) (atc.PipelineRef, string, []byte) {
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
+	argsForCall := fake.dryRunPipelineConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigReturns(result1 atc.ConfigImpact, result2 []concourse.ConfigWarning, result3 error) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = nil
+	fake.dryRunPipelineConfigReturns = struct {
+		result1 atc.ConfigImpact
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigReturnsOnCall(i int, result1 atc.ConfigImpact, result2 []concourse.ConfigWarning, result3 error) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = nil
+	if fake.dryRunPipelineConfigReturnsOnCall == nil {
+		fake.dryRunPipelineConfigReturnsOnCall = make(map[int]struct {
+			result1 atc.ConfigImpact
+			result2 []concourse.ConfigWarning
+			result3 error
+		})
+	}
+	fake.dryRunPipelineConfigReturnsOnCall[i] = struct {
+		result1 atc.ConfigImpact
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) EnableResourceVersion(arg1 atc.PipelineRef, arg2 string, arg3 int) (bool, error) {
 	fake.enableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.enableResourceVersionReturnsOnCall[len(fake.enableResourceVersionArgsForCall)]
 	fake.enableResourceVersionArgsForCall = append(fake.enableResourceVersionArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 int
 	}{arg1, arg2, arg3})
@@ -1736,13 +1992,13 @@ func (fake *FakeTeam) EnableResourceVersionCallCount() int {
 	return len(fake.enableResourceVersionArgsForCall)
 }
 
-func (fake *FakeTeam) EnableResourceVersionCalls(stub func(string, string, int) (bool, error)) {
+func (fake *FakeTeam) EnableResourceVersionCalls(stub func(atc.PipelineRef, string, int) (bool, error)) {
 	fake.enableResourceVersionMutex.Lock()
 	defer fake.enableResourceVersionMutex.Unlock()
 	fake.EnableResourceVersionStub = stub
 }
 
-func (fake *FakeTeam) EnableResourceVersionArgsForCall(i int) (string, string, int) {
+func (fake *FakeTeam) EnableResourceVersionArgsForCall(i int) (atc.PipelineRef, string, int) {
 	fake.enableResourceVersionMutex.RLock()
 	defer fake.enableResourceVersionMutex.RUnlock()
 	argsForCall := fake.enableResourceVersionArgsForCall[i]
@@ -1775,11 +2031,78 @@ func (fake *FakeTeam) EnableResourceVersionReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) ExposePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) ExportPipeline(arg1 atc.PipelineRef, arg2 bool) (atc.PipelineBundle, bool, error) {
+	fake.exportPipelineMutex.Lock()
+	ret, specificReturn := fake.exportPipelineReturnsOnCall[len(fake.exportPipelineArgsForCall)]
+	fake.exportPipelineArgsForCall = append(fake.exportPipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("ExportPipeline", []interface{}{arg1, arg2})
+	fake.exportPipelineMutex.Unlock()
+	if fake.ExportPipelineStub != nil {
+		return fake.ExportPipelineStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.exportPipelineReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ExportPipelineCallCount() int {
+	fake.exportPipelineMutex.RLock()
+	defer fake.exportPipelineMutex.RUnlock()
+	return len(fake.exportPipelineArgsForCall)
+}
+
+func (fake *FakeTeam) ExportPipelineCalls(stub func(atc.PipelineRef, bool) (atc.PipelineBundle, bool, error)) {
+	fake.exportPipelineMutex.Lock()
+	defer fake.exportPipelineMutex.Unlock()
+	fake.ExportPipelineStub = stub
+}
+
+func (fake *FakeTeam) ExportPipelineArgsForCall(i int) (atc.PipelineRef, bool) {
+	fake.exportPipelineMutex.RLock()
+	defer fake.exportPipelineMutex.RUnlock()
+	argsForCall := fake.exportPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ExportPipelineReturns(result1 atc.PipelineBundle, result2 bool, result3 error) {
+	fake.exportPipelineMutex.Lock()
+	defer fake.exportPipelineMutex.Unlock()
+	fake.ExportPipelineStub = nil
+	fake.exportPipelineReturns = struct {
+		result1 atc.PipelineBundle
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ExportPipelineReturnsOnCall(i int, result1 atc.PipelineBundle, result2 bool, result3 error) {
+	fake.exportPipelineMutex.Lock()
+	defer fake.exportPipelineMutex.Unlock()
+	fake.ExportPipelineStub = nil
+	if fake.exportPipelineReturnsOnCall == nil {
+		fake.exportPipelineReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineBundle
+			result2 bool
+			result3 error
+		})
+	}
+	fake.exportPipelineReturnsOnCall[i] = struct {
+		result1 atc.PipelineBundle
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ExposePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.exposePipelineMutex.Lock()
 	ret, specificReturn := fake.exposePipelineReturnsOnCall[len(fake.exposePipelineArgsForCall)]
 	fake.exposePipelineArgsForCall = append(fake.exposePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("ExposePipeline", []interface{}{arg1})
 	fake.exposePipelineMutex.Unlock()
@@ -1799,13 +2122,13 @@ func (fake *FakeTeam) ExposePipelineCallCount() int {
 	return len(fake.exposePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) ExposePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) ExposePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.exposePipelineMutex.Lock()
 	defer fake.exposePipelineMutex.Unlock()
 	fake.ExposePipelineStub = stub
 }
 
-func (fake *FakeTeam) ExposePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) ExposePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
 	argsForCall := fake.exposePipelineArgsForCall[i]
@@ -1964,11 +2287,11 @@ func (fake *FakeTeam) GetContainerReturnsOnCall(i int, result1 atc.Container, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) HidePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) HidePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.hidePipelineMutex.Lock()
 	ret, specificReturn := fake.hidePipelineReturnsOnCall[len(fake.hidePipelineArgsForCall)]
 	fake.hidePipelineArgsForCall = append(fake.hidePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("HidePipeline", []interface{}{arg1})
 	fake.hidePipelineMutex.Unlock()
@@ -1988,13 +2311,13 @@ func (fake *FakeTeam) HidePipelineCallCount() int {
 	return len(fake.hidePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) HidePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) HidePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.hidePipelineMutex.Lock()
 	defer fake.hidePipelineMutex.Unlock()
 	fake.HidePipelineStub = stub
 }
 
-func (fake *FakeTeam) HidePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) HidePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
 	argsForCall := fake.hidePipelineArgsForCall[i]
@@ -2027,11 +2350,75 @@ func (fake *FakeTeam) HidePipelineReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) Job(arg1 string, arg2 string) (atc.Job, bool, error) {
+func (fake *FakeTeam) ImportPipeline(arg1 atc.PipelineRef, arg2 atc.PipelineBundle) (atc.ImportPipelineResponse, error) {
+	fake.importPipelineMutex.Lock()
+	ret, specificReturn := fake.importPipelineReturnsOnCall[len(fake.importPipelineArgsForCall)]
+	fake.importPipelineArgsForCall = append(fake.importPipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 atc.PipelineBundle
+	}{arg1, arg2})
+	fake.recordInvocation("ImportPipeline", []interface{}{arg1, arg2})
+	fake.importPipelineMutex.Unlock()
+	if fake.ImportPipelineStub != nil {
+		return fake.ImportPipelineStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.importPipelineReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ImportPipelineCallCount() int {
+	fake.importPipelineMutex.RLock()
+	defer fake.importPipelineMutex.RUnlock()
+	return len(fake.importPipelineArgsForCall)
+}
+
+func (fake *FakeTeam) ImportPipelineCalls(stub func(atc.PipelineRef, atc.PipelineBundle) (atc.ImportPipelineResponse, error)) {
+	fake.importPipelineMutex.Lock()
+	defer fake.importPipelineMutex.Unlock()
+	fake.ImportPipelineStub = stub
+}
+
+func (fake *FakeTeam) ImportPipelineArgsForCall(i int) (atc.PipelineRef, atc.PipelineBundle) {
+	fake.importPipelineMutex.RLock()
+	defer fake.importPipelineMutex.RUnlock()
+	argsForCall := fake.importPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ImportPipelineReturns(result1 atc.ImportPipelineResponse, result2 error) {
+	fake.importPipelineMutex.Lock()
+	defer fake.importPipelineMutex.Unlock()
+	fake.ImportPipelineStub = nil
+	fake.importPipelineReturns = struct {
+		result1 atc.ImportPipelineResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ImportPipelineReturnsOnCall(i int, result1 atc.ImportPipelineResponse, result2 error) {
+	fake.importPipelineMutex.Lock()
+	defer fake.importPipelineMutex.Unlock()
+	fake.ImportPipelineStub = nil
+	if fake.importPipelineReturnsOnCall == nil {
+		fake.importPipelineReturnsOnCall = make(map[int]struct {
+			result1 atc.ImportPipelineResponse
+			result2 error
+		})
+	}
+	fake.importPipelineReturnsOnCall[i] = struct {
+		result1 atc.ImportPipelineResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) Job(arg1 atc.PipelineRef, arg2 string) (atc.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
 	fake.jobArgsForCall = append(fake.jobArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("Job", []interface{}{arg1, arg2})
@@ -2052,13 +2439,13 @@ func (fake *FakeTeam) JobCallCount() int {
 	return len(fake.jobArgsForCall)
 }
 
-func (fake *FakeTeam) JobCalls(stub func(string, string) (atc.Job, bool, error)) {
+func (fake *FakeTeam) JobCalls(stub func(atc.PipelineRef, string) (atc.Job, bool, error)) {
 	fake.jobMutex.Lock()
 	defer fake.jobMutex.Unlock()
 	fake.JobStub = stub
 }
 
-func (fake *FakeTeam) JobArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) JobArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	argsForCall := fake.jobArgsForCall[i]
@@ -2094,11 +2481,11 @@ func (fake *FakeTeam) JobReturnsOnCall(i int, result1 atc.Job, result2 bool, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) JobBuild(arg1 string, arg2 string, arg3 string) (atc.Build, bool, error) {
+func (fake *FakeTeam) JobBuild(arg1 atc.PipelineRef, arg2 string, arg3 string) (atc.Build, bool, error) {
 	fake.jobBuildMutex.Lock()
 	ret, specificReturn := fake.jobBuildReturnsOnCall[len(fake.jobBuildArgsForCall)]
 	fake.jobBuildArgsForCall = append(fake.jobBuildArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
@@ -2120,13 +2507,13 @@ func (fake *FakeTeam) JobBuildCallCount() int {
 	return len(fake.jobBuildArgsForCall)
 }
 
-func (fake *FakeTeam) JobBuildCalls(stub func(string, string, string) (atc.Build, bool, error)) {
+func (fake *FakeTeam) JobBuildCalls(stub func(atc.PipelineRef, string, string) (atc.Build, bool, error)) {
 	fake.jobBuildMutex.Lock()
 	defer fake.jobBuildMutex.Unlock()
 	fake.JobBuildStub = stub
 }
 
-func (fake *FakeTeam) JobBuildArgsForCall(i int) (string, string, string) {
+func (fake *FakeTeam) JobBuildArgsForCall(i int) (atc.PipelineRef, string, string) {
 	fake.jobBuildMutex.RLock()
 	defer fake.jobBuildMutex.RUnlock()
 	argsForCall := fake.jobBuildArgsForCall[i]
@@ -2162,11 +2549,11 @@ func (fake *FakeTeam) JobBuildReturnsOnCall(i int, result1 atc.Build, result2 bo
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) JobBuilds(arg1 string, arg2 string, arg3 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
+func (fake *FakeTeam) JobBuilds(arg1 atc.PipelineRef, arg2 string, arg3 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
 	fake.jobBuildsMutex.Lock()
 	ret, specificReturn := fake.jobBuildsReturnsOnCall[len(fake.jobBuildsArgsForCall)]
 	fake.jobBuildsArgsForCall = append(fake.jobBuildsArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 concourse.Page
 	}{arg1, arg2, arg3})
@@ -2188,13 +2575,13 @@ func (fake *FakeTeam) JobBuildsCallCount() int {
 	return len(fake.jobBuildsArgsForCall)
 }
 
-func (fake *FakeTeam) JobBuildsCalls(stub func(string, string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
+func (fake *FakeTeam) JobBuildsCalls(stub func(atc.PipelineRef, string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
 	fake.jobBuildsMutex.Lock()
 	defer fake.jobBuildsMutex.Unlock()
 	fake.JobBuildsStub = stub
 }
 
-func (fake *FakeTeam) JobBuildsArgsForCall(i int) (string, string, concourse.Page) {
+func (fake *FakeTeam) JobBuildsArgsForCall(i int) (atc.PipelineRef, string, concourse.Page) {
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
 	argsForCall := fake.jobBuildsArgsForCall[i]
@@ -2296,11 +2683,11 @@ func (fake *FakeTeam) ListContainersReturnsOnCall(i int, result1 []atc.Container
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) ListJobs(arg1 string) ([]atc.Job, error) {
+func (fake *FakeTeam) ListJobs(arg1 atc.PipelineRef) ([]atc.Job, error) {
 	fake.listJobsMutex.Lock()
 	ret, specificReturn := fake.listJobsReturnsOnCall[len(fake.listJobsArgsForCall)]
 	fake.listJobsArgsForCall = append(fake.listJobsArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("ListJobs", []interface{}{arg1})
 	fake.listJobsMutex.Unlock()
@@ -2320,13 +2707,13 @@ func (fake *FakeTeam) ListJobsCallCount() int {
 	return len(fake.listJobsArgsForCall)
 }
 
-func (fake *FakeTeam) ListJobsCalls(stub func(string) ([]atc.Job, error)) {
+func (fake *FakeTeam) ListJobsCalls(stub func(atc.PipelineRef) ([]atc.Job, error)) {
 	fake.listJobsMutex.Lock()
 	defer fake.listJobsMutex.Unlock()
 	fake.ListJobsStub = stub
 }
 
-func (fake *FakeTeam) ListJobsArgsForCall(i int) string {
+func (fake *FakeTeam) ListJobsArgsForCall(i int) atc.PipelineRef {
 	fake.listJobsMutex.RLock()
 	defer fake.listJobsMutex.RUnlock()
 	argsForCall := fake.listJobsArgsForCall[i]
@@ -2414,11 +2801,11 @@ func (fake *FakeTeam) ListPipelinesReturnsOnCall(i int, result1 []atc.Pipeline,
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) ListResources(arg1 string) ([]atc.Resource, error) {
+func (fake *FakeTeam) ListResources(arg1 atc.PipelineRef) ([]atc.Resource, error) {
 	fake.listResourcesMutex.Lock()
 	ret, specificReturn := fake.listResourcesReturnsOnCall[len(fake.listResourcesArgsForCall)]
 	fake.listResourcesArgsForCall = append(fake.listResourcesArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("ListResources", []interface{}{arg1})
 	fake.listResourcesMutex.Unlock()
@@ -2438,13 +2825,13 @@ func (fake *FakeTeam) ListResourcesCallCount() int {
 	return len(fake.listResourcesArgsForCall)
 }
 
-func (fake *FakeTeam) ListResourcesCalls(stub func(string) ([]atc.Resource, error)) {
+func (fake *FakeTeam) ListResourcesCalls(stub func(atc.PipelineRef) ([]atc.Resource, error)) {
 	fake.listResourcesMutex.Lock()
 	defer fake.listResourcesMutex.Unlock()
 	fake.ListResourcesStub = stub
 }
 
-func (fake *FakeTeam) ListResourcesArgsForCall(i int) string {
+func (fake *FakeTeam) ListResourcesArgsForCall(i int) atc.PipelineRef {
 	fake.listResourcesMutex.RLock()
 	defer fake.listResourcesMutex.RUnlock()
 	argsForCall := fake.listResourcesArgsForCall[i]
@@ -2649,17 +3036,18 @@ func (fake *FakeTeam) OrderingPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) PauseJob(arg1 string, arg2 string) (bool, error) {
+func (fake *FakeTeam) PauseJob(arg1 atc.PipelineRef, arg2 string, arg3 string) (bool, error) {
 	fake.pauseJobMutex.Lock()
 	ret, specificReturn := fake.pauseJobReturnsOnCall[len(fake.pauseJobArgsForCall)]
 	fake.pauseJobArgsForCall = append(fake.pauseJobArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("PauseJob", []interface{}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PauseJob", []interface{}{arg1, arg2, arg3})
 	fake.pauseJobMutex.Unlock()
 	if fake.PauseJobStub != nil {
-		return fake.PauseJobStub(arg1, arg2)
+		return fake.PauseJobStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2674,17 +3062,17 @@ func (fake *FakeTeam) PauseJobCallCount() int {
 	return len(fake.pauseJobArgsForCall)
 }
 
-func (fake *FakeTeam) PauseJobCalls(stub func(string, string) (bool, error)) {
+func (fake *FakeTeam) PauseJobCalls(stub func(atc.PipelineRef, string, string) (bool, error)) {
 	fake.pauseJobMutex.Lock()
 	defer fake.pauseJobMutex.Unlock()
 	fake.PauseJobStub = stub
 }
 
-func (fake *FakeTeam) PauseJobArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) PauseJobArgsForCall(i int) (atc.PipelineRef, string, string) {
 	fake.pauseJobMutex.RLock()
 	defer fake.pauseJobMutex.RUnlock()
 	argsForCall := fake.pauseJobArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeTeam) PauseJobReturns(result1 bool, result2 error) {
@@ -2713,16 +3101,17 @@ func (fake *FakeTeam) PauseJobReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) PausePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) PausePipeline(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.pausePipelineMutex.Lock()
 	ret, specificReturn := fake.pausePipelineReturnsOnCall[len(fake.pausePipelineArgsForCall)]
 	fake.pausePipelineArgsForCall = append(fake.pausePipelineArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	fake.recordInvocation("PausePipeline", []interface{}{arg1})
+		arg1 atc.PipelineRef
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("PausePipeline", []interface{}{arg1, arg2})
 	fake.pausePipelineMutex.Unlock()
 	if fake.PausePipelineStub != nil {
-		return fake.PausePipelineStub(arg1)
+		return fake.PausePipelineStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2737,17 +3126,17 @@ func (fake *FakeTeam) PausePipelineCallCount() int {
 	return len(fake.pausePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PausePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) PausePipelineCalls(stub func(atc.PipelineRef, string) (bool, error)) {
 	fake.pausePipelineMutex.Lock()
 	defer fake.pausePipelineMutex.Unlock()
 	fake.PausePipelineStub = stub
 }
 
-func (fake *FakeTeam) PausePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PausePipelineArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.pausePipelineMutex.RLock()
 	defer fake.pausePipelineMutex.RUnlock()
 	argsForCall := fake.pausePipelineArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTeam) PausePipelineReturns(result1 bool, result2 error) {
@@ -2776,11 +3165,11 @@ func (fake *FakeTeam) PausePipelineReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (atc.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (atc.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -2800,13 +3189,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (atc.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (atc.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -2842,11 +3231,11 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 atc.Pipeline, result2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) PipelineBuilds(arg1 string, arg2 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
+func (fake *FakeTeam) PipelineBuilds(arg1 atc.PipelineRef, arg2 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
 	fake.pipelineBuildsMutex.Lock()
 	ret, specificReturn := fake.pipelineBuildsReturnsOnCall[len(fake.pipelineBuildsArgsForCall)]
 	fake.pipelineBuildsArgsForCall = append(fake.pipelineBuildsArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 concourse.Page
 	}{arg1, arg2})
 	fake.recordInvocation("PipelineBuilds", []interface{}{arg1, arg2})
@@ -2867,13 +3256,13 @@ func (fake *FakeTeam) PipelineBuildsCallCount() int {
 	return len(fake.pipelineBuildsArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineBuildsCalls(stub func(string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
+func (fake *FakeTeam) PipelineBuildsCalls(stub func(atc.PipelineRef, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
 	fake.pipelineBuildsMutex.Lock()
 	defer fake.pipelineBuildsMutex.Unlock()
 	fake.PipelineBuildsStub = stub
 }
 
-func (fake *FakeTeam) PipelineBuildsArgsForCall(i int) (string, concourse.Page) {
+func (fake *FakeTeam) PipelineBuildsArgsForCall(i int) (atc.PipelineRef, concourse.Page) {
 	fake.pipelineBuildsMutex.RLock()
 	defer fake.pipelineBuildsMutex.RUnlock()
 	argsForCall := fake.pipelineBuildsArgsForCall[i]
@@ -2912,11 +3301,11 @@ func (fake *FakeTeam) PipelineBuildsReturnsOnCall(i int, result1 []atc.Build, re
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) PipelineConfig(arg1 string) (atc.Config, string, bool, error) {
+func (fake *FakeTeam) PipelineConfig(arg1 atc.PipelineRef) (atc.Config, string, bool, error) {
 	fake.pipelineConfigMutex.Lock()
 	ret, specificReturn := fake.pipelineConfigReturnsOnCall[len(fake.pipelineConfigArgsForCall)]
 	fake.pipelineConfigArgsForCall = append(fake.pipelineConfigArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("PipelineConfig", []interface{}{arg1})
 	fake.pipelineConfigMutex.Unlock()
@@ -2936,13 +3325,13 @@ func (fake *FakeTeam) PipelineConfigCallCount() int {
 	return len(fake.pipelineConfigArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineConfigCalls(stub func(string) (atc.Config, string, bool, error)) {
+func (fake *FakeTeam) PipelineConfigCalls(stub func(atc.PipelineRef) (atc.Config, string, bool, error)) {
 	fake.pipelineConfigMutex.Lock()
 	defer fake.pipelineConfigMutex.Unlock()
 	fake.PipelineConfigStub = stub
 }
 
-func (fake *FakeTeam) PipelineConfigArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineConfigArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
 	argsForCall := fake.pipelineConfigArgsForCall[i]
@@ -2981,11 +3370,144 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
+func (fake *FakeTeam) PipelineConfigVersion(arg1 atc.PipelineRef, arg2 int) (atc.PipelineConfigVersion, bool, error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionReturnsOnCall[len(fake.pipelineConfigVersionArgsForCall)]
+	fake.pipelineConfigVersionArgsForCall = append(fake.pipelineConfigVersionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("PipelineConfigVersion", []interface{}{arg1, arg2})
+	fake.pipelineConfigVersionMutex.Unlock()
+	if fake.PipelineConfigVersionStub != nil {
+		return fake.PipelineConfigVersionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineConfigVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCallCount() int {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	return len(fake.pipelineConfigVersionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCalls(stub func(atc.PipelineRef, int) (atc.PipelineConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturns(result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	fake.pipelineConfigVersionReturns = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturnsOnCall(i int, result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	if fake.pipelineConfigVersionReturnsOnCall == nil {
+		fake.pipelineConfigVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersions(arg1 atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionsReturnsOnCall[len(fake.pipelineConfigVersionsArgsForCall)]
+	fake.pipelineConfigVersionsArgsForCall = append(fake.pipelineConfigVersionsArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	fake.recordInvocation("PipelineConfigVersions", []interface{}{arg1})
+	fake.pipelineConfigVersionsMutex.Unlock()
+	if fake.PipelineConfigVersionsStub != nil {
+		return fake.PipelineConfigVersionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineConfigVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCallCount() int {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	return len(fake.pipelineConfigVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCalls(stub func(atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturns(result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	fake.pipelineConfigVersionsReturns = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturnsOnCall(i int, result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	if fake.pipelineConfigVersionsReturnsOnCall == nil {
+		fake.pipelineConfigVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionsReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) RenamePipeline(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
 	fake.renamePipelineArgsForCall = append(fake.renamePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("RenamePipeline", []interface{}{arg1, arg2})
@@ -3006,13 +3528,13 @@ func (fake *FakeTeam) RenamePipelineCallCount() int {
 	return len(fake.renamePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) RenamePipelineCalls(stub func(string, string) (bool, error)) {
+func (fake *FakeTeam) RenamePipelineCalls(stub func(atc.PipelineRef, string) (bool, error)) {
 	fake.renamePipelineMutex.Lock()
 	defer fake.renamePipelineMutex.Unlock()
 	fake.RenamePipelineStub = stub
 }
 
-func (fake *FakeTeam) RenamePipelineArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) RenamePipelineArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	argsForCall := fake.renamePipelineArgsForCall[i]
@@ -3109,11 +3631,11 @@ func (fake *FakeTeam) RenameTeamReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) Resource(arg1 string, arg2 string) (atc.Resource, bool, error) {
+func (fake *FakeTeam) Resource(arg1 atc.PipelineRef, arg2 string) (atc.Resource, bool, error) {
 	fake.resourceMutex.Lock()
 	ret, specificReturn := fake.resourceReturnsOnCall[len(fake.resourceArgsForCall)]
 	fake.resourceArgsForCall = append(fake.resourceArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("Resource", []interface{}{arg1, arg2})
@@ -3134,13 +3656,13 @@ func (fake *FakeTeam) ResourceCallCount() int {
 	return len(fake.resourceArgsForCall)
 }
 
-func (fake *FakeTeam) ResourceCalls(stub func(string, string) (atc.Resource, bool, error)) {
+func (fake *FakeTeam) ResourceCalls(stub func(atc.PipelineRef, string) (atc.Resource, bool, error)) {
 	fake.resourceMutex.Lock()
 	defer fake.resourceMutex.Unlock()
 	fake.ResourceStub = stub
 }
 
-func (fake *FakeTeam) ResourceArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) ResourceArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
 	argsForCall := fake.resourceArgsForCall[i]
@@ -3176,11 +3698,11 @@ func (fake *FakeTeam) ResourceReturnsOnCall(i int, result1 atc.Resource, result2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) ResourceVersions(arg1 string, arg2 string, arg3 concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
+func (fake *FakeTeam) ResourceVersions(arg1 atc.PipelineRef, arg2 string, arg3 concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
 	fake.resourceVersionsMutex.Lock()
 	ret, specificReturn := fake.resourceVersionsReturnsOnCall[len(fake.resourceVersionsArgsForCall)]
 	fake.resourceVersionsArgsForCall = append(fake.resourceVersionsArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 concourse.Page
 	}{arg1, arg2, arg3})
@@ -3202,13 +3724,13 @@ func (fake *FakeTeam) ResourceVersionsCallCount() int {
 	return len(fake.resourceVersionsArgsForCall)
 }
 
-func (fake *FakeTeam) ResourceVersionsCalls(stub func(string, string, concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error)) {
+func (fake *FakeTeam) ResourceVersionsCalls(stub func(atc.PipelineRef, string, concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error)) {
 	fake.resourceVersionsMutex.Lock()
 	defer fake.resourceVersionsMutex.Unlock()
 	fake.ResourceVersionsStub = stub
 }
 
-func (fake *FakeTeam) ResourceVersionsArgsForCall(i int) (string, string, concourse.Page) {
+func (fake *FakeTeam) ResourceVersionsArgsForCall(i int) (atc.PipelineRef, string, concourse.Page) {
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
 	argsForCall := fake.resourceVersionsArgsForCall[i]
@@ -3247,6 +3769,74 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RollbackPipelineConfig(arg1 atc.PipelineRef, arg2 int, arg3 string) ([]concourse.ConfigWarning, bool, error) {
+	fake.rollbackPipelineConfigMutex.Lock()
+	ret, specificReturn := fake.rollbackPipelineConfigReturnsOnCall[len(fake.rollbackPipelineConfigArgsForCall)]
+	fake.rollbackPipelineConfigArgsForCall = append(fake.rollbackPipelineConfigArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RollbackPipelineConfig", []interface{}{arg1, arg2, arg3})
+	fake.rollbackPipelineConfigMutex.Unlock()
+	if fake.RollbackPipelineConfigStub != nil {
+		return fake.RollbackPipelineConfigStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.rollbackPipelineConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) RollbackPipelineConfigCallCount() int {
+	fake.rollbackPipelineConfigMutex.RLock()
+	defer fake.rollbackPipelineConfigMutex.RUnlock()
+	return len(fake.rollbackPipelineConfigArgsForCall)
+}
+
+func (fake *FakeTeam) RollbackPipelineConfigCalls(stub func(atc.PipelineRef, int, string) ([]concourse.ConfigWarning, bool, error)) {
+	fake.rollbackPipelineConfigMutex.Lock()
+	defer fake.rollbackPipelineConfigMutex.Unlock()
+	fake.RollbackPipelineConfigStub = stub
+}
+
+func (fake *FakeTeam) RollbackPipelineConfigArgsForCall(i int) (atc.PipelineRef, int, string) {
+	fake.rollbackPipelineConfigMutex.RLock()
+	defer fake.rollbackPipelineConfigMutex.RUnlock()
+	argsForCall := fake.rollbackPipelineConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) RollbackPipelineConfigReturns(result1 []concourse.ConfigWarning, result2 bool, result3 error) {
+	fake.rollbackPipelineConfigMutex.Lock()
+	defer fake.rollbackPipelineConfigMutex.Unlock()
+	fake.RollbackPipelineConfigStub = nil
+	fake.rollbackPipelineConfigReturns = struct {
+		result1 []concourse.ConfigWarning
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) RollbackPipelineConfigReturnsOnCall(i int, result1 []concourse.ConfigWarning, result2 bool, result3 error) {
+	fake.rollbackPipelineConfigMutex.Lock()
+	defer fake.rollbackPipelineConfigMutex.Unlock()
+	fake.RollbackPipelineConfigStub = nil
+	if fake.rollbackPipelineConfigReturnsOnCall == nil {
+		fake.rollbackPipelineConfigReturnsOnCall = make(map[int]struct {
+			result1 []concourse.ConfigWarning
+			result2 bool
+			result3 error
+		})
+	}
+	fake.rollbackPipelineConfigReturnsOnCall[i] = struct {
+		result1 []concourse.ConfigWarning
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Team(arg1 string) (atc.Team, bool, error) {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -3313,11 +3903,11 @@ func (fake *FakeTeam) TeamReturnsOnCall(i int, result1 atc.Team, result2 bool, r
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
+func (fake *FakeTeam) UnpauseJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
 	fake.unpauseJobArgsForCall = append(fake.unpauseJobArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("UnpauseJob", []interface{}{arg1, arg2})
@@ -3338,13 +3928,13 @@ func (fake *FakeTeam) UnpauseJobCallCount() int {
 	return len(fake.unpauseJobArgsForCall)
 }
 
-func (fake *FakeTeam) UnpauseJobCalls(stub func(string, string) (bool, error)) {
+func (fake *FakeTeam) UnpauseJobCalls(stub func(atc.PipelineRef, string) (bool, error)) {
 	fake.unpauseJobMutex.Lock()
 	defer fake.unpauseJobMutex.Unlock()
 	fake.UnpauseJobStub = stub
 }
 
-func (fake *FakeTeam) UnpauseJobArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) UnpauseJobArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	argsForCall := fake.unpauseJobArgsForCall[i]
@@ -3377,11 +3967,11 @@ func (fake *FakeTeam) UnpauseJobReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) UnpausePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) UnpausePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.unpausePipelineMutex.Lock()
 	ret, specificReturn := fake.unpausePipelineReturnsOnCall[len(fake.unpausePipelineArgsForCall)]
 	fake.unpausePipelineArgsForCall = append(fake.unpausePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("UnpausePipeline", []interface{}{arg1})
 	fake.unpausePipelineMutex.Unlock()
@@ -3401,13 +3991,13 @@ func (fake *FakeTeam) UnpausePipelineCallCount() int {
 	return len(fake.unpausePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) UnpausePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) UnpausePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.unpausePipelineMutex.Lock()
 	defer fake.unpausePipelineMutex.Unlock()
 	fake.UnpausePipelineStub = stub
 }
 
-func (fake *FakeTeam) UnpausePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) UnpausePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.unpausePipelineMutex.RLock()
 	defer fake.unpausePipelineMutex.RUnlock()
 	argsForCall := fake.unpausePipelineArgsForCall[i]
@@ -3440,11 +4030,11 @@ func (fake *FakeTeam) UnpausePipelineReturnsOnCall(i int, result1 bool, result2
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) VersionedResourceTypes(arg1 string) (atc.VersionedResourceTypes, bool, error) {
+func (fake *FakeTeam) VersionedResourceTypes(arg1 atc.PipelineRef) (atc.VersionedResourceTypes, bool, error) {
 	fake.versionedResourceTypesMutex.Lock()
 	ret, specificReturn := fake.versionedResourceTypesReturnsOnCall[len(fake.versionedResourceTypesArgsForCall)]
 	fake.versionedResourceTypesArgsForCall = append(fake.versionedResourceTypesArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("VersionedResourceTypes", []interface{}{arg1})
 	fake.versionedResourceTypesMutex.Unlock()
@@ -3464,13 +4054,13 @@ func (fake *FakeTeam) VersionedResourceTypesCallCount() int {
 	return len(fake.versionedResourceTypesArgsForCall)
 }
 
-func (fake *FakeTeam) VersionedResourceTypesCalls(stub func(string) (atc.VersionedResourceTypes, bool, error)) {
+func (fake *FakeTeam) VersionedResourceTypesCalls(stub func(atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)) {
 	fake.versionedResourceTypesMutex.Lock()
 	defer fake.versionedResourceTypesMutex.Unlock()
 	fake.VersionedResourceTypesStub = stub
 }
 
-func (fake *FakeTeam) VersionedResourceTypesArgsForCall(i int) string {
+func (fake *FakeTeam) VersionedResourceTypesArgsForCall(i int) atc.PipelineRef {
 	fake.versionedResourceTypesMutex.RLock()
 	defer fake.versionedResourceTypesMutex.RUnlock()
 	argsForCall := fake.versionedResourceTypesArgsForCall[i]
@@ -3509,6 +4099,8 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.archivePipelineMutex.RLock()
+	defer fake.archivePipelineMutex.RUnlock()
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
 	fake.buildsMutex.RLock()
@@ -3541,8 +4133,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.destroyTeamMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
 	defer fake.enableResourceVersionMutex.RUnlock()
+	fake.exportPipelineMutex.RLock()
+	defer fake.exportPipelineMutex.RUnlock()
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
 	fake.getArtifactMutex.RLock()
@@ -3551,12 +4147,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.getContainerMutex.RUnlock()
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
-	fake.jobMutex.RLock()
-	defer fake.jobMutex.RUnlock()
+	fake.importPipelineMutex.RLock()
+	defer fake.importPipelineMutex.RUnlock()
 	fake.jobBuildMutex.RLock()
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobMutex.RLock()
+	defer fake.jobMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -3575,12 +4173,16 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pauseJobMutex.RUnlock()
 	fake.pausePipelineMutex.RLock()
 	defer fake.pausePipelineMutex.RUnlock()
-	fake.pipelineMutex.RLock()
-	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineBuildsMutex.RLock()
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	fake.pipelineMutex.RLock()
+	defer fake.pipelineMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -3589,6 +4191,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.rollbackPipelineConfigMutex.RLock()
+	defer fake.rollbackPipelineConfigMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
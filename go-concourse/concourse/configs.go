@@ -8,15 +8,16 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) PipelineConfig(pipelineName string) (atc.Config, string, bool, error) {
+func (team *team) PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -30,6 +31,7 @@ func (team *team) PipelineConfig(pipelineName string) (atc.Config, string, bool,
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetConfig,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &response)
 
 	switch err.(type) {
@@ -45,27 +47,149 @@ func (team *team) PipelineConfig(pipelineName string) (atc.Config, string, bool,
 	}
 }
 
+func (team *team) PipelineConfigVersions(pipelineRef atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	var configVersions []atc.PipelineConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListPipelineConfigVersions,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &configVersions,
+	})
+
+	switch err.(type) {
+	case nil:
+		return configVersions, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) PipelineConfigVersion(pipelineRef atc.PipelineRef, version int) (atc.PipelineConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name":  pipelineRef.Name,
+		"team_name":      team.name,
+		"config_version": strconv.Itoa(version),
+	}
+
+	var configVersion atc.PipelineConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetPipelineConfigVersion,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &configVersion,
+	})
+
+	switch err.(type) {
+	case nil:
+		return configVersion, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.PipelineConfigVersion{}, false, nil
+	default:
+		return atc.PipelineConfigVersion{}, false, err
+	}
+}
+
+// RollbackPipelineConfig saves the pipeline with the config it had at the
+// given version, as stored by the server.
+func (team *team) RollbackPipelineConfig(pipelineRef atc.PipelineRef, version int, configVersion string) ([]ConfigWarning, bool, error) {
+	params := rata.Params{
+		"pipeline_name":  pipelineRef.Name,
+		"team_name":      team.name,
+		"config_version": strconv.Itoa(version),
+	}
+
+	var configResponse setConfigResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RollbackPipelineConfig,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+		Header: http.Header{
+			atc.ConfigVersionHeader: {configVersion},
+		},
+	}, &internal.Response{
+		Result: &configResponse,
+	})
+
+	switch err := err.(type) {
+	case nil:
+		return configResponse.Warnings, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	case internal.UnexpectedResponseError:
+		if err.StatusCode == http.StatusBadRequest {
+			var validationErr atc.SaveConfigResponse
+			jsonErr := json.Unmarshal([]byte(err.Body), &validationErr)
+			if jsonErr != nil {
+				return nil, false, jsonErr
+			}
+
+			return nil, false, InvalidConfigError{
+				Errors: validationErr.Errors,
+			}
+		}
+
+		return nil, false, err
+	default:
+		return nil, false, err
+	}
+}
+
 type ConfigWarning struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
 }
 
 type setConfigResponse struct {
-	Errors   []string        `json:"errors"`
-	Warnings []ConfigWarning `json:"warnings"`
+	Errors   []string          `json:"errors"`
+	Warnings []ConfigWarning   `json:"warnings"`
+	DryRun   *atc.ConfigImpact `json:"dry_run"`
 }
 
-func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
-	params := rata.Params{
-		"pipeline_name": pipelineName,
-		"team_name":     team.name,
-	}
-
-	queryParams := url.Values{}
+func (team *team) CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
+	queryParams := pipelineRef.QueryParams()
 	if checkCredentials {
 		queryParams.Add(atc.SaveConfigCheckCreds, "")
 	}
 
+	created, configResponse, err := team.saveConfig(pipelineRef, configVersion, passedConfig, queryParams)
+	if err != nil {
+		return false, false, []ConfigWarning{}, err
+	}
+
+	return created, !created, configResponse.Warnings, nil
+}
+
+func (team *team) DryRunPipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte) (atc.ConfigImpact, []ConfigWarning, error) {
+	queryParams := pipelineRef.QueryParams()
+	queryParams.Add(atc.SaveConfigDryRun, "")
+
+	_, configResponse, err := team.saveConfig(pipelineRef, configVersion, passedConfig, queryParams)
+	if err != nil {
+		return atc.ConfigImpact{}, []ConfigWarning{}, err
+	}
+
+	if configResponse.DryRun == nil {
+		return atc.ConfigImpact{}, []ConfigWarning{}, errors.New("server did not return a dry run report")
+	}
+
+	return *configResponse.DryRun, configResponse.Warnings, nil
+}
+
+func (team *team) saveConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, queryParams url.Values) (bool, setConfigResponse, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
 	response := internal.Response{}
 
 	err := team.connection.Send(internal.Request{
@@ -88,34 +212,34 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 				var validationErr atc.SaveConfigResponse
 				err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
 				if err != nil {
-					return false, false, []ConfigWarning{}, err
+					return false, setConfigResponse{}, err
 				}
 
-				return false, false, []ConfigWarning{}, InvalidConfigError{
+				return false, setConfigResponse{}, InvalidConfigError{
 					Errors: validationErr.Errors,
 				}
 			}
 		}
 
-		return false, false, []ConfigWarning{}, err
+		return false, setConfigResponse{}, err
 	}
 
 	configResponse := setConfigResponse{}
 	readCloser, ok := response.Result.(io.ReadCloser)
 	if !ok {
-		return false, false, []ConfigWarning{}, errors.New("Failed to assert type of response result")
+		return false, setConfigResponse{}, errors.New("Failed to assert type of response result")
 	}
 	defer readCloser.Close()
 
 	contents, err := ioutil.ReadAll(readCloser)
 	if err != nil {
-		return false, false, []ConfigWarning{}, err
+		return false, setConfigResponse{}, err
 	}
 
 	err = json.Unmarshal(contents, &configResponse)
 	if err != nil {
-		return false, false, []ConfigWarning{}, err
+		return false, setConfigResponse{}, err
 	}
 
-	return response.Created, !response.Created, configResponse.Warnings, nil
+	return response.Created, configResponse, nil
 }
@@ -84,7 +84,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the given config and version for that pipeline", func() {
-				pipelineConfig, version, found, err := team.PipelineConfig("mypipeline")
+				pipelineConfig, version, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pipelineConfig).To(Equal(expectedConfig))
 				Expect(version).To(Equal(expectedVersion))
@@ -103,7 +103,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.PipelineConfig("mypipeline")
+				_, _, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -120,7 +120,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the error", func() {
-				_, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -136,7 +136,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns an error", func() {
-				_, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -195,7 +195,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns true for created and false for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeTrue())
 				Expect(updated).To(BeFalse())
@@ -217,7 +217,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -230,7 +230,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				It("submits with check_creds query param set", func() {
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(0))
 
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
@@ -249,7 +249,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false for created and true for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeFalse())
 				Expect(updated).To(BeTrue())
@@ -271,7 +271,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -284,7 +284,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				It("submits with check_creds query param set", func() {
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(0))
 
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
@@ -300,7 +300,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns config validation error", func() {
-				_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("invalid pipeline config:\n"))
 				Expect(err.Error()).To(ContainSubstring("fake-error1\nfake-error2"))
@@ -312,10 +312,229 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
 		})
 	})
+
+	Describe("DryRunPipelineConfig", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config"
+
+		Context("when the config is valid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, "dry_run="),
+						ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.SaveConfigResponse{
+							Warnings: []atc.ConfigWarning{{Type: "some-type", Message: "some-warning"}},
+							DryRun: &atc.ConfigImpact{
+								NewResourceScopes: []string{"some-resource"},
+								TriggeredJobs:     []string{"some-job"},
+							},
+						}),
+					),
+				)
+			})
+
+			It("returns the dry run report and the warnings", func() {
+				impact, warnings, err := team.DryRunPipelineConfig(atc.PipelineRef{Name: "mypipeline"}, "42", []byte("jobs: []"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(impact).To(Equal(atc.ConfigImpact{
+					NewResourceScopes: []string{"some-resource"},
+					TriggeredJobs:     []string{"some-job"},
+				}))
+				Expect(warnings).To(Equal([]concourse.ConfigWarning{{Type: "some-type", Message: "some-warning"}}))
+			})
+		})
+
+		Context("when the config is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, "dry_run="),
+						ghttp.RespondWith(http.StatusBadRequest, `{"errors":["fake-error"]}`),
+					),
+				)
+			})
+
+			It("returns a config validation error", func() {
+				_, _, err := team.DryRunPipelineConfig(atc.PipelineRef{Name: "mypipeline"}, "42", []byte("jobs: []"))
+				Expect(err).To(Equal(concourse.InvalidConfigError{Errors: []string{"fake-error"}}))
+			})
+		})
+	})
+
+	Describe("PipelineConfigVersions", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions"
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.PipelineConfigVersion{
+							{Version: 2, CreatedBy: "some-user", CreatedAt: 2},
+							{Version: 1, CreatedAt: 1},
+						}),
+					),
+				)
+			})
+
+			It("returns the config versions", func() {
+				versions, found, err := team.PipelineConfigVersions(atc.PipelineRef{Name: "mypipeline"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal([]atc.PipelineConfigVersion{
+					{Version: 2, CreatedBy: "some-user", CreatedAt: 2},
+					{Version: 1, CreatedAt: 1},
+				}))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.PipelineConfigVersions(atc.PipelineRef{Name: "mypipeline"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the ATC returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, _, err := team.PipelineConfigVersions(atc.PipelineRef{Name: "mypipeline"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("PipelineConfigVersion", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions/3"
+
+		Context("when the version exists", func() {
+			var expectedConfig atc.Config
+
+			BeforeEach(func() {
+				expectedConfig = atc.Config{
+					Jobs: atc.JobConfigs{{Name: "some-job"}},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{
+							Version:   3,
+							CreatedBy: "some-user",
+							CreatedAt: 3,
+							Config:    &expectedConfig,
+						}),
+					),
+				)
+			})
+
+			It("returns the config version", func() {
+				version, found, err := team.PipelineConfigVersion(atc.PipelineRef{Name: "mypipeline"}, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(version.Version).To(Equal(3))
+				Expect(version.CreatedBy).To(Equal("some-user"))
+				Expect(version.Config).To(Equal(&expectedConfig))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.PipelineConfigVersion(atc.PipelineRef{Name: "mypipeline"}, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RollbackPipelineConfig", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions/3/rollback"
+
+		Context("when the version exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "7"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.SaveConfigResponse{
+							Warnings: []atc.ConfigWarning{{Type: "some-type", Message: "some-message"}},
+						}),
+					),
+				)
+			})
+
+			It("rolls back and returns the warnings", func() {
+				warnings, found, err := team.RollbackPipelineConfig(atc.PipelineRef{Name: "mypipeline"}, 3, "7")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(warnings).To(Equal([]concourse.ConfigWarning{{Type: "some-type", Message: "some-message"}}))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.RollbackPipelineConfig(atc.PipelineRef{Name: "mypipeline"}, 3, "7")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the stored config is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusBadRequest, atc.SaveConfigResponse{Errors: []string{"fake-error"}}),
+					),
+				)
+			})
+
+			It("returns the validation errors", func() {
+				_, _, err := team.RollbackPipelineConfig(atc.PipelineRef{Name: "mypipeline"}, 3, "7")
+				Expect(err).To(Equal(concourse.InvalidConfigError{Errors: []string{"fake-error"}}))
+			})
+		})
+	})
 })
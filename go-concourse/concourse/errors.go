@@ -1,6 +1,7 @@
 package concourse
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -49,3 +50,7 @@ type InvalidConfigError struct {
 func (c InvalidConfigError) Error() string {
 	return fmt.Sprintf("invalid pipeline config:\n%s", strings.Join(c.Errors, "\n"))
 }
+
+// ErrPipelineExists is returned when importing a pipeline which already
+// exists.
+var ErrPipelineExists = errors.New("pipeline already exists")
@@ -25,3 +25,17 @@ func (client *client) BuildEvents(buildID string) (Events, error) {
 
 	return eventstream.NewSSEEventStream(sseEvents), nil
 }
+
+func (client *client) CheckEvents(checkID string) (Events, error) {
+	sseEvents, err := client.connection.ConnectToEventStream(internal.Request{
+		RequestName: atc.CheckEvents,
+		Params: rata.Params{
+			"check_id": checkID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventstream.NewSSEEventStream(sseEvents), nil
+}
@@ -1,17 +1,18 @@
 package concourse
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
-	"net/url"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) ListJobs(pipelineName string) ([]atc.Job, error) {
+func (team *team) ListJobs(pipelineRef atc.PipelineRef) ([]atc.Job, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -19,6 +20,7 @@ func (team *team) ListJobs(pipelineName string) ([]atc.Job, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListJobs,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &jobs,
 	})
@@ -26,9 +28,9 @@ func (team *team) ListJobs(pipelineName string) ([]atc.Job, error) {
 	return jobs, err
 }
 
-func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
+func (team *team) Job(pipelineRef atc.PipelineRef, jobName string) (atc.Job, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -37,6 +39,7 @@ func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetJob,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &job,
 	})
@@ -50,9 +53,9 @@ func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
 	}
 }
 
-func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error) {
+func (team *team) JobBuilds(pipelineRef atc.PipelineRef, jobName string, page Page) ([]atc.Build, Pagination, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -63,7 +66,7 @@ func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]a
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListJobBuilds,
 		Params:      params,
-		Query:       page.QueryParams(),
+		Query:       mergeQueryParams(page.QueryParams(), pipelineRef.QueryParams()),
 	}, &internal.Response{
 		Result:  &builds,
 		Headers: &headers,
@@ -83,16 +86,24 @@ func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]a
 	}
 }
 
-func (team *team) PauseJob(pipelineName string, jobName string) (bool, error) {
+func (team *team) PauseJob(pipelineRef atc.PipelineRef, jobName string, comment string) (bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
 
-	err := team.connection.Send(internal.Request{
+	jsonBytes, err := json.Marshal(atc.PauseRequest{Comment: comment})
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
 		RequestName: atc.PauseJob,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
 	}, &internal.Response{})
 
 	switch err.(type) {
@@ -105,9 +116,9 @@ func (team *team) PauseJob(pipelineName string, jobName string) (bool, error) {
 	}
 }
 
-func (team *team) UnpauseJob(pipelineName string, jobName string) (bool, error) {
+func (team *team) UnpauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -115,6 +126,7 @@ func (team *team) UnpauseJob(pipelineName string, jobName string) (bool, error)
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.UnpauseJob,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{})
 
 	switch err.(type) {
@@ -127,15 +139,15 @@ func (team *team) UnpauseJob(pipelineName string, jobName string) (bool, error)
 	}
 }
 
-func (team *team) ClearTaskCache(pipelineName string, jobName string, stepName string, cachePath string) (int64, error) {
+func (team *team) ClearTaskCache(pipelineRef atc.PipelineRef, jobName string, stepName string, cachePath string) (int64, error) {
 	params := rata.Params{
 		"team_name":     team.name,
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"step_name":     stepName,
 	}
 
-	queryParams := url.Values{}
+	queryParams := pipelineRef.QueryParams()
 	if len(cachePath) > 0 {
 		queryParams.Add(atc.ClearTaskCacheQueryPath, cachePath)
 	}
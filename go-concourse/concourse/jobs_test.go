@@ -39,7 +39,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 
 		It("returns jobs that belong to the pipeline", func() {
-			pipelines, err := team.ListJobs("mypipeline")
+			pipelines, err := team.ListJobs(atc.PipelineRef{Name: "mypipeline"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pipelines).To(Equal(expectedJobs))
 		})
@@ -101,7 +101,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns the given job for that pipeline", func() {
-				job, found, err := team.Job("mypipeline", "myjob")
+				job, found, err := team.Job(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(job).To(Equal(expectedJob))
 				Expect(found).To(BeTrue())
@@ -121,7 +121,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, found, err := team.Job("mypipeline", "myjob")
+				_, found, err := team.Job(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -159,7 +159,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -173,7 +173,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds since that id", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -185,7 +185,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24, Limit: 5})
+					builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24, Limit: 5})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -200,7 +200,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds until that id", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Until: 26})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -212,7 +212,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Until: 26, Limit: 15})
+					builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Until: 26, Limit: 15})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -227,7 +227,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("sends both the since and the until", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24, Until: 26})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24, Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -247,7 +247,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and an error", func() {
-				_, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).To(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -266,7 +266,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -291,7 +291,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("returns the pagination data from the header", func() {
-					_, pagination, _, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+					_, pagination, _, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(pagination.Previous).To(Equal(&concourse.Page{Since: 452, Limit: 123}))
@@ -313,7 +313,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns pagination data with nil pages", func() {
-				_, pagination, _, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, pagination, _, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pagination.Previous).To(BeNil())
@@ -334,6 +334,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyJSONRepresenting(atc.PauseRequest{Comment: "waiting on a fix upstream"}),
 					ghttp.RespondWith(expectedStatus, nil),
 				),
 			)
@@ -346,7 +347,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns no error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName, "waiting on a fix upstream")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(paused).To(BeTrue())
 				}).To(Change(func() int {
@@ -362,7 +363,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName, "waiting on a fix upstream")
 					Expect(err).To(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -378,7 +379,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName, "waiting on a fix upstream")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -412,7 +413,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns no error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(paused).To(BeTrue())
 				}).To(Change(func() int {
@@ -428,7 +429,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).To(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -444,7 +445,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -478,7 +479,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			Context("when no cache path is given", func() {
 				It("succeeds", func() {
 					Expect(func() {
-						numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "mystep", "")
+						numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mystep", "")
 						Expect(err).NotTo(HaveOccurred())
 						Expect(numDeleted).To(Equal(int64(1)))
 					}).To(Change(func() int {
@@ -491,7 +492,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				Context("when the cache path exists", func() {
 					It("succeeds", func() {
 						Expect(func() {
-							numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "mystep", "mycachepath")
+							numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mystep", "mycachepath")
 							Expect(err).NotTo(HaveOccurred())
 							Expect(numDeleted).To(Equal(int64(1)))
 						}).To(Change(func() int {
@@ -516,7 +517,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("returns that 0 caches were deleted", func() {
 				Expect(func() {
-					numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "my-nonexistent-step", "mycachepath")
+					numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "my-nonexistent-step", "mycachepath")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(numDeleted).To(BeZero())
 				}).To(Change(func() int {
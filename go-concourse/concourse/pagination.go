@@ -84,3 +84,13 @@ func (p Page) QueryParams() url.Values {
 
 	return queryParams
 }
+
+// mergeQueryParams returns the query params of a paginated request along with
+// the given extra params.
+func mergeQueryParams(params url.Values, extra url.Values) url.Values {
+	for key, values := range extra {
+		params[key] = values
+	}
+
+	return params
+}
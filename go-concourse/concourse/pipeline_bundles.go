@@ -0,0 +1,87 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ExportPipeline(pipelineRef atc.PipelineRef, includeBuilds bool) (atc.PipelineBundle, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	queryParams := pipelineRef.QueryParams()
+	if includeBuilds {
+		queryParams.Add(atc.ExportPipelineBuilds, "true")
+	}
+
+	var bundle atc.PipelineBundle
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ExportPipeline,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &bundle,
+	})
+
+	switch err.(type) {
+	case nil:
+		return bundle, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.PipelineBundle{}, false, nil
+	default:
+		return atc.PipelineBundle{}, false, err
+	}
+}
+
+func (team *team) ImportPipeline(pipelineRef atc.PipelineRef, bundle atc.PipelineBundle) (atc.ImportPipelineResponse, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	jsonBytes, err := json.Marshal(bundle)
+	if err != nil {
+		return atc.ImportPipelineResponse{}, err
+	}
+
+	var importResponse atc.ImportPipelineResponse
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.ImportPipeline,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &importResponse,
+	})
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		switch unexpectedResponseError.StatusCode {
+		case http.StatusConflict:
+			return atc.ImportPipelineResponse{}, ErrPipelineExists
+		case http.StatusBadRequest:
+			var validationErr atc.ImportPipelineResponse
+			err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
+			if err != nil {
+				return atc.ImportPipelineResponse{}, err
+			}
+
+			return atc.ImportPipelineResponse{}, InvalidConfigError{
+				Errors: validationErr.Errors,
+			}
+		}
+	}
+
+	if err != nil {
+		return atc.ImportPipelineResponse{}, err
+	}
+
+	return importResponse, nil
+}
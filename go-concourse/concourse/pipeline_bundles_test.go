@@ -0,0 +1,145 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Pipeline Bundles", func() {
+	bundle := atc.PipelineBundle{
+		Format: atc.PipelineBundleFormat,
+		Config: atc.Config{
+			Jobs: atc.JobConfigs{{Name: "some-job"}},
+		},
+		Resources: []atc.ResourceBundle{
+			{
+				Name: "some-resource",
+				Versions: []atc.BundledVersion{
+					{Version: atc.Version{"ref": "v1"}, Enabled: true},
+				},
+			},
+		},
+		Jobs: []atc.JobBundle{{Name: "some-job", Paused: true}},
+	}
+
+	Describe("ExportPipeline", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/export"
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, bundle),
+					),
+				)
+			})
+
+			It("returns the bundle", func() {
+				exported, found, err := team.ExportPipeline(atc.PipelineRef{Name: "mypipeline"}, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(exported).To(Equal(bundle))
+			})
+		})
+
+		Context("when builds are asked for", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "include_builds=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, bundle),
+					),
+				)
+			})
+
+			It("asks for them", func() {
+				_, _, err := team.ExportPipeline(atc.PipelineRef{Name: "mypipeline"}, true)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.ExportPipeline(atc.PipelineRef{Name: "mypipeline"}, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ImportPipeline", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/import"
+
+		Context("when the pipeline is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting(bundle),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.ImportPipelineResponse{
+							Skipped: []string{"versions of resource 'some-resource': nope"},
+						}),
+					),
+				)
+			})
+
+			It("returns what was skipped", func() {
+				response, err := team.ImportPipeline(atc.PipelineRef{Name: "mypipeline"}, bundle)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Skipped).To(Equal([]string{"versions of resource 'some-resource': nope"}))
+			})
+		})
+
+		Context("when the pipeline already exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusConflict, ""),
+					),
+				)
+			})
+
+			It("returns ErrPipelineExists", func() {
+				_, err := team.ImportPipeline(atc.PipelineRef{Name: "mypipeline"}, bundle)
+				Expect(err).To(Equal(concourse.ErrPipelineExists))
+			})
+		})
+
+		Context("when the bundle is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusBadRequest, atc.ImportPipelineResponse{
+							Errors: []string{"unsupported bundle format 2 (expected 1)"},
+						}),
+					),
+				)
+			})
+
+			It("returns the errors", func() {
+				_, err := team.ImportPipeline(atc.PipelineRef{Name: "mypipeline"}, bundle)
+				Expect(err).To(Equal(concourse.InvalidConfigError{
+					Errors: []string{"unsupported bundle format 2 (expected 1)"},
+				}))
+			})
+		})
+	})
+})
@@ -19,13 +19,14 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				atcServer.AppendHandlers(
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting(atc.PauseRequest{Comment: "upgrading the database"}),
 						ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
 					),
 				)
 			})
 
 			It("return true and no error", func() {
-				found, err := team.PausePipeline("mypipeline")
+				found, err := team.PausePipeline(atc.PipelineRef{Name: "mypipeline"}, "upgrading the database")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -42,7 +43,66 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.PausePipeline("mypipeline")
+				found, err := team.PausePipeline(atc.PipelineRef{Name: "mypipeline"}, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when pausing an instance of the pipeline", func() {
+			BeforeEach(func() {
+				expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/pause"
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, `instance_vars=%7B%22branch%22%3A%22release-1.2%22%7D`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("identifies the instance by its instance vars", func() {
+				found, err := team.PausePipeline(atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "release-1.2"},
+				}, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("ArchivePipeline", func() {
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/archive"
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("return true and no error", func() {
+				found, err := team.ArchivePipeline(atc.PipelineRef{Name: "mypipeline"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the pipeline doesn't exist", func() {
+			BeforeEach(func() {
+				expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/archive"
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				found, err := team.ArchivePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -62,7 +122,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.UnpausePipeline("mypipeline")
+				found, err := team.UnpausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -79,7 +139,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.UnpausePipeline("mypipeline")
+				found, err := team.UnpausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -99,7 +159,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.ExposePipeline("mypipeline")
+				found, err := team.ExposePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -116,7 +176,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.ExposePipeline("mypipeline")
+				found, err := team.ExposePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -136,7 +196,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.HidePipeline("mypipeline")
+				found, err := team.HidePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -153,7 +213,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.HidePipeline("mypipeline")
+				found, err := team.HidePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -241,7 +301,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns the requested pipeline", func() {
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline).To(Equal(expectedPipeline))
@@ -259,7 +319,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false", func() {
-				_, found, err := team.Pipeline(pipelineName)
+				_, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -373,7 +433,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 
 			It("deletes the pipeline when called", func() {
 				Expect(func() {
-					found, err := team.DeletePipeline("mypipeline")
+					found, err := team.DeletePipeline(atc.PipelineRef{Name: "mypipeline"})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 				}).To(Change(func() int {
@@ -393,7 +453,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				found, err := team.DeletePipeline("mypipeline")
+				found, err := team.DeletePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -415,7 +475,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("renames the pipeline when called", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(renamed).To(BeTrue())
 			})
@@ -429,7 +489,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(renamed).To(BeFalse())
 			})
@@ -443,7 +503,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns an error", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).To(MatchError(ContainSubstring("418 I'm a teapot")))
 				Expect(renamed).To(BeFalse())
 			})
@@ -491,7 +551,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns the build and no error", func() {
-				build, err := team.CreatePipelineBuild("mypipeline", plan)
+				build, err := team.CreatePipelineBuild(atc.PipelineRef{Name: "mypipeline"}, plan)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(build).To(Equal(expectedBuild))
 			})
@@ -532,7 +592,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -545,7 +605,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds since that id", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -557,7 +617,23 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24, Limit: 5})
+					builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24, Limit: 5})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(builds).To(Equal(expectedBuilds))
+				})
+			})
+
+			Context("and the pipeline is an instance", func() {
+				BeforeEach(func() {
+					expectedQuery = fmt.Sprint("since=24&instance_vars=%7B%22branch%22%3A%22release-1.2%22%7D")
+				})
+
+				It("identifies the instance alongside the page", func() {
+					builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{
+						Name:         "mypipeline",
+						InstanceVars: atc.InstanceVars{"branch": "release-1.2"},
+					}, concourse.Page{Since: 24})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -571,7 +647,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds until that id", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Until: 26})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -583,7 +659,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Until: 26, Limit: 15})
+					builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Until: 26, Limit: 15})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -597,7 +673,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("sends both since and until", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24, Until: 26})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24, Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -615,7 +691,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and an error", func() {
-				_, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).To(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -632,7 +708,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -655,7 +731,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("returns the pagination data from the header", func() {
-					_, pagination, _, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+					_, pagination, _, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(pagination.Previous).To(Equal(&concourse.Page{Since: 452, Limit: 123}))
@@ -675,7 +751,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns pagination data with nil pages", func() {
-				_, pagination, _, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, pagination, _, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pagination.Previous).To(BeNil())
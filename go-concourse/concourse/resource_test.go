@@ -36,7 +36,7 @@ var _ = Describe("ATC Handler Resource", func() {
 		})
 
 		It("returns resources that belong to the pipeline", func() {
-			pipelines, err := team.ListResources("some-pipeline")
+			pipelines, err := team.ListResources(atc.PipelineRef{Name: "some-pipeline"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pipelines).To(Equal(expectedResources))
 		})
@@ -56,7 +56,7 @@ var _ = Describe("ATC Handler Resource", func() {
 		})
 
 		JustBeforeEach(func() {
-			resource, found, clientErr = team.Resource("some-pipeline", "myresource")
+			resource, found, clientErr = team.Resource(atc.PipelineRef{Name: "some-pipeline"}, "myresource")
 		})
 
 		Context("when the server returns the resource", func() {
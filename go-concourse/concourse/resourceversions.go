@@ -9,9 +9,9 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) ResourceVersions(pipelineName string, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, bool, error) {
+func (team *team) ResourceVersions(pipelineRef atc.PipelineRef, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"resource_name": resourceName,
 		"team_name":     team.name,
 	}
@@ -22,7 +22,7 @@ func (team *team) ResourceVersions(pipelineName string, resourceName string, pag
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListResourceVersions,
 		Params:      params,
-		Query:       page.QueryParams(),
+		Query:       mergeQueryParams(page.QueryParams(), pipelineRef.QueryParams()),
 	}, &internal.Response{
 		Result:  &resourceVersions,
 		Headers: &headers,
@@ -42,17 +42,17 @@ func (team *team) ResourceVersions(pipelineName string, resourceName string, pag
 	}
 }
 
-func (team *team) DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error) {
-	return team.sendResourceVersion(pipelineName, resourceName, resourceVersionID, atc.DisableResourceVersion)
+func (team *team) DisableResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error) {
+	return team.sendResourceVersion(pipelineRef, resourceName, resourceVersionID, atc.DisableResourceVersion)
 }
 
-func (team *team) EnableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error) {
-	return team.sendResourceVersion(pipelineName, resourceName, resourceVersionID, atc.EnableResourceVersion)
+func (team *team) EnableResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error) {
+	return team.sendResourceVersion(pipelineRef, resourceName, resourceVersionID, atc.EnableResourceVersion)
 }
 
-func (team *team) sendResourceVersion(pipelineName string, resourceName string, resourceVersionID int, resourceVersionReq string) (bool, error) {
+func (team *team) sendResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, resourceVersionReq string) (bool, error) {
 	params := rata.Params{
-		"pipeline_name":              pipelineName,
+		"pipeline_name":              pipelineRef.Name,
 		"resource_name":              resourceName,
 		"resource_config_version_id": strconv.Itoa(resourceVersionID),
 		"team_name":                  team.name,
@@ -61,6 +61,7 @@ func (team *team) sendResourceVersion(pipelineName string, resourceName string,
 	err := team.connection.Send(internal.Request{
 		RequestName: resourceVersionReq,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, nil)
 
 	switch err.(type) {
@@ -0,0 +1,213 @@
+package vars
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// LocalVarSource is the source of vars which are local to a build, e.g. those
+// set by a load_var step, and referenced as ((.:some-var)).
+const LocalVarSource = "."
+
+// RedactedValue replaces the values of redacted vars in a build's output.
+const RedactedValue = "((redacted))"
+
+// BuildVariables holds the vars which are local to a single build, along with
+// which of their values must be redacted from the build's output.
+//
+// Nested plans, e.g. the steps of an in_parallel, run in a local scope created
+// by NewLocalScope. Vars set within a local scope are not visible to its
+// parent, but their values are redacted by every scope of the build.
+//
+// Any other vars are fetched from the build's pipeline: those from a named
+// source, e.g. ((vault:some-var)), come from its var sources and are always
+// redacted, while those without a source come from its static vars. Steps
+// consult credential managers before these, so static vars have the lowest
+// precedence.
+type BuildVariables struct {
+	parent       *BuildVariables
+	pipelineVars Variables
+
+	lock sync.RWMutex
+	vars map[string]interface{}
+
+	redactions *redactions
+}
+
+type redactions struct {
+	lock   sync.RWMutex
+	values []interface{}
+}
+
+var _ Variables = &BuildVariables{}
+
+// NewBuildVariables returns the root scope of a build's vars. pipelineVars
+// may be nil if the build does not belong to a pipeline.
+func NewBuildVariables(pipelineVars Variables) *BuildVariables {
+	return &BuildVariables{
+		pipelineVars: pipelineVars,
+		vars:         map[string]interface{}{},
+		redactions:   &redactions{},
+	}
+}
+
+// NewLocalScope returns a child scope which can see all of this scope's vars.
+// Vars added to the child shadow any of the same name in this scope.
+func (v *BuildVariables) NewLocalScope() *BuildVariables {
+	return &BuildVariables{
+		parent:       v,
+		pipelineVars: v.pipelineVars,
+		vars:         map[string]interface{}{},
+		redactions:   v.redactions,
+	}
+}
+
+// Get returns the value of a local var, consulting parent scopes if it is not
+// set in this one. Any other var is fetched from the build's pipeline.
+func (v *BuildVariables) Get(varDef VariableDefinition) (interface{}, bool, error) {
+	if varDef.Source != LocalVarSource {
+		return v.getFromPipeline(varDef)
+	}
+
+	for scope := v; scope != nil; scope = scope.parent {
+		val, found := scope.get(varDef.Name)
+		if found {
+			return val, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (v *BuildVariables) getFromPipeline(varDef VariableDefinition) (interface{}, bool, error) {
+	if v.pipelineVars == nil {
+		return nil, false, nil
+	}
+
+	val, found, err := v.pipelineVars.Get(varDef)
+	if err != nil || !found {
+		return nil, found, err
+	}
+
+	// static vars are part of the pipeline's config, so only vars fetched
+	// from var sources are secret
+	if varDef.Source != "" {
+		v.addRedaction(val)
+	}
+
+	return val, true, nil
+}
+
+func (v *BuildVariables) get(name string) (interface{}, bool) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	val, found := v.vars[name]
+	return val, found
+}
+
+func (v *BuildVariables) List() ([]VariableDefinition, error) {
+	seen := map[string]bool{}
+
+	var defs []VariableDefinition
+	for scope := v; scope != nil; scope = scope.parent {
+		scope.lock.RLock()
+		for name := range scope.vars {
+			if seen[name] {
+				continue
+			}
+
+			seen[name] = true
+
+			defs = append(defs, VariableDefinition{
+				Source: LocalVarSource,
+				Name:   name,
+			})
+		}
+		scope.lock.RUnlock()
+	}
+
+	return defs, nil
+}
+
+// AddLocalVar sets a local var in this scope, replacing any previous value.
+// If redact is true, the value will be removed from any text passed to
+// Redact.
+func (v *BuildVariables) AddLocalVar(name string, val interface{}, redact bool) {
+	v.lock.Lock()
+	v.vars[name] = val
+	v.lock.Unlock()
+
+	if redact {
+		v.addRedaction(val)
+	}
+}
+
+func (v *BuildVariables) addRedaction(val interface{}) {
+	v.redactions.add(val)
+}
+
+// Redact replaces every occurrence of a redacted var's value in the given
+// text with RedactedValue. Values which are maps or lists have each of their
+// scalar values redacted.
+//
+// Values are redacted regardless of which scope of the build they were added
+// to, and remain redacted even once they have been replaced.
+func (v *BuildVariables) Redact(text string) string {
+	return v.redactions.redact(text)
+}
+
+func (r *redactions) add(val interface{}) {
+	r.lock.Lock()
+	r.values = append(r.values, val)
+	r.lock.Unlock()
+}
+
+func (r *redactions) redact(text string) string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	var secrets []string
+	for _, val := range r.values {
+		secrets = appendSecrets(secrets, val)
+	}
+
+	// redact the longest values first so that values which contain other
+	// values are redacted entirely
+	sort.Slice(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+
+	for _, secret := range secrets {
+		text = strings.Replace(text, secret, RedactedValue, -1)
+	}
+
+	return text
+}
+
+func appendSecrets(secrets []string, val interface{}) []string {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		for _, sub := range v {
+			secrets = appendSecrets(secrets, sub)
+		}
+	case map[interface{}]interface{}:
+		for _, sub := range v {
+			secrets = appendSecrets(secrets, sub)
+		}
+	case []interface{}:
+		for _, sub := range v {
+			secrets = appendSecrets(secrets, sub)
+		}
+	case nil, bool:
+	default:
+		secret := fmt.Sprint(v)
+		if secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+
+	return secrets
+}
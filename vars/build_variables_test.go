@@ -0,0 +1,179 @@
+package vars_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/concourse/concourse/vars"
+)
+
+var _ = Describe("BuildVariables", func() {
+	var buildVars *BuildVariables
+
+	BeforeEach(func() {
+		buildVars = NewBuildVariables(nil)
+	})
+
+	Describe("Get", func() {
+		BeforeEach(func() {
+			buildVars.AddLocalVar("a", "foo", false)
+		})
+
+		It("returns local vars", func() {
+			val, found, err := buildVars.Get(VariableDefinition{Source: ".", Name: "a"})
+			Expect(val).To(Equal("foo"))
+			Expect(found).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns not found if the var has not been set", func() {
+			val, found, err := buildVars.Get(VariableDefinition{Source: ".", Name: "b"})
+			Expect(val).To(BeNil())
+			Expect(found).To(BeFalse())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns not found for vars without the local source", func() {
+			_, found, err := buildVars.Get(VariableDefinition{Name: "a"})
+			Expect(found).To(BeFalse())
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Get with pipeline vars", func() {
+		BeforeEach(func() {
+			buildVars = NewBuildVariables(pipelineVariables{
+				"vault": {"token": "s3cr3t"},
+				"":      {"branch": "master"},
+			})
+		})
+
+		It("returns vars from the named var source", func() {
+			val, found, err := buildVars.Get(VariableDefinition{Source: "vault", Name: "token"})
+			Expect(val).To(Equal("s3cr3t"))
+			Expect(found).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns them from local scopes too", func() {
+			val, found, err := buildVars.NewLocalScope().Get(VariableDefinition{Source: "vault", Name: "token"})
+			Expect(val).To(Equal("s3cr3t"))
+			Expect(found).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("redacts their values", func() {
+			_, _, err := buildVars.Get(VariableDefinition{Source: "vault", Name: "token"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(buildVars.Redact("s3cr3t")).To(Equal("((redacted))"))
+		})
+
+		It("returns static vars without redacting them", func() {
+			val, found, err := buildVars.Get(VariableDefinition{Name: "branch"})
+			Expect(val).To(Equal("master"))
+			Expect(found).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(buildVars.Redact("master")).To(Equal("master"))
+		})
+
+		It("returns not found for vars from an unknown source", func() {
+			_, found, err := buildVars.Get(VariableDefinition{Source: "bogus", Name: "token"})
+			Expect(found).To(BeFalse())
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("List", func() {
+		It("returns the local vars", func() {
+			buildVars.AddLocalVar("a", "foo", false)
+
+			defs, err := buildVars.List()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(defs).To(ConsistOf(VariableDefinition{Source: ".", Name: "a"}))
+		})
+	})
+
+	Describe("NewLocalScope", func() {
+		var localScope *BuildVariables
+
+		BeforeEach(func() {
+			buildVars.AddLocalVar("a", "foo", false)
+			buildVars.AddLocalVar("b", "bar", false)
+
+			localScope = buildVars.NewLocalScope()
+			localScope.AddLocalVar("b", "shadowed", false)
+			localScope.AddLocalVar("c", "baz", true)
+		})
+
+		It("can see the vars of its parent", func() {
+			val, found, err := localScope.Get(VariableDefinition{Source: ".", Name: "a"})
+			Expect(val).To(Equal("foo"))
+			Expect(found).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("shadows the vars of its parent", func() {
+			val, found, err := localScope.Get(VariableDefinition{Source: ".", Name: "b"})
+			Expect(val).To(Equal("shadowed"))
+			Expect(found).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not leak vars to its parent", func() {
+			val, found, err := buildVars.Get(VariableDefinition{Source: ".", Name: "b"})
+			Expect(val).To(Equal("bar"))
+			Expect(found).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err = buildVars.Get(VariableDefinition{Source: ".", Name: "c"})
+			Expect(found).To(BeFalse())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists the vars of every scope once", func() {
+			defs, err := localScope.List()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(defs).To(ConsistOf(
+				VariableDefinition{Source: ".", Name: "a"},
+				VariableDefinition{Source: ".", Name: "b"},
+				VariableDefinition{Source: ".", Name: "c"},
+			))
+		})
+
+		It("redacts its values from the parent's output", func() {
+			Expect(buildVars.Redact("baz")).To(Equal("((redacted))"))
+		})
+	})
+
+	Describe("Redact", func() {
+		BeforeEach(func() {
+			buildVars.AddLocalVar("revealed", "hello", false)
+			buildVars.AddLocalVar("secret", "s3cr3t", true)
+			buildVars.AddLocalVar("nested", map[string]interface{}{
+				"token": "some-token",
+				"list":  []interface{}{"listed", 42, true},
+			}, true)
+		})
+
+		It("redacts the values of redacted vars", func() {
+			Expect(buildVars.Redact("hello, s3cr3t and some-token")).To(Equal("hello, ((redacted)) and ((redacted))"))
+		})
+
+		It("redacts scalar values within lists", func() {
+			Expect(buildVars.Redact("listed 42 true")).To(Equal("((redacted)) ((redacted)) true"))
+		})
+	})
+})
+
+type pipelineVariables map[string]map[string]interface{}
+
+func (v pipelineVariables) Get(varDef VariableDefinition) (interface{}, bool, error) {
+	val, found := v[varDef.Source][varDef.Name]
+	return val, found, nil
+}
+
+func (v pipelineVariables) List() ([]VariableDefinition, error) {
+	return nil, nil
+}
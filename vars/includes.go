@@ -0,0 +1,217 @@
+package vars
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// IncludeSections are the lists of a pipeline config which included files
+// contribute to, in the order they are merged.
+var IncludeSections = []string{"resources", "resource_types", "jobs"}
+
+var sectionLabels = map[string]string{
+	"resources":      "resource",
+	"resource_types": "resource type",
+	"jobs":           "job",
+}
+
+// Include is an entry of a config's top-level include section. The file is
+// evaluated with its own vars, falling back to the vars of the including
+// config, and its resources, resource types and jobs are merged in.
+type Include struct {
+	Name string          `yaml:"name"`
+	File string          `yaml:"file"`
+	Vars StaticVariables `yaml:"vars,omitempty"`
+}
+
+// IncludeLoader reads an included file.
+type IncludeLoader func(path string) ([]byte, error)
+
+// Origin records which file an element of a resolved config came from.
+type Origin struct {
+	Section string
+	Name    string
+	File    string
+
+	// Include is the name of the include that brought the file in, or empty
+	// for the root config.
+	Include string
+}
+
+func (origin Origin) String() string {
+	if origin.Include == "" {
+		return origin.File
+	}
+
+	return fmt.Sprintf("%s (include '%s')", origin.File, origin.Include)
+}
+
+func (origin Origin) sameFile(other Origin) bool {
+	return origin.File == other.File && origin.Include == other.Include
+}
+
+type Origins []Origin
+
+type includeResolver struct {
+	loader        IncludeLoader
+	expectAllKeys bool
+
+	sections map[string][]interface{}
+	origins  map[string][]Origin
+	seen     map[string]Origin
+}
+
+func (resolver *includeResolver) resolve(config map[interface{}]interface{}, origin Origin, params []Variables, chain []string) error {
+	includes, err := parseIncludes(config[includeKey], origin)
+	if err != nil {
+		return err
+	}
+
+	for _, section := range IncludeSections {
+		elems, err := sectionElements(config[section], section, origin)
+		if err != nil {
+			return err
+		}
+
+		for _, elem := range elems {
+			err := resolver.add(section, elem, origin)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, include := range includes {
+		path := include.File
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(origin.File), path)
+		}
+
+		for _, parent := range chain {
+			if parent == path {
+				return fmt.Errorf("include cycle: %s -> %s", strings.Join(chain, " -> "), path)
+			}
+		}
+
+		payload, err := resolver.loader(path)
+		if err != nil {
+			return fmt.Errorf("could not read included file (%s): %s", path, err)
+		}
+
+		includeParams := append([]Variables{include.Vars}, params...)
+
+		evaluated, err := NewTemplate(payload).Evaluate(NewMultiVars(includeParams), EvaluateOpts{ExpectAllKeys: resolver.expectAllKeys})
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+
+		var included map[interface{}]interface{}
+		err = yaml.Unmarshal(evaluated, &included)
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+
+		for key := range included {
+			if key != includeKey && !isIncludeSection(key) {
+				return fmt.Errorf("%s: included files may only define %s (found '%v')", path, strings.Join(IncludeSections, ", "), key)
+			}
+		}
+
+		includeOrigin := Origin{File: path, Include: include.Name}
+
+		err = resolver.resolve(included, includeOrigin, includeParams, append(chain, path))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (resolver *includeResolver) add(section string, elem interface{}, origin Origin) error {
+	var name string
+	if fields, ok := elem.(map[interface{}]interface{}); ok {
+		name, _ = fields["name"].(string)
+	}
+
+	origin.Section = section
+	origin.Name = name
+
+	key := section + "/" + name
+	if existing, found := resolver.seen[key]; found && name != "" {
+		if !existing.sameFile(origin) {
+			return fmt.Errorf("%s '%s' from %s conflicts with the one from %s", sectionLabels[section], name, origin, existing)
+		}
+	} else {
+		resolver.seen[key] = origin
+	}
+
+	resolver.sections[section] = append(resolver.sections[section], elem)
+	resolver.origins[section] = append(resolver.origins[section], origin)
+
+	return nil
+}
+
+const includeKey = "include"
+
+func isIncludeSection(key interface{}) bool {
+	for _, section := range IncludeSections {
+		if key == section {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseIncludes(value interface{}, origin Origin) ([]Include, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	payload, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var includes []Include
+	err = yaml.UnmarshalStrict(payload, &includes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: malformed include section: %s", origin.File, err)
+	}
+
+	names := map[string]bool{}
+	for i, include := range includes {
+		if include.Name == "" {
+			return nil, fmt.Errorf("%s: include[%d] has no name", origin.File, i)
+		}
+
+		if include.File == "" {
+			return nil, fmt.Errorf("%s: include '%s' has no file", origin.File, include.Name)
+		}
+
+		if names[include.Name] {
+			return nil, fmt.Errorf("%s: include '%s' is defined more than once", origin.File, include.Name)
+		}
+
+		names[include.Name] = true
+	}
+
+	return includes, nil
+}
+
+func sectionElements(value interface{}, section string, origin Origin) ([]interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	elems, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must be a list", origin.File, section)
+	}
+
+	return elems, nil
+}
@@ -0,0 +1,199 @@
+package vars_test
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Includes", func() {
+	var (
+		files  map[string]string
+		params []vars.Variables
+
+		resolved []byte
+		origins  vars.Origins
+		err      error
+	)
+
+	loader := func(path string) ([]byte, error) {
+		payload, found := files[path]
+		if !found {
+			return nil, errors.New("no such file")
+		}
+
+		return []byte(payload), nil
+	}
+
+	BeforeEach(func() {
+		files = map[string]string{
+			"ci/common.yml": `
+resources:
+- name: repo
+  type: git
+  source: {uri: ((uri))}
+jobs:
+- name: unit
+  plan:
+  - get: repo
+  - task: ((task))
+`,
+		}
+
+		params = []vars.Variables{vars.StaticVariables{"task": "test"}}
+	})
+
+	JustBeforeEach(func() {
+		resolved, origins, err = vars.NewTemplateResolver([]byte(files["ci/pipeline.yml"]), params).
+			WithIncludes("ci/pipeline.yml", loader).
+			ResolveWithOrigins(false, false)
+	})
+
+	Context("when the config includes a file", func() {
+		BeforeEach(func() {
+			files["ci/pipeline.yml"] = `
+include:
+- name: common
+  file: common.yml
+  vars: {uri: https://example.com/repo.git}
+jobs:
+- name: deploy
+  plan:
+  - get: repo
+    passed: [unit]
+`
+		})
+
+		It("merges the included elements, evaluated with the include's vars", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved).To(MatchYAML(`
+resources:
+- name: repo
+  type: git
+  source: {uri: https://example.com/repo.git}
+jobs:
+- name: deploy
+  plan:
+  - get: repo
+    passed: [unit]
+- name: unit
+  plan:
+  - get: repo
+  - task: test
+`))
+		})
+
+		It("returns where each element came from", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(origins).To(Equal(vars.Origins{
+				{Section: "resources", Name: "repo", File: "ci/common.yml", Include: "common"},
+				{Section: "jobs", Name: "deploy", File: "ci/pipeline.yml"},
+				{Section: "jobs", Name: "unit", File: "ci/common.yml", Include: "common"},
+			}))
+		})
+	})
+
+	Context("when the same file is included twice with different vars", func() {
+		BeforeEach(func() {
+			files["ci/pipeline.yml"] = `
+include:
+- name: one
+  file: common.yml
+- name: two
+  file: common.yml
+`
+		})
+
+		It("reports the conflict", func() {
+			Expect(err).To(MatchError("resource 'repo' from ci/common.yml (include 'two') conflicts with the one from ci/common.yml (include 'one')"))
+		})
+	})
+
+	Context("when an included element conflicts with the root config", func() {
+		BeforeEach(func() {
+			files["ci/pipeline.yml"] = `
+include:
+- name: common
+  file: common.yml
+jobs:
+- name: unit
+`
+		})
+
+		It("reports the conflict", func() {
+			Expect(err).To(MatchError("job 'unit' from ci/common.yml (include 'common') conflicts with the one from ci/pipeline.yml"))
+		})
+	})
+
+	Context("when an included file defines other sections", func() {
+		BeforeEach(func() {
+			files["ci/groups.yml"] = `
+groups:
+- name: all
+`
+			files["ci/pipeline.yml"] = `
+include:
+- name: groups
+  file: groups.yml
+`
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError("ci/groups.yml: included files may only define resources, resource_types, jobs (found 'groups')"))
+		})
+	})
+
+	Context("when includes form a cycle", func() {
+		BeforeEach(func() {
+			files["ci/a.yml"] = `
+include:
+- name: b
+  file: b.yml
+`
+			files["ci/b.yml"] = `
+include:
+- name: a
+  file: a.yml
+`
+			files["ci/pipeline.yml"] = `
+include:
+- name: a
+  file: a.yml
+`
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError("include cycle: ci/pipeline.yml -> ci/a.yml -> ci/b.yml -> ci/a.yml"))
+		})
+	})
+
+	Context("when an include has no file", func() {
+		BeforeEach(func() {
+			files["ci/pipeline.yml"] = `
+include:
+- name: common
+`
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError("ci/pipeline.yml: include 'common' has no file"))
+		})
+	})
+
+	Context("when the config has no include section", func() {
+		BeforeEach(func() {
+			files["ci/pipeline.yml"] = `
+jobs:
+- name: unit
+`
+		})
+
+		It("returns no origins", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved).To(MatchYAML(`jobs: [{name: unit}]`))
+			Expect(origins).To(BeNil())
+		})
+	})
+})
@@ -9,6 +9,10 @@ type StaticVariables map[string]interface{}
 var _ Variables = StaticVariables{}
 
 func (v StaticVariables) Get(varDef VariableDefinition) (interface{}, bool, error) {
+	if varDef.Source != "" {
+		return nil, false, nil
+	}
+
 	val, found := v.processed()[varDef.Name]
 	return val, found, nil
 }
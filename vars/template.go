@@ -1,6 +1,7 @@
 package vars
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
@@ -58,10 +59,15 @@ func (t Template) interpolateRoot(obj interface{}, tracker varsTracker) (interfa
 type interpolator struct{}
 
 var (
-	interpolationRegex         = regexp.MustCompile(`\(\((!?[-/\.\w\pL]+)\)\)`)
+	interpolationRegex         = regexp.MustCompile(`\(\((!?[-/\.:\w\pL]+)\)\)`)
 	interpolationAnchoredRegex = regexp.MustCompile("\\A" + interpolationRegex.String() + "\\z")
 )
 
+// IsReference returns true if the given value is a single ((var)) reference.
+func IsReference(value string) bool {
+	return interpolationAnchoredRegex.MatchString(value)
+}
+
 func (i interpolator) Interpolate(node interface{}, varsLookup varsLookup) (interface{}, error) {
 	switch typedNode := node.(type) {
 	case map[interface{}]interface{}:
@@ -103,7 +109,7 @@ func (i interpolator) Interpolate(node interface{}, varsLookup varsLookup) (inte
 				}
 
 				switch foundVal.(type) {
-				case string, int, int16, int32, int64, uint, uint16, uint32, uint64:
+				case string, json.Number, int, int16, int32, int64, uint, uint16, uint32, uint64:
 					foundValStr := fmt.Sprintf("%v", foundVal)
 					typedNode = strings.Replace(typedNode, fmt.Sprintf("((%s))", name), foundValStr, -1)
 					typedNode = strings.Replace(typedNode, fmt.Sprintf("((!%s))", name), foundValStr, -1)
@@ -139,7 +145,14 @@ type varsLookup struct {
 var ErrEmptyVar = errors.New("empty var")
 
 func (l varsLookup) Get(name string) (interface{}, bool, error) {
-	splitName := strings.Split(name, ".")
+	var source string
+
+	path := name
+	if i := strings.Index(path, ":"); i != -1 {
+		source, path = path[:i], path[i+1:]
+	}
+
+	splitName := strings.Split(path, ".")
 
 	// this should be impossible since interpolationRegex only matches non-empty
 	// vars, but better to error than to panic
@@ -147,7 +160,10 @@ func (l varsLookup) Get(name string) (interface{}, bool, error) {
 		return nil, false, ErrEmptyVar
 	}
 
-	val, found, err := l.varsTracker.Get(splitName[0])
+	val, found, err := l.varsTracker.Get(VariableDefinition{
+		Source: source,
+		Name:   splitName[0],
+	})
 	if !found || err != nil {
 		return val, found, err
 	}
@@ -206,10 +222,12 @@ func newVarsTracker(vars Variables, expectAllFound, expectAllUsed bool) varsTrac
 	}
 }
 
-func (t varsTracker) Get(name string) (interface{}, bool, error) {
+func (t varsTracker) Get(varDef VariableDefinition) (interface{}, bool, error) {
+	name := qualifiedName(varDef)
+
 	t.visitedAll[name] = struct{}{}
 
-	val, found, err := t.vars.Get(VariableDefinition{Name: name})
+	val, found, err := t.vars.Get(varDef)
 	if !found {
 		t.missing[name] = struct{}{}
 	}
@@ -252,8 +270,9 @@ func (t varsTracker) ExtraError() error {
 	unusedNames := map[string]struct{}{}
 
 	for _, def := range allDefs {
-		if _, found := t.visitedAll[def.Name]; !found {
-			unusedNames[def.Name] = struct{}{}
+		name := qualifiedName(def)
+		if _, found := t.visitedAll[name]; !found {
+			unusedNames[name] = struct{}{}
 		}
 	}
 
@@ -264,6 +283,16 @@ func (t varsTracker) ExtraError() error {
 	return UnusedVarsError{Vars: names(unusedNames)}
 }
 
+// qualifiedName returns the name of the var as it would be referenced in a
+// template, i.e. including its source if it has one.
+func qualifiedName(varDef VariableDefinition) string {
+	if varDef.Source == "" {
+		return varDef.Name
+	}
+
+	return varDef.Source + ":" + varDef.Name
+}
+
 func names(mapWithNames map[string]struct{}) []string {
 	var names []string
 	for name, _ := range mapWithNames {
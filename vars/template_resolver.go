@@ -3,9 +3,11 @@ package vars
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"regexp"
 
 	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v2"
 )
 
 var templateOldStyleFormatRegex = regexp.MustCompile(`\{\{([-\w\p{L}]+)\}\}`)
@@ -13,6 +15,9 @@ var templateOldStyleFormatRegex = regexp.MustCompile(`\{\{([-\w\p{L}]+)\}\}`)
 type TemplateResolver struct {
 	configPayload []byte
 	params        []Variables
+
+	path   string
+	loader IncludeLoader
 }
 
 // Creates a template resolver, given a configPayload and a slice of param sources. If more than
@@ -25,22 +30,87 @@ func NewTemplateResolver(configPayload []byte, params []Variables) TemplateResol
 	}
 }
 
+// WithIncludes makes the resolver follow the config's include section. The
+// config is read from path, and included files are resolved relative to the
+// file including them.
+func (resolver TemplateResolver) WithIncludes(path string, loader IncludeLoader) TemplateResolver {
+	resolver.path = filepath.Clean(path)
+	resolver.loader = loader
+	return resolver
+}
+
 func (resolver TemplateResolver) Resolve(expectAllKeys bool, allowEmptyInOldStyleTemplates bool) ([]byte, error) {
+	configPayload, _, err := resolver.ResolveWithOrigins(expectAllKeys, allowEmptyInOldStyleTemplates)
+	return configPayload, err
+}
+
+// ResolveWithOrigins resolves the config like Resolve, and when the config
+// includes other files, also returns which file each of its resources,
+// resource types and jobs came from.
+func (resolver TemplateResolver) ResolveWithOrigins(expectAllKeys bool, allowEmptyInOldStyleTemplates bool) ([]byte, Origins, error) {
 	var err error
 
 	if PresentDeprecated(resolver.configPayload) {
 		resolver.configPayload, err = resolver.ResolveDeprecated(allowEmptyInOldStyleTemplates)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	resolver.configPayload, err = resolver.resolve(expectAllKeys)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if resolver.loader == nil {
+		return resolver.configPayload, nil, nil
+	}
+
+	return resolver.resolveIncludes(expectAllKeys)
+}
+
+func (resolver TemplateResolver) resolveIncludes(expectAllKeys bool) ([]byte, Origins, error) {
+	var config map[interface{}]interface{}
+	err := yaml.Unmarshal(resolver.configPayload, &config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, found := config[includeKey]; !found {
+		return resolver.configPayload, nil, nil
+	}
+
+	includes := &includeResolver{
+		loader:        resolver.loader,
+		expectAllKeys: expectAllKeys,
+
+		sections: map[string][]interface{}{},
+		origins:  map[string][]Origin{},
+		seen:     map[string]Origin{},
+	}
+
+	err = includes.resolve(config, Origin{File: resolver.path}, resolver.params, []string{resolver.path})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delete(config, includeKey)
+
+	var origins Origins
+	for _, section := range IncludeSections {
+		if elems, found := includes.sections[section]; found {
+			config[section] = elems
+		}
+
+		origins = append(origins, includes.origins[section]...)
+	}
+
+	payload, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return resolver.configPayload, nil
+	return payload, origins, nil
 }
 
 func (resolver TemplateResolver) resolve(expectAllKeys bool) ([]byte, error) {
@@ -1,6 +1,7 @@
 package vars_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	. "github.com/onsi/ginkgo"
@@ -228,6 +229,17 @@ dup-key: ((key3))
 		Expect(result).To(Equal([]byte("address: 10.0.0.0:4222\n")))
 	})
 
+	It("can interpolate json numbers in the middle of a string as they are written", func() {
+		template := NewTemplate([]byte("image: golang:((go))"))
+		vars := StaticVariables{
+			"go": json.Number("1.10"),
+		}
+
+		result, err := template.Evaluate(vars, EvaluateOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal([]byte("image: golang:1.10\n")))
+	})
+
 	It("raises error when interpolating an unsupported type in the middle of a string", func() {
 		template := NewTemplate([]byte("address: ((definition)):((eulers_number))"))
 		vars := StaticVariables{
@@ -339,6 +351,24 @@ dup-key: ((key3))
 		Expect(err.Error()).To(ContainSubstring("missing field 'subkey_not_found' in var: key.subkey_not_found"))
 	})
 
+	It("can interpolate values from a named source", func() {
+		template := NewTemplate([]byte("abc: ((.:key))\nxyz: ((.:key.subkey))"))
+		vars := NewBuildVariables(nil)
+		vars.AddLocalVar("key", map[string]interface{}{"subkey": "e"}, false)
+
+		result, err := template.Evaluate(vars, EvaluateOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal([]byte("abc:\n  subkey: e\nxyz: e\n")))
+	})
+
+	It("does not look up vars with a source in static variables", func() {
+		template := NewTemplate([]byte("((.:key))"))
+		vars := StaticVariables{"key": "val"}
+
+		_, err := template.Evaluate(vars, EvaluateOpts{ExpectAllKeys: true})
+		Expect(err).To(MatchError("undefined vars: .:key"))
+	})
+
 	It("returns error if finding variable fails", func() {
 		template := NewTemplate([]byte("((key))"))
 		vars := &FakeVariables{GetErr: errors.New("fake-err")}
@@ -0,0 +1,37 @@
+package vars
+
+// TrackedVariables records the values of the vars fetched through it, e.g.
+// the credentials interpolated into a resource's source, so that they can be
+// redacted from any output produced while using them.
+type TrackedVariables struct {
+	Variables
+
+	redactions *redactions
+}
+
+var _ Variables = &TrackedVariables{}
+
+func NewTrackedVariables(variables Variables) *TrackedVariables {
+	return &TrackedVariables{
+		Variables:  variables,
+		redactions: &redactions{},
+	}
+}
+
+// Get fetches the var, recording its value if it is found.
+func (v *TrackedVariables) Get(varDef VariableDefinition) (interface{}, bool, error) {
+	val, found, err := v.Variables.Get(varDef)
+	if err != nil || !found {
+		return val, found, err
+	}
+
+	v.redactions.add(val)
+
+	return val, true, nil
+}
+
+// Redact replaces every occurrence of the value of a var fetched so far in
+// the given text with RedactedValue.
+func (v *TrackedVariables) Redact(text string) string {
+	return v.redactions.redact(text)
+}
@@ -0,0 +1,49 @@
+package vars_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/concourse/concourse/vars"
+)
+
+var _ = Describe("TrackedVariables", func() {
+	var trackedVars *TrackedVariables
+
+	BeforeEach(func() {
+		trackedVars = NewTrackedVariables(StaticVariables{
+			"password": "some-password",
+			"creds":    map[interface{}]interface{}{"key": "some-key"},
+		})
+	})
+
+	Describe("Get", func() {
+		It("returns the var", func() {
+			val, found, err := trackedVars.Get(VariableDefinition{Name: "password"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("some-password"))
+		})
+
+		It("returns not found if the var does not exist", func() {
+			_, found, err := trackedVars.Get(VariableDefinition{Name: "bogus"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("Redact", func() {
+		It("redacts the values of the vars fetched so far", func() {
+			text := "logged in with some-password and some-key"
+			Expect(trackedVars.Redact(text)).To(Equal(text))
+
+			_, _, err := trackedVars.Get(VariableDefinition{Name: "password"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(trackedVars.Redact(text)).To(Equal("logged in with ((redacted)) and some-key"))
+
+			_, _, err = trackedVars.Get(VariableDefinition{Name: "creds"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(trackedVars.Redact(text)).To(Equal("logged in with ((redacted)) and ((redacted))"))
+		})
+	})
+})
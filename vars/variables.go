@@ -6,6 +6,10 @@ type Variables interface {
 }
 
 type VariableDefinition struct {
+	// Source is the name of the source the var is to be fetched from, e.g. "."
+	// for ((.:some-var)). It is empty for vars which don't specify a source.
+	Source string
+
 	Name    string
 	Type    string
 	Options interface{}
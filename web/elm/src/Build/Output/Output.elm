@@ -175,6 +175,18 @@ handleEvent event ( model, effects, outmsg ) =
             , outmsg
             )
 
+        TaskCached origin buildName time ->
+            ( updateStep origin.id
+                (finishStep 0 (Just time)
+                    << appendStepLog
+                        ("satisfied from cache: reusing the outputs of build #" ++ buildName ++ "\n")
+                        (Just time)
+                )
+                model
+            , effects
+            , outmsg
+            )
+
         InitializeGet origin time ->
             ( updateStep origin.id (setInitialize time) model
             , effects
@@ -211,6 +223,48 @@ handleEvent event ( model, effects, outmsg ) =
             , outmsg
             )
 
+        Initialize origin time ->
+            ( updateStep origin.id (setInitialize time) model
+            , effects
+            , outmsg
+            )
+
+        Start origin time ->
+            ( updateStep origin.id (setStart time) model
+            , effects
+            , outmsg
+            )
+
+        Finish origin time succeeded ->
+            ( updateStep origin.id
+                (finishStep
+                    (if succeeded then
+                        0
+
+                     else
+                        1
+                    )
+                    (Just time)
+                )
+                model
+            , effects
+            , outmsg
+            )
+
+        Skipped origin _ ->
+            ( updateStep origin.id StepTree.skipTree model
+            , effects
+            , outmsg
+            )
+
+        Retrying _ _ _ _ ->
+            -- the retry tabs already switch to the next attempt once it starts
+            ( model, effects, outmsg )
+
+        FinishAttempt _ _ _ _ ->
+            -- each attempt's tab already shows how its steps went
+            ( model, effects, outmsg )
+
         BuildStatus status date ->
             let
                 newSt =
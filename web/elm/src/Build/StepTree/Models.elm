@@ -15,6 +15,7 @@ module Build.StepTree.Models exposing
     , finishTree
     , focusRetry
     , map
+    , skipTree
     , updateAt
     , wrapHook
     , wrapMultiStep
@@ -44,17 +45,21 @@ type StepTree
     | Get Step
     | ArtifactOutput Step
     | Put Step
+    | SetPipeline Step
+    | LoadVar Step
     | Aggregate (Array StepTree)
     | InParallel (Array StepTree)
     | Do (Array StepTree)
     | OnSuccess HookedStep
     | OnFailure HookedStep
+    | OnRetry HookedStep
     | OnAbort HookedStep
     | OnError HookedStep
     | Ensure HookedStep
     | Try StepTree
     | Retry StepID Int TabFocus (Array StepTree)
     | Timeout StepTree
+    | If StepTree
 
 
 type alias StepFocus =
@@ -90,6 +95,7 @@ type StepState
     | StepStateSucceeded
     | StepStateFailed
     | StepStateErrored
+    | StepStateSkipped
 
 
 type alias Version =
@@ -124,12 +130,19 @@ type BuildEvent
     | InitializeTask Origin Time.Posix
     | StartTask Origin Time.Posix
     | FinishTask Origin Int Time.Posix
+    | TaskCached Origin String Time.Posix
     | InitializeGet Origin Time.Posix
     | StartGet Origin Time.Posix
     | FinishGet Origin Int Concourse.Version Concourse.Metadata (Maybe Time.Posix)
     | InitializePut Origin Time.Posix
     | StartPut Origin Time.Posix
     | FinishPut Origin Int Concourse.Version Concourse.Metadata (Maybe Time.Posix)
+    | Initialize Origin Time.Posix
+    | Start Origin Time.Posix
+    | Finish Origin Time.Posix Bool
+    | Skipped Origin Time.Posix
+    | Retrying Origin Int String Time.Posix
+    | FinishAttempt Origin Int String Time.Posix
     | Log Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
     | End
@@ -181,6 +194,12 @@ map f tree =
         Put step ->
             Put (f step)
 
+        SetPipeline step ->
+            SetPipeline (f step)
+
+        LoadVar step ->
+            LoadVar (f step)
+
         _ ->
             tree
 
@@ -209,6 +228,9 @@ updateStep update tree =
         OnFailure hookedStep ->
             OnFailure { hookedStep | step = update hookedStep.step }
 
+        OnRetry hookedStep ->
+            OnRetry { hookedStep | step = update hookedStep.step }
+
         OnAbort hookedStep ->
             OnAbort { hookedStep | step = update hookedStep.step }
 
@@ -224,6 +246,9 @@ updateStep update tree =
         Timeout step ->
             Timeout (update step)
 
+        If step ->
+            If (update step)
+
         _ ->
             --impossible
             tree
@@ -238,6 +263,9 @@ updateHook update tree =
         OnFailure hookedStep ->
             OnFailure { hookedStep | hook = update hookedStep.hook }
 
+        OnRetry hookedStep ->
+            OnRetry { hookedStep | hook = update hookedStep.hook }
+
         OnAbort hookedStep ->
             OnAbort { hookedStep | hook = update hookedStep.hook }
 
@@ -329,6 +357,12 @@ finishTree root =
         Put step ->
             Put (finishStep step)
 
+        SetPipeline step ->
+            SetPipeline (finishStep step)
+
+        LoadVar step ->
+            LoadVar (finishStep step)
+
         Aggregate trees ->
             Aggregate (Array.map finishTree trees)
 
@@ -344,6 +378,9 @@ finishTree root =
         OnFailure hookedStep ->
             OnFailure (finishHookedStep hookedStep)
 
+        OnRetry hookedStep ->
+            OnRetry (finishHookedStep hookedStep)
+
         OnAbort hookedStep ->
             OnAbort (finishHookedStep hookedStep)
 
@@ -362,6 +399,9 @@ finishTree root =
         Timeout tree ->
             Timeout (finishTree tree)
 
+        If tree ->
+            If (finishTree tree)
+
 
 finishStep : Step -> Step
 finishStep step =
@@ -386,3 +426,84 @@ finishHookedStep hooked =
         | step = finishTree hooked.step
         , hook = finishTree hooked.hook
     }
+
+
+skipTree : StepTree -> StepTree
+skipTree root =
+    case root of
+        Task step ->
+            Task (skipStep step)
+
+        ArtifactInput step ->
+            ArtifactInput (skipStep step)
+
+        Get step ->
+            Get (skipStep step)
+
+        ArtifactOutput step ->
+            ArtifactOutput (skipStep step)
+
+        Put step ->
+            Put (skipStep step)
+
+        SetPipeline step ->
+            SetPipeline (skipStep step)
+
+        LoadVar step ->
+            LoadVar (skipStep step)
+
+        Aggregate trees ->
+            Aggregate (Array.map skipTree trees)
+
+        InParallel trees ->
+            InParallel (Array.map skipTree trees)
+
+        Do trees ->
+            Do (Array.map skipTree trees)
+
+        OnSuccess hookedStep ->
+            OnSuccess (skipHookedStep hookedStep)
+
+        OnFailure hookedStep ->
+            OnFailure (skipHookedStep hookedStep)
+
+        OnRetry hookedStep ->
+            OnRetry (skipHookedStep hookedStep)
+
+        OnAbort hookedStep ->
+            OnAbort (skipHookedStep hookedStep)
+
+        OnError hookedStep ->
+            OnError (skipHookedStep hookedStep)
+
+        Ensure hookedStep ->
+            Ensure (skipHookedStep hookedStep)
+
+        Try tree ->
+            Try (skipTree tree)
+
+        Retry id tab focus trees ->
+            Retry id tab focus (Array.map skipTree trees)
+
+        Timeout tree ->
+            Timeout (skipTree tree)
+
+        If tree ->
+            If (skipTree tree)
+
+
+skipStep : Step -> Step
+skipStep step =
+    if step.state == StepStatePending then
+        { step | state = StepStateSkipped }
+
+    else
+        step
+
+
+skipHookedStep : HookedStep -> HookedStep
+skipHookedStep hooked =
+    { hooked
+        | step = skipTree hooked.step
+        , hook = skipTree hooked.hook
+    }
@@ -81,6 +81,12 @@ init hl resources buildPlan =
         Concourse.BuildStepPut name ->
             initBottom hl Put buildPlan.id name
 
+        Concourse.BuildStepSetPipeline name ->
+            initBottom hl SetPipeline buildPlan.id name
+
+        Concourse.BuildStepLoadVar name ->
+            initBottom hl LoadVar buildPlan.id name
+
         Concourse.BuildStepAggregate plans ->
             initMultiStep hl resources buildPlan.id Aggregate plans
 
@@ -99,6 +105,9 @@ init hl resources buildPlan =
         Concourse.BuildStepOnFailure hookedPlan ->
             initHookedStep hl resources OnFailure hookedPlan
 
+        Concourse.BuildStepOnRetry hookedPlan ->
+            initHookedStep hl resources OnRetry hookedPlan
+
         Concourse.BuildStepOnAbort hookedPlan ->
             initHookedStep hl resources OnAbort hookedPlan
 
@@ -114,6 +123,9 @@ init hl resources buildPlan =
         Concourse.BuildStepTimeout plan ->
             initWrappedStep hl resources Timeout plan
 
+        Concourse.BuildStepIf plan ->
+            initIfStep hl resources buildPlan.id plan
+
 
 initMultiStep :
     Highlight
@@ -208,6 +220,21 @@ initWrappedStep hl resources create plan =
     }
 
 
+initIfStep :
+    Highlight
+    -> Concourse.BuildResources
+    -> StepID
+    -> Concourse.BuildPlan
+    -> StepTreeModel
+initIfStep hl resources planId plan =
+    let
+        model =
+            initWrappedStep hl resources If plan
+    in
+    -- the if step itself is focused when it is skipped
+    { model | foci = Dict.insert planId identity model.foci }
+
+
 initHookedStep :
     Highlight
     -> Concourse.BuildResources
@@ -250,6 +277,9 @@ treeIsActive stepTree =
         OnFailure { step } ->
             treeIsActive step
 
+        OnRetry { step } ->
+            treeIsActive step
+
         OnAbort { step } ->
             treeIsActive step
 
@@ -265,6 +295,9 @@ treeIsActive stepTree =
         Timeout tree ->
             treeIsActive tree
 
+        If tree ->
+            treeIsActive tree
+
         Retry _ _ _ trees ->
             List.any treeIsActive (Array.toList trees)
 
@@ -283,6 +316,12 @@ treeIsActive stepTree =
         Put step ->
             stepIsActive step
 
+        SetPipeline step ->
+            stepIsActive step
+
+        LoadVar step ->
+            stepIsActive step
+
 
 stepIsActive : Step -> Bool
 stepIsActive =
@@ -443,6 +482,12 @@ viewTree session model tree =
         Put step ->
             viewStep model session step StepHeaderPut
 
+        SetPipeline step ->
+            viewStep model session step StepHeaderSetPipeline
+
+        LoadVar step ->
+            viewStep model session step StepHeaderLoadVar
+
         Try step ->
             viewTree session model step
 
@@ -463,6 +508,9 @@ viewTree session model tree =
         Timeout step ->
             viewTree session model step
 
+        If step ->
+            viewTree session model step
+
         Aggregate steps ->
             Html.div [ class "aggregate" ]
                 (Array.toList <| Array.map (viewSeq session model) steps)
@@ -481,6 +529,9 @@ viewTree session model tree =
         OnFailure { step, hook } ->
             viewHooked session "failure" model step hook
 
+        OnRetry { step, hook } ->
+            viewHooked session "retry" model step hook
+
         OnAbort { step, hook } ->
             viewHooked session "abort" model step hook
 
@@ -538,7 +589,7 @@ viewHooked session name model step hook =
 
 isActive : StepState -> Bool
 isActive state =
-    state /= StepStatePending && state /= StepStateCancelled
+    state /= StepStatePending && state /= StepStateCancelled && state /= StepStateSkipped
 
 
 viewStep : StepTreeModel -> { timeZone : Time.Zone, hovered : HoverState.HoverState } -> Step -> StepHeaderType -> Html Message
@@ -779,6 +830,17 @@ viewStepState state id tooltip =
                 )
                 tooltip
 
+        StepStateSkipped ->
+            Icon.iconWithTooltip
+                { sizePx = 28
+                , image = "ic-skipped.svg"
+                }
+                (attribute "data-step-state" "skipped"
+                    :: Styles.stepStatusIcon
+                    ++ eventHandlers
+                )
+                tooltip
+
         StepStateSucceeded ->
             Icon.iconWithTooltip
                 { sizePx = 28
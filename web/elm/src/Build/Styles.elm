@@ -167,6 +167,9 @@ stepHeader state =
                     StepStateCancelled ->
                         Colors.frame
 
+                    StepStateSkipped ->
+                        Colors.frame
+
                     StepStateSucceeded ->
                         Colors.frame
                )
@@ -189,6 +192,12 @@ stepHeaderIcon icon =
 
                 StepHeaderTask ->
                     "terminal"
+
+                StepHeaderSetPipeline ->
+                    "breadcrumb-pipeline"
+
+                StepHeaderLoadVar ->
+                    "cogs"
     in
     [ style "height" "28px"
     , style "width" "28px"
@@ -16,8 +16,11 @@ module Concourse exposing
     , BuildStep(..)
     , CSRFToken
     , Cause
+    , Check
+    , CheckStatus(..)
     , ClusterInfo
     , HookedPlan
+    , InstanceVars
     , Job
     , JobBuildIdentifier
     , JobIdentifier
@@ -47,6 +50,7 @@ module Concourse exposing
     , decodeBuildResources
     , decodeBuildStatus
     , decodeCause
+    , decodeCheck
     , decodeInfo
     , decodeJob
     , decodeMetadata
@@ -56,6 +60,10 @@ module Concourse exposing
     , decodeUser
     , decodeVersion
     , decodeVersionedResource
+    , instanceVarsQuery
+    , instanceVarsQueryParams
+    , parseInstanceVars
+    , pipelineRefString
     , retrieveCSRFToken
     )
 
@@ -65,6 +73,7 @@ import Json.Decode
 import Json.Decode.Extra exposing (andMap)
 import Json.Encode
 import Time
+import Url.Builder
 
 
 
@@ -144,6 +153,7 @@ type alias BuildName =
 type alias JobBuildIdentifier =
     { teamName : TeamName
     , pipelineName : PipelineName
+    , pipelineInstanceVars : InstanceVars
     , jobName : JobName
     , buildName : BuildName
     }
@@ -184,6 +194,7 @@ decodeBuild =
                 (Json.Decode.succeed JobIdentifier
                     |> andMap (Json.Decode.field "team_name" Json.Decode.string)
                     |> andMap (Json.Decode.field "pipeline_name" Json.Decode.string)
+                    |> andMap (defaultTo Dict.empty <| Json.Decode.field "pipeline_instance_vars" decodeInstanceVars)
                     |> andMap (Json.Decode.field "job_name" Json.Decode.string)
                 )
             )
@@ -337,17 +348,21 @@ type BuildStep
     | BuildStepGet StepName (Maybe Version)
     | BuildStepArtifactOutput StepName
     | BuildStepPut StepName
+    | BuildStepSetPipeline StepName
+    | BuildStepLoadVar StepName
     | BuildStepAggregate (Array BuildPlan)
     | BuildStepInParallel (Array BuildPlan)
     | BuildStepDo (Array BuildPlan)
     | BuildStepOnSuccess HookedPlan
     | BuildStepOnFailure HookedPlan
+    | BuildStepOnRetry HookedPlan
     | BuildStepOnAbort HookedPlan
     | BuildStepOnError HookedPlan
     | BuildStepEnsure HookedPlan
     | BuildStepTry BuildPlan
     | BuildStepRetry (Array BuildPlan)
     | BuildStepTimeout BuildPlan
+    | BuildStepIf BuildPlan
 
 
 type alias HookedPlan =
@@ -379,6 +394,10 @@ decodeBuildPlan_ =
                     lazy (\_ -> decodeBuildStepPut)
                 , Json.Decode.field "artifact_output" <|
                     lazy (\_ -> decodeBuildStepArtifactOutput)
+                , Json.Decode.field "set_pipeline" <|
+                    lazy (\_ -> decodeBuildStepSetPipeline)
+                , Json.Decode.field "load_var" <|
+                    lazy (\_ -> decodeBuildStepLoadVar)
                 , Json.Decode.field "dependent_get" <|
                     lazy (\_ -> decodeBuildStepGet)
                 , Json.Decode.field "aggregate" <|
@@ -391,6 +410,8 @@ decodeBuildPlan_ =
                     lazy (\_ -> decodeBuildStepOnSuccess)
                 , Json.Decode.field "on_failure" <|
                     lazy (\_ -> decodeBuildStepOnFailure)
+                , Json.Decode.field "on_retry" <|
+                    lazy (\_ -> decodeBuildStepOnRetry)
                 , Json.Decode.field "on_abort" <|
                     lazy (\_ -> decodeBuildStepOnAbort)
                 , Json.Decode.field "on_error" <|
@@ -403,6 +424,10 @@ decodeBuildPlan_ =
                     lazy (\_ -> decodeBuildStepRetry)
                 , Json.Decode.field "timeout" <|
                     lazy (\_ -> decodeBuildStepTimeout)
+                , Json.Decode.field "across" <|
+                    lazy (\_ -> decodeBuildStepAcross)
+                , Json.Decode.field "if" <|
+                    lazy (\_ -> decodeBuildStepIf)
                 ]
             )
 
@@ -438,6 +463,18 @@ decodeBuildStepPut =
         |> andMap (Json.Decode.field "name" Json.Decode.string)
 
 
+decodeBuildStepSetPipeline : Json.Decode.Decoder BuildStep
+decodeBuildStepSetPipeline =
+    Json.Decode.succeed BuildStepSetPipeline
+        |> andMap (Json.Decode.field "name" Json.Decode.string)
+
+
+decodeBuildStepLoadVar : Json.Decode.Decoder BuildStep
+decodeBuildStepLoadVar =
+    Json.Decode.succeed BuildStepLoadVar
+        |> andMap (Json.Decode.field "name" Json.Decode.string)
+
+
 decodeBuildStepAggregate : Json.Decode.Decoder BuildStep
 decodeBuildStepAggregate =
     Json.Decode.succeed BuildStepAggregate
@@ -450,6 +487,12 @@ decodeBuildStepInParallel =
         |> andMap (Json.Decode.field "steps" <| Json.Decode.array (lazy (\_ -> decodeBuildPlan_)))
 
 
+decodeBuildStepAcross : Json.Decode.Decoder BuildStep
+decodeBuildStepAcross =
+    Json.Decode.succeed BuildStepInParallel
+        |> andMap (Json.Decode.field "steps" <| Json.Decode.array (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_)))
+
+
 decodeBuildStepDo : Json.Decode.Decoder BuildStep
 decodeBuildStepDo =
     Json.Decode.succeed BuildStepDo
@@ -474,6 +517,15 @@ decodeBuildStepOnFailure =
         )
 
 
+decodeBuildStepOnRetry : Json.Decode.Decoder BuildStep
+decodeBuildStepOnRetry =
+    Json.Decode.map BuildStepOnRetry
+        (Json.Decode.succeed HookedPlan
+            |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+            |> andMap (Json.Decode.field "on_retry" <| lazy (\_ -> decodeBuildPlan_))
+        )
+
+
 decodeBuildStepOnAbort : Json.Decode.Decoder BuildStep
 decodeBuildStepOnAbort =
     Json.Decode.map BuildStepOnAbort
@@ -519,6 +571,12 @@ decodeBuildStepTimeout =
         |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
 
 
+decodeBuildStepIf : Json.Decode.Decoder BuildStep
+decodeBuildStepIf =
+    Json.Decode.succeed BuildStepIf
+        |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+
+
 
 -- Info
 
@@ -547,6 +605,7 @@ type alias JobName =
 type alias JobIdentifier =
     { teamName : TeamName
     , pipelineName : PipelineName
+    , pipelineInstanceVars : InstanceVars
     , jobName : JobName
     }
 
@@ -555,6 +614,7 @@ type alias Job =
     { pipeline : PipelineIdentifier
     , name : JobName
     , pipelineName : PipelineName
+    , pipelineInstanceVars : InstanceVars
     , teamName : TeamName
     , nextBuild : Maybe Build
     , finishedBuild : Maybe Build
@@ -586,6 +646,7 @@ decodeJob pi =
     Json.Decode.succeed (Job pi)
         |> andMap (Json.Decode.field "name" Json.Decode.string)
         |> andMap (Json.Decode.field "pipeline_name" Json.Decode.string)
+        |> andMap (defaultTo Dict.empty <| Json.Decode.field "pipeline_instance_vars" decodeInstanceVars)
         |> andMap (Json.Decode.field "team_name" Json.Decode.string)
         |> andMap (Json.Decode.maybe (Json.Decode.field "next_build" decodeBuild))
         |> andMap (Json.Decode.maybe (Json.Decode.field "finished_build" decodeBuild))
@@ -624,12 +685,22 @@ type alias PipelineName =
 type alias PipelineIdentifier =
     { teamName : TeamName
     , pipelineName : PipelineName
+    , pipelineInstanceVars : InstanceVars
     }
 
 
+{-| The vars which tell an instance of a pipeline apart from the other
+instances of the same name. Their values are kept JSON encoded, so that
+identifiers can be compared.
+-}
+type alias InstanceVars =
+    Dict String String
+
+
 type alias Pipeline =
     { id : Int
     , name : PipelineName
+    , instanceVars : InstanceVars
     , paused : Bool
     , public : Bool
     , teamName : TeamName
@@ -649,6 +720,7 @@ decodePipeline =
     Json.Decode.succeed Pipeline
         |> andMap (Json.Decode.field "id" Json.Decode.int)
         |> andMap (Json.Decode.field "name" Json.Decode.string)
+        |> andMap (defaultTo Dict.empty <| Json.Decode.field "instance_vars" decodeInstanceVars)
         |> andMap (Json.Decode.field "paused" Json.Decode.bool)
         |> andMap (Json.Decode.field "public" Json.Decode.bool)
         |> andMap (Json.Decode.field "team_name" Json.Decode.string)
@@ -663,6 +735,73 @@ decodePipelineGroup =
         |> andMap (defaultTo [] <| Json.Decode.field "resources" <| Json.Decode.list Json.Decode.string)
 
 
+decodeInstanceVars : Json.Decode.Decoder InstanceVars
+decodeInstanceVars =
+    Json.Decode.dict (Json.Decode.map (Json.Encode.encode 0) Json.Decode.value)
+
+
+encodeInstanceVars : InstanceVars -> String
+encodeInstanceVars instanceVars =
+    "{"
+        ++ (instanceVars
+                |> Dict.toList
+                |> List.map (\( key, value ) -> Json.Encode.encode 0 (Json.Encode.string key) ++ ":" ++ value)
+                |> String.join ","
+           )
+        ++ "}"
+
+
+{-| Parses the instance vars given as the `instance_vars` query param. Vars
+which can't be parsed identify no instance.
+-}
+parseInstanceVars : Maybe String -> InstanceVars
+parseInstanceVars =
+    Maybe.andThen (Json.Decode.decodeString decodeInstanceVars >> Result.toMaybe)
+        >> Maybe.withDefault Dict.empty
+
+
+instanceVarsQueryParams : InstanceVars -> List Url.Builder.QueryParameter
+instanceVarsQueryParams instanceVars =
+    if Dict.isEmpty instanceVars then
+        []
+
+    else
+        [ Url.Builder.string "instance_vars" (encodeInstanceVars instanceVars) ]
+
+
+{-| The query string identifying the instance of a pipeline in API requests,
+e.g. `?instance_vars={"branch":"master"}`, or nothing for pipelines which
+aren't instanced.
+-}
+instanceVarsQuery : InstanceVars -> String
+instanceVarsQuery =
+    instanceVarsQueryParams >> Url.Builder.toQuery
+
+
+{-| Names an instance of a pipeline the way fly does, e.g.
+`some-pipeline/branch:master`.
+-}
+pipelineRefString : PipelineName -> InstanceVars -> String
+pipelineRefString name instanceVars =
+    if Dict.isEmpty instanceVars then
+        name
+
+    else
+        name
+            ++ "/"
+            ++ (instanceVars
+                    |> Dict.toList
+                    |> List.map (\( key, value ) -> key ++ ":" ++ instanceVarString value)
+                    |> String.join ","
+               )
+
+
+instanceVarString : String -> String
+instanceVarString value =
+    Json.Decode.decodeString Json.Decode.string value
+        |> Result.withDefault value
+
+
 
 -- Resource
 
@@ -670,6 +809,7 @@ decodePipelineGroup =
 type alias Resource =
     { teamName : String
     , pipelineName : String
+    , pipelineInstanceVars : InstanceVars
     , name : String
     , icon : Maybe String
     , failingToCheck : Bool
@@ -679,12 +819,14 @@ type alias Resource =
     , pinnedVersion : Maybe Version
     , pinnedInConfig : Bool
     , pinComment : Maybe String
+    , latestCheckId : Maybe Int
     }
 
 
 type alias ResourceIdentifier =
     { teamName : String
     , pipelineName : String
+    , pipelineInstanceVars : InstanceVars
     , resourceName : String
     }
 
@@ -700,6 +842,7 @@ type alias VersionedResource =
 type alias VersionedResourceIdentifier =
     { teamName : String
     , pipelineName : String
+    , pipelineInstanceVars : InstanceVars
     , resourceName : String
     , versionID : Int
     }
@@ -710,6 +853,7 @@ decodeResource =
     Json.Decode.succeed Resource
         |> andMap (Json.Decode.field "team_name" Json.Decode.string)
         |> andMap (Json.Decode.field "pipeline_name" Json.Decode.string)
+        |> andMap (defaultTo Dict.empty <| Json.Decode.field "pipeline_instance_vars" decodeInstanceVars)
         |> andMap (Json.Decode.field "name" Json.Decode.string)
         |> andMap (Json.Decode.maybe (Json.Decode.field "icon" Json.Decode.string))
         |> andMap (defaultTo False <| Json.Decode.field "failing_to_check" Json.Decode.bool)
@@ -719,6 +863,60 @@ decodeResource =
         |> andMap (Json.Decode.maybe (Json.Decode.field "pinned_version" decodeVersion))
         |> andMap (defaultTo False <| Json.Decode.field "pinned_in_config" Json.Decode.bool)
         |> andMap (Json.Decode.maybe (Json.Decode.field "pin_comment" Json.Decode.string))
+        |> andMap (Json.Decode.maybe (Json.Decode.field "latest_check_id" Json.Decode.int))
+
+
+type CheckStatus
+    = CheckStatusPending
+    | CheckStatusStarted
+    | CheckStatusSucceeded
+    | CheckStatusErrored
+    | CheckStatusSkipped
+
+
+type alias Check =
+    { id : Int
+    , status : CheckStatus
+    , createdBy : String
+    , createTime : Maybe Time.Posix
+    , endTime : Maybe Time.Posix
+    , checkError : Maybe String
+    }
+
+
+decodeCheck : Json.Decode.Decoder Check
+decodeCheck =
+    Json.Decode.succeed Check
+        |> andMap (Json.Decode.field "id" Json.Decode.int)
+        |> andMap (Json.Decode.field "status" decodeCheckStatus)
+        |> andMap (Json.Decode.field "created_by" Json.Decode.string)
+        |> andMap (Json.Decode.maybe (Json.Decode.field "create_time" (Json.Decode.map dateFromSeconds Json.Decode.int)))
+        |> andMap (Json.Decode.maybe (Json.Decode.field "end_time" (Json.Decode.map dateFromSeconds Json.Decode.int)))
+        |> andMap (Json.Decode.maybe (Json.Decode.field "check_error" Json.Decode.string))
+
+
+decodeCheckStatus : Json.Decode.Decoder CheckStatus
+decodeCheckStatus =
+    customDecoder Json.Decode.string <|
+        \status ->
+            case status of
+                "pending" ->
+                    Ok CheckStatusPending
+
+                "started" ->
+                    Ok CheckStatusStarted
+
+                "succeeded" ->
+                    Ok CheckStatusSucceeded
+
+                "errored" ->
+                    Ok CheckStatusErrored
+
+                "skipped" ->
+                    Ok CheckStatusSkipped
+
+                unknown ->
+                    Err <| Json.Decode.Failure "unknown check status" <| Json.Encode.string unknown
 
 
 decodeVersionedResource : Json.Decode.Decoder VersionedResource
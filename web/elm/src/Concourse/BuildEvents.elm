@@ -113,6 +113,15 @@ decodeBuildEvent =
                                 (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
                             )
 
+                    "task-cached" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 TaskCached
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "build_name" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     "initialize-get" ->
                         Json.Decode.field
                             "data"
@@ -151,6 +160,59 @@ decodeBuildEvent =
                     "finish-put" ->
                         Json.Decode.field "data" (decodeFinishResource FinishPut)
 
+                    "initialize" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 Initialize
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "start" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 Start
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "finish" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 Finish
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                                (Json.Decode.field "succeeded" Json.Decode.bool)
+                            )
+
+                    "skipped" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 Skipped
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "retrying" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map4 Retrying
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "attempt" Json.Decode.int)
+                                (Json.Decode.field "wait" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "finish-attempt" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map4 FinishAttempt
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "attempt" Json.Decode.int)
+                                (Json.Decode.field "status" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     unknown ->
                         Json.Decode.fail ("unknown event type: " ++ unknown)
             )
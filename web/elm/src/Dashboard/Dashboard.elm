@@ -225,6 +225,12 @@ handleCallback msg ( model, effects ) =
             ( model, effects )
 
 
+isPipeline : Concourse.PipelineIdentifier -> Pipeline -> Bool
+isPipeline pipelineId pipeline =
+    (pipeline.name == pipelineId.pipelineName)
+        && (pipeline.instanceVars == pipelineId.pipelineInstanceVars)
+
+
 updatePipeline :
     (Pipeline -> Pipeline)
     -> Concourse.PipelineIdentifier
@@ -241,7 +247,7 @@ updatePipeline updater pipelineId model =
                             newPipelines =
                                 g.pipelines
                                     |> List.Extra.updateIf
-                                        (.name >> (==) pipelineId.pipelineName)
+                                        (isPipeline pipelineId)
                                         updater
                         in
                         { g | pipelines = newPipelines }
@@ -386,7 +392,7 @@ updateBody msg ( model, effects ) =
                             (\g ->
                                 g.pipelines
                                     |> List.Extra.find
-                                        (.name >> (==) pipelineId.pipelineName)
+                                        (isPipeline pipelineId)
                                     |> Maybe.map
                                         (.status >> (==) PipelineStatusPaused)
                             )
@@ -414,7 +420,7 @@ updateBody msg ( model, effects ) =
                             (\g ->
                                 g.pipelines
                                     |> List.Extra.find
-                                        (.name >> (==) pipelineId.pipelineName)
+                                        (isPipeline pipelineId)
                                     |> Maybe.map .public
                             )
             in
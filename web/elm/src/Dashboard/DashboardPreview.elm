@@ -71,6 +71,7 @@ viewJob hovered job =
         jobId =
             { jobName = job.name
             , pipelineName = job.pipelineName
+            , pipelineInstanceVars = job.pipelineInstanceVars
             , teamName = job.teamName
             }
     in
@@ -161,10 +161,12 @@ allPipelines data =
                                 (\j ->
                                     (j.teamName == p.teamName)
                                         && (j.pipelineName == p.name)
+                                        && (j.pipelineInstanceVars == p.instanceVars)
                                 )
                 in
                 { id = p.id
                 , name = p.name
+                , instanceVars = p.instanceVars
                 , teamName = p.teamName
                 , public = p.public
                 , jobs = jobs
@@ -174,6 +176,7 @@ allPipelines data =
                             (\r ->
                                 (r.teamName == p.teamName)
                                     && (r.pipelineName == p.name)
+                                    && (r.pipelineInstanceVars == p.instanceVars)
                                     && r.failingToCheck
                             )
                 , status = pipelineStatus p jobs
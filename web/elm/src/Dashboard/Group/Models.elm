@@ -15,6 +15,7 @@ type alias Group =
 type alias Pipeline =
     { id : Int
     , name : String
+    , instanceVars : Concourse.InstanceVars
     , teamName : String
     , public : Bool
     , jobs : List Concourse.Job
@@ -64,7 +64,7 @@ hdPipelineView { pipeline, pipelineRunningKeyframes } =
             []
         , Html.div
             (class "dashboardhd-pipeline-name" :: Styles.pipelineCardBodyHd)
-            [ Html.text pipeline.name ]
+            [ Html.text <| Concourse.pipelineRefString pipeline.name pipeline.instanceVars ]
         ]
             ++ (if pipeline.resourceError then
                     [ Html.div Styles.resourceErrorTriangle [] ]
@@ -111,7 +111,7 @@ headerView pipeline =
             )
             [ Html.div
                 (class "dashboard-pipeline-name" :: Styles.pipelineName)
-                [ Html.text pipeline.name ]
+                [ Html.text <| Concourse.pipelineRefString pipeline.name pipeline.instanceVars ]
             , Html.div
                 [ classList
                     [ ( "dashboard-resource-error", pipeline.resourceError )
@@ -142,6 +142,7 @@ footerView userState pipeline now hovered =
 
         pipelineId =
             { pipelineName = pipeline.name
+            , pipelineInstanceVars = pipeline.instanceVars
             , teamName = pipeline.teamName
             }
     in
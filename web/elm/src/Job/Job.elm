@@ -170,6 +170,7 @@ handleCallback callback ( model, effects ) =
                                         { id =
                                             { teamName = job.teamName
                                             , pipelineName = job.pipelineName
+                                            , pipelineInstanceVars = job.pipelineInstanceVars
                                             , jobName = job.jobName
                                             , buildName = build.name
                                             }
@@ -422,6 +423,7 @@ view session model =
                 }
                 (Just
                     { pipelineName = model.jobIdentifier.pipelineName
+                    , pipelineInstanceVars = model.jobIdentifier.pipelineInstanceVars
                     , teamName = model.jobIdentifier.teamName
                     }
                 )
@@ -32,6 +32,7 @@ type Callback
     | ResourcesFetched (Fetched Json.Encode.Value)
     | BuildResourcesFetched (Fetched ( Int, Concourse.BuildResources ))
     | ResourceFetched (Fetched Concourse.Resource)
+    | ResourceChecksFetched (Fetched (List Concourse.Check))
     | VersionedResourcesFetched (Fetched ( Maybe Page, Paginated Concourse.VersionedResource ))
     | ClusterInfoFetched (Fetched Concourse.ClusterInfo)
     | PausedToggled (Fetched ())
@@ -40,7 +41,7 @@ type Callback
     | VersionPinned (Fetched ())
     | VersionUnpinned (Fetched ())
     | VersionToggled VersionToggleAction VersionId (Fetched ())
-    | Checked (Fetched ())
+    | Checked (Fetched Int)
     | CommentSet (Fetched ())
     | TokenSentToFly (Fetched ())
     | APIDataFetched (Fetched ( Time.Posix, Concourse.APIData ))
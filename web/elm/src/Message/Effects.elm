@@ -15,7 +15,9 @@ import Concourse
 import Concourse.BuildStatus
 import Concourse.Pagination exposing (Page)
 import Dashboard.Group.Models
+import Dict
 import Json.Encode
+import List.Extra
 import Message.Callback exposing (Callback(..))
 import Message.Message
     exposing
@@ -109,6 +111,7 @@ type Effect
     | FetchJobs Concourse.PipelineIdentifier
     | FetchJobBuilds Concourse.JobIdentifier (Maybe Page)
     | FetchResource Concourse.ResourceIdentifier
+    | FetchResourceChecks Concourse.ResourceIdentifier
     | FetchVersionedResources Concourse.ResourceIdentifier (Maybe Page)
     | FetchResources Concourse.PipelineIdentifier
     | FetchBuildResources Concourse.BuildId
@@ -200,6 +203,10 @@ runEffect effect key csrfToken =
             Network.Resource.fetchResource id
                 |> Task.attempt ResourceFetched
 
+        FetchResourceChecks id ->
+            Network.Resource.fetchResourceChecks id
+                |> Task.attempt ResourceChecksFetched
+
         FetchVersionedResources id paging ->
             Network.Resource.fetchVersionedResources id paging
                 |> Task.map (\b -> ( paging, b ))
@@ -304,8 +311,7 @@ runEffect effect key csrfToken =
         SendTogglePipelineRequest pipelineIdentifier isPaused ->
             Network.Pipeline.togglePause
                 isPaused
-                pipelineIdentifier.teamName
-                pipelineIdentifier.pipelineName
+                pipelineIdentifier
                 csrfToken
                 |> Task.attempt (PipelineToggled pipelineIdentifier)
 
@@ -316,7 +322,8 @@ runEffect effect key csrfToken =
             tooltipHd ( teamName, pipelineName )
 
         SendOrderPipelinesRequest teamName pipelines ->
-            Network.Pipeline.order teamName (List.map .name pipelines) csrfToken
+            -- instances of a pipeline are ordered along with each other
+            Network.Pipeline.order teamName (pipelines |> List.map .name |> List.Extra.unique) csrfToken
                 |> Task.attempt (always EmptyCallback)
 
         SendLogOutRequest ->
@@ -420,8 +427,7 @@ runEffect effect key csrfToken =
         ChangeVisibility action pipelineId ->
             Network.Pipeline.changeVisibility
                 action
-                pipelineId.teamName
-                pipelineId.pipelineName
+                pipelineId
                 csrfToken
                 |> Task.attempt (VisibilityChanged action pipelineId)
 
@@ -447,7 +453,15 @@ toHtmlID domId =
             Base64.encode t
 
         SideBarPipeline p ->
-            Base64.encode p.teamName ++ "_" ++ Base64.encode p.pipelineName
+            Base64.encode p.teamName
+                ++ "_"
+                ++ Base64.encode p.pipelineName
+                ++ (if Dict.isEmpty p.pipelineInstanceVars then
+                        ""
+
+                    else
+                        "_" ++ Base64.encode (Concourse.instanceVarsQuery p.pipelineInstanceVars)
+                   )
 
         _ ->
             ""
@@ -16,7 +16,7 @@ fetchJobBuild : Concourse.JobBuildIdentifier -> Task Http.Error Concourse.Build
 fetchJobBuild jbi =
     let
         url =
-            "/api/v1/teams/" ++ jbi.teamName ++ "/pipelines/" ++ jbi.pipelineName ++ "/jobs/" ++ jbi.jobName ++ "/builds/" ++ jbi.buildName
+            "/api/v1/teams/" ++ jbi.teamName ++ "/pipelines/" ++ jbi.pipelineName ++ "/jobs/" ++ jbi.jobName ++ "/builds/" ++ jbi.buildName ++ Concourse.instanceVarsQuery jbi.pipelineInstanceVars
     in
     Http.toTask <| Http.get url Concourse.decodeBuild
 
@@ -53,4 +53,4 @@ fetchJobBuilds job page =
             , "builds"
             ]
     in
-    Network.Pagination.fetch Concourse.decodeBuild segments page
+    Network.Pagination.fetch Concourse.decodeBuild segments (Concourse.instanceVarsQueryParams job.pipelineInstanceVars) page
@@ -10,6 +10,7 @@ module Network.Job exposing
     )
 
 import Concourse
+import Dict
 import Http
 import HttpBuilder
 import Json.Decode
@@ -19,30 +20,36 @@ import Task exposing (Task)
 fetchJob : Concourse.JobIdentifier -> Task Http.Error Concourse.Job
 fetchJob job =
     Http.toTask <|
-        Http.get ("/api/v1/teams/" ++ job.teamName ++ "/pipelines/" ++ job.pipelineName ++ "/jobs/" ++ job.jobName) (Concourse.decodeJob { teamName = job.teamName, pipelineName = job.pipelineName })
+        Http.get ("/api/v1/teams/" ++ job.teamName ++ "/pipelines/" ++ job.pipelineName ++ "/jobs/" ++ job.jobName ++ Concourse.instanceVarsQuery job.pipelineInstanceVars)
+            (Concourse.decodeJob
+                { teamName = job.teamName
+                , pipelineName = job.pipelineName
+                , pipelineInstanceVars = job.pipelineInstanceVars
+                }
+            )
 
 
 fetchJobs : Concourse.PipelineIdentifier -> Task Http.Error (List Concourse.Job)
 fetchJobs pi =
     Http.toTask <|
-        Http.get ("/api/v1/teams/" ++ pi.teamName ++ "/pipelines/" ++ pi.pipelineName ++ "/jobs") (Json.Decode.list (Concourse.decodeJob pi))
+        Http.get ("/api/v1/teams/" ++ pi.teamName ++ "/pipelines/" ++ pi.pipelineName ++ "/jobs" ++ Concourse.instanceVarsQuery pi.pipelineInstanceVars) (Json.Decode.list (Concourse.decodeJob pi))
 
 
 fetchAllJobs : Task Http.Error (Maybe (List Concourse.Job))
 fetchAllJobs =
     Http.toTask <|
-        Http.get "/api/v1/jobs" (Json.Decode.nullable <| Json.Decode.list (Concourse.decodeJob { teamName = "", pipelineName = "" }))
+        Http.get "/api/v1/jobs" (Json.Decode.nullable <| Json.Decode.list (Concourse.decodeJob { teamName = "", pipelineName = "", pipelineInstanceVars = Dict.empty }))
 
 
 fetchJobsRaw : Concourse.PipelineIdentifier -> Task Http.Error Json.Decode.Value
 fetchJobsRaw pi =
     Http.toTask <|
-        Http.get ("/api/v1/teams/" ++ pi.teamName ++ "/pipelines/" ++ pi.pipelineName ++ "/jobs") Json.Decode.value
+        Http.get ("/api/v1/teams/" ++ pi.teamName ++ "/pipelines/" ++ pi.pipelineName ++ "/jobs" ++ Concourse.instanceVarsQuery pi.pipelineInstanceVars) Json.Decode.value
 
 
 triggerBuild : Concourse.JobIdentifier -> Concourse.CSRFToken -> Task Http.Error Concourse.Build
 triggerBuild job csrfToken =
-    HttpBuilder.post ("/api/v1/teams/" ++ job.teamName ++ "/pipelines/" ++ job.pipelineName ++ "/jobs/" ++ job.jobName ++ "/builds")
+    HttpBuilder.post ("/api/v1/teams/" ++ job.teamName ++ "/pipelines/" ++ job.pipelineName ++ "/jobs/" ++ job.jobName ++ "/builds" ++ Concourse.instanceVarsQuery job.pipelineInstanceVars)
         |> HttpBuilder.withHeader Concourse.csrfTokenHeaderName csrfToken
         |> HttpBuilder.withExpect (Http.expectJson Concourse.decodeBuild)
         |> HttpBuilder.toTask
@@ -59,7 +66,7 @@ unpause =
 
 
 pauseUnpause : Bool -> Concourse.JobIdentifier -> Concourse.CSRFToken -> Task Http.Error ()
-pauseUnpause shouldPause { teamName, pipelineName, jobName } csrfToken =
+pauseUnpause shouldPause { teamName, pipelineName, pipelineInstanceVars, jobName } csrfToken =
     let
         action =
             if shouldPause then
@@ -71,7 +78,7 @@ pauseUnpause shouldPause { teamName, pipelineName, jobName } csrfToken =
     Http.toTask <|
         Http.request
             { method = "PUT"
-            , url = "/api/v1/teams/" ++ teamName ++ "/pipelines/" ++ pipelineName ++ "/jobs/" ++ jobName ++ "/" ++ action
+            , url = "/api/v1/teams/" ++ teamName ++ "/pipelines/" ++ pipelineName ++ "/jobs/" ++ jobName ++ "/" ++ action ++ Concourse.instanceVarsQuery pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , body = Http.emptyBody
             , expect = Http.expectStringResponse (\_ -> Ok ())
@@ -39,14 +39,15 @@ import Url.Parser.Query as Query
 fetch :
     Json.Decode.Decoder a
     -> List String
+    -> List Url.Builder.QueryParameter
     -> Maybe Page
     -> Task Http.Error (Paginated a)
-fetch decoder segments p =
+fetch decoder segments query p =
     Http.toTask <|
         Http.request
             { method = "GET"
             , headers = []
-            , url = Url.Builder.absolute segments (params p)
+            , url = Url.Builder.absolute segments (query ++ params p)
             , body = Http.emptyBody
             , expect = Http.expectStringResponse (parsePagination decoder)
             , timeout = Nothing
@@ -29,10 +29,15 @@ order teamName pipelineNames csrfToken =
 
 
 fetchPipeline : Concourse.PipelineIdentifier -> Task Http.Error Concourse.Pipeline
-fetchPipeline { teamName, pipelineName } =
+fetchPipeline { teamName, pipelineName, pipelineInstanceVars } =
     Http.toTask <|
         Http.get
-            ("/api/v1/teams/" ++ teamName ++ "/pipelines/" ++ pipelineName)
+            ("/api/v1/teams/"
+                ++ teamName
+                ++ "/pipelines/"
+                ++ pipelineName
+                ++ Concourse.instanceVarsQuery pipelineInstanceVars
+            )
             Concourse.decodePipeline
 
 
@@ -46,8 +51,7 @@ fetchPipelines =
 
 togglePause :
     Bool
-    -> String
-    -> String
+    -> Concourse.PipelineIdentifier
     -> Concourse.CSRFToken
     -> Task Http.Error ()
 togglePause isPaused =
@@ -58,12 +62,19 @@ togglePause isPaused =
         putAction "pause"
 
 
-putAction : String -> String -> String -> Concourse.CSRFToken -> Task Http.Error ()
-putAction action teamName pipelineName csrfToken =
+putAction : String -> Concourse.PipelineIdentifier -> Concourse.CSRFToken -> Task Http.Error ()
+putAction action { teamName, pipelineName, pipelineInstanceVars } csrfToken =
     Http.toTask <|
         Http.request
             { method = "PUT"
-            , url = "/api/v1/teams/" ++ teamName ++ "/pipelines/" ++ pipelineName ++ "/" ++ action
+            , url =
+                "/api/v1/teams/"
+                    ++ teamName
+                    ++ "/pipelines/"
+                    ++ pipelineName
+                    ++ "/"
+                    ++ action
+                    ++ Concourse.instanceVarsQuery pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , expect = Http.expectStringResponse (always (Ok ()))
             , body = Http.emptyBody
@@ -74,11 +85,10 @@ putAction action teamName pipelineName csrfToken =
 
 changeVisibility :
     VisibilityAction
-    -> String
-    -> String
+    -> Concourse.PipelineIdentifier
     -> Concourse.CSRFToken
     -> Task Http.Error ()
-changeVisibility action teamName pipelineName csrfToken =
+changeVisibility action { teamName, pipelineName, pipelineInstanceVars } csrfToken =
     let
         endpoint =
             case action of
@@ -97,6 +107,7 @@ changeVisibility action teamName pipelineName csrfToken =
                     ++ "/pipelines/"
                     ++ pipelineName
                     ++ endpoint
+                    ++ Concourse.instanceVarsQuery pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , expect = Http.expectStringResponse (always (Ok ()))
             , body = Http.emptyBody
@@ -6,6 +6,7 @@ module Network.Resource exposing
     , fetchInputTo
     , fetchOutputOf
     , fetchResource
+    , fetchResourceChecks
     , fetchResourcesRaw
     , fetchVersionedResource
     , fetchVersionedResources
@@ -40,12 +41,28 @@ fetchResource rid =
             ++ rid.pipelineName
             ++ "/resources/"
             ++ rid.resourceName
+            ++ Concourse.instanceVarsQuery rid.pipelineInstanceVars
+
+
+fetchResourceChecks : Concourse.ResourceIdentifier -> Task Http.Error (List Concourse.Check)
+fetchResourceChecks rid =
+    Http.toTask
+        << (\a -> Http.get a (Json.Decode.list Concourse.decodeCheck))
+    <|
+        "/api/v1/teams/"
+            ++ rid.teamName
+            ++ "/pipelines/"
+            ++ rid.pipelineName
+            ++ "/resources/"
+            ++ rid.resourceName
+            ++ "/checks"
+            ++ Concourse.instanceVarsQuery rid.pipelineInstanceVars
 
 
 fetchResourcesRaw : Concourse.PipelineIdentifier -> Task Http.Error Json.Decode.Value
 fetchResourcesRaw pi =
     Http.toTask <|
-        Http.get ("/api/v1/teams/" ++ pi.teamName ++ "/pipelines/" ++ pi.pipelineName ++ "/resources") Json.Decode.value
+        Http.get ("/api/v1/teams/" ++ pi.teamName ++ "/pipelines/" ++ pi.pipelineName ++ "/resources" ++ Concourse.instanceVarsQuery pi.pipelineInstanceVars) Json.Decode.value
 
 
 fetchVersionedResource : Concourse.VersionedResourceIdentifier -> Task Http.Error Concourse.VersionedResource
@@ -61,6 +78,7 @@ fetchVersionedResource vrid =
             ++ vrid.resourceName
             ++ "/versions/"
             ++ String.fromInt vrid.versionID
+            ++ Concourse.instanceVarsQuery vrid.pipelineInstanceVars
 
 
 fetchVersionedResources :
@@ -81,7 +99,7 @@ fetchVersionedResources rid page =
             , "versions"
             ]
     in
-    Network.Pagination.fetch Concourse.decodeVersionedResource segments page
+    Network.Pagination.fetch Concourse.decodeVersionedResource segments (Concourse.instanceVarsQueryParams rid.pipelineInstanceVars) page
 
 
 enableDisableVersionedResource : Bool -> Concourse.VersionedResourceIdentifier -> Concourse.CSRFToken -> Task Http.Error ()
@@ -108,6 +126,7 @@ enableDisableVersionedResource enable vrid csrfToken =
                     ++ String.fromInt vrid.versionID
                     ++ "/"
                     ++ action
+                    ++ Concourse.instanceVarsQuery vrid.pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , body = Http.emptyBody
             , expect = Http.expectStringResponse (\_ -> Ok ())
@@ -141,6 +160,7 @@ fetchInputOutput action vrid =
             ++ String.fromInt vrid.versionID
             ++ "/"
             ++ action
+            ++ Concourse.instanceVarsQuery vrid.pipelineInstanceVars
 
 
 fetchCausality : Concourse.VersionedResourceIdentifier -> Task Http.Error (List Concourse.Cause)
@@ -156,6 +176,7 @@ fetchCausality vrid =
                 ++ "/versions/"
                 ++ String.fromInt vrid.versionID
                 ++ "/causality"
+                ++ Concourse.instanceVarsQuery vrid.pipelineInstanceVars
 
 
 pinVersion : Concourse.VersionedResourceIdentifier -> Concourse.CSRFToken -> Task Http.Error ()
@@ -173,6 +194,7 @@ pinVersion vrid csrfToken =
                     ++ "/versions/"
                     ++ String.fromInt vrid.versionID
                     ++ "/pin"
+                    ++ Concourse.instanceVarsQuery vrid.pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , body = Http.emptyBody
             , expect = Http.expectStringResponse (\_ -> Ok ())
@@ -197,6 +219,7 @@ unpinVersion rid csrfToken =
                     ++ "/resources/"
                     ++ rid.resourceName
                     ++ "/unpin"
+                    ++ Concourse.instanceVarsQuery rid.pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , body = Http.emptyBody
             , expect = Http.expectStringResponse (\_ -> Ok ())
@@ -208,7 +231,7 @@ unpinVersion rid csrfToken =
 check :
     Concourse.ResourceIdentifier
     -> Concourse.CSRFToken
-    -> Task Http.Error ()
+    -> Task Http.Error Int
 check rid csrfToken =
     Http.toTask <|
         Http.request
@@ -221,11 +244,12 @@ check rid csrfToken =
                     ++ "/resources/"
                     ++ rid.resourceName
                     ++ "/check"
+                    ++ Concourse.instanceVarsQuery rid.pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , body =
                 Http.jsonBody <|
                     Json.Encode.object [ ( "from", Json.Encode.null ) ]
-            , expect = Http.expectStringResponse (\_ -> Ok ())
+            , expect = Http.expectJson (Json.Decode.field "id" Json.Decode.int)
             , timeout = Nothing
             , withCredentials = False
             }
@@ -248,6 +272,7 @@ setPinComment rid csrfToken comment =
                     ++ "/resources/"
                     ++ rid.resourceName
                     ++ "/pin_comment"
+                    ++ Concourse.instanceVarsQuery rid.pipelineInstanceVars
             , headers = [ Http.header Concourse.csrfTokenHeaderName csrfToken ]
             , body =
                 Http.jsonBody <|
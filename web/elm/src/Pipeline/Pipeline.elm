@@ -376,7 +376,9 @@ subscriptions =
 
 documentTitle : Model -> String
 documentTitle model =
-    model.pipelineLocator.pipelineName
+    Concourse.pipelineRefString
+        model.pipelineLocator.pipelineName
+        model.pipelineLocator.pipelineInstanceVars
 
 
 view : Session -> Model -> Html Message
@@ -494,6 +496,7 @@ viewPinMenuDropdown { pinnedResources, pipeline, isPinMenuExpanded } =
                                         { id =
                                             { teamName = pipeline.teamName
                                             , pipelineName = pipeline.pipelineName
+                                            , pipelineInstanceVars = pipeline.pipelineInstanceVars
                                             , resourceName = resourceName
                                             }
                                         , page = Nothing
@@ -32,7 +32,11 @@ type alias Model =
         , checkStatus : CheckStatus
         , checkError : String
         , checkSetupError : String
+        , checkLog : String
+        , checkEventsUrl : Maybe String
         , lastChecked : Maybe Time.Posix
+        , latestCheckId : Maybe Int
+        , checks : List Concourse.Check
         , pinnedVersion : PinnedVersion
         , now : Maybe Time.Posix
         , resourceIdentifier : Concourse.ResourceIdentifier
@@ -15,6 +15,7 @@ module Resource.Resource exposing
     )
 
 import Application.Models exposing (Session)
+import Build.StepTree.Models as STModels
 import Concourse
 import Concourse.BuildStatus
 import Concourse.Pagination
@@ -105,7 +106,11 @@ init flags =
             , checkStatus = Models.CheckingSuccessfully
             , checkError = ""
             , checkSetupError = ""
+            , checkLog = ""
+            , checkEventsUrl = Nothing
             , lastChecked = Nothing
+            , latestCheckId = Nothing
+            , checks = []
             , pinnedVersion = NotPinned
             , currentPage = flags.paging
             , versions =
@@ -194,13 +199,20 @@ getUpdateMessage model =
         UpdateMsg.AOK
 
 
-subscriptions : List Subscription
-subscriptions =
+subscriptions : Model -> List Subscription
+subscriptions model =
     [ OnClockTick Subscription.FiveSeconds
     , OnClockTick Subscription.OneSecond
     , OnKeyDown
     , OnKeyUp
     ]
+        ++ (case model.checkEventsUrl of
+                Nothing ->
+                    []
+
+                Just url ->
+                    [ Subscription.FromEventSource ( url, [ "end", "event" ] ) ]
+           )
 
 
 handleCallback : Callback -> Session -> ET Model
@@ -212,6 +224,7 @@ handleCallback callback session ( model, effects ) =
                 , resourceIdentifier =
                     { teamName = resource.teamName
                     , pipelineName = resource.pipelineName
+                    , pipelineInstanceVars = resource.pipelineInstanceVars
                     , resourceName = resource.name
                     }
                 , checkStatus =
@@ -223,6 +236,7 @@ handleCallback callback session ( model, effects ) =
                 , checkError = resource.checkError
                 , checkSetupError = resource.checkSetupError
                 , lastChecked = resource.lastChecked
+                , latestCheckId = resource.latestCheckId
                 , icon = resource.icon
               }
                 |> updatePinnedVersion resource
@@ -234,8 +248,17 @@ handleCallback callback session ( model, effects ) =
                         Nothing ->
                             []
                    )
+                ++ (if resource.latestCheckId /= model.latestCheckId then
+                        [ FetchResourceChecks model.resourceIdentifier ]
+
+                    else
+                        []
+                   )
             )
 
+        ResourceChecksFetched (Ok checks) ->
+            ( { model | checks = checks }, effects )
+
         ResourceFetched (Err err) ->
             case err of
                 Http.BadStatus { status } ->
@@ -294,6 +317,7 @@ handleCallback callback session ( model, effects ) =
                                             { id =
                                                 { teamName = model.resourceIdentifier.teamName
                                                 , pipelineName = model.resourceIdentifier.pipelineName
+                                                , pipelineInstanceVars = model.resourceIdentifier.pipelineInstanceVars
                                                 , resourceName = model.resourceIdentifier.resourceName
                                                 , versionID = vr.id
                                                 }
@@ -416,13 +440,25 @@ handleCallback callback session ( model, effects ) =
             , effects
             )
 
-        Checked (Ok ()) ->
-            ( { model | checkStatus = Models.CheckingSuccessfully }
+        Checked (Ok checkID) ->
+            let
+                url =
+                    "/api/v1/checks/" ++ String.fromInt checkID ++ "/events"
+            in
+            ( { model
+                | checkStatus = Models.CheckingSuccessfully
+                , checkLog = ""
+                , checkEventsUrl = Just url
+              }
             , effects
                 ++ [ FetchResource model.resourceIdentifier
                    , FetchVersionedResources
                         model.resourceIdentifier
                         model.currentPage
+                   , OpenBuildEventStream
+                        { url = url
+                        , eventTypes = [ "end", "event" ]
+                        }
                    ]
             )
 
@@ -496,6 +532,32 @@ handleDelivery delivery ( model, effects ) =
                 ++ fetchDataForExpandedVersions model
             )
 
+        EventsReceived (Ok envelopes) ->
+            List.foldl handleCheckEvent ( model, effects ) envelopes
+
+        _ ->
+            ( model, effects )
+
+
+handleCheckEvent : STModels.BuildEventEnvelope -> ET Model
+handleCheckEvent envelope ( model, effects ) =
+    case envelope.data of
+        STModels.Log _ output _ ->
+            ( { model | checkLog = model.checkLog ++ output }, effects )
+
+        STModels.Error _ message _ ->
+            ( { model | checkLog = model.checkLog ++ message ++ "\n" }, effects )
+
+        STModels.End ->
+            ( { model | checkEventsUrl = Nothing }
+            , effects
+                ++ [ CloseBuildEventStream
+                   , FetchResource model.resourceIdentifier
+                   , FetchVersionedResources model.resourceIdentifier model.currentPage
+                   , FetchResourceChecks model.resourceIdentifier
+                   ]
+            )
+
         _ ->
             ( model, effects )
 
@@ -735,6 +797,7 @@ view session model =
             [ SideBar.view session
                 (Just
                     { pipelineName = model.resourceIdentifier.pipelineName
+                    , pipelineInstanceVars = model.resourceIdentifier.pipelineInstanceVars
                     , teamName = model.resourceIdentifier.teamName
                     }
                 )
@@ -798,7 +861,7 @@ header session model =
 
 
 body :
-    { a | userState : UserState, hovered : HoverState.HoverState }
+    { a | userState : UserState, hovered : HoverState.HoverState, timeZone : Time.Zone }
     -> Model
     -> Html Message
 body session model =
@@ -807,6 +870,8 @@ body session model =
             { checkStatus = model.checkStatus
             , checkSetupError = model.checkSetupError
             , checkError = model.checkError
+            , checkLog = model.checkLog
+            , latestCheckId = model.latestCheckId
             , hovered = session.hovered
             , userState = session.userState
             , teamName = model.resourceIdentifier.teamName
@@ -815,10 +880,68 @@ body session model =
     Html.div
         (id "body" :: Resource.Styles.body)
         [ checkSection sectionModel
+        , checkHistory session model
         , viewVersionedResources session model
         ]
 
 
+checkHistory :
+    { a | timeZone : Time.Zone }
+    -> { b | now : Maybe Time.Posix, checks : List Concourse.Check }
+    -> Html Message
+checkHistory { timeZone } { now, checks } =
+    if List.isEmpty checks then
+        Html.text ""
+
+    else
+        Html.table
+            (id "recent-checks" :: Resource.Styles.checkHistory)
+            (List.map (viewCheck timeZone now) checks)
+
+
+viewCheck : Time.Zone -> Maybe Time.Posix -> Concourse.Check -> Html Message
+viewCheck timeZone now check =
+    let
+        created =
+            case ( now, check.createTime ) of
+                ( Just n, Just date ) ->
+                    Html.td
+                        [ title <| formatDate timeZone date ]
+                        [ Html.text (Duration.format (Duration.between date n) ++ " ago") ]
+
+                _ ->
+                    Html.td [] []
+    in
+    Html.tr
+        [ class "check"
+        , title <| Maybe.withDefault "" check.checkError
+        ]
+        [ Html.td [] [ Html.text <| "#" ++ String.fromInt check.id ]
+        , Html.td [] [ Html.text <| checkStatusText check.status ]
+        , Html.td [] [ Html.text check.createdBy ]
+        , created
+        ]
+
+
+checkStatusText : Concourse.CheckStatus -> String
+checkStatusText status =
+    case status of
+        Concourse.CheckStatusPending ->
+            "pending"
+
+        Concourse.CheckStatusStarted ->
+            "started"
+
+        Concourse.CheckStatusSucceeded ->
+            "succeeded"
+
+        Concourse.CheckStatusErrored ->
+            "errored"
+
+        Concourse.CheckStatusSkipped ->
+            "skipped"
+
+
 paginationMenu :
     { a | hovered : HoverState.HoverState }
     ->
@@ -931,12 +1054,14 @@ checkSection :
         | checkStatus : Models.CheckStatus
         , checkSetupError : String
         , checkError : String
+        , checkLog : String
+        , latestCheckId : Maybe Int
         , hovered : HoverState.HoverState
         , userState : UserState
         , teamName : String
     }
     -> Html Message
-checkSection ({ checkStatus, checkSetupError, checkError } as model) =
+checkSection ({ checkStatus, checkSetupError, checkError, checkLog, latestCheckId } as model) =
     let
         failingToCheck =
             checkStatus == Models.FailingToCheck
@@ -966,6 +1091,12 @@ checkSection ({ checkStatus, checkSetupError, checkError } as model) =
                         ]
                     ]
 
+            else if not (String.isEmpty checkLog) then
+                [ Html.div [ class "step-body" ]
+                    [ Html.pre [] [ Html.text checkLog ]
+                    ]
+                ]
+
             else
                 []
 
@@ -993,6 +1124,14 @@ checkSection ({ checkStatus, checkSetupError, checkError } as model) =
             Html.div
                 Resource.Styles.checkBarStatus
                 [ Html.h3 [] [ Html.text checkMessage ]
+                , case latestCheckId of
+                    Just checkId ->
+                        Html.span
+                            (id "latest-check-id" :: Resource.Styles.latestCheckId)
+                            [ Html.text <| "check #" ++ String.fromInt checkId ]
+
+                    Nothing ->
+                        Html.text ""
                 , statusIcon
                 ]
 
@@ -1569,6 +1708,7 @@ viewBuildsByJob buildDict jobName =
                                     { id =
                                         { teamName = job.teamName
                                         , pipelineName = job.pipelineName
+                                        , pipelineInstanceVars = job.pipelineInstanceVars
                                         , jobName = job.jobName
                                         , buildName = build.name
                                         }
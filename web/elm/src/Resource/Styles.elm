@@ -3,6 +3,7 @@ module Resource.Styles exposing
     , checkBarStatus
     , checkButton
     , checkButtonIcon
+    , checkHistory
     , checkStatusIcon
     , commentBar
     , commentBarContent
@@ -18,6 +19,7 @@ module Resource.Styles exposing
     , headerHeight
     , headerLastCheckedSection
     , headerResourceName
+    , latestCheckId
     , pagination
     , pinBar
     , pinBarTooltip
@@ -362,6 +364,21 @@ checkBarStatus =
     ]
 
 
+latestCheckId : List (Html.Attribute msg)
+latestCheckId =
+    [ style "margin-left" "auto"
+    , style "margin-right" "10px"
+    , style "color" Colors.pending
+    ]
+
+
+checkHistory : List (Html.Attribute msg)
+checkHistory =
+    [ style "margin" "5px 0 10px 0"
+    , style "color" Colors.pending
+    ]
+
+
 checkButton : Bool -> List (Html.Attribute msg)
 checkButton isClickable =
     [ style "height" "28px"
@@ -69,11 +69,12 @@ type alias StepID =
 build : Parser (Route -> a) a
 build =
     let
-        buildHelper teamName pipelineName jobName buildName h =
+        buildHelper teamName pipelineName jobName buildName instanceVars h =
             Build
                 { id =
                     { teamName = teamName
                     , pipelineName = pipelineName
+                    , pipelineInstanceVars = Concourse.parseInstanceVars instanceVars
                     , jobName = jobName
                     , buildName = buildName
                     }
@@ -89,6 +90,7 @@ build =
             </> string
             </> s "builds"
             </> string
+            <?> Query.string "instance_vars"
             </> fragment parseHighlight
         )
 
@@ -122,11 +124,12 @@ parsePage since until limit =
 resource : Parser (Route -> a) a
 resource =
     let
-        resourceHelper teamName pipelineName resourceName since until limit =
+        resourceHelper teamName pipelineName resourceName instanceVars since until limit =
             Resource
                 { id =
                     { teamName = teamName
                     , pipelineName = pipelineName
+                    , pipelineInstanceVars = Concourse.parseInstanceVars instanceVars
                     , resourceName = resourceName
                     }
                 , page = parsePage since until limit
@@ -139,6 +142,7 @@ resource =
             </> string
             </> s "resources"
             </> string
+            <?> Query.string "instance_vars"
             <?> Query.int "since"
             <?> Query.int "until"
             <?> Query.int "limit"
@@ -148,11 +152,12 @@ resource =
 job : Parser (Route -> a) a
 job =
     let
-        jobHelper teamName pipelineName jobName since until limit =
+        jobHelper teamName pipelineName jobName instanceVars since until limit =
             Job
                 { id =
                     { teamName = teamName
                     , pipelineName = pipelineName
+                    , pipelineInstanceVars = Concourse.parseInstanceVars instanceVars
                     , jobName = jobName
                     }
                 , page = parsePage since until limit
@@ -165,6 +170,7 @@ job =
             </> string
             </> s "jobs"
             </> string
+            <?> Query.string "instance_vars"
             <?> Query.int "since"
             <?> Query.int "until"
             <?> Query.int "limit"
@@ -174,11 +180,12 @@ job =
 pipeline : Parser (Route -> a) a
 pipeline =
     map
-        (\t p g ->
+        (\t p iv g ->
             Pipeline
                 { id =
                     { teamName = t
                     , pipelineName = p
+                    , pipelineInstanceVars = Concourse.parseInstanceVars iv
                     }
                 , groups = g
                 }
@@ -187,6 +194,7 @@ pipeline =
             </> string
             </> s "pipelines"
             </> string
+            <?> Query.string "instance_vars"
             <?> Query.custom "group" identity
         )
 
@@ -216,6 +224,7 @@ buildRoute b =
                 { id =
                     { teamName = j.teamName
                     , pipelineName = j.pipelineName
+                    , pipelineInstanceVars = j.pipelineInstanceVars
                     , jobName = j.jobName
                     , buildName = b.name
                     }
@@ -232,15 +241,23 @@ jobRoute j =
         { id =
             { teamName = j.teamName
             , pipelineName = j.pipelineName
+            , pipelineInstanceVars = j.pipelineInstanceVars
             , jobName = j.name
             }
         , page = Nothing
         }
 
 
-pipelineRoute : { a | name : String, teamName : String } -> Route
+pipelineRoute : { a | name : String, teamName : String, instanceVars : Concourse.InstanceVars } -> Route
 pipelineRoute p =
-    Pipeline { id = { teamName = p.teamName, pipelineName = p.name }, groups = [] }
+    Pipeline
+        { id =
+            { teamName = p.teamName
+            , pipelineName = p.name
+            , pipelineInstanceVars = p.instanceVars
+            }
+        , groups = []
+        }
 
 
 dashboardRoute : Bool -> Route
@@ -328,29 +345,27 @@ sitemap =
         ]
 
 
-pageToQueryString : Maybe Pagination.Page -> String
-pageToQueryString page =
+pageToQueryParams : Maybe Pagination.Page -> List Builder.QueryParameter
+pageToQueryParams page =
     case page of
         Nothing ->
-            ""
+            []
 
         Just { direction, limit } ->
-            "?"
-                ++ (case direction of
-                        Since id ->
-                            "since=" ++ String.fromInt id
+            [ case direction of
+                Since id ->
+                    Builder.int "since" id
 
-                        Until id ->
-                            "until=" ++ String.fromInt id
+                Until id ->
+                    Builder.int "until" id
 
-                        From id ->
-                            "from=" ++ String.fromInt id
+                From id ->
+                    Builder.int "from" id
 
-                        To id ->
-                            "to=" ++ String.fromInt id
-                   )
-                ++ "&limit="
-                ++ String.fromInt limit
+                To id ->
+                    Builder.int "to" id
+            , Builder.int "limit" limit
+            ]
 
 
 toString : Route -> String
@@ -365,6 +380,7 @@ toString route =
                 ++ id.jobName
                 ++ "/builds/"
                 ++ id.buildName
+                ++ Concourse.instanceVarsQuery id.pipelineInstanceVars
                 ++ showHighlight highlight
 
         Job { id, page } ->
@@ -374,7 +390,10 @@ toString route =
                 ++ id.pipelineName
                 ++ "/jobs/"
                 ++ id.jobName
-                ++ pageToQueryString page
+                ++ Builder.toQuery
+                    (Concourse.instanceVarsQueryParams id.pipelineInstanceVars
+                        ++ pageToQueryParams page
+                    )
 
         Resource { id, page } ->
             "/teams/"
@@ -383,7 +402,10 @@ toString route =
                 ++ id.pipelineName
                 ++ "/resources/"
                 ++ id.resourceName
-                ++ pageToQueryString page
+                ++ Builder.toQuery
+                    (Concourse.instanceVarsQueryParams id.pipelineInstanceVars
+                        ++ pageToQueryParams page
+                    )
 
         OneOffBuild { id, highlight } ->
             "/builds/"
@@ -395,13 +417,10 @@ toString route =
                 ++ id.teamName
                 ++ "/pipelines/"
                 ++ id.pipelineName
-                ++ (case groups of
-                        [] ->
-                            ""
-
-                        gs ->
-                            "?group=" ++ String.join "&group=" gs
-                   )
+                ++ Builder.toQuery
+                    (Concourse.instanceVarsQueryParams id.pipelineInstanceVars
+                        ++ List.map (Builder.string "group") groups
+                    )
 
         Dashboard (Normal (Just search)) ->
             "/?search=" ++ search
@@ -432,13 +451,25 @@ extractPid : Route -> Maybe Concourse.PipelineIdentifier
 extractPid route =
     case route of
         Build { id } ->
-            Just { teamName = id.teamName, pipelineName = id.pipelineName }
+            Just
+                { teamName = id.teamName
+                , pipelineName = id.pipelineName
+                , pipelineInstanceVars = id.pipelineInstanceVars
+                }
 
         Job { id } ->
-            Just { teamName = id.teamName, pipelineName = id.pipelineName }
+            Just
+                { teamName = id.teamName
+                , pipelineName = id.pipelineName
+                , pipelineInstanceVars = id.pipelineInstanceVars
+                }
 
         Resource { id } ->
-            Just { teamName = id.teamName, pipelineName = id.pipelineName }
+            Just
+                { teamName = id.teamName
+                , pipelineName = id.pipelineName
+                , pipelineInstanceVars = id.pipelineInstanceVars
+                }
 
         Pipeline { id } ->
             Just id
@@ -12,6 +12,7 @@ type alias PipelineScoped a =
     { a
         | teamName : String
         , pipelineName : String
+        , pipelineInstanceVars : Concourse.InstanceVars
     }
 
 
@@ -27,13 +28,16 @@ pipeline session p =
         isCurrent =
             case session.currentPipeline of
                 Just cp ->
-                    cp.pipelineName == p.name && cp.teamName == p.teamName
+                    cp.pipelineName == p.name && cp.pipelineInstanceVars == p.instanceVars && cp.teamName == p.teamName
 
                 Nothing ->
                     False
 
         pipelineId =
-            { pipelineName = p.name, teamName = p.teamName }
+            { pipelineName = p.name
+            , pipelineInstanceVars = p.instanceVars
+            , teamName = p.teamName
+            }
 
         isHovered =
             HoverState.isHovered (SideBarPipeline pipelineId) session.hovered
@@ -63,7 +67,7 @@ pipeline session p =
         , href =
             Routes.toString <|
                 Routes.Pipeline { id = pipelineId, groups = [] }
-        , text = p.name
+        , text = Concourse.pipelineRefString p.name p.instanceVars
         , domID = SideBarPipeline pipelineId
         , tooltip =
             HoverState.tooltip
@@ -348,8 +348,8 @@ subscriptions mdl =
         PipelineModel _ ->
             Pipeline.subscriptions
 
-        ResourceModel _ ->
-            Resource.subscriptions
+        ResourceModel model ->
+            Resource.subscriptions model
 
         DashboardModel _ ->
             Dashboard.subscriptions
@@ -32,6 +32,7 @@ breadcrumbs route =
                 [ pipelineBreadcumb
                     { teamName = id.teamName
                     , pipelineName = id.pipelineName
+                    , pipelineInstanceVars = id.pipelineInstanceVars
                     }
                 ]
 
@@ -39,6 +40,7 @@ breadcrumbs route =
                 [ pipelineBreadcumb
                     { teamName = id.teamName
                     , pipelineName = id.pipelineName
+                    , pipelineInstanceVars = id.pipelineInstanceVars
                     }
                 , breadcrumbSeparator
                 , jobBreadcrumb id.jobName
@@ -48,6 +50,7 @@ breadcrumbs route =
                 [ pipelineBreadcumb
                     { teamName = id.teamName
                     , pipelineName = id.pipelineName
+                    , pipelineInstanceVars = id.pipelineInstanceVars
                     }
                 , breadcrumbSeparator
                 , resourceBreadcrumb id.resourceName
@@ -57,6 +60,7 @@ breadcrumbs route =
                 [ pipelineBreadcumb
                     { teamName = id.teamName
                     , pipelineName = id.pipelineName
+                    , pipelineInstanceVars = id.pipelineInstanceVars
                     }
                 , breadcrumbSeparator
                 , jobBreadcrumb id.jobName
@@ -92,7 +96,11 @@ pipelineBreadcumb pipelineId =
          ]
             ++ Styles.breadcrumbItem True
         )
-        (breadcrumbComponent "pipeline" pipelineId.pipelineName)
+        (breadcrumbComponent "pipeline" <|
+            Concourse.pipelineRefString
+                pipelineId.pipelineName
+                pipelineId.pipelineInstanceVars
+        )
 
 
 jobBreadcrumb : String -> Html Message
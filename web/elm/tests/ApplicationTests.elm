@@ -3,6 +3,7 @@ module ApplicationTests exposing (all)
 import Application.Application as Application
 import Browser
 import Common exposing (queryView)
+import Dict
 import Expect
 import Message.Effects as Effects
 import Message.Subscription as Subscription exposing (Delivery(..))
@@ -63,7 +64,7 @@ all =
             \_ ->
                 let
                     pipelineIdentifier =
-                        { pipelineName = "p", teamName = "t" }
+                        { pipelineName = "p", pipelineInstanceVars = Dict.empty, teamName = "t" }
                 in
                 Common.init "/"
                     |> Application.update
@@ -48,6 +48,7 @@ all =
             buildId =
                 { teamName = "team"
                 , pipelineName = "pipeline"
+                , pipelineInstanceVars = Dict.empty
                 , jobName = "job"
                 , buildName = "1"
                 }
@@ -75,6 +76,7 @@ all =
                     Just
                         { teamName = "team"
                         , pipelineName = "pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , jobName = "job"
                         }
                 , status = Concourse.BuildStatusSucceeded
@@ -93,6 +95,7 @@ all =
                     Just
                         { teamName = "team"
                         , pipelineName = "pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , jobName = "job"
                         }
                 , status = Concourse.BuildStatusStarted
@@ -174,9 +177,11 @@ all =
                             { pipeline =
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 }
                             , name = "job"
                             , pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , teamName = "team"
                             , nextBuild = Nothing
                             , finishedBuild = Nothing
@@ -198,9 +203,11 @@ all =
                             { pipeline =
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 }
                             , name = "job"
                             , pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , teamName = "team"
                             , nextBuild = Nothing
                             , finishedBuild = Nothing
@@ -262,6 +269,7 @@ all =
                                         Just
                                             { teamName = "t"
                                             , pipelineName = "p"
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "j"
                                             }
                                   , status = Concourse.BuildStatusStarted
@@ -360,6 +368,7 @@ all =
                                         Just
                                             { teamName = "t"
                                             , pipelineName = "p"
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "j"
                                             }
                                   , status = Concourse.BuildStatusStarted
@@ -435,6 +444,7 @@ all =
                                         Just
                                             { teamName = "t"
                                             , pipelineName = "p"
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "j"
                                             }
                                   , status = Concourse.BuildStatusStarted
@@ -510,6 +520,7 @@ all =
                                         Just
                                             { teamName = "t"
                                             , pipelineName = "p"
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "j"
                                             }
                                   , status = Concourse.BuildStatusStarted
@@ -638,6 +649,7 @@ all =
                                         Just
                                             { teamName = "team"
                                             , pipelineName = "pipeline"
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "job"
                                             }
                                   , status = Concourse.BuildStatusSucceeded
@@ -693,6 +705,7 @@ all =
                                         Just
                                             { teamName = "team"
                                             , pipelineName = "pipeline"
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "job"
                                             }
                                   , status = Concourse.BuildStatusAborted
@@ -739,6 +752,7 @@ all =
                                         Just
                                             { teamName = "team"
                                             , pipelineName = "pipeline"
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "job"
                                             }
                                   , status = Concourse.BuildStatusPending
@@ -1031,9 +1045,11 @@ all =
                                 { pipeline =
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                                 , name = ""
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , teamName = "team"
                                 , nextBuild = Nothing
                                 , finishedBuild = Nothing
@@ -1080,6 +1096,7 @@ all =
                         [ Effects.DoTriggerBuild
                             { teamName = "team"
                             , pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , jobName = "job"
                             }
                         ]
@@ -1189,6 +1206,7 @@ all =
                         (Effects.FetchJobBuild 1
                             { teamName = "team"
                             , pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , jobName = "job"
                             , buildName = "1"
                             }
@@ -1304,6 +1322,7 @@ all =
                             (Effects.FetchBuildHistory
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , jobName = "job"
                                 }
                                 Nothing
@@ -1314,6 +1333,7 @@ all =
                             (Effects.FetchBuildJobDetails
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , jobName = "job"
                                 }
                             )
@@ -1544,6 +1564,7 @@ all =
                                                 Just
                                                     { teamName = "team"
                                                     , pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , jobName = "job"
                                                     }
                                           , status = Concourse.BuildStatusSucceeded
@@ -1593,6 +1614,7 @@ all =
                                                 Just
                                                     { teamName = "team"
                                                     , pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , jobName = "job"
                                                     }
                                           , status = Concourse.BuildStatusSucceeded
@@ -1639,6 +1661,7 @@ all =
                                                 Just
                                                     { teamName = "team"
                                                     , pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , jobName = "job"
                                                     }
                                           , status = Concourse.BuildStatusSucceeded
@@ -1722,6 +1745,7 @@ all =
                             [ Effects.FetchBuildHistory
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , jobName = "job"
                                 }
                                 (Just { direction = Until 1, limit = 100 })
@@ -1796,6 +1820,7 @@ all =
                                                 Just
                                                     { teamName = "team"
                                                     , pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , jobName = "job"
                                                     }
                                           , status = Concourse.BuildStatusSucceeded
@@ -1814,6 +1839,7 @@ all =
                             (Effects.FetchBuildHistory
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , jobName = "job"
                                 }
                                 (Just { direction = Until 2, limit = 100 })
@@ -1910,6 +1936,7 @@ all =
                                                 Just
                                                     { teamName = "team"
                                                     , pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , jobName = "job"
                                                     }
                                           , status = Concourse.BuildStatusSucceeded
@@ -1928,6 +1955,7 @@ all =
                             [ Effects.FetchBuildHistory
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , jobName = "job"
                                 }
                                 (Just { direction = Until 2, limit = 100 })
@@ -3363,6 +3391,7 @@ all =
                                                 Just
                                                     { teamName = "t"
                                                     , pipelineName = "p"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , jobName = "j"
                                                     }
                                           , status = Concourse.BuildStatusStarted
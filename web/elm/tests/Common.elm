@@ -13,6 +13,7 @@ module Common exposing
 
 import Application.Application as Application
 import Concourse
+import Dict
 import Expect exposing (Expectation)
 import Html
 import Message.Callback as Callback
@@ -123,6 +124,7 @@ myBrowserFetchedTheBuild =
                             Just
                                 { teamName = "other-team"
                                 , pipelineName = "yet-another-pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , jobName = "job"
                                 }
                       , status = Concourse.BuildStatusStarted
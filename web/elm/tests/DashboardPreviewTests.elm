@@ -5,6 +5,7 @@ import Colors
 import Common exposing (defineHoverBehaviour, isColorWithStripes, queryView)
 import Concourse
 import Dashboard.DashboardPreview as DP
+import Dict
 import Expect
 import Message.Callback as Callback
 import Message.Message exposing (DomID(..))
@@ -181,6 +182,7 @@ dashboardWithJob j =
                       , pipelines =
                             [ { id = 0
                               , name = "pipeline"
+                              , instanceVars = Dict.empty
                               , paused = False
                               , public = True
                               , teamName = "team"
@@ -210,9 +212,11 @@ job =
     { pipeline =
         { teamName = "team"
         , pipelineName = "pipeline"
+        , pipelineInstanceVars = Dict.empty
         }
     , name = "job"
     , pipelineName = "pipeline"
+    , pipelineInstanceVars = Dict.empty
     , teamName = "team"
     , nextBuild = Nothing
     , finishedBuild = Nothing
@@ -264,5 +268,6 @@ jobId : Concourse.JobIdentifier
 jobId =
     { teamName = "team"
     , pipelineName = "pipeline"
+    , pipelineInstanceVars = Dict.empty
     , jobName = "job"
     }
@@ -3,6 +3,7 @@ module DashboardSearchTests exposing (all)
 import Application.Application as Application
 import Common exposing (queryView)
 import Concourse
+import Dict
 import Expect exposing (Expectation)
 import Message.Callback as Callback
 import Message.Message
@@ -47,6 +48,7 @@ all =
                           , pipelines =
                                 [ { id = 0
                                   , name = "pipeline"
+                                  , instanceVars = Dict.empty
                                   , paused = False
                                   , public = True
                                   , teamName = "team1"
@@ -57,9 +59,11 @@ all =
                                 [ { pipeline =
                                         { teamName = "team1"
                                         , pipelineName = "pipeline"
+                                        , pipelineInstanceVars = Dict.empty
                                         }
                                   , name = "job"
                                   , pipelineName = "pipeline"
+                                  , pipelineInstanceVars = Dict.empty
                                   , teamName = "team1"
                                   , nextBuild =
                                         Just
@@ -69,6 +73,7 @@ all =
                                                 Just
                                                     { teamName = "team1"
                                                     , pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , jobName = "job"
                                                     }
                                             , status = Concourse.BuildStatusStarted
@@ -181,6 +186,7 @@ all =
                           , pipelines =
                                 [ { id = 0
                                   , name = "pipeline"
+                                  , instanceVars = Dict.empty
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
@@ -1710,6 +1710,7 @@ all =
                                         , pipelines =
                                             [ { id = 0
                                               , name = "pipeline"
+                                              , instanceVars = Dict.empty
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
@@ -1720,6 +1721,7 @@ all =
                                         , resources =
                                             [ { teamName = "team"
                                               , pipelineName = "pipeline"
+                                              , pipelineInstanceVars = Dict.empty
                                               , name = "resource"
                                               , failingToCheck = True
                                               , checkError = ""
@@ -1728,6 +1730,7 @@ all =
                                               , pinnedVersion = Nothing
                                               , pinnedInConfig = False
                                               , pinComment = Nothing
+                                              , latestCheckId = Nothing
                                               , icon = Nothing
                                               }
                                             ]
@@ -2109,6 +2112,7 @@ all =
                         let
                             pipelineId =
                                 { pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 , teamName = "team"
                                 }
 
@@ -2700,6 +2704,7 @@ all =
                             , hoverable =
                                 Msgs.PipelineButton
                                     { pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = "team"
                                     }
                             , hoveredSelector =
@@ -2743,6 +2748,7 @@ all =
                             , hoverable =
                                 Msgs.PipelineButton
                                     { pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = "team"
                                     }
                             , hoveredSelector =
@@ -2773,6 +2779,7 @@ all =
                                             Msgs.Click <|
                                                 Msgs.PipelineButton
                                                     { pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , teamName = "team"
                                                     }
                                         )
@@ -2792,6 +2799,7 @@ all =
                                             Msgs.Click <|
                                                 Msgs.PipelineButton
                                                     { pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , teamName = "team"
                                                     }
                                         )
@@ -2811,6 +2819,7 @@ all =
                                             Msgs.Click <|
                                                 Msgs.PipelineButton
                                                     { pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , teamName = "team"
                                                     }
                                         )
@@ -2818,6 +2827,7 @@ all =
                                     |> Expect.equal
                                         [ Effects.SendTogglePipelineRequest
                                             { pipelineName = "pipeline"
+                                            , pipelineInstanceVars = Dict.empty
                                             , teamName = "team"
                                             }
                                             False
@@ -2834,6 +2844,7 @@ all =
                                             Msgs.Click <|
                                                 Msgs.PipelineButton
                                                     { pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , teamName = "team"
                                                     }
                                         )
@@ -2841,6 +2852,7 @@ all =
                                     |> Application.handleCallback
                                         (Callback.PipelineToggled
                                             { pipelineName = "pipeline"
+                                            , pipelineInstanceVars = Dict.empty
                                             , teamName = "team"
                                             }
                                             (Ok ())
@@ -2858,6 +2870,7 @@ all =
                                             Msgs.Click <|
                                                 Msgs.PipelineButton
                                                     { pipelineName = "pipeline"
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , teamName = "team"
                                                     }
                                         )
@@ -2865,6 +2878,7 @@ all =
                                     |> Application.handleCallback
                                         (Callback.PipelineToggled
                                             { pipelineName = "pipeline"
+                                            , pipelineInstanceVars = Dict.empty
                                             , teamName = "team"
                                             }
                                             (Err <|
@@ -3600,6 +3614,7 @@ givenPipelineWithJob user =
     , pipelines =
         [ { id = 0
           , name = "pipeline"
+          , instanceVars = Dict.empty
           , paused = False
           , public = True
           , teamName = "team"
@@ -3610,16 +3625,18 @@ givenPipelineWithJob user =
         [ { pipeline =
                 { teamName = "team"
                 , pipelineName = "pipeline"
+                , pipelineInstanceVars = Dict.empty
                 }
           , name = "job"
           , pipelineName = "pipeline"
+          , pipelineInstanceVars = Dict.empty
           , teamName = "team"
           , nextBuild = Nothing
           , finishedBuild =
                 Just
                     { id = 0
                     , name = "1"
-                    , job = Just { teamName = "team", pipelineName = "pipeline", jobName = "job" }
+                    , job = Just { teamName = "team", pipelineName = "pipeline", pipelineInstanceVars = Dict.empty, jobName = "job" }
                     , status = Concourse.BuildStatusSucceeded
                     , duration = { startedAt = Nothing, finishedAt = Nothing }
                     , reapTime = Nothing
@@ -3644,6 +3661,7 @@ oneTeamOnePipelinePaused teamName user =
     , pipelines =
         [ { id = 0
           , name = "pipeline"
+          , instanceVars = Dict.empty
           , paused = True
           , public = True
           , teamName = teamName
@@ -3663,6 +3681,7 @@ oneTeamOnePipelineNonPublic teamName user =
     , pipelines =
         [ { id = 0
           , name = "pipeline"
+          , instanceVars = Dict.empty
           , paused = False
           , public = False
           , teamName = teamName
@@ -3685,6 +3704,7 @@ onePipeline : String -> Concourse.Pipeline
 onePipeline teamName =
     { id = 0
     , name = "pipeline"
+    , instanceVars = Dict.empty
     , paused = False
     , public = True
     , teamName = teamName
@@ -3696,6 +3716,7 @@ onePipelinePaused : String -> Concourse.Pipeline
 onePipelinePaused teamName =
     { id = 0
     , name = "pipeline"
+    , instanceVars = Dict.empty
     , paused = True
     , public = True
     , teamName = teamName
@@ -3715,6 +3736,7 @@ apiData pipelines user =
                             (\i p ->
                                 { id = i
                                 , name = p
+                                , instanceVars = Dict.empty
                                 , paused = False
                                 , public = True
                                 , teamName = teamName
@@ -3740,6 +3762,7 @@ running j =
                     Just
                         { teamName = "team"
                         , pipelineName = "pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , jobName = "job"
                         }
                 , status = Concourse.BuildStatusStarted
@@ -3767,9 +3790,11 @@ jobWithNameTransitionedAt jobName transitionedAt status =
     { pipeline =
         { teamName = "team"
         , pipelineName = "pipeline"
+        , pipelineInstanceVars = Dict.empty
         }
     , name = jobName
     , pipelineName = "pipeline"
+    , pipelineInstanceVars = Dict.empty
     , teamName = "team"
     , nextBuild = Nothing
     , finishedBuild =
@@ -3780,6 +3805,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                 Just
                     { teamName = "team"
                     , pipelineName = "pipeline"
+                    , pipelineInstanceVars = Dict.empty
                     , jobName = jobName
                     }
             , status = status
@@ -3799,6 +3825,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                         Just
                             { teamName = "team"
                             , pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , jobName = jobName
                             }
                     , status = status
@@ -3822,9 +3849,11 @@ circularJobs =
     [ { pipeline =
             { teamName = "team"
             , pipelineName = "pipeline"
+            , pipelineInstanceVars = Dict.empty
             }
       , name = "jobA"
       , pipelineName = "pipeline"
+      , pipelineInstanceVars = Dict.empty
       , teamName = "team"
       , nextBuild = Nothing
       , finishedBuild =
@@ -3835,6 +3864,7 @@ circularJobs =
                     Just
                         { teamName = "team"
                         , pipelineName = "pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , jobName = "jobA"
                         }
                 , status = Concourse.BuildStatusSucceeded
@@ -3852,6 +3882,7 @@ circularJobs =
                     Just
                         { teamName = "team"
                         , pipelineName = "pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , jobName = "jobA"
                         }
                 , status = Concourse.BuildStatusSucceeded
@@ -3876,9 +3907,11 @@ circularJobs =
     , { pipeline =
             { teamName = "team"
             , pipelineName = "pipeline"
+            , pipelineInstanceVars = Dict.empty
             }
       , name = "jobB"
       , pipelineName = "pipeline"
+      , pipelineInstanceVars = Dict.empty
       , teamName = "team"
       , nextBuild = Nothing
       , finishedBuild =
@@ -3889,6 +3922,7 @@ circularJobs =
                     Just
                         { teamName = "team"
                         , pipelineName = "pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , jobName = "jobB"
                         }
                 , status = Concourse.BuildStatusSucceeded
@@ -3906,6 +3940,7 @@ circularJobs =
                     Just
                         { teamName = "team"
                         , pipelineName = "pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , jobName = "jobB"
                         }
                 , status = Concourse.BuildStatusSucceeded
@@ -43,12 +43,14 @@ all =
                 someJobInfo =
                     { jobName = "some-job"
                     , pipelineName = "some-pipeline"
+                    , pipelineInstanceVars = Dict.empty
                     , teamName = "some-team"
                     }
 
                 jobInfo =
                     { jobName = "job"
                     , pipelineName = "pipeline"
+                    , pipelineInstanceVars = Dict.empty
                     , teamName = "team"
                     }
 
@@ -69,9 +71,11 @@ all =
                 someJob =
                     { name = "some-job"
                     , pipelineName = "some-pipeline"
+                    , pipelineInstanceVars = Dict.empty
                     , teamName = "some-team"
                     , pipeline =
                         { pipelineName = "some-pipeline"
+                        , pipelineInstanceVars = Dict.empty
                         , teamName = "some-team"
                         }
                     , nextBuild = Nothing
@@ -116,9 +120,11 @@ all =
                                 Ok
                                     { name = "job"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = "team"
                                     , pipeline =
                                         { pipelineName = "pipeline"
+                                        , pipelineInstanceVars = Dict.empty
                                         , teamName = "team"
                                         }
                                     , nextBuild = Nothing
@@ -201,6 +207,7 @@ all =
                                     jobId =
                                         { jobName = "job"
                                         , pipelineName = "pipeline"
+                                        , pipelineInstanceVars = Dict.empty
                                         , teamName = "team"
                                         }
 
@@ -477,6 +484,7 @@ all =
                                 jobId =
                                     { jobName = "job"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = "team"
                                     }
 
@@ -516,6 +524,7 @@ all =
                                 jobId =
                                     { jobName = "job"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = "team"
                                     }
 
@@ -574,6 +583,7 @@ all =
                                 jobId =
                                     { jobName = "job"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = "team"
                                     }
 
@@ -662,6 +672,7 @@ all =
                                 jobId =
                                     { jobName = "job"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = "team"
                                     }
 
@@ -744,6 +755,7 @@ all =
                         jobId =
                             { jobName = "job"
                             , pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , teamName = "team"
                             }
 
@@ -16,6 +16,7 @@ all =
             let
                 pipeline =
                     { pipelineName = "pipeline"
+                    , pipelineInstanceVars = Dict.empty
                     , teamName = "team"
                     }
 
@@ -3,6 +3,7 @@ module PipelineTests exposing (all)
 import Application.Application as Application
 import Char
 import Common exposing (defineHoverBehaviour)
+import Dict
 import Expect exposing (..)
 import Html.Attributes as Attr
 import Json.Encode
@@ -89,6 +90,7 @@ all =
                                 (Ok
                                     { id = 0
                                     , name = "pipeline"
+                                    , instanceVars = Dict.empty
                                     , paused = False
                                     , public = True
                                     , teamName = "team"
@@ -309,6 +311,7 @@ all =
                         { pipelineLocator =
                             { teamName = "some-team"
                             , pipelineName = "some-pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             }
                         , turbulenceImgSrc = "some-turbulence-img-src"
                         , selectedGroups = []
@@ -376,6 +379,7 @@ all =
                             (Effects.FetchPipeline
                                 { teamName = "team"
                                 , pipelineName = "pipeline"
+                                , pipelineInstanceVars = Dict.empty
                                 }
                             )
                         |> Expect.true "should refresh pipeline"
@@ -862,6 +866,7 @@ all =
                                         { id =
                                             { teamName = "team"
                                             , pipelineName = "pipeline"
+                                            , pipelineInstanceVars = Dict.empty
                                             , resourceName = "resource"
                                             }
                                         , page = Nothing
@@ -955,6 +960,7 @@ all =
                                 (Ok
                                     { id = 0
                                     , name = "pipeline"
+                                    , instanceVars = Dict.empty
                                     , paused = True
                                     , public = True
                                     , teamName = "team"
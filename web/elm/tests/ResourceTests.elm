@@ -75,6 +75,7 @@ versionID : Models.VersionId
 versionID =
     { teamName = teamName
     , pipelineName = pipelineName
+    , pipelineInstanceVars = Dict.empty
     , resourceName = resourceName
     , versionID = 1
     }
@@ -84,6 +85,7 @@ otherVersionID : Models.VersionId
 otherVersionID =
     { teamName = teamName
     , pipelineName = pipelineName
+    , pipelineInstanceVars = Dict.empty
     , resourceName = resourceName
     , versionID = 2
     }
@@ -93,6 +95,7 @@ disabledVersionID : Models.VersionId
 disabledVersionID =
     { teamName = teamName
     , pipelineName = pipelineName
+    , pipelineInstanceVars = Dict.empty
     , resourceName = resourceName
     , versionID = 3
     }
@@ -252,6 +255,7 @@ all =
                             (Effects.FetchResource
                                 { resourceName = resourceName
                                 , pipelineName = pipelineName
+                                , pipelineInstanceVars = Dict.empty
                                 , teamName = teamName
                                 }
                             )
@@ -260,6 +264,7 @@ all =
                             (Effects.FetchVersionedResources
                                 { resourceName = resourceName
                                 , pipelineName = pipelineName
+                                , pipelineInstanceVars = Dict.empty
                                 , teamName = teamName
                                 }
                                 Nothing
@@ -300,6 +305,7 @@ all =
                                         Just
                                             { teamName = teamName
                                             , pipelineName = pipelineName
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "some-job"
                                             }
                                     , status = Concourse.BuildStatusSucceeded
@@ -338,6 +344,7 @@ all =
                                         Just
                                             { teamName = teamName
                                             , pipelineName = pipelineName
+                                            , pipelineInstanceVars = Dict.empty
                                             , jobName = "some-job"
                                             }
                                     , status = Concourse.BuildStatusSucceeded
@@ -1269,6 +1276,7 @@ all =
                             [ Effects.FetchResource
                                 { resourceName = resourceName
                                 , pipelineName = pipelineName
+                                , pipelineInstanceVars = Dict.empty
                                 , teamName = teamName
                                 }
                             ]
@@ -1782,6 +1790,7 @@ all =
                                             [ Effects.SetPinComment
                                                 { teamName = teamName
                                                 , pipelineName = pipelineName
+                                                , pipelineInstanceVars = Dict.empty
                                                 , resourceName = resourceName
                                                 }
                                                 "foo"
@@ -1799,6 +1808,7 @@ all =
                                             [ Effects.SetPinComment
                                                 { teamName = teamName
                                                 , pipelineName = pipelineName
+                                                , pipelineInstanceVars = Dict.empty
                                                 , resourceName = resourceName
                                                 }
                                                 "foo"
@@ -1869,6 +1879,7 @@ all =
                                             [ Effects.SetPinComment
                                                 { teamName = teamName
                                                 , pipelineName = pipelineName
+                                                , pipelineInstanceVars = Dict.empty
                                                 , resourceName = resourceName
                                                 }
                                                 "foo"
@@ -1963,6 +1974,7 @@ all =
                                                 [ Effects.FetchResource
                                                     { teamName = teamName
                                                     , pipelineName = pipelineName
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , resourceName = resourceName
                                                     }
                                                 ]
@@ -2017,6 +2029,7 @@ all =
                                                 [ Effects.FetchResource
                                                     { teamName = teamName
                                                     , pipelineName = pipelineName
+                                                    , pipelineInstanceVars = Dict.empty
                                                     , resourceName = resourceName
                                                     }
                                                 ]
@@ -2410,6 +2423,7 @@ all =
                                     [ Effects.SetPinComment
                                         { teamName = teamName
                                         , pipelineName = pipelineName
+                                        , pipelineInstanceVars = Dict.empty
                                         , resourceName = resourceName
                                         }
                                         "pinned by some-user at Jan 1 1970 12:00:00 AM"
@@ -2727,6 +2741,7 @@ all =
                                 [ Effects.DoCheck
                                     { resourceName = resourceName
                                     , pipelineName = pipelineName
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = teamName
                                     }
                                 ]
@@ -2830,7 +2845,7 @@ all =
                                     Message.Message.CheckButton True
                                 )
                             |> Tuple.first
-                            |> Application.handleCallback (Callback.Checked <| Ok ())
+                            |> Application.handleCallback (Callback.Checked <| Ok 1)
                             |> Tuple.first
                             |> checkBar (UserStateLoggedIn sampleUser)
                             |> Query.children []
@@ -2842,6 +2857,47 @@ all =
                                     }
                                     ++ [ style "background-size" "14px 14px" ]
                                 )
+                , test "shows the id of the resource's latest check" <|
+                    \_ ->
+                        init
+                            |> givenResourceWithLatestCheck
+                            |> Tuple.first
+                            |> queryView
+                            |> Query.find [ id "latest-check-id" ]
+                            |> Query.has [ text "check #42" ]
+                , test "fetches the resource's checks when it has a new latest check" <|
+                    \_ ->
+                        init
+                            |> givenResourceWithLatestCheck
+                            |> Tuple.second
+                            |> Expect.equal
+                                [ Effects.FetchResourceChecks
+                                    { resourceName = resourceName
+                                    , pipelineName = pipelineName
+                                    , pipelineInstanceVars = Dict.empty
+                                    , teamName = teamName
+                                    }
+                                ]
+                , test "lists the resource's recent checks" <|
+                    \_ ->
+                        init
+                            |> givenResourceIsNotPinned
+                            |> Application.handleCallback
+                                (Callback.ResourceChecksFetched <|
+                                    Ok
+                                        [ { id = 42
+                                          , status = Concourse.CheckStatusErrored
+                                          , createdBy = "interval"
+                                          , createTime = Nothing
+                                          , endTime = Nothing
+                                          , checkError = Just "nope"
+                                          }
+                                        ]
+                                )
+                            |> Tuple.first
+                            |> queryView
+                            |> Query.find [ id "recent-checks" ]
+                            |> Query.has [ text "#42", text "errored", text "interval" ]
                 , test "when check resolves successfully, resource and versions refresh" <|
                     \_ ->
                         init
@@ -2852,21 +2908,48 @@ all =
                                     Message.Message.CheckButton True
                                 )
                             |> Tuple.first
-                            |> Application.handleCallback (Callback.Checked <| Ok ())
+                            |> Application.handleCallback (Callback.Checked <| Ok 1)
                             |> Tuple.second
                             |> Expect.equal
                                 [ Effects.FetchResource
                                     { resourceName = resourceName
                                     , pipelineName = pipelineName
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = teamName
                                     }
                                 , Effects.FetchVersionedResources
                                     { resourceName = resourceName
                                     , pipelineName = pipelineName
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = teamName
                                     }
                                     Nothing
+                                , Effects.OpenBuildEventStream
+                                    { url = "/api/v1/checks/1/events"
+                                    , eventTypes = [ "end", "event" ]
+                                    }
                                 ]
+                , test "when check resolves successfully, listens for the check's events" <|
+                    \_ ->
+                        init
+                            |> givenResourceIsNotPinned
+                            |> givenUserIsAuthorized
+                            |> update
+                                (Message.Message.Click <|
+                                    Message.Message.CheckButton True
+                                )
+                            |> Tuple.first
+                            |> Application.handleCallback (Callback.Checked <| Ok 1)
+                            |> Tuple.first
+                            |> Application.subscriptions
+                            |> List.member
+                                (Subscription.FromEventSource
+                                    ( "/api/v1/checks/1/events"
+                                    , [ "end", "event" ]
+                                    )
+                                )
+                            |> Expect.true
+                                "why aren't we listening for check events!?"
                 , test "when check resolves unsuccessfully, status is error" <|
                     \_ ->
                         init
@@ -2929,6 +3012,7 @@ all =
                                 [ Effects.FetchResource
                                     { resourceName = resourceName
                                     , pipelineName = pipelineName
+                                    , pipelineInstanceVars = Dict.empty
                                     , teamName = teamName
                                     }
                                 ]
@@ -3029,6 +3113,7 @@ all =
                                     Ok
                                         { teamName = teamName
                                         , pipelineName = pipelineName
+                                        , pipelineInstanceVars = Dict.empty
                                         , name = resourceName
                                         , failingToCheck = False
                                         , checkError = ""
@@ -3037,6 +3122,7 @@ all =
                                         , pinnedVersion = Nothing
                                         , pinnedInConfig = False
                                         , pinComment = Nothing
+                                        , latestCheckId = Nothing
                                         , icon = Nothing
                                         }
                                 )
@@ -3058,6 +3144,7 @@ all =
                                     Ok
                                         { teamName = teamName
                                         , pipelineName = pipelineName
+                                        , pipelineInstanceVars = Dict.empty
                                         , name = resourceName
                                         , failingToCheck = False
                                         , checkError = ""
@@ -3068,6 +3155,7 @@ all =
                                         , pinnedVersion = Nothing
                                         , pinnedInConfig = False
                                         , pinComment = Nothing
+                                        , latestCheckId = Nothing
                                         , icon = Nothing
                                         }
                                 )
@@ -3098,6 +3186,7 @@ all =
                                 Ok
                                     { teamName = teamName
                                     , pipelineName = pipelineName
+                                    , pipelineInstanceVars = Dict.empty
                                     , name = resourceName
                                     , failingToCheck = True
                                     , checkError = "some error"
@@ -3106,6 +3195,7 @@ all =
                                     , pinnedVersion = Nothing
                                     , pinnedInConfig = False
                                     , pinComment = Nothing
+                                    , latestCheckId = Nothing
                                     , icon = Nothing
                                     }
                             )
@@ -3178,6 +3268,28 @@ givenUserIsAuthorized =
         >> Tuple.first
 
 
+givenResourceWithLatestCheck : Application.Model -> ( Application.Model, List Effects.Effect )
+givenResourceWithLatestCheck =
+    Application.handleCallback
+        (Callback.ResourceFetched <|
+            Ok
+                { teamName = teamName
+                , pipelineName = pipelineName
+                , pipelineInstanceVars = Dict.empty
+                , name = resourceName
+                , failingToCheck = False
+                , checkError = ""
+                , checkSetupError = ""
+                , lastChecked = Nothing
+                , pinnedVersion = Nothing
+                , pinnedInConfig = False
+                , pinComment = Nothing
+                , latestCheckId = Just 42
+                , icon = Nothing
+                }
+        )
+
+
 givenResourcePinnedStatically : Application.Model -> Application.Model
 givenResourcePinnedStatically =
     Application.handleCallback
@@ -3185,6 +3297,7 @@ givenResourcePinnedStatically =
             Ok
                 { teamName = teamName
                 , pipelineName = pipelineName
+                , pipelineInstanceVars = Dict.empty
                 , name = resourceName
                 , failingToCheck = False
                 , checkError = ""
@@ -3193,6 +3306,7 @@ givenResourcePinnedStatically =
                 , pinnedVersion = Just (Dict.fromList [ ( "version", version ) ])
                 , pinnedInConfig = True
                 , pinComment = Nothing
+                , latestCheckId = Nothing
                 , icon = Nothing
                 }
         )
@@ -3206,6 +3320,7 @@ givenResourcePinnedDynamically =
             Ok
                 { teamName = teamName
                 , pipelineName = pipelineName
+                , pipelineInstanceVars = Dict.empty
                 , name = resourceName
                 , failingToCheck = False
                 , checkError = ""
@@ -3214,6 +3329,7 @@ givenResourcePinnedDynamically =
                 , pinnedVersion = Just (Dict.fromList [ ( "version", version ) ])
                 , pinnedInConfig = False
                 , pinComment = Nothing
+                , latestCheckId = Nothing
                 , icon = Nothing
                 }
         )
@@ -3227,6 +3343,7 @@ givenResourcePinnedWithComment =
             Ok
                 { teamName = teamName
                 , pipelineName = pipelineName
+                , pipelineInstanceVars = Dict.empty
                 , name = resourceName
                 , failingToCheck = False
                 , checkError = ""
@@ -3236,6 +3353,7 @@ givenResourcePinnedWithComment =
                     Just (Dict.fromList [ ( "version", version ) ])
                 , pinnedInConfig = False
                 , pinComment = Just "some pin comment"
+                , latestCheckId = Nothing
                 , icon = Nothing
                 }
         )
@@ -3249,6 +3367,7 @@ givenResourceIsNotPinned =
             Ok
                 { teamName = teamName
                 , pipelineName = pipelineName
+                , pipelineInstanceVars = Dict.empty
                 , name = resourceName
                 , failingToCheck = False
                 , checkError = ""
@@ -3257,6 +3376,7 @@ givenResourceIsNotPinned =
                 , pinnedVersion = Nothing
                 , pinnedInConfig = False
                 , pinComment = Nothing
+                , latestCheckId = Nothing
                 , icon = Nothing
                 }
         )
@@ -3270,6 +3390,7 @@ givenResourceHasIcon =
             Ok
                 { teamName = teamName
                 , pipelineName = pipelineName
+                , pipelineInstanceVars = Dict.empty
                 , name = resourceName
                 , failingToCheck = False
                 , checkError = ""
@@ -3278,6 +3399,7 @@ givenResourceHasIcon =
                 , pinnedVersion = Nothing
                 , pinnedInConfig = False
                 , pinComment = Nothing
+                , latestCheckId = Nothing
                 , icon = Just resourceIcon
                 }
         )
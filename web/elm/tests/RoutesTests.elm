@@ -1,22 +1,63 @@
 module RoutesTests exposing (all)
 
+import Dict
 import Expect
 import Routes
-import Test exposing (Test, test)
+import Test exposing (Test, describe, test)
 import Url
 
 
 all : Test
 all =
-    test "parses dashboard search query" <|
-        \_ ->
-            Routes.parsePath
-                { protocol = Url.Http
-                , host = ""
-                , port_ = Nothing
-                , path = "/"
-                , query = Just "search=asdf"
-                , fragment = Nothing
-                }
-                |> Expect.equal
-                    (Just (Routes.Dashboard (Routes.Normal (Just "asdf"))))
+    describe "Routes"
+        [ test "parses dashboard search query" <|
+            \_ ->
+                Routes.parsePath
+                    { protocol = Url.Http
+                    , host = ""
+                    , port_ = Nothing
+                    , path = "/"
+                    , query = Just "search=asdf"
+                    , fragment = Nothing
+                    }
+                    |> Expect.equal
+                        (Just (Routes.Dashboard (Routes.Normal (Just "asdf"))))
+        , test "parses the instance vars of a pipeline" <|
+            \_ ->
+                Routes.parsePath
+                    { protocol = Url.Http
+                    , host = ""
+                    , port_ = Nothing
+                    , path = "/teams/team/pipelines/pipeline"
+                    , query = Just "instance_vars=%7B%22branch%22%3A%22feature%22%7D"
+                    , fragment = Nothing
+                    }
+                    |> Expect.equal
+                        (Just
+                            (Routes.Pipeline
+                                { id =
+                                    { teamName = "team"
+                                    , pipelineName = "pipeline"
+                                    , pipelineInstanceVars =
+                                        Dict.fromList [ ( "branch", "\"feature\"" ) ]
+                                    }
+                                , groups = []
+                                }
+                            )
+                        )
+        , test "includes the instance vars of a job's pipeline" <|
+            \_ ->
+                Routes.Job
+                    { id =
+                        { teamName = "team"
+                        , pipelineName = "pipeline"
+                        , pipelineInstanceVars =
+                            Dict.fromList [ ( "branch", "\"feature\"" ) ]
+                        , jobName = "job"
+                        }
+                    , page = Nothing
+                    }
+                    |> Routes.toString
+                    |> Expect.equal
+                        "/teams/team/pipelines/pipeline/jobs/job?instance_vars=%7B%22branch%22%3A%22feature%22%7D"
+        ]
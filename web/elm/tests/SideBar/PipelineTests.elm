@@ -2,6 +2,7 @@ module SideBar.PipelineTests exposing (all)
 
 import Colors
 import Common
+import Dict
 import Expect
 import HoverState
 import Html exposing (Html)
@@ -27,6 +28,7 @@ all =
                                     (SideBarPipeline
                                         { teamName = "team"
                                         , pipelineName = "pipeline"
+                                        , pipelineInstanceVars = Dict.empty
                                         }
                                     )
                                     { left = 0
@@ -38,6 +40,7 @@ all =
                                 Just
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                             }
                             singlePipeline
@@ -147,6 +150,7 @@ pipeline { active, hovered } =
         pipelineIdentifier =
             { teamName = "team"
             , pipelineName = "pipeline"
+            , pipelineInstanceVars = Dict.empty
             }
 
         hoveredDomId =
@@ -173,6 +177,7 @@ pipeline { active, hovered } =
 singlePipeline =
     { id = 1
     , name = "pipeline"
+    , instanceVars = Dict.empty
     , paused = False
     , public = True
     , teamName = "team"
@@ -1,6 +1,7 @@
 module SideBar.TeamTests exposing (all)
 
 import Common
+import Dict
 import Expect
 import HoverState
 import Html exposing (Html)
@@ -32,6 +33,7 @@ all =
                                 , pipelines =
                                     [ { id = 0
                                       , name = "pipeline"
+                                      , instanceVars = Dict.empty
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
@@ -42,6 +44,7 @@ all =
                                     Just
                                         { teamName = "team"
                                         , pipelineName = "pipeline"
+                                        , pipelineInstanceVars = Dict.empty
                                         }
                                 }
                                 { name = "team"
@@ -422,6 +425,7 @@ team { active, expanded, hovered } =
         pipelines =
             [ { id = 1
               , name = "pipeline"
+              , instanceVars = Dict.empty
               , paused = False
               , public = True
               , teamName = "team"
@@ -431,7 +435,7 @@ team { active, expanded, hovered } =
 
         activePipeline =
             if active then
-                Just { teamName = "team", pipelineName = "pipeline" }
+                Just { teamName = "team", pipelineName = "pipeline", pipelineInstanceVars = Dict.empty }
 
             else
                 Nothing
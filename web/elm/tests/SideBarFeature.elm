@@ -451,6 +451,7 @@ hasSideBar iAmLookingAtThePage =
             , hoverable =
                 Message.SideBarPipeline
                     { pipelineName = "pipeline"
+                    , pipelineInstanceVars = Dict.empty
                     , teamName = "team"
                     }
             , hoveredSelector =
@@ -758,6 +759,7 @@ apiDataLoads =
                       , pipelines =
                             [ { id = 0
                               , name = "pipeline"
+                              , instanceVars = Dict.empty
                               , paused = False
                               , public = True
                               , teamName = "team"
@@ -765,6 +767,7 @@ apiDataLoads =
                               }
                             , { id = 1
                               , name = "other-pipeline"
+                              , instanceVars = Dict.empty
                               , paused = False
                               , public = True
                               , teamName = "team"
@@ -789,6 +792,7 @@ dataRefreshes =
                 Ok
                     [ { id = 0
                       , name = "pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -796,6 +800,7 @@ dataRefreshes =
                       }
                     , { id = 1
                       , name = "other-pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -1061,6 +1066,7 @@ iHoveredTheFirstPipelineLink =
                         Message.SideBarPipeline
                             { teamName = "team"
                             , pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             }
             )
 
@@ -1247,6 +1253,7 @@ thePipelineIsPaused =
                 (Ok
                     { id = 1
                     , name = "pipeline"
+                    , instanceVars = Dict.empty
                     , paused = True
                     , public = True
                     , teamName = "team"
@@ -1310,6 +1317,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                 Ok
                     [ { id = 0
                       , name = "pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -1317,6 +1325,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                       }
                     , { id = 1
                       , name = "other-pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -1324,6 +1333,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                       }
                     , { id = 2
                       , name = "yet-another-pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -1331,6 +1341,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                       }
                     , { id = 3
                       , name = "yet-another-pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "other-team"
@@ -1347,6 +1358,7 @@ myBrowserFetchedPipelines =
                 Ok
                     [ { id = 0
                       , name = "pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -1354,6 +1366,7 @@ myBrowserFetchedPipelines =
                       }
                     , { id = 1
                       , name = "other-pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -1448,6 +1461,7 @@ iHoveredThePipelineLink =
                     Just <|
                         Message.SideBarPipeline
                             { pipelineName = "pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , teamName = "team"
                             }
             )
@@ -1555,6 +1569,7 @@ iClickAPipelineLink =
                         { groups = []
                         , id =
                             { pipelineName = "other-pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , teamName = "team"
                             }
                         }
@@ -1587,6 +1602,7 @@ iNavigateBackToThePipelinePage =
                         { groups = []
                         , id =
                             { pipelineName = "yet-another-pipeline"
+                            , pipelineInstanceVars = Dict.empty
                             , teamName = "other-team"
                             }
                         }
@@ -1657,6 +1673,7 @@ iAmLookingAtAOneOffBuildPageOnANonPhoneScreen =
                 (Ok
                     [ { id = 0
                       , name = "pipeline"
+                      , instanceVars = Dict.empty
                       , paused = False
                       , public = True
                       , teamName = "team"
@@ -1,6 +1,7 @@
 module SideBarTests exposing (all)
 
 import Browser.Dom
+import Dict
 import Expect
 import HoverState
 import Message.Callback as Callback
@@ -40,6 +41,7 @@ all =
                                 SideBarPipeline
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                       }
                     , []
@@ -54,6 +56,7 @@ all =
                                 (SideBarPipeline
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                                 )
                             )
@@ -65,6 +68,7 @@ all =
                                 SideBarPipeline
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                       }
                     , []
@@ -83,6 +87,7 @@ all =
                                 SideBarPipeline
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                       }
                     , []
@@ -97,6 +102,7 @@ all =
                                 SideBarPipeline
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                             )
             , test "callback with tooltip position turns pending -> tooltip" <|
@@ -107,6 +113,7 @@ all =
                                 (SideBarPipeline
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                                 )
                       }
@@ -122,6 +129,7 @@ all =
                                 (SideBarPipeline
                                     { teamName = "team"
                                     , pipelineName = "pipeline"
+                                    , pipelineInstanceVars = Dict.empty
                                     }
                                 )
                                 { left = 1
@@ -192,6 +200,7 @@ model =
         RemoteData.Success
             [ { id = 0
               , name = "pipeline"
+              , instanceVars = Dict.empty
               , paused = False
               , public = True
               , teamName = "team"
@@ -209,4 +218,5 @@ domID =
     SideBarPipeline
         { teamName = "team"
         , pipelineName = "pipeline"
+        , pipelineInstanceVars = Dict.empty
         }
@@ -45,6 +45,7 @@ all =
                                     { id =
                                         { teamName = "t"
                                         , pipelineName = "p"
+                                        , pipelineInstanceVars = Dict.empty
                                         , jobName = "j"
                                         }
                                     , page = Nothing
@@ -65,6 +66,7 @@ all =
                                     { id =
                                         { teamName = "t"
                                         , pipelineName = "p"
+                                        , pipelineInstanceVars = Dict.empty
                                         , resourceName = "r"
                                         }
                                     , page = Nothing
@@ -100,6 +102,7 @@ all =
                                     { id =
                                         { teamName = "t"
                                         , pipelineName = "p"
+                                        , pipelineInstanceVars = Dict.empty
                                         }
                                     , groups = []
                                     }
@@ -301,6 +301,7 @@ all =
                                 { id =
                                     { teamName = "t"
                                     , pipelineName = "p"
+                                    , pipelineInstanceVars = Dict.empty
                                     , resourceName = "r"
                                     }
                                 , page = Nothing
@@ -315,6 +316,7 @@ all =
                                     { id =
                                         { teamName = "t"
                                         , pipelineName = "p"
+                                        , pipelineInstanceVars = Dict.empty
                                         , resourceName = "r"
                                         }
                                     , page = Nothing
@@ -326,6 +328,7 @@ all =
                         Ok
                             { id = 0
                             , name = "p"
+                            , instanceVars = Dict.empty
                             , paused = True
                             , public = True
                             , teamName = "t"
@@ -1082,6 +1085,7 @@ all =
                               , pipelines =
                                     [ { id = 0
                                       , name = "pipeline"
+                                      , instanceVars = Dict.empty
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
@@ -1319,6 +1323,7 @@ all =
                                 Ok
                                     { id = 0
                                     , name = "p"
+                                    , instanceVars = Dict.empty
                                     , paused = True
                                     , public = True
                                     , teamName = "t"
@@ -1359,6 +1364,7 @@ all =
 
                 pipelineIdentifier =
                     { pipelineName = "p"
+                    , pipelineInstanceVars = Dict.empty
                     , teamName = "t"
                     }
 
@@ -1401,7 +1407,7 @@ all =
                                 }
                     }
                 , hoverable =
-                    Msgs.PipelineButton { pipelineName = "p", teamName = "t" }
+                    Msgs.PipelineButton { pipelineName = "p", pipelineInstanceVars = Dict.empty, teamName = "t" }
                 }
             , defineHoverBehaviour
                 { name = "play pipeline icon when unauthenticated"
@@ -1436,7 +1442,7 @@ all =
                                 }
                     }
                 , hoverable =
-                    Msgs.PipelineButton { pipelineName = "p", teamName = "t" }
+                    Msgs.PipelineButton { pipelineName = "p", pipelineInstanceVars = Dict.empty, teamName = "t" }
                 }
             , defineHoverBehaviour
                 { name = "play pipeline icon when unauthorized"
@@ -1479,7 +1485,7 @@ all =
                         ]
                     }
                 , hoverable =
-                    Msgs.PipelineButton { pipelineName = "p", teamName = "t" }
+                    Msgs.PipelineButton { pipelineName = "p", pipelineInstanceVars = Dict.empty, teamName = "t" }
                 }
             , test "clicking play button sends TogglePipelinePaused msg" <|
                 \_ ->
@@ -1635,6 +1641,7 @@ onePipeline : String -> Concourse.Pipeline
 onePipeline teamName =
     { id = 0
     , name = "pipeline"
+    , instanceVars = Dict.empty
     , paused = False
     , public = True
     , teamName = teamName
@@ -311,6 +311,15 @@ function resetPipelineFocus() {
   return g
 }
 
+// identifies the instance of an instanced pipeline in its URLs
+function instanceVarsQuery(instanceVars) {
+  if (!instanceVars || Object.keys(instanceVars).length === 0) {
+    return "";
+  }
+
+  return "?instance_vars="+encodeURIComponent(JSON.stringify(instanceVars));
+}
+
 function createGraph(svg, jobs, resources) {
   var graph = new Graph();
 
@@ -321,7 +330,7 @@ function createGraph(svg, jobs, resources) {
 
   for (var i in resources) {
     var resource = resources[i];
-    resourceURLs[resource.name] = "/teams/"+resource.team_name+"/pipelines/"+resource.pipeline_name+"/resources/"+encodeURIComponent(resource.name);
+    resourceURLs[resource.name] = "/teams/"+resource.team_name+"/pipelines/"+resource.pipeline_name+"/resources/"+encodeURIComponent(resource.name)+instanceVarsQuery(resource.pipeline_instance_vars);
     resourceFailing[resource.name] = resource.failing_to_check;
     resourcePinned[resource.name] = resource.pinned_version;
     resourceIcons[resource.name] = resource.icon;
@@ -334,13 +343,13 @@ function createGraph(svg, jobs, resources) {
 
     var classes = ["job"];
 
-    var url = "/teams/"+job.team_name+"/pipelines/"+job.pipeline_name+"/jobs/"+encodeURIComponent(job.name);
+    var url = "/teams/"+job.team_name+"/pipelines/"+job.pipeline_name+"/jobs/"+encodeURIComponent(job.name)+instanceVarsQuery(job.pipeline_instance_vars);
     if (job.next_build) {
       var build = job.next_build
-      url = "/teams/"+build.team_name+"/pipelines/"+build.pipeline_name+"/jobs/"+encodeURIComponent(build.job_name)+"/builds/"+build.name;
+      url = "/teams/"+build.team_name+"/pipelines/"+build.pipeline_name+"/jobs/"+encodeURIComponent(build.job_name)+"/builds/"+build.name+instanceVarsQuery(build.pipeline_instance_vars);
     } else if (job.finished_build) {
       var build = job.finished_build
-      url = "/teams/"+build.team_name+"/pipelines/"+build.pipeline_name+"/jobs/"+encodeURIComponent(build.job_name)+"/builds/"+build.name;
+      url = "/teams/"+build.team_name+"/pipelines/"+build.pipeline_name+"/jobs/"+encodeURIComponent(build.job_name)+"/builds/"+build.name+instanceVarsQuery(build.pipeline_instance_vars);
     }
 
     var status;